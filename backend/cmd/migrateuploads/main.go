@@ -0,0 +1,89 @@
+// Command migrateuploads moves the files of the local upload paths into the bucket of the S3 storage,
+// keeping their path as object key. Run it from the folder of taniad with the same config,
+// once upload_storage is s3. Files are removed locally only once they are in the bucket,
+// so it can be run again after a failure.
+package main
+
+import (
+	"errors"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/pflag"
+	"github.com/usetania/tania-core/config"
+	"github.com/usetania/tania-core/src/helper/filehelper"
+)
+
+func main() {
+	dryRun := pflag.Bool("dry_run", false, "List the files to move without moving them")
+
+	err := config.InitViperConfig()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *config.Config.UploadStorage != config.StorageS3 {
+		log.Fatal("Set upload_storage to " + config.StorageS3 + " to migrate the uploads to the bucket")
+	}
+
+	file := filehelper.NewFile()
+
+	uploadPaths := []string{
+		*config.Config.UploadPathArea,
+		*config.Config.UploadPathCrop,
+		*config.Config.UploadPathReservoir,
+	}
+
+	moved := 0
+
+	for _, uploadPath := range uploadPaths {
+		err := filepath.WalkDir(uploadPath, func(srcPath string, d fs.DirEntry, err error) error {
+			if err != nil {
+				// An upload path without any file yet.
+				if srcPath == uploadPath && errors.Is(err, fs.ErrNotExist) {
+					return nil
+				}
+
+				return err
+			}
+
+			if d.IsDir() {
+				return nil
+			}
+
+			if *dryRun {
+				log.Println("Would move", srcPath)
+
+				return nil
+			}
+
+			content, err := os.ReadFile(srcPath)
+			if err != nil {
+				return err
+			}
+
+			err = file.WriteFile(filepath.ToSlash(srcPath), content)
+			if err != nil {
+				return err
+			}
+
+			err = os.Remove(srcPath)
+			if err != nil {
+				return err
+			}
+
+			log.Println("Moved", srcPath)
+
+			moved++
+
+			return nil
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	log.Println("Moved", moved, "files to the bucket", *config.Config.S3Bucket)
+}
@@ -22,9 +22,12 @@ import (
 	"github.com/usetania/tania-core/src/eventbus"
 	growthserver "github.com/usetania/tania-core/src/growth/server"
 	growthstorage "github.com/usetania/tania-core/src/growth/storage"
+	livestockserver "github.com/usetania/tania-core/src/livestock/server"
+	livestockstorage "github.com/usetania/tania-core/src/livestock/storage"
 	locationserver "github.com/usetania/tania-core/src/location/server"
 	tasksserver "github.com/usetania/tania-core/src/tasks/server"
 	taskstorage "github.com/usetania/tania-core/src/tasks/storage"
+	userdomain "github.com/usetania/tania-core/src/user/domain"
 	userserver "github.com/usetania/tania-core/src/user/server"
 )
 
@@ -65,6 +68,11 @@ func main() {
 		inMem.reservoirReadStorage,
 		inMem.materialEventStorage,
 		inMem.materialReadStorage,
+		inMem.supplierEventStorage,
+		inMem.supplierReadStorage,
+		inMem.materialTypeCatalogStorage,
+		inMem.equipmentEventStorage,
+		inMem.equipmentReadStorage,
 		inMem.cropReadStorage,
 		bus,
 	)
@@ -79,6 +87,8 @@ func main() {
 		inMem.areaReadStorage,
 		inMem.materialReadStorage,
 		inMem.reservoirReadStorage,
+		inMem.equipmentReadStorage,
+		inMem.animalReadStorage,
 		inMem.taskEventStorage,
 		inMem.taskReadStorage,
 	)
@@ -86,6 +96,21 @@ func main() {
 		e.Logger.Fatal(err)
 	}
 
+	taskServer.StartLowStockChecker(time.Duration(*config.Config.LowStockCheckInterval) * time.Minute)
+	taskServer.StartExpirationChecker(
+		time.Duration(*config.Config.ExpirationCheckInterval)*time.Minute,
+		*config.Config.ExpirationWarningDays,
+	)
+	taskServer.StartReservoirChecker(
+		time.Duration(*config.Config.ReservoirCheckInterval)*time.Minute,
+		*config.Config.ReservoirDryWarningDays,
+	)
+	taskServer.StartWaterQualityChecker(time.Duration(*config.Config.WaterQualityCheckInterval) * time.Minute)
+	taskServer.StartEquipmentChecker(
+		time.Duration(*config.Config.EquipmentCheckInterval)*time.Minute,
+		*config.Config.EquipmentMaintenanceWarningDays,
+	)
+
 	growthServer, err := growthserver.NewGrowthServer(
 		db,
 		bus,
@@ -101,6 +126,19 @@ func main() {
 		e.Logger.Fatal(err)
 	}
 
+	livestockServer, err := livestockserver.NewLivestockServer(
+		db,
+		bus,
+		inMem.animalEventStorage,
+		inMem.animalReadStorage,
+		inMem.farmReadStorage,
+		inMem.areaReadStorage,
+		inMem.materialReadStorage,
+	)
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+
 	userServer, err := userserver.NewUserServer(db, bus)
 	if err != nil {
 		e.Logger.Fatal(err)
@@ -147,6 +185,7 @@ func main() {
 	farmGroup := API.Group("/farms", APIMiddlewares...)
 	farmServer.Mount(farmGroup)
 	growthServer.Mount(farmGroup)
+	livestockServer.Mount(farmGroup)
 
 	taskGroup := API.Group("/tasks", APIMiddlewares...)
 	taskServer.Mount(taskGroup)
@@ -189,19 +228,26 @@ func headerNoCache(next echo.HandlerFunc) echo.HandlerFunc {
 }
 
 type InMemory struct {
-	farmEventStorage      *assetsstorage.FarmEventStorage
-	farmReadStorage       *assetsstorage.FarmReadStorage
-	areaEventStorage      *assetsstorage.AreaEventStorage
-	areaReadStorage       *assetsstorage.AreaReadStorage
-	reservoirEventStorage *assetsstorage.ReservoirEventStorage
-	reservoirReadStorage  *assetsstorage.ReservoirReadStorage
-	materialEventStorage  *assetsstorage.MaterialEventStorage
-	materialReadStorage   *assetsstorage.MaterialReadStorage
-	cropEventStorage      *growthstorage.CropEventStorage
-	cropReadStorage       *growthstorage.CropReadStorage
-	cropActivityStorage   *growthstorage.CropActivityStorage
-	taskEventStorage      *taskstorage.TaskEventStorage
-	taskReadStorage       *taskstorage.TaskReadStorage
+	farmEventStorage           *assetsstorage.FarmEventStorage
+	farmReadStorage            *assetsstorage.FarmReadStorage
+	areaEventStorage           *assetsstorage.AreaEventStorage
+	areaReadStorage            *assetsstorage.AreaReadStorage
+	reservoirEventStorage      *assetsstorage.ReservoirEventStorage
+	reservoirReadStorage       *assetsstorage.ReservoirReadStorage
+	materialEventStorage       *assetsstorage.MaterialEventStorage
+	materialReadStorage        *assetsstorage.MaterialReadStorage
+	supplierEventStorage       *assetsstorage.SupplierEventStorage
+	supplierReadStorage        *assetsstorage.SupplierReadStorage
+	materialTypeCatalogStorage *assetsstorage.MaterialTypeCatalogStorage
+	equipmentEventStorage      *assetsstorage.EquipmentEventStorage
+	equipmentReadStorage       *assetsstorage.EquipmentReadStorage
+	cropEventStorage           *growthstorage.CropEventStorage
+	cropReadStorage            *growthstorage.CropReadStorage
+	cropActivityStorage        *growthstorage.CropActivityStorage
+	animalEventStorage         *livestockstorage.AnimalEventStorage
+	animalReadStorage          *livestockstorage.AnimalReadStorage
+	taskEventStorage           *taskstorage.TaskEventStorage
+	taskReadStorage            *taskstorage.TaskReadStorage
 }
 
 func initInMemory() *InMemory {
@@ -218,10 +264,21 @@ func initInMemory() *InMemory {
 		materialEventStorage: assetsstorage.CreateMaterialEventStorage(),
 		materialReadStorage:  assetsstorage.CreateMaterialReadStorage(),
 
+		supplierEventStorage: assetsstorage.CreateSupplierEventStorage(),
+		supplierReadStorage:  assetsstorage.CreateSupplierReadStorage(),
+
+		materialTypeCatalogStorage: assetsstorage.CreateMaterialTypeCatalogStorage(),
+
+		equipmentEventStorage: assetsstorage.CreateEquipmentEventStorage(),
+		equipmentReadStorage:  assetsstorage.CreateEquipmentReadStorage(),
+
 		cropEventStorage:    growthstorage.CreateCropEventStorage(),
 		cropReadStorage:     growthstorage.CreateCropReadStorage(),
 		cropActivityStorage: growthstorage.CreateCropActivityStorage(),
 
+		animalEventStorage: livestockstorage.CreateAnimalEventStorage(),
+		animalReadStorage:  livestockstorage.CreateAnimalReadStorage(),
+
 		taskEventStorage: taskstorage.CreateTaskEventStorage(),
 		taskReadStorage:  taskstorage.CreateTaskReadStorage(),
 	}
@@ -339,6 +396,11 @@ func tokenValidationWithConfig(db *sql.DB) echo.MiddlewareFunc {
 				return c.JSON(http.StatusUnauthorized, map[string]string{"data": "Unauthorized"})
 			}
 
+			// SQLite gives back the UID as text when it was saved as text.
+			if s, ok := uid.(string); ok {
+				uid = []byte(s)
+			}
+
 			ubyte, ok := uid.([]byte)
 			if !ok {
 				return c.JSON(http.StatusInternalServerError, map[string]string{"data": "Error user UID type assertion"})
@@ -358,11 +420,54 @@ func tokenValidationWithConfig(db *sql.DB) echo.MiddlewareFunc {
 
 			c.Set("USER_UID", userUID)
 
+			if c.Request().Method != http.MethodGet {
+				allowed, err := isChangeAllowed(db, userUID, c.Path())
+				if err != nil {
+					return c.JSON(http.StatusInternalServerError, map[string]string{"data": err.Error()})
+				}
+
+				if !allowed {
+					return c.JSON(http.StatusForbidden, map[string]string{"data": "Forbidden"})
+				}
+			}
+
 			return next(c)
 		}
 	}
 }
 
+// isChangeAllowed checks the roles of the user against the part of the API in the route path, like "farms".
+func isChangeAllowed(db *sql.DB, userUID uuid.UUID, path string) (bool, error) {
+	var param interface{} = userUID
+	if *config.Config.TaniaPersistenceEngine == config.DBMysql {
+		param = userUID.Bytes()
+	}
+
+	rows, err := db.Query(`SELECT ROLE FROM USER_READ_ROLE WHERE USER_UID = ?`, param)
+	if err != nil {
+		return false, err
+	}
+
+	defer rows.Close()
+
+	roles := []string{}
+
+	for rows.Next() {
+		var role string
+
+		err = rows.Scan(&role)
+		if err != nil {
+			return false, err
+		}
+
+		roles = append(roles, role)
+	}
+
+	part := strings.Split(strings.TrimPrefix(strings.TrimPrefix(path, "/"), "api/"), "/")[0]
+
+	return userdomain.AllowsChange(roles, part), nil
+}
+
 func logMiddleware() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
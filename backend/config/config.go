@@ -15,11 +15,18 @@ const (
 	DBMysql    = "mysql"
 )
 
+const (
+	StorageLocal = "local"
+	StorageS3    = "s3"
+)
+
 type Configuration struct {
 	AppPort                *string   `mapstructure:"app_port"`
 	DemoMode               *bool     `mapstructure:"demo_mode"`
 	UploadPathArea         *string   `mapstructure:"upload_path_area"`
 	UploadPathCrop         *string   `mapstructure:"upload_path_crop"`
+	UploadPathReservoir    *string   `mapstructure:"upload_path_reservoir"`
+	UploadStorage          *string   `mapstructure:"upload_storage"`
 	TaniaPersistenceEngine *string   `mapstructure:"tania_persistence_engine"`
 	SqlitePath             *string   `mapstructure:"sqlite_path"`
 	MysqlHost              *string   `mapstructure:"mysql_host"`
@@ -29,6 +36,46 @@ type Configuration struct {
 	MysqlPassword          *string   `mapstructure:"mysql_password"`
 	RedirectURI            []*string `mapstructure:"redirect_uri"`
 	ClientID               *string   `mapstructure:"client_id"`
+
+	// S3 compatible object storage of the uploads, used when UploadStorage is s3.
+	// The upload paths are the prefixes of the object keys.
+	S3Endpoint      *string `mapstructure:"s3_endpoint"`
+	S3Region        *string `mapstructure:"s3_region"`
+	S3Bucket        *string `mapstructure:"s3_bucket"`
+	S3AccessKey     *string `mapstructure:"s3_access_key"`
+	S3SecretKey     *string `mapstructure:"s3_secret_key"`
+	S3PathStyle     *bool   `mapstructure:"s3_path_style"`
+	S3PresignExpiry *int    `mapstructure:"s3_presign_expiry"`
+
+	// Minutes between two checks of the materials below their reorder point. Disabled when zero.
+	LowStockCheckInterval *int `mapstructure:"low_stock_check_interval"`
+
+	// Minutes between two checks of the materials expiring within ExpirationWarningDays. Disabled when zero.
+	ExpirationCheckInterval *int `mapstructure:"expiration_check_interval"`
+	ExpirationWarningDays   *int `mapstructure:"expiration_warning_days"`
+
+	// Minutes between two checks of the buckets predicted to run dry within ReservoirDryWarningDays.
+	// Disabled when zero.
+	ReservoirCheckInterval  *int `mapstructure:"reservoir_check_interval"`
+	ReservoirDryWarningDays *int `mapstructure:"reservoir_dry_warning_days"`
+
+	// Minutes between two checks of the water quality measured outside its acceptable range. Disabled when zero.
+	WaterQualityCheckInterval *int `mapstructure:"water_quality_check_interval"`
+
+	// Minutes between two checks of the equipment maintenances due within EquipmentMaintenanceWarningDays.
+	// Disabled when zero.
+	EquipmentCheckInterval          *int `mapstructure:"equipment_check_interval"`
+	EquipmentMaintenanceWarningDays *int `mapstructure:"equipment_maintenance_warning_days"`
+
+	// OpenID Connect login through an external identity provider. Disabled when the discovery URL is empty.
+	OIDCDiscoveryURL *string           `mapstructure:"oidc_discovery_url"`
+	OIDCClientID     *string           `mapstructure:"oidc_client_id"`
+	OIDCClientSecret *string           `mapstructure:"oidc_client_secret"`
+	OIDCCallbackURI  *string           `mapstructure:"oidc_callback_uri"`
+	OIDCScopes       []*string         `mapstructure:"oidc_scopes"`
+	OIDCGroupsClaim  *string           `mapstructure:"oidc_groups_claim"`
+	OIDCRoleMapping  map[string]string `mapstructure:"oidc_role_mapping"`
+	OIDCDefaultRole  *string           `mapstructure:"oidc_default_role"`
 }
 
 /*
@@ -72,6 +119,17 @@ func InitViperConfig() error {
 	// Local Upload Path
 	pflag.String("upload_path_area", "uploads/areas", "Upload path for the Area photo")
 	pflag.String("upload_path_crop", "uploads/crops", "Upload path for the Crop photo")
+	pflag.String("upload_path_reservoir", "uploads/reservoirs", "Upload path for the Reservoir photo")
+
+	// Upload Storage
+	pflag.String("upload_storage", "local", "Storage of the uploaded files. Available storages: local, s3")
+	pflag.String("s3_endpoint", "", "S3 API endpoint, like http://localhost:9000. Leave empty for Amazon S3")
+	pflag.String("s3_region", "us-east-1", "S3 region")
+	pflag.String("s3_bucket", "tania", "S3 bucket of the uploaded files")
+	pflag.String("s3_access_key", "", "S3 access key")
+	pflag.String("s3_secret_key", "", "S3 secret key")
+	pflag.Bool("s3_path_style", true, "Put the bucket in the URL path instead of its host, as MinIO expects")
+	pflag.Int("s3_presign_expiry", 15, "Minutes a pre-signed download URL of an uploaded file is valid")
 
 	// Built-In implicit grant OAuth 2
 	pflag.StringSlice(
@@ -81,6 +139,58 @@ func InitViperConfig() error {
 	)
 	pflag.String("client_id", "f0ece679-3f53-463e-b624-73e83049d6ac", "OAuth2 Implicit Grant Client ID for frontend")
 
+	// Inventory
+	pflag.Int(
+		"low_stock_check_interval",
+		60,
+		"Minutes between two checks of the materials below their reorder point. Set to 0 to disable",
+	)
+	pflag.Int(
+		"expiration_check_interval",
+		60,
+		"Minutes between two checks of the materials expiring soon. Set to 0 to disable",
+	)
+	pflag.Int("expiration_warning_days", 30, "Number of days before its expiration date when a material is expiring soon")
+	pflag.Int(
+		"reservoir_check_interval",
+		60,
+		"Minutes between two checks of the buckets predicted to run dry. Set to 0 to disable",
+	)
+	pflag.Int(
+		"reservoir_dry_warning_days",
+		3,
+		"Number of days before a bucket is predicted to run dry to create a refill task",
+	)
+	pflag.Int(
+		"water_quality_check_interval",
+		60,
+		"Minutes between two checks of the water quality out of its acceptable range. Set to 0 to disable",
+	)
+	pflag.Int(
+		"equipment_check_interval",
+		60,
+		"Minutes between two checks of the equipment maintenances coming due. Set to 0 to disable",
+	)
+	pflag.Int(
+		"equipment_maintenance_warning_days",
+		7,
+		"Number of days before an equipment maintenance is due to create its task",
+	)
+
+	// OpenID Connect
+	pflag.String("oidc_discovery_url", "", "OpenID Connect issuer or discovery URL. Leave empty to disable OIDC login")
+	pflag.String("oidc_client_id", "", "OpenID Connect client ID registered at the identity provider")
+	pflag.String("oidc_client_secret", "", "OpenID Connect client secret")
+	pflag.String(
+		"oidc_callback_uri",
+		"http://localhost:8080/api/oidc/callback",
+		"Tania callback URI registered at the identity provider",
+	)
+	pflag.StringSlice("oidc_scopes", []string{"openid", "profile", "email"}, "OpenID Connect scopes to request")
+	pflag.String("oidc_groups_claim", "groups", "ID token claim which contains the user groups")
+	pflag.StringToString("oidc_role_mapping", map[string]string{}, "Mapping of identity provider group to Tania role")
+	pflag.String("oidc_default_role", "STAFF", "Role of users without mapped group. Leave empty to deny them")
+
 	pflag.Parse()
 
 	err := v.BindPFlags(pflag.CommandLine)
@@ -24,6 +24,29 @@ CREATE TABLE IF NOT EXISTS `FARM_READ` (
 
 CREATE UNIQUE INDEX `FARM_READ_UID_UNIQUE_INDEX` ON `FARM_READ` (`UID`);
 
+CREATE TABLE IF NOT EXISTS `FARM_READ_UNIT_SYSTEM` (
+    `FARM_UID` BINARY(16) PRIMARY KEY,
+    `UNIT_SYSTEM` VARCHAR(20),
+    FOREIGN KEY(`FARM_UID`) REFERENCES `FARM_READ`(`UID`)
+) ENGINE=InnoDB;
+
+CREATE TABLE IF NOT EXISTS `FARM_READ_CURRENCY` (
+    `FARM_UID` BINARY(16) PRIMARY KEY,
+    `CURRENCY` VARCHAR(3),
+    FOREIGN KEY(`FARM_UID`) REFERENCES `FARM_READ`(`UID`)
+) ENGINE=InnoDB;
+
+CREATE TABLE IF NOT EXISTS `FARM_READ_EXCHANGE_RATE` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `FARM_UID` BINARY(16),
+    `FROM_CURRENCY` VARCHAR(3),
+    `TO_CURRENCY` VARCHAR(3),
+    `RATE` DOUBLE,
+    `EFFECTIVE_DATE` DATETIME,
+    `CREATED_DATE` DATETIME,
+    FOREIGN KEY(`FARM_UID`) REFERENCES `FARM_READ`(`UID`)
+) ENGINE=InnoDB;
+
 -- RESERVOIR --
 
 CREATE TABLE IF NOT EXISTS `RESERVOIR_EVENT` (
@@ -59,6 +82,86 @@ CREATE TABLE IF NOT EXISTS `RESERVOIR_READ_NOTES` (
 CREATE UNIQUE INDEX `RESERVOIR_READ_NOTES_UID_UNIQUE_INDEX` ON `RESERVOIR_READ_NOTES` (`UID`);
 CREATE INDEX `RESERVOIR_READ_NOTES_RESERVOIR_UID_INDEX` ON `RESERVOIR_READ_NOTES` (`RESERVOIR_UID`);
 
+CREATE TABLE IF NOT EXISTS `RESERVOIR_READ_LEVEL` (
+    `RESERVOIR_UID` BINARY(16) PRIMARY KEY,
+    `WATER_PER_WATERING` FLOAT,
+    `LEVEL` FLOAT,
+    `LEVEL_DATE` DATETIME,
+    `DAILY_CONSUMPTION` FLOAT,
+    `PREDICTED_DRY_DATE` DATETIME,
+    FOREIGN KEY(`RESERVOIR_UID`) REFERENCES `RESERVOIR_READ`(`UID`)
+) ENGINE=InnoDB;
+
+CREATE TABLE IF NOT EXISTS `RESERVOIR_READ_LEVEL_HISTORY` (
+    `ID` INT PRIMARY KEY AUTO_INCREMENT,
+    `RESERVOIR_UID` BINARY(16),
+    `TYPE` VARCHAR(255),
+    `SOURCE` VARCHAR(255),
+    `LEVEL` FLOAT,
+    `VOLUME` FLOAT,
+    `AREA_UID` BINARY(16),
+    `CROP_UID` BINARY(16),
+    `LEVEL_DATE` DATETIME,
+    FOREIGN KEY(`RESERVOIR_UID`) REFERENCES `RESERVOIR_READ`(`UID`)
+) ENGINE=InnoDB;
+
+CREATE INDEX `RESERVOIR_READ_LEVEL_HISTORY_DATE_INDEX`
+    ON `RESERVOIR_READ_LEVEL_HISTORY` (`RESERVOIR_UID`, `LEVEL_DATE`);
+
+CREATE TABLE IF NOT EXISTS `RESERVOIR_READ_WATER_QUALITY` (
+    `RESERVOIR_UID` BINARY(16),
+    `PARAMETER` VARCHAR(255),
+    `UNIT` VARCHAR(255),
+    `VALUE` FLOAT,
+    `MEASURED_DATE` DATETIME,
+    `MIN` FLOAT,
+    `MAX` FLOAT,
+    `OUT_OF_RANGE` TINYINT(1),
+    PRIMARY KEY(`RESERVOIR_UID`, `PARAMETER`),
+    FOREIGN KEY(`RESERVOIR_UID`) REFERENCES `RESERVOIR_READ`(`UID`)
+) ENGINE=InnoDB;
+
+CREATE TABLE IF NOT EXISTS `RESERVOIR_READ_WATER_QUALITY_HISTORY` (
+    `ID` INT PRIMARY KEY AUTO_INCREMENT,
+    `RESERVOIR_UID` BINARY(16),
+    `PARAMETER` VARCHAR(255),
+    `VALUE` FLOAT,
+    `UNIT` VARCHAR(255),
+    `SOURCE` VARCHAR(255),
+    `MEASURED_DATE` DATETIME,
+    FOREIGN KEY(`RESERVOIR_UID`) REFERENCES `RESERVOIR_READ`(`UID`)
+) ENGINE=InnoDB;
+
+CREATE INDEX `RESERVOIR_READ_WATER_QUALITY_HISTORY_DATE_INDEX`
+    ON `RESERVOIR_READ_WATER_QUALITY_HISTORY` (`RESERVOIR_UID`, `MEASURED_DATE`);
+
+CREATE TABLE IF NOT EXISTS `RESERVOIR_READ_NUTRIENT_RECIPE` (
+    `RESERVOIR_UID` BINARY(16),
+    `MATERIAL_UID` BINARY(16),
+    `MATERIAL_NAME` VARCHAR(255),
+    `AMOUNT_PER_LITER` FLOAT,
+    `QUANTITY_UNIT` VARCHAR(255),
+    PRIMARY KEY(`RESERVOIR_UID`, `MATERIAL_UID`),
+    FOREIGN KEY(`RESERVOIR_UID`) REFERENCES `RESERVOIR_READ`(`UID`)
+) ENGINE=InnoDB;
+
+CREATE TABLE IF NOT EXISTS `RESERVOIR_READ_PHOTO` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `RESERVOIR_UID` BINARY(16),
+    `FILENAME` VARCHAR(255),
+    `MIME_TYPE` VARCHAR(255),
+    `SIZE` INTEGER,
+    `WIDTH` INTEGER,
+    `HEIGHT` INTEGER,
+    `CAPTION` TEXT,
+    `TAKEN_DATE` DATETIME,
+    `POSITION` INTEGER,
+    `IS_COVER` TINYINT(1),
+    FOREIGN KEY(`RESERVOIR_UID`) REFERENCES `RESERVOIR_READ`(`UID`)
+) ENGINE=InnoDB;
+
+CREATE INDEX `RESERVOIR_READ_PHOTO_RESERVOIR_UID_INDEX` ON `RESERVOIR_READ_PHOTO` (`RESERVOIR_UID`);
+
 -- AREA --
 
 CREATE TABLE IF NOT EXISTS `AREA_EVENT` (
@@ -105,6 +208,41 @@ CREATE TABLE IF NOT EXISTS `AREA_READ_NOTES` (
 CREATE UNIQUE INDEX `AREA_READ_NOTES_UID_UNIQUE_INDEX` ON `AREA_READ_NOTES` (`UID`);
 CREATE INDEX `AREA_READ_NOTES_AREA_UID_INDEX` ON `AREA_READ_NOTES` (`AREA_UID`);
 
+CREATE TABLE IF NOT EXISTS `AREA_READ_RETIRED` (
+    `AREA_UID` BINARY(16) PRIMARY KEY,
+    `RETIRED_DATE` DATETIME,
+    FOREIGN KEY(`AREA_UID`) REFERENCES `AREA_READ`(`UID`)
+) ENGINE=InnoDB;
+
+CREATE TABLE IF NOT EXISTS `AREA_READ_BOUNDARY` (
+    `AREA_UID` BINARY(16) PRIMARY KEY,
+    `BOUNDARY` MEDIUMTEXT,
+    FOREIGN KEY(`AREA_UID`) REFERENCES `AREA_READ`(`UID`)
+) ENGINE=InnoDB;
+
+CREATE TABLE IF NOT EXISTS `AREA_READ_CAPACITY` (
+    `AREA_UID` BINARY(16) PRIMARY KEY,
+    `CAPACITY` TEXT,
+    FOREIGN KEY(`AREA_UID`) REFERENCES `AREA_READ`(`UID`)
+) ENGINE=InnoDB;
+
+CREATE TABLE IF NOT EXISTS `AREA_READ_PHOTO` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `AREA_UID` BINARY(16),
+    `FILENAME` VARCHAR(255),
+    `MIME_TYPE` VARCHAR(255),
+    `SIZE` INTEGER,
+    `WIDTH` INTEGER,
+    `HEIGHT` INTEGER,
+    `CAPTION` TEXT,
+    `TAKEN_DATE` DATETIME,
+    `POSITION` INTEGER,
+    `IS_COVER` TINYINT(1),
+    FOREIGN KEY(`AREA_UID`) REFERENCES `AREA_READ`(`UID`)
+) ENGINE=InnoDB;
+
+CREATE INDEX `AREA_READ_PHOTO_AREA_UID_INDEX` ON `AREA_READ_PHOTO` (`AREA_UID`);
+
 -- MATERIAL --
 
 CREATE TABLE IF NOT EXISTS `MATERIAL_EVENT` (
@@ -134,6 +272,97 @@ CREATE TABLE IF NOT EXISTS `MATERIAL_READ` (
 
 CREATE INDEX `MATERIAL_READ_UID_UNIQUE_INDEX` ON `MATERIAL_READ` (`UID`);
 
+CREATE TABLE IF NOT EXISTS `MATERIAL_READ_REORDER_POINT` (
+    `MATERIAL_UID` BINARY(16) PRIMARY KEY,
+    `REORDER_POINT` FLOAT,
+    FOREIGN KEY(`MATERIAL_UID`) REFERENCES `MATERIAL_READ`(`UID`)
+) ENGINE=InnoDB;
+
+CREATE TABLE IF NOT EXISTS `MATERIAL_READ_TYPE_LABEL` (
+    `MATERIAL_UID` BINARY(16) PRIMARY KEY,
+    `LABEL` VARCHAR(100),
+    FOREIGN KEY(`MATERIAL_UID`) REFERENCES `MATERIAL_READ`(`UID`)
+) ENGINE=InnoDB;
+
+CREATE TABLE IF NOT EXISTS `SUPPLIER_EVENT` (
+    `ID` INT PRIMARY KEY AUTO_INCREMENT,
+    `SUPPLIER_UID` BINARY(16),
+    `VERSION` INT,
+    `CREATED_DATE` DATETIME,
+    `EVENT` JSON
+);
+
+CREATE INDEX `SUPPLIER_EVENT_SUPPLIER_UID_INDEX` ON `SUPPLIER_EVENT` (`SUPPLIER_UID`);
+
+CREATE TABLE IF NOT EXISTS `SUPPLIER_READ` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `NAME` VARCHAR(255),
+    `PHONE` VARCHAR(255),
+    `EMAIL` VARCHAR(255),
+    `ADDRESS` TEXT,
+    `CREATED_DATE` DATETIME
+) ENGINE=InnoDB;
+
+CREATE TABLE IF NOT EXISTS `MATERIAL_TYPE_CATALOG` (
+    `FARM_UID` BINARY(16),
+    `CATALOG` VARCHAR(50),
+    `CODE` VARCHAR(50),
+    `LABEL` VARCHAR(100),
+    `POSITION` INT,
+    PRIMARY KEY (`FARM_UID`, `CATALOG`, `CODE`)
+) ENGINE=InnoDB;
+
+CREATE TABLE IF NOT EXISTS `MATERIAL_TYPE_CATALOG_DEFAULT` (
+    `FARM_UID` BINARY(16),
+    `CATALOG` VARCHAR(50),
+    `CODE` VARCHAR(50),
+    PRIMARY KEY (`FARM_UID`, `CATALOG`, `CODE`)
+) ENGINE=InnoDB;
+
+CREATE TABLE IF NOT EXISTS `EQUIPMENT_EVENT` (
+    `ID` INT PRIMARY KEY AUTO_INCREMENT,
+    `EQUIPMENT_UID` BINARY(16),
+    `VERSION` INT,
+    `CREATED_DATE` DATETIME,
+    `EVENT` JSON
+);
+
+CREATE INDEX `EQUIPMENT_EVENT_EQUIPMENT_UID_INDEX` ON `EQUIPMENT_EVENT` (`EQUIPMENT_UID`);
+
+CREATE TABLE IF NOT EXISTS `EQUIPMENT_READ` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `NAME` VARCHAR(255),
+    `TYPE` VARCHAR(50),
+    `SERIAL_NUMBER` VARCHAR(255),
+    `FARM_UID` BINARY(16),
+    `FARM_NAME` VARCHAR(255),
+    `LOCATION_TYPE` VARCHAR(50),
+    `LOCATION_UID` BINARY(16),
+    `LOCATION_NAME` VARCHAR(255),
+    `PURCHASE_DATE` DATETIME,
+    `COST_AMOUNT` DOUBLE,
+    `COST_CURRENCY` VARCHAR(10),
+    `CREATED_DATE` DATETIME
+) ENGINE=InnoDB;
+
+CREATE INDEX `EQUIPMENT_READ_FARM_UID_INDEX` ON `EQUIPMENT_READ` (`FARM_UID`);
+
+CREATE TABLE IF NOT EXISTS `EQUIPMENT_READ_MAINTENANCE_SCHEDULE` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `EQUIPMENT_UID` BINARY(16),
+    `TITLE` VARCHAR(255),
+    `INTERVAL_DAYS` INT,
+    `LAST_DONE_DATE` DATETIME,
+    `NEXT_DUE_DATE` DATETIME,
+    `POSITION` INT,
+    FOREIGN KEY(`EQUIPMENT_UID`) REFERENCES `EQUIPMENT_READ`(`UID`)
+) ENGINE=InnoDB;
+
+CREATE INDEX `EQUIPMENT_READ_MAINTENANCE_SCHEDULE_EQUIPMENT_UID_INDEX`
+    ON `EQUIPMENT_READ_MAINTENANCE_SCHEDULE` (`EQUIPMENT_UID`);
+CREATE INDEX `EQUIPMENT_READ_MAINTENANCE_SCHEDULE_NEXT_DUE_DATE_INDEX`
+    ON `EQUIPMENT_READ_MAINTENANCE_SCHEDULE` (`NEXT_DUE_DATE`);
+
 -- CROP --
 
 CREATE TABLE IF NOT EXISTS `CROP_EVENT` (
@@ -183,9 +412,14 @@ CREATE TABLE IF NOT EXISTS `CROP_READ_PHOTO` (
     `WIDTH` INT,
     `HEIGHT` INT,
     `DESCRIPTION` TEXT,
+    `TAKEN_DATE` DATETIME,
+    `LATITUDE` DOUBLE,
+    `LONGITUDE` DOUBLE,
     FOREIGN KEY(`CROP_UID`) REFERENCES `CROP_READ`(`UID`)
 );
 
+CREATE INDEX `CROP_READ_PHOTO_TAKEN_DATE_INDEX` ON `CROP_READ_PHOTO` (`TAKEN_DATE`);
+
 CREATE TABLE IF NOT EXISTS `CROP_READ_MOVED_AREA` (
     `ID` INT PRIMARY KEY AUTO_INCREMENT,
     `CROP_UID` BINARY(16),
@@ -249,6 +483,42 @@ CREATE TABLE IF NOT EXISTS `CROP_ACTIVITY` (
     FOREIGN KEY(`CROP_UID`) REFERENCES `CROP_READ`(`UID`)
 );
 
+-- LIVESTOCK --
+
+CREATE TABLE IF NOT EXISTS `ANIMAL_EVENT` (
+    `ID` INT PRIMARY KEY AUTO_INCREMENT,
+    `ANIMAL_UID` BINARY(16),
+    `VERSION` INT,
+    `CREATED_DATE` DATETIME,
+    `EVENT` JSON
+);
+
+CREATE INDEX `ANIMAL_EVENT_ANIMAL_UID_INDEX` ON `ANIMAL_EVENT` (`ANIMAL_UID`);
+
+CREATE TABLE IF NOT EXISTS `ANIMAL_READ` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `SPECIES` VARCHAR(50),
+    `TAG_ID` VARCHAR(50),
+    `SEX` VARCHAR(10),
+    `BIRTH_DATE` DATETIME,
+    `ANIMAL_GROUP` VARCHAR(100),
+    `FARM_UID` BINARY(16),
+    `FARM_NAME` VARCHAR(255),
+    `PEN_AREA_UID` BINARY(16),
+    `PEN_AREA_NAME` VARCHAR(255),
+    `MOTHER_UID` BINARY(16),
+    `STATUS` VARCHAR(10),
+    `WEIGHT` FLOAT,
+    `WEIGHT_UNIT` VARCHAR(10),
+    `WEIGHED_DATE` DATETIME,
+    `OFFSPRING_COUNT` INT,
+    `DEATH_DATE` DATETIME,
+    `DEATH_CAUSE` TEXT,
+    `CREATED_DATE` DATETIME
+) ENGINE=InnoDB;
+
+CREATE INDEX `ANIMAL_READ_FARM_UID_INDEX` ON `ANIMAL_READ` (`FARM_UID`);
+
 -- TASK --
 
 CREATE TABLE IF NOT EXISTS `TASK_EVENT` (
@@ -282,6 +552,25 @@ CREATE TABLE IF NOT EXISTS `TASK_READ` (
 
 CREATE INDEX `TASK_READ_UID_UNIQUE_INDEX` ON `TASK_READ` (`UID`);
 
+CREATE TABLE IF NOT EXISTS `TASK_READ_EQUIPMENT_MAINTENANCE` (
+    `TASK_UID` BINARY(16) PRIMARY KEY,
+    `MAINTENANCE_SCHEDULE_UID` BINARY(16),
+    FOREIGN KEY(`TASK_UID`) REFERENCES `TASK_READ`(`UID`)
+) ENGINE=InnoDB;
+
+CREATE TABLE IF NOT EXISTS `TASK_READ_INVENTORY` (
+    `TASK_UID` BINARY(16) PRIMARY KEY,
+    `REASON` VARCHAR(50),
+    FOREIGN KEY(`TASK_UID`) REFERENCES `TASK_READ`(`UID`)
+) ENGINE=InnoDB;
+
+CREATE TABLE IF NOT EXISTS `TASK_READ_RESERVOIR` (
+    `TASK_UID` BINARY(16) PRIMARY KEY,
+    `REASON` VARCHAR(50),
+    `PARAMETER` VARCHAR(50),
+    FOREIGN KEY(`TASK_UID`) REFERENCES `TASK_READ`(`UID`)
+) ENGINE=InnoDB;
+
 -- USER --
 
 CREATE TABLE IF NOT EXISTS `USER_EVENT` (
@@ -313,4 +602,21 @@ CREATE TABLE IF NOT EXISTS `USER_AUTH` (
 );
 
 CREATE UNIQUE INDEX `USER_AUTH_USER_UID_UNIQUE_INDEX` ON `USER_AUTH` (`USER_UID`);
-CREATE UNIQUE INDEX `USER_AUTH_ACCESS_TOKEN_UNIQUE_INDEX` ON `USER_AUTH` (`ACCESS_TOKEN`);
\ No newline at end of file
+CREATE UNIQUE INDEX `USER_AUTH_ACCESS_TOKEN_UNIQUE_INDEX` ON `USER_AUTH` (`ACCESS_TOKEN`);
+
+CREATE TABLE IF NOT EXISTS `USER_IDENTITY` (
+    `ISSUER` VARCHAR(255),
+    `SUBJECT` VARCHAR(255),
+    `USER_UID` BINARY(16),
+    `CREATED_DATE` DATETIME
+);
+
+CREATE UNIQUE INDEX `USER_IDENTITY_ISSUER_SUBJECT_UNIQUE_INDEX` ON `USER_IDENTITY` (`ISSUER`, `SUBJECT`);
+
+CREATE TABLE IF NOT EXISTS `USER_READ_ROLE` (
+    `ID` INT PRIMARY KEY AUTO_INCREMENT,
+    `USER_UID` BINARY(16),
+    `ROLE` VARCHAR(255)
+);
+
+CREATE INDEX `USER_READ_ROLE_USER_UID_INDEX` ON `USER_READ_ROLE` (`USER_UID`);
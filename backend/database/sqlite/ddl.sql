@@ -24,6 +24,29 @@ CREATE TABLE IF NOT EXISTS "FARM_READ" (
 
 CREATE UNIQUE INDEX IF NOT EXISTS "FARM_READ_UID_UNIQUE_INDEX" ON "FARM_READ" ("UID");
 
+CREATE TABLE IF NOT EXISTS "FARM_READ_UNIT_SYSTEM" (
+    "FARM_UID" BLOB PRIMARY KEY,
+    "UNIT_SYSTEM" TEXT,
+    FOREIGN KEY("FARM_UID") REFERENCES "FARM_READ"("UID")
+);
+
+CREATE TABLE IF NOT EXISTS "FARM_READ_CURRENCY" (
+    "FARM_UID" BLOB PRIMARY KEY,
+    "CURRENCY" TEXT,
+    FOREIGN KEY("FARM_UID") REFERENCES "FARM_READ"("UID")
+);
+
+CREATE TABLE IF NOT EXISTS "FARM_READ_EXCHANGE_RATE" (
+    "UID" BLOB PRIMARY KEY,
+    "FARM_UID" BLOB,
+    "FROM_CURRENCY" TEXT,
+    "TO_CURRENCY" TEXT,
+    "RATE" REAL,
+    "EFFECTIVE_DATE" TEXT,
+    "CREATED_DATE" TEXT,
+    FOREIGN KEY("FARM_UID") REFERENCES "FARM_READ"("UID")
+);
+
 -- AREA --
 
 CREATE TABLE IF NOT EXISTS "AREA_EVENT" (
@@ -70,6 +93,41 @@ CREATE TABLE IF NOT EXISTS "AREA_READ_NOTES" (
 CREATE UNIQUE INDEX IF NOT EXISTS "AREA_READ_NOTES_UID_UNIQUE_INDEX" ON "AREA_READ_NOTES" ("UID");
 CREATE INDEX IF NOT EXISTS "AREA_READ_NOTES_AREA_UID_INDEX" ON "AREA_READ_NOTES" ("AREA_UID");
 
+CREATE TABLE IF NOT EXISTS "AREA_READ_RETIRED" (
+    "AREA_UID" BLOB PRIMARY KEY,
+    "RETIRED_DATE" TEXT,
+    FOREIGN KEY("AREA_UID") REFERENCES "AREA_READ"("UID")
+);
+
+CREATE TABLE IF NOT EXISTS "AREA_READ_BOUNDARY" (
+    "AREA_UID" BLOB PRIMARY KEY,
+    "BOUNDARY" TEXT,
+    FOREIGN KEY("AREA_UID") REFERENCES "AREA_READ"("UID")
+);
+
+CREATE TABLE IF NOT EXISTS "AREA_READ_CAPACITY" (
+    "AREA_UID" BLOB PRIMARY KEY,
+    "CAPACITY" TEXT,
+    FOREIGN KEY("AREA_UID") REFERENCES "AREA_READ"("UID")
+);
+
+CREATE TABLE IF NOT EXISTS "AREA_READ_PHOTO" (
+    "UID" BLOB PRIMARY KEY,
+    "AREA_UID" BLOB,
+    "FILENAME" TEXT,
+    "MIME_TYPE" TEXT,
+    "SIZE" INTEGER,
+    "WIDTH" INTEGER,
+    "HEIGHT" INTEGER,
+    "CAPTION" TEXT,
+    "TAKEN_DATE" TEXT,
+    "POSITION" INTEGER,
+    "IS_COVER" BOOLEAN,
+    FOREIGN KEY("AREA_UID") REFERENCES "AREA_READ"("UID")
+);
+
+CREATE INDEX IF NOT EXISTS "AREA_READ_PHOTO_AREA_UID_INDEX" ON "AREA_READ_PHOTO" ("AREA_UID");
+
 -- RESERVOIR --
 
 CREATE TABLE IF NOT EXISTS "RESERVOIR_EVENT" (
@@ -105,6 +163,86 @@ CREATE TABLE IF NOT EXISTS "RESERVOIR_READ_NOTES" (
 CREATE UNIQUE INDEX IF NOT EXISTS "RESERVOIR_READ_NOTES_UID_UNIQUE_INDEX" ON "RESERVOIR_READ_NOTES" ("UID");
 CREATE INDEX IF NOT EXISTS "RESERVOIR_READ_NOTES_RESERVOIR_UID_INDEX" ON "RESERVOIR_READ_NOTES" ("RESERVOIR_UID");
 
+CREATE TABLE IF NOT EXISTS "RESERVOIR_READ_LEVEL" (
+    "RESERVOIR_UID" BLOB PRIMARY KEY,
+    "WATER_PER_WATERING" REAL,
+    "LEVEL" REAL,
+    "LEVEL_DATE" TEXT,
+    "DAILY_CONSUMPTION" REAL,
+    "PREDICTED_DRY_DATE" TEXT,
+    FOREIGN KEY("RESERVOIR_UID") REFERENCES "RESERVOIR_READ"("UID")
+);
+
+CREATE TABLE IF NOT EXISTS "RESERVOIR_READ_LEVEL_HISTORY" (
+    "ID" INTEGER PRIMARY KEY,
+    "RESERVOIR_UID" BLOB,
+    "TYPE" TEXT,
+    "SOURCE" TEXT,
+    "LEVEL" REAL,
+    "VOLUME" REAL,
+    "AREA_UID" BLOB,
+    "CROP_UID" BLOB,
+    "LEVEL_DATE" TEXT,
+    FOREIGN KEY("RESERVOIR_UID") REFERENCES "RESERVOIR_READ"("UID")
+);
+
+CREATE INDEX IF NOT EXISTS "RESERVOIR_READ_LEVEL_HISTORY_DATE_INDEX"
+    ON "RESERVOIR_READ_LEVEL_HISTORY" ("RESERVOIR_UID", "LEVEL_DATE");
+
+CREATE TABLE IF NOT EXISTS "RESERVOIR_READ_WATER_QUALITY" (
+    "RESERVOIR_UID" BLOB,
+    "PARAMETER" TEXT,
+    "UNIT" TEXT,
+    "VALUE" REAL,
+    "MEASURED_DATE" TEXT,
+    "MIN" REAL,
+    "MAX" REAL,
+    "OUT_OF_RANGE" BOOLEAN,
+    PRIMARY KEY("RESERVOIR_UID", "PARAMETER"),
+    FOREIGN KEY("RESERVOIR_UID") REFERENCES "RESERVOIR_READ"("UID")
+);
+
+CREATE TABLE IF NOT EXISTS "RESERVOIR_READ_WATER_QUALITY_HISTORY" (
+    "ID" INTEGER PRIMARY KEY,
+    "RESERVOIR_UID" BLOB,
+    "PARAMETER" TEXT,
+    "VALUE" REAL,
+    "UNIT" TEXT,
+    "SOURCE" TEXT,
+    "MEASURED_DATE" TEXT,
+    FOREIGN KEY("RESERVOIR_UID") REFERENCES "RESERVOIR_READ"("UID")
+);
+
+CREATE INDEX IF NOT EXISTS "RESERVOIR_READ_WATER_QUALITY_HISTORY_DATE_INDEX"
+    ON "RESERVOIR_READ_WATER_QUALITY_HISTORY" ("RESERVOIR_UID", "MEASURED_DATE");
+
+CREATE TABLE IF NOT EXISTS "RESERVOIR_READ_NUTRIENT_RECIPE" (
+    "RESERVOIR_UID" BLOB,
+    "MATERIAL_UID" BLOB,
+    "MATERIAL_NAME" TEXT,
+    "AMOUNT_PER_LITER" REAL,
+    "QUANTITY_UNIT" TEXT,
+    PRIMARY KEY("RESERVOIR_UID", "MATERIAL_UID"),
+    FOREIGN KEY("RESERVOIR_UID") REFERENCES "RESERVOIR_READ"("UID")
+);
+
+CREATE TABLE IF NOT EXISTS "RESERVOIR_READ_PHOTO" (
+    "UID" BLOB PRIMARY KEY,
+    "RESERVOIR_UID" BLOB,
+    "FILENAME" TEXT,
+    "MIME_TYPE" TEXT,
+    "SIZE" INTEGER,
+    "WIDTH" INTEGER,
+    "HEIGHT" INTEGER,
+    "CAPTION" TEXT,
+    "TAKEN_DATE" TEXT,
+    "POSITION" INTEGER,
+    "IS_COVER" BOOLEAN,
+    FOREIGN KEY("RESERVOIR_UID") REFERENCES "RESERVOIR_READ"("UID")
+);
+
+CREATE INDEX IF NOT EXISTS "RESERVOIR_READ_PHOTO_RESERVOIR_UID_INDEX" ON "RESERVOIR_READ_PHOTO" ("RESERVOIR_UID");
+
 -- MATERIAL --
 
 CREATE TABLE IF NOT EXISTS "MATERIAL_EVENT" (
@@ -134,6 +272,97 @@ CREATE TABLE IF NOT EXISTS "MATERIAL_READ" (
 
 CREATE INDEX IF NOT EXISTS "MATERIAL_READ_UID_UNIQUE_INDEX" ON "MATERIAL_READ" ("UID");
 
+CREATE TABLE IF NOT EXISTS "MATERIAL_READ_REORDER_POINT" (
+    "MATERIAL_UID" BLOB PRIMARY KEY,
+    "REORDER_POINT" REAL,
+    FOREIGN KEY("MATERIAL_UID") REFERENCES "MATERIAL_READ"("UID")
+);
+
+CREATE TABLE IF NOT EXISTS "MATERIAL_READ_TYPE_LABEL" (
+    "MATERIAL_UID" BLOB PRIMARY KEY,
+    "LABEL" TEXT,
+    FOREIGN KEY("MATERIAL_UID") REFERENCES "MATERIAL_READ"("UID")
+);
+
+CREATE TABLE IF NOT EXISTS "SUPPLIER_EVENT" (
+    "ID" INTEGER PRIMARY KEY,
+    "SUPPLIER_UID" BLOB,
+    "VERSION" INTEGER,
+    "CREATED_DATE" TEXT,
+    "EVENT" BLOB
+);
+
+CREATE INDEX IF NOT EXISTS "SUPPLIER_EVENT_SUPPLIER_UID_INDEX" ON "SUPPLIER_EVENT" ("SUPPLIER_UID");
+
+CREATE TABLE IF NOT EXISTS "SUPPLIER_READ" (
+    "UID" BLOB PRIMARY KEY,
+    "NAME" TEXT,
+    "PHONE" TEXT,
+    "EMAIL" TEXT,
+    "ADDRESS" TEXT,
+    "CREATED_DATE" TEXT
+);
+
+CREATE TABLE IF NOT EXISTS "MATERIAL_TYPE_CATALOG" (
+    "FARM_UID" BLOB,
+    "CATALOG" TEXT,
+    "CODE" TEXT,
+    "LABEL" TEXT,
+    "POSITION" INTEGER,
+    PRIMARY KEY ("FARM_UID", "CATALOG", "CODE")
+);
+
+CREATE TABLE IF NOT EXISTS "MATERIAL_TYPE_CATALOG_DEFAULT" (
+    "FARM_UID" BLOB,
+    "CATALOG" TEXT,
+    "CODE" TEXT,
+    PRIMARY KEY ("FARM_UID", "CATALOG", "CODE")
+);
+
+CREATE TABLE IF NOT EXISTS "EQUIPMENT_EVENT" (
+    "ID" INTEGER PRIMARY KEY,
+    "EQUIPMENT_UID" BLOB,
+    "VERSION" INTEGER,
+    "CREATED_DATE" TEXT,
+    "EVENT" BLOB
+);
+
+CREATE INDEX IF NOT EXISTS "EQUIPMENT_EVENT_EQUIPMENT_UID_INDEX" ON "EQUIPMENT_EVENT" ("EQUIPMENT_UID");
+
+CREATE TABLE IF NOT EXISTS "EQUIPMENT_READ" (
+    "UID" BLOB PRIMARY KEY,
+    "NAME" TEXT,
+    "TYPE" TEXT,
+    "SERIAL_NUMBER" TEXT,
+    "FARM_UID" BLOB,
+    "FARM_NAME" TEXT,
+    "LOCATION_TYPE" TEXT,
+    "LOCATION_UID" BLOB,
+    "LOCATION_NAME" TEXT,
+    "PURCHASE_DATE" TEXT,
+    "COST_AMOUNT" REAL,
+    "COST_CURRENCY" TEXT,
+    "CREATED_DATE" TEXT
+);
+
+CREATE INDEX IF NOT EXISTS "EQUIPMENT_READ_FARM_UID_INDEX" ON "EQUIPMENT_READ" ("FARM_UID");
+
+CREATE TABLE IF NOT EXISTS "EQUIPMENT_READ_MAINTENANCE_SCHEDULE" (
+    "UID" BLOB PRIMARY KEY,
+    "EQUIPMENT_UID" BLOB,
+    "TITLE" TEXT,
+    "INTERVAL_DAYS" INTEGER,
+    "LAST_DONE_DATE" TEXT,
+    "NEXT_DUE_DATE" TEXT,
+    "POSITION" INTEGER,
+    FOREIGN KEY("EQUIPMENT_UID") REFERENCES "EQUIPMENT_READ"("UID")
+);
+
+CREATE INDEX IF NOT EXISTS "EQUIPMENT_READ_MAINTENANCE_SCHEDULE_EQUIPMENT_UID_INDEX"
+    ON "EQUIPMENT_READ_MAINTENANCE_SCHEDULE" ("EQUIPMENT_UID");
+CREATE INDEX IF NOT EXISTS "EQUIPMENT_READ_MAINTENANCE_SCHEDULE_NEXT_DUE_DATE_INDEX"
+    ON "EQUIPMENT_READ_MAINTENANCE_SCHEDULE" ("NEXT_DUE_DATE");
+
 -- CROP --
 
 CREATE TABLE IF NOT EXISTS "CROP_EVENT" (
@@ -183,9 +412,14 @@ CREATE TABLE IF NOT EXISTS "CROP_READ_PHOTO" (
     "WIDTH" INTEGER,
     "HEIGHT" INTEGER,
     "DESCRIPTION" TEXT,
+    "TAKEN_DATE" TEXT,
+    "LATITUDE" REAL,
+    "LONGITUDE" REAL,
     FOREIGN KEY("CROP_UID") REFERENCES "CROP_READ"("UID")
 );
 
+CREATE INDEX IF NOT EXISTS "CROP_READ_PHOTO_TAKEN_DATE_INDEX" ON "CROP_READ_PHOTO" ("TAKEN_DATE");
+
 CREATE TABLE IF NOT EXISTS "CROP_READ_MOVED_AREA" (
     "ID" INTEGER PRIMARY KEY,
     "CROP_UID" BLOB,
@@ -249,6 +483,42 @@ CREATE TABLE IF NOT EXISTS "CROP_ACTIVITY" (
     FOREIGN KEY("CROP_UID") REFERENCES "CROP_READ"("UID")
 );
 
+-- LIVESTOCK --
+
+CREATE TABLE IF NOT EXISTS "ANIMAL_EVENT" (
+    "ID" INTEGER PRIMARY KEY,
+    "ANIMAL_UID" BLOB,
+    "VERSION" INTEGER,
+    "CREATED_DATE" TEXT,
+    "EVENT" BLOB
+);
+
+CREATE INDEX IF NOT EXISTS "ANIMAL_EVENT_ANIMAL_UID_INDEX" ON "ANIMAL_EVENT" ("ANIMAL_UID");
+
+CREATE TABLE IF NOT EXISTS "ANIMAL_READ" (
+    "UID" BLOB PRIMARY KEY,
+    "SPECIES" TEXT,
+    "TAG_ID" TEXT,
+    "SEX" TEXT,
+    "BIRTH_DATE" TEXT,
+    "ANIMAL_GROUP" TEXT,
+    "FARM_UID" BLOB,
+    "FARM_NAME" TEXT,
+    "PEN_AREA_UID" BLOB,
+    "PEN_AREA_NAME" TEXT,
+    "MOTHER_UID" BLOB,
+    "STATUS" TEXT,
+    "WEIGHT" REAL,
+    "WEIGHT_UNIT" TEXT,
+    "WEIGHED_DATE" TEXT,
+    "OFFSPRING_COUNT" INTEGER,
+    "DEATH_DATE" TEXT,
+    "DEATH_CAUSE" TEXT,
+    "CREATED_DATE" TEXT
+);
+
+CREATE INDEX IF NOT EXISTS "ANIMAL_READ_FARM_UID_INDEX" ON "ANIMAL_READ" ("FARM_UID");
+
 -- TASK --
 
 CREATE TABLE IF NOT EXISTS "TASK_EVENT" (
@@ -281,6 +551,25 @@ CREATE TABLE IF NOT EXISTS "TASK_READ" (
 
 CREATE INDEX IF NOT EXISTS "TASK_READ_UID_UNIQUE_INDEX" ON "TASK_READ" ("UID");
 
+CREATE TABLE IF NOT EXISTS "TASK_READ_EQUIPMENT_MAINTENANCE" (
+    "TASK_UID" BLOB PRIMARY KEY,
+    "MAINTENANCE_SCHEDULE_UID" BLOB,
+    FOREIGN KEY("TASK_UID") REFERENCES "TASK_READ"("UID")
+);
+
+CREATE TABLE IF NOT EXISTS "TASK_READ_INVENTORY" (
+    "TASK_UID" BLOB PRIMARY KEY,
+    "REASON" TEXT,
+    FOREIGN KEY("TASK_UID") REFERENCES "TASK_READ"("UID")
+);
+
+CREATE TABLE IF NOT EXISTS "TASK_READ_RESERVOIR" (
+    "TASK_UID" BLOB PRIMARY KEY,
+    "REASON" TEXT,
+    "PARAMETER" TEXT,
+    FOREIGN KEY("TASK_UID") REFERENCES "TASK_READ"("UID")
+);
+
 -- USER --
 
 CREATE TABLE IF NOT EXISTS "USER_EVENT" (
@@ -312,4 +601,21 @@ CREATE TABLE IF NOT EXISTS "USER_AUTH" (
 );
 
 CREATE UNIQUE INDEX IF NOT EXISTS "USER_AUTH_USER_UID_UNIQUE_INDEX" ON "USER_AUTH" ("USER_UID");
-CREATE UNIQUE INDEX IF NOT EXISTS "USER_AUTH_ACCESS_TOKEN_UNIQUE_INDEX" ON "USER_AUTH" ("ACCESS_TOKEN");
\ No newline at end of file
+CREATE UNIQUE INDEX IF NOT EXISTS "USER_AUTH_ACCESS_TOKEN_UNIQUE_INDEX" ON "USER_AUTH" ("ACCESS_TOKEN");
+
+CREATE TABLE IF NOT EXISTS "USER_IDENTITY" (
+    "ISSUER" TEXT,
+    "SUBJECT" TEXT,
+    "USER_UID" BLOB,
+    "CREATED_DATE" TEXT
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "USER_IDENTITY_ISSUER_SUBJECT_UNIQUE_INDEX" ON "USER_IDENTITY" ("ISSUER", "SUBJECT");
+
+CREATE TABLE IF NOT EXISTS "USER_READ_ROLE" (
+    "ID" INTEGER PRIMARY KEY,
+    "USER_UID" BLOB,
+    "ROLE" TEXT
+);
+
+CREATE INDEX IF NOT EXISTS "USER_READ_ROLE_USER_UID_INDEX" ON "USER_READ_ROLE" ("USER_UID");
@@ -46,10 +46,24 @@ func (w *AreaEventWrapper) UnmarshalJSON(b []byte) error {
 		e = domain.AreaReservoirChanged{}
 	case "AreaPhotoAdded":
 		e = domain.AreaPhotoAdded{}
+	case "AreaPhotoUpdated":
+		e = domain.AreaPhotoUpdated{}
+	case "AreaPhotoRemoved":
+		e = domain.AreaPhotoRemoved{}
+	case "AreaPhotosReordered":
+		e = domain.AreaPhotosReordered{}
+	case "AreaCoverPhotoChanged":
+		e = domain.AreaCoverPhotoChanged{}
 	case "AreaNoteAdded":
 		e = domain.AreaNoteAdded{}
 	case "AreaNoteRemoved":
 		e = domain.AreaNoteRemoved{}
+	case "AreaRetired":
+		e = domain.AreaRetired{}
+	case "AreaBoundaryChanged":
+		e = domain.AreaBoundaryChanged{}
+	case "AreaCapacityChanged":
+		e = domain.AreaCapacityChanged{}
 	}
 
 	_, err = Decode(f, &mapped, &e)
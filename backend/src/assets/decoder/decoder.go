@@ -97,6 +97,16 @@ func WaterSourceHook() mapstructure.DecodeHookFunc {
 	}
 }
 
+// catalogTypeData is the code and label of the catalog type of a material type, like its plant type.
+func catalogTypeData(mapped map[string]interface{}, field string) (string, string) {
+	data, _ := mapped["Data"].(map[string]interface{})
+	catalogType, _ := data[field].(map[string]interface{})
+	code, _ := catalogType["code"].(string)
+	label, _ := catalogType["label"].(string)
+
+	return code, label
+}
+
 func MaterialTypeHook() mapstructure.DecodeHookFunc {
 	return func(f reflect.Type, t reflect.Type, data interface{}) (interface{}, error) {
 		if f != reflect.TypeOf(map[string]interface{}{}) {
@@ -111,61 +121,54 @@ func MaterialTypeHook() mapstructure.DecodeHookFunc {
 
 		mapped := data.(map[string]interface{})
 
+		// The material keeps the catalog type of the event, even once removed from the catalog of the farm.
 		if val, ok := mapped["Type"]; ok {
 			switch val {
 			case domain.MaterialTypePlantCode:
-				mapped2 := mapped["Data"].(map[string]interface{})
-				mapped3 := mapped2["PlantType"].(map[string]interface{})
-				typeCode := mapped3["code"].(string)
+				code, label := catalogTypeData(mapped, "PlantType")
 
-				t, err := domain.CreateMaterialTypePlant(typeCode)
+				pt, err := domain.RestoreCatalogType(domain.CatalogPlantType, code, label)
 				if err != nil {
 					return data, err
 				}
 
-				return t, nil
+				return domain.MaterialTypePlant{PlantType: domain.PlantType(pt)}, nil
 
 			case domain.MaterialTypeSeedCode:
-				mapped2 := mapped["Data"].(map[string]interface{})
-				mapped3 := mapped2["PlantType"].(map[string]interface{})
-				typeCode := mapped3["code"].(string)
+				code, label := catalogTypeData(mapped, "PlantType")
 
-				t, err := domain.CreateMaterialTypeSeed(typeCode)
+				pt, err := domain.RestoreCatalogType(domain.CatalogPlantType, code, label)
 				if err != nil {
 					return data, err
 				}
 
-				return t, nil
+				return domain.MaterialTypeSeed{PlantType: domain.PlantType(pt)}, nil
 
 			case domain.MaterialTypeGrowingMediumCode:
 				return domain.MaterialTypeGrowingMedium{}, nil
 
 			case domain.MaterialTypeAgrochemicalCode:
-				mapped2 := mapped["Data"].(map[string]interface{})
-				mapped3 := mapped2["ChemicalType"].(map[string]interface{})
-				typeCode := mapped3["code"].(string)
+				code, label := catalogTypeData(mapped, "ChemicalType")
 
-				t, err := domain.CreateMaterialTypeAgrochemical(typeCode)
+				ct, err := domain.RestoreCatalogType(domain.CatalogChemicalType, code, label)
 				if err != nil {
 					return data, err
 				}
 
-				return t, nil
+				return domain.MaterialTypeAgrochemical{ChemicalType: domain.ChemicalType(ct)}, nil
 
 			case domain.MaterialTypeLabelAndCropSupportCode:
 				return domain.MaterialTypeLabelAndCropSupport{}, nil
 
 			case domain.MaterialTypeSeedingContainerCode:
-				mapped2 := mapped["Data"].(map[string]interface{})
-				mapped3 := mapped2["ContainerType"].(map[string]interface{})
-				typeCode := mapped3["code"].(string)
+				code, label := catalogTypeData(mapped, "ContainerType")
 
-				t, err := domain.CreateMaterialTypeSeedingContainer(typeCode)
+				ct, err := domain.RestoreCatalogType(domain.CatalogContainerType, code, label)
 				if err != nil {
-					return nil, err
+					return data, err
 				}
 
-				return t, nil
+				return domain.MaterialTypeSeedingContainer{ContainerType: domain.ContainerType(ct)}, nil
 
 			case domain.MaterialTypePostHarvestSupplyCode:
 				return domain.MaterialTypePostHarvestSupply{}, nil
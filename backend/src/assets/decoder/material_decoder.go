@@ -109,6 +109,56 @@ func (w *MaterialEventWrapper) UnmarshalJSON(b []byte) error {
 			return err
 		}
 
+		w.EventData = e
+
+	case "MaterialConsumed":
+		e := domain.MaterialConsumed{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "MaterialReorderPointChanged":
+		e := domain.MaterialReorderPointChanged{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "MaterialFarmAssigned":
+		e := domain.MaterialFarmAssigned{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "MaterialStockReceived":
+		e := domain.MaterialStockReceived{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "MaterialStockIssued":
+		e := domain.MaterialStockIssued{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
 		w.EventData = e
 	}
 
@@ -43,6 +43,32 @@ func (w *ReservoirEventWrapper) UnmarshalJSON(b []byte) error {
 		e = domain.ReservoirNoteAdded{}
 	case "ReservoirNoteRemoved":
 		e = domain.ReservoirNoteRemoved{}
+	case "ReservoirLevelRecorded":
+		e = domain.ReservoirLevelRecorded{}
+	case "ReservoirRefilled":
+		e = domain.ReservoirRefilled{}
+	case "ReservoirWaterConsumed":
+		e = domain.ReservoirWaterConsumed{}
+	case "ReservoirWaterPerWateringChanged":
+		e = domain.ReservoirWaterPerWateringChanged{}
+	case "ReservoirWaterQualityMeasured":
+		e = domain.ReservoirWaterQualityMeasured{}
+	case "ReservoirWaterQualityRangeChanged":
+		e = domain.ReservoirWaterQualityRangeChanged{}
+	case "ReservoirNutrientRecipeChanged":
+		e = domain.ReservoirNutrientRecipeChanged{}
+	case "ReservoirDosed":
+		e = domain.ReservoirDosed{}
+	case "ReservoirPhotoAdded":
+		e = domain.ReservoirPhotoAdded{}
+	case "ReservoirPhotoUpdated":
+		e = domain.ReservoirPhotoUpdated{}
+	case "ReservoirPhotoRemoved":
+		e = domain.ReservoirPhotoRemoved{}
+	case "ReservoirPhotosReordered":
+		e = domain.ReservoirPhotosReordered{}
+	case "ReservoirCoverPhotoChanged":
+		e = domain.ReservoirCoverPhotoChanged{}
 	}
 
 	_, err = Decode(f, &mapped, &e)
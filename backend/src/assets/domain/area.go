@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/helper/unithelper"
 	"github.com/usetania/tania-core/src/helper/validationhelper"
 )
 
@@ -14,10 +15,14 @@ type Area struct {
 	Type         AreaType               `json:"type"`
 	Location     AreaLocation           `json:"location"`
 	Photo        AreaPhoto              `json:"photo"`
+	Photos       []AreaPhoto            `json:"photos"`
+	Boundary     *AreaBoundary          `json:"boundary,omitempty"`
+	Capacity     *AreaCapacity          `json:"capacity,omitempty"`
 	CreatedDate  time.Time              `json:"created_date"`
 	Notes        map[uuid.UUID]AreaNote `json:"-"`
 	ReservoirUID uuid.UUID              `json:"-"`
 	FarmUID      uuid.UUID              `json:"-"`
+	IsRetired    bool                   `json:"-"`
 
 	// Events
 	Version            int
@@ -28,11 +33,13 @@ type AreaService interface {
 	FindFarmByID(farmUID uuid.UUID) (AreaFarmServiceResult, error)
 	FindReservoirByID(reservoirUID uuid.UUID) (AreaReservoirServiceResult, error)
 	CountCropsByAreaID(areaUID uuid.UUID) (int, error)
+	CountPlantsByAreaID(areaUID uuid.UUID) (int, error)
 }
 
 type AreaFarmServiceResult struct {
-	UID  uuid.UUID
-	Name string
+	UID        uuid.UUID
+	Name       string
+	IsArchived bool
 }
 
 type AreaReservoirServiceResult struct {
@@ -95,8 +102,10 @@ func GetAreaLocation(code string) AreaLocation {
 }
 
 const (
-	SquareMeter = "m2"
-	Hectare     = "Ha"
+	SquareMeter = unithelper.SquareMeter
+	Hectare     = unithelper.Hectare
+	Acre        = unithelper.Acre
+	SquareFoot  = unithelper.SquareFoot
 )
 
 type AreaUnit struct {
@@ -108,6 +117,8 @@ func AreaUnits() []AreaUnit {
 	return []AreaUnit{
 		{Symbol: SquareMeter, Label: "Square Meter"},
 		{Symbol: Hectare, Label: "Hectare"},
+		{Symbol: Acre, Label: "Acre"},
+		{Symbol: SquareFoot, Label: "Square Foot"},
 	}
 }
 
@@ -126,12 +137,48 @@ type AreaSize struct {
 	Value float32  `json:"value"`
 }
 
+// SquareMeters converts an area size to square meters. Sizes without a known unit are taken as square meters.
+func SquareMeters(size AreaSize) float64 {
+	value, err := unithelper.ToSquareMeters(float64(size.Value), size.Unit.Symbol)
+	if err != nil {
+		return float64(size.Value)
+	}
+
+	return value
+}
+
+// ConvertAreaSize converts an area size to the unit of the symbol. Sizes without a known unit are kept as they are.
+func ConvertAreaSize(size AreaSize, symbol string) AreaSize {
+	unit := GetAreaUnit(symbol)
+	if unit == (AreaUnit{}) || GetAreaUnit(size.Unit.Symbol) == (AreaUnit{}) {
+		return size
+	}
+
+	value, err := unithelper.FromSquareMeters(SquareMeters(size), symbol)
+	if err != nil {
+		return size
+	}
+
+	return AreaSize{Unit: unit, Value: float32(value)}
+}
+
+// DisplayAreaSize converts an area size to the unit it is shown in for a farm unit system.
+// A size entered in a unit of the unit system is shown as it is.
+func DisplayAreaSize(size AreaSize, unitSystem string) AreaSize {
+	return ConvertAreaSize(size, unithelper.AreaUnit(unitSystem, size.Unit.Symbol, SquareMeters(size)))
+}
+
+// AreaPhoto is a photo of the area gallery. Photo of the area is its cover photo.
 type AreaPhoto struct {
-	Filename string `json:"filename"`
-	MimeType string `json:"mime_type"`
-	Size     int    `json:"size"`
-	Width    int    `json:"width"`
-	Height   int    `json:"height"`
+	UID       uuid.UUID  `json:"uid"`
+	Filename  string     `json:"filename"`
+	MimeType  string     `json:"mime_type"`
+	Size      int        `json:"size"`
+	Width     int        `json:"width"`
+	Height    int        `json:"height"`
+	Caption   string     `json:"caption"`
+	TakenDate *time.Time `json:"taken_date"`
+	IsCover   bool       `json:"is_cover"`
 }
 
 type AreaNote struct {
@@ -173,14 +220,75 @@ func (a *Area) Transition(event interface{}) {
 		a.ReservoirUID = e.ReservoirUID
 
 	case AreaPhotoAdded:
-		a.Photo = AreaPhoto{
-			Filename: e.Filename,
-			MimeType: e.MimeType,
-			Size:     e.Size,
-			Width:    e.Width,
-			Height:   e.Height,
+		photo := AreaPhoto{
+			UID:       e.UID,
+			Filename:  e.Filename,
+			MimeType:  e.MimeType,
+			Size:      e.Size,
+			Width:     e.Width,
+			Height:    e.Height,
+			Caption:   e.Caption,
+			TakenDate: e.TakenDate,
 		}
 
+		if photo.UID == (uuid.UUID{}) {
+			// Before the gallery, a new photo replaced the area photo.
+			photo.UID = uuid.NewV5(a.UID, e.Filename)
+			a.Photos = nil
+		}
+
+		a.Photos = append(a.Photos, photo)
+
+		if len(a.Photos) == 1 {
+			a.setCoverPhoto(photo.UID)
+		}
+
+	case AreaPhotoUpdated:
+		for i, v := range a.Photos {
+			if v.UID == e.UID {
+				a.Photos[i].Caption = e.Caption
+				a.Photos[i].TakenDate = e.TakenDate
+			}
+		}
+
+		a.setCoverPhoto(a.Photo.UID)
+
+	case AreaPhotoRemoved:
+		photos := []AreaPhoto{}
+
+		for _, v := range a.Photos {
+			if v.UID != e.UID {
+				photos = append(photos, v)
+			}
+		}
+
+		a.Photos = photos
+
+		if a.Photo.UID == e.UID {
+			coverUID := uuid.UUID{}
+			if len(a.Photos) > 0 {
+				coverUID = a.Photos[0].UID
+			}
+
+			a.setCoverPhoto(coverUID)
+		}
+
+	case AreaPhotosReordered:
+		photos := []AreaPhoto{}
+
+		for _, uid := range e.PhotoUIDs {
+			for _, v := range a.Photos {
+				if v.UID == uid {
+					photos = append(photos, v)
+				}
+			}
+		}
+
+		a.Photos = photos
+
+	case AreaCoverPhotoChanged:
+		a.setCoverPhoto(e.UID)
+
 	case AreaNoteAdded:
 		if len(a.Notes) == 0 {
 			a.Notes = make(map[uuid.UUID]AreaNote)
@@ -194,6 +302,17 @@ func (a *Area) Transition(event interface{}) {
 
 	case AreaNoteRemoved:
 		delete(a.Notes, e.UID)
+
+	case AreaRetired:
+		a.IsRetired = true
+
+	case AreaBoundaryChanged:
+		boundary := e.Boundary
+		a.Boundary = &boundary
+		a.Size = e.Size
+
+	case AreaCapacityChanged:
+		a.Capacity = e.Capacity
 	}
 }
 
@@ -237,6 +356,10 @@ func CreateArea(
 		return nil, err
 	}
 
+	if farm.IsArchived {
+		return nil, AreaError{Code: AreaErrorFarmArchivedCode}
+	}
+
 	reservoir, err := areaService.FindReservoirByID(reservoirUID)
 	if err != nil {
 		return nil, err
@@ -285,12 +408,16 @@ func (a *Area) ChangeName(name string) error {
 	return nil
 }
 
-// ChangeSize changes an area size.
+// ChangeSize changes an area size. The size of an area with a boundary is computed from it.
 func (a *Area) ChangeSize(size AreaSize) error {
 	if err := validateSize(size); err != nil {
 		return err
 	}
 
+	if a.Boundary != nil {
+		return AreaError{Code: AreaErrorSizeFromBoundaryCode}
+	}
+
 	a.TrackChange(AreaSizeChanged{
 		AreaUID: a.UID,
 		Size:    size,
@@ -348,18 +475,14 @@ func (a *Area) ChangeReservoir(reservoirUID uuid.UUID) error {
 	return nil
 }
 
-// TODO: Do file type validation here.
+// ChangePhoto adds a photo to the area gallery and makes it the cover photo.
 func (a *Area) ChangePhoto(photo AreaPhoto) error {
-	a.TrackChange(AreaPhotoAdded{
-		AreaUID:  a.UID,
-		Filename: photo.Filename,
-		MimeType: photo.MimeType,
-		Size:     photo.Size,
-		Width:    photo.Width,
-		Height:   photo.Height,
-	})
+	err := a.AddPhoto(photo)
+	if err != nil {
+		return err
+	}
 
-	return nil
+	return a.ChangeCoverPhoto(a.Photos[len(a.Photos)-1].UID)
 }
 
 func (a *Area) AddNewNote(content string) error {
@@ -451,3 +574,28 @@ func validateAreaType(areaType string) error {
 
 	return nil
 }
+
+// Retire removes an area from use. It is refused while the area still has plants,
+// they have to be moved, harvested or dumped first. The area is kept for the crop history.
+func (a *Area) Retire(areaService AreaService) error {
+	if a.IsRetired {
+		return AreaError{Code: AreaErrorAlreadyRetiredCode}
+	}
+
+	count, err := areaService.CountPlantsByAreaID(a.UID)
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return AreaError{Code: AreaErrorHasActiveCropsCode}
+	}
+
+	a.TrackChange(AreaRetired{
+		AreaUID:     a.UID,
+		FarmUID:     a.FarmUID,
+		RetiredDate: time.Now(),
+	})
+
+	return nil
+}
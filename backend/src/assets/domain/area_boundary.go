@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"encoding/json"
+
+	"github.com/usetania/tania-core/src/helper/geohelper"
+	"github.com/usetania/tania-core/src/helper/unithelper"
+)
+
+const (
+	GeoJSONPolygon = "Polygon"
+	GeoJSONFeature = "Feature"
+)
+
+// AreaBoundary is a GeoJSON Polygon geometry. Positions are longitude and latitude in WGS 84,
+// the first ring is the area border and the next rings are holes.
+type AreaBoundary struct {
+	Type        string        `json:"type"`
+	Coordinates [][][]float64 `json:"coordinates"`
+}
+
+// ParseAreaBoundary reads a GeoJSON Polygon geometry, or a Feature with a Polygon geometry
+// as exported by most GIS tools.
+func ParseAreaBoundary(geojson string) (AreaBoundary, error) {
+	feature := struct {
+		Type     string        `json:"type"`
+		Geometry *AreaBoundary `json:"geometry"`
+	}{}
+
+	err := json.Unmarshal([]byte(geojson), &feature)
+	if err != nil {
+		return AreaBoundary{}, AreaError{Code: AreaErrorInvalidBoundaryCode}
+	}
+
+	boundary := AreaBoundary{}
+
+	if feature.Type == GeoJSONFeature {
+		if feature.Geometry == nil {
+			return AreaBoundary{}, AreaError{Code: AreaErrorInvalidBoundaryCode}
+		}
+
+		boundary = *feature.Geometry
+	} else {
+		err = json.Unmarshal([]byte(geojson), &boundary)
+		if err != nil {
+			return AreaBoundary{}, AreaError{Code: AreaErrorInvalidBoundaryCode}
+		}
+	}
+
+	err = validateBoundary(boundary)
+	if err != nil {
+		return AreaBoundary{}, err
+	}
+
+	return boundary, nil
+}
+
+// NewAreaBoundary builds a boundary from the rings of a polygon.
+func NewAreaBoundary(coordinates [][][]float64) (AreaBoundary, error) {
+	boundary := AreaBoundary{
+		Type:        GeoJSONPolygon,
+		Coordinates: coordinates,
+	}
+
+	err := validateBoundary(boundary)
+	if err != nil {
+		return AreaBoundary{}, err
+	}
+
+	return boundary, nil
+}
+
+// Size computes the geodesic surface of the boundary in the given unit.
+func (b AreaBoundary) Size(unit AreaUnit) AreaSize {
+	squareMeters := geohelper.PolygonArea(b.Coordinates)
+
+	area, err := unithelper.FromSquareMeters(squareMeters, unit.Symbol)
+	if err != nil {
+		area = squareMeters
+		unit = GetAreaUnit(SquareMeter)
+	}
+
+	return AreaSize{
+		Unit:  unit,
+		Value: float32(area),
+	}
+}
+
+// ChangeBoundary sets the area boundary. The area size is computed from the boundary
+// and keeps the current size unit.
+func (a *Area) ChangeBoundary(boundary AreaBoundary) error {
+	err := validateBoundary(boundary)
+	if err != nil {
+		return err
+	}
+
+	unit := GetAreaUnit(a.Size.Unit.Symbol)
+	if unit == (AreaUnit{}) {
+		unit = GetAreaUnit(SquareMeter)
+	}
+
+	a.TrackChange(AreaBoundaryChanged{
+		AreaUID:  a.UID,
+		Boundary: boundary,
+		Size:     boundary.Size(unit),
+	})
+
+	return nil
+}
+
+func validateBoundary(boundary AreaBoundary) error {
+	if boundary.Type != GeoJSONPolygon || len(boundary.Coordinates) == 0 {
+		return AreaError{Code: AreaErrorInvalidBoundaryCode}
+	}
+
+	for _, ring := range boundary.Coordinates {
+		// A closed ring needs at least three distinct positions.
+		if len(ring) < 4 {
+			return AreaError{Code: AreaErrorInvalidBoundaryCode}
+		}
+
+		for _, position := range ring {
+			if len(position) < 2 ||
+				position[0] < -180 || position[0] > 180 ||
+				position[1] < -90 || position[1] > 90 {
+				return AreaError{Code: AreaErrorInvalidBoundaryCode}
+			}
+		}
+
+		first, last := ring[0], ring[len(ring)-1]
+		if first[0] != last[0] || first[1] != last[1] {
+			return AreaError{Code: AreaErrorInvalidBoundaryCode}
+		}
+
+		if !geohelper.IsSimpleRing(ring) {
+			return AreaError{Code: AreaErrorInvalidBoundaryCode}
+		}
+	}
+
+	if geohelper.PolygonArea(boundary.Coordinates) <= 0 {
+		return AreaError{Code: AreaErrorInvalidBoundaryCode}
+	}
+
+	return nil
+}
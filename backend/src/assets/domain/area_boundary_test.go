@@ -0,0 +1,85 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	. "github.com/usetania/tania-core/src/assets/domain"
+)
+
+// About 100 by 100 meters near Yogyakarta.
+const fieldGeoJSON = `{"type":"Polygon","coordinates":[[[110.36,-7.8],[110.3609066,-7.8],
+	[110.3609066,-7.7990958],[110.36,-7.7990958],[110.36,-7.8]]]}`
+
+func TestParseAreaBoundary(t *testing.T) {
+	t.Parallel()
+	// Given
+	feature := `{"type":"Feature","properties":{"name":"Field"},"geometry":` + fieldGeoJSON + `}`
+	notClosed := `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,1]]]}`
+	selfIntersecting := `{"type":"Polygon","coordinates":[[[0,0],[1,1],[1,0],[0,1],[0,0]]]}`
+	outOfRange := `{"type":"Polygon","coordinates":[[[0,0],[200,0],[200,1],[0,1],[0,0]]]}`
+	point := `{"type":"Point","coordinates":[110.36,-7.8]}`
+
+	// When
+	boundary, err := ParseAreaBoundary(fieldGeoJSON)
+	_, errFeature := ParseAreaBoundary(feature)
+	_, errNotClosed := ParseAreaBoundary(notClosed)
+	_, errSelfIntersecting := ParseAreaBoundary(selfIntersecting)
+	_, errOutOfRange := ParseAreaBoundary(outOfRange)
+	_, errPoint := ParseAreaBoundary(point)
+	_, errMalformed := ParseAreaBoundary("not json")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, GeoJSONPolygon, boundary.Type)
+	assert.Nil(t, errFeature)
+	assert.Equal(t, AreaError{Code: AreaErrorInvalidBoundaryCode}, errNotClosed)
+	assert.Equal(t, AreaError{Code: AreaErrorInvalidBoundaryCode}, errSelfIntersecting)
+	assert.Equal(t, AreaError{Code: AreaErrorInvalidBoundaryCode}, errOutOfRange)
+	assert.Equal(t, AreaError{Code: AreaErrorInvalidBoundaryCode}, errPoint)
+	assert.Equal(t, AreaError{Code: AreaErrorInvalidBoundaryCode}, errMalformed)
+}
+
+func TestAreaChangeBoundary(t *testing.T) {
+	t.Parallel()
+	// Given
+	farmUID, _ := uuid.NewV4()
+	farmResult := AreaFarmServiceResult{UID: farmUID}
+
+	reservoirUID, _ := uuid.NewV4()
+	reservoirResult := AreaReservoirServiceResult{UID: reservoirUID}
+
+	area, err := CreateArea(
+		mockAreaService(farmResult, reservoirResult),
+		farmUID,
+		reservoirUID,
+		"My Area 1",
+		AreaTypeGrowing,
+		AreaSize{Unit: GetAreaUnit(Hectare), Value: float32(5)},
+		AreaLocationOutdoor,
+	)
+
+	boundary, _ := ParseAreaBoundary(fieldGeoJSON)
+
+	// When
+	errBoundary := area.ChangeBoundary(boundary)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Nil(t, errBoundary)
+	assert.NotNil(t, area.Boundary)
+	assert.Equal(t, Hectare, area.Size.Unit.Symbol)
+	assert.InDelta(t, 1, area.Size.Value, 0.001)
+
+	event, ok := area.UncommittedChanges[1].(AreaBoundaryChanged)
+	assert.True(t, ok)
+	assert.Equal(t, area.UID, event.AreaUID)
+	assert.Equal(t, area.Size, event.Size)
+
+	// When
+	errSize := area.ChangeSize(AreaSize{Unit: GetAreaUnit(SquareMeter), Value: float32(10)})
+
+	// Then
+	assert.Equal(t, AreaError{Code: AreaErrorSizeFromBoundaryCode}, errSize)
+}
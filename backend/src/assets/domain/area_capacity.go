@@ -0,0 +1,132 @@
+package domain
+
+const (
+	AreaCapacityPlant   = "PLANT"
+	AreaCapacityTray    = "TRAY"
+	AreaCapacitySpacing = "SPACING"
+
+	centimetersPerMeter = 100
+)
+
+// AreaCapacity is how much an area can hold. It is either a number of plants, a number of trays,
+// or derived from the area size and the spacing needed by each plant.
+type AreaCapacity struct {
+	Type string `json:"type"`
+	// Value is the number of plants or trays.
+	Value int `json:"value,omitempty"`
+	// Spacing is the distance between plants in centimeters, used for plant types without their own spacing.
+	Spacing float32 `json:"spacing,omitempty"`
+	// PlantTypeSpacings are the spacings in centimeters by plant type code.
+	PlantTypeSpacings map[string]float32 `json:"plant_type_spacings,omitempty"`
+}
+
+// AreaOccupancy is what an area currently holds.
+type AreaOccupancy struct {
+	Plants       int            `json:"plants"`
+	Trays        int            `json:"trays"`
+	PlantsByType map[string]int `json:"plants_by_type"`
+}
+
+// AddPlants counts the plants of a crop batch in the area. Plants grown in trays
+// fill as many trays as needed for the number of cells of their tray.
+func (o *AreaOccupancy) AddPlants(plantType string, plants, trayCells int) {
+	if plants <= 0 {
+		return
+	}
+
+	if o.PlantsByType == nil {
+		o.PlantsByType = make(map[string]int)
+	}
+
+	o.Plants += plants
+	o.PlantsByType[plantType] += plants
+
+	if trayCells > 0 {
+		o.Trays += (plants + trayCells - 1) / trayCells
+	}
+}
+
+// PlantSpacing returns the spacing in centimeters of a plant type.
+func (c AreaCapacity) PlantSpacing(plantType string) float32 {
+	if v, ok := c.PlantTypeSpacings[plantType]; ok && v > 0 {
+		return v
+	}
+
+	return c.Spacing
+}
+
+// PlantSurface is the surface in square meters taken by a single plant of a plant type.
+func (c AreaCapacity) PlantSurface(plantType string) float64 {
+	spacing := float64(c.PlantSpacing(plantType)) / centimetersPerMeter
+
+	return spacing * spacing
+}
+
+// UsedRatio is the part of the capacity taken by the occupancy, 1 when the area is full.
+func (c AreaCapacity) UsedRatio(size AreaSize, occupancy AreaOccupancy) float64 {
+	switch c.Type {
+	case AreaCapacityPlant:
+		return float64(occupancy.Plants) / float64(c.Value)
+	case AreaCapacityTray:
+		return float64(occupancy.Trays) / float64(c.Value)
+	case AreaCapacitySpacing:
+		surface := SquareMeters(size)
+		if surface <= 0 {
+			return 0
+		}
+
+		used := 0.0
+		for plantType, plants := range occupancy.PlantsByType {
+			used += float64(plants) * c.PlantSurface(plantType)
+		}
+
+		return used / surface
+	}
+
+	return 0
+}
+
+// ChangeCapacity sets the area capacity. A nil capacity removes it, so the area is not limited.
+// The plant type spacings are of the plant types of the catalog of the farm.
+func (a *Area) ChangeCapacity(capacity *AreaCapacity, catalog MaterialTypeCatalog) error {
+	if capacity != nil {
+		err := validateCapacity(*capacity, catalog)
+		if err != nil {
+			return err
+		}
+	}
+
+	a.TrackChange(AreaCapacityChanged{
+		AreaUID:  a.UID,
+		Capacity: capacity,
+	})
+
+	return nil
+}
+
+func validateCapacity(capacity AreaCapacity, catalog MaterialTypeCatalog) error {
+	switch capacity.Type {
+	case AreaCapacityPlant, AreaCapacityTray:
+		if capacity.Value <= 0 {
+			return AreaError{Code: AreaErrorInvalidCapacityCode}
+		}
+	case AreaCapacitySpacing:
+		if capacity.Spacing < 0 {
+			return AreaError{Code: AreaErrorInvalidCapacityCode}
+		}
+
+		for k, v := range capacity.PlantTypeSpacings {
+			if catalog.FindPlantType(k) == (PlantType{}) || v <= 0 {
+				return AreaError{Code: AreaErrorInvalidCapacityCode}
+			}
+		}
+
+		if capacity.Spacing == 0 && len(capacity.PlantTypeSpacings) == 0 {
+			return AreaError{Code: AreaErrorInvalidCapacityCode}
+		}
+	default:
+		return AreaError{Code: AreaErrorInvalidCapacityTypeCode}
+	}
+
+	return nil
+}
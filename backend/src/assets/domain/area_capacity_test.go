@@ -0,0 +1,89 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	. "github.com/usetania/tania-core/src/assets/domain"
+)
+
+func TestAreaChangeCapacity(t *testing.T) {
+	t.Parallel()
+	// Given
+	farmUID, _ := uuid.NewV4()
+	farmResult := AreaFarmServiceResult{UID: farmUID}
+
+	reservoirUID, _ := uuid.NewV4()
+	reservoirResult := AreaReservoirServiceResult{UID: reservoirUID}
+
+	area, err := CreateArea(
+		mockAreaService(farmResult, reservoirResult),
+		farmUID,
+		reservoirUID,
+		"My Area 1",
+		AreaTypeGrowing,
+		AreaSize{Unit: GetAreaUnit(SquareMeter), Value: float32(10)},
+		AreaLocationOutdoor,
+	)
+
+	catalog := DefaultMaterialTypeCatalog(farmUID)
+
+	spacing := &AreaCapacity{
+		Type:              AreaCapacitySpacing,
+		Spacing:           50,
+		PlantTypeSpacings: map[string]float32{PlantTypeTree: 100},
+	}
+
+	// When
+	errSpacing := area.ChangeCapacity(spacing, catalog)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Nil(t, errSpacing)
+	assert.Equal(t, spacing, area.Capacity)
+	assert.Equal(t, AreaCapacityChanged{AreaUID: area.UID, Capacity: spacing}, area.UncommittedChanges[1])
+
+	// When
+	errType := area.ChangeCapacity(&AreaCapacity{Type: "BUCKET", Value: 10}, catalog)
+	errValue := area.ChangeCapacity(&AreaCapacity{Type: AreaCapacityTray}, catalog)
+	errPlantType := area.ChangeCapacity(&AreaCapacity{
+		Type:              AreaCapacitySpacing,
+		PlantTypeSpacings: map[string]float32{"MUSHROOM": 10},
+	}, catalog)
+	errRemove := area.ChangeCapacity(nil, catalog)
+
+	// Then
+	assert.Equal(t, AreaError{Code: AreaErrorInvalidCapacityTypeCode}, errType)
+	assert.Equal(t, AreaError{Code: AreaErrorInvalidCapacityCode}, errValue)
+	assert.Equal(t, AreaError{Code: AreaErrorInvalidCapacityCode}, errPlantType)
+	assert.Nil(t, errRemove)
+	assert.Nil(t, area.Capacity)
+}
+
+func TestAreaCapacityUsedRatio(t *testing.T) {
+	t.Parallel()
+	// Given
+	size := AreaSize{Unit: GetAreaUnit(SquareMeter), Value: float32(10)}
+
+	occupancy := AreaOccupancy{}
+	occupancy.AddPlants(PlantTypeVegetable, 20, 15)
+	occupancy.AddPlants(PlantTypeTree, 2, 0)
+
+	// When
+	plants := AreaCapacity{Type: AreaCapacityPlant, Value: 44}.UsedRatio(size, occupancy)
+	trays := AreaCapacity{Type: AreaCapacityTray, Value: 4}.UsedRatio(size, occupancy)
+	spacing := AreaCapacity{
+		Type:              AreaCapacitySpacing,
+		Spacing:           50,
+		PlantTypeSpacings: map[string]float32{PlantTypeTree: 100},
+	}.UsedRatio(size, occupancy)
+
+	// Then
+	assert.Equal(t, 22, occupancy.Plants)
+	assert.Equal(t, 2, occupancy.Trays)
+	assert.InDelta(t, 0.5, plants, 0.0001)
+	assert.InDelta(t, 0.5, trays, 0.0001)
+	// 20 plants of 0.25 m2 and 2 plants of 1 m2 in 10 m2.
+	assert.InDelta(t, 0.7, spacing, 0.0001)
+}
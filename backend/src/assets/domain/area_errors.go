@@ -21,6 +21,22 @@ const (
 	AreaNoteErrorInvalidContent
 	AreaNoteErrorInvalidID
 	AreaNoteErrorNotFound
+
+	AreaErrorFarmArchivedCode
+	AreaErrorHasActiveCropsCode
+	AreaErrorAlreadyRetiredCode
+
+	AreaErrorInvalidBoundaryCode
+	AreaErrorSizeFromBoundaryCode
+
+	AreaErrorInvalidCapacityTypeCode
+	AreaErrorInvalidCapacityCode
+
+	AreaPhotoErrorInvalidFilename
+	AreaPhotoErrorInvalidMimeType
+	AreaPhotoErrorInvalidSize
+	AreaPhotoErrorNotFound
+	AreaPhotoErrorInvalidOrder
 )
 
 // AreaError is a custom error from Go built-in error.
@@ -62,6 +78,30 @@ func (e AreaError) Error() string {
 		return "Invalid crop note content"
 	case AreaNoteErrorNotFound:
 		return "Area note not found"
+	case AreaErrorFarmArchivedCode:
+		return "Cannot add area to an archived farm"
+	case AreaErrorHasActiveCropsCode:
+		return "Area still has active crops. Move, harvest, or dump them first"
+	case AreaErrorAlreadyRetiredCode:
+		return "Area is already retired"
+	case AreaErrorInvalidBoundaryCode:
+		return "Area boundary should be a valid GeoJSON polygon"
+	case AreaErrorSizeFromBoundaryCode:
+		return "Area size is computed from its boundary and cannot be changed"
+	case AreaErrorInvalidCapacityTypeCode:
+		return "Area capacity type should be PLANT, TRAY or SPACING"
+	case AreaErrorInvalidCapacityCode:
+		return "Area capacity should be a positive number of plants or trays, or spacings of known plant types"
+	case AreaPhotoErrorInvalidFilename:
+		return "Area photo filename is required"
+	case AreaPhotoErrorInvalidMimeType:
+		return "Area photo should be an image"
+	case AreaPhotoErrorInvalidSize:
+		return "Area photo is empty"
+	case AreaPhotoErrorNotFound:
+		return "Area photo not found"
+	case AreaPhotoErrorInvalidOrder:
+		return "Area photo order should list every photo of the area once"
 	default:
 		return "Unrecognized Area Error Code"
 	}
@@ -42,13 +42,40 @@ type AreaReservoirChanged struct {
 	ReservoirUID uuid.UUID
 }
 
+// AreaPhotoAdded adds a photo to the area gallery.
+// It replaced the area photo when it was recorded without UID, before the gallery.
 type AreaPhotoAdded struct {
-	AreaUID  uuid.UUID
-	Filename string
-	MimeType string
-	Size     int
-	Width    int
-	Height   int
+	AreaUID   uuid.UUID
+	UID       uuid.UUID
+	Filename  string
+	MimeType  string
+	Size      int
+	Width     int
+	Height    int
+	Caption   string
+	TakenDate *time.Time
+}
+
+type AreaPhotoUpdated struct {
+	AreaUID   uuid.UUID
+	UID       uuid.UUID
+	Caption   string
+	TakenDate *time.Time
+}
+
+type AreaPhotoRemoved struct {
+	AreaUID uuid.UUID
+	UID     uuid.UUID
+}
+
+type AreaPhotosReordered struct {
+	AreaUID   uuid.UUID
+	PhotoUIDs []uuid.UUID
+}
+
+type AreaCoverPhotoChanged struct {
+	AreaUID uuid.UUID
+	UID     uuid.UUID
 }
 
 type AreaNoteAdded struct {
@@ -62,3 +89,20 @@ type AreaNoteRemoved struct {
 	AreaUID uuid.UUID
 	UID     uuid.UUID
 }
+
+type AreaRetired struct {
+	AreaUID     uuid.UUID
+	FarmUID     uuid.UUID
+	RetiredDate time.Time
+}
+
+type AreaBoundaryChanged struct {
+	AreaUID  uuid.UUID
+	Boundary AreaBoundary
+	Size     AreaSize
+}
+
+type AreaCapacityChanged struct {
+	AreaUID  uuid.UUID
+	Capacity *AreaCapacity
+}
@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// AddPhoto adds a photo to the end of the area gallery. The first photo becomes the cover photo.
+// A photo without UID gets a new one.
+func (a *Area) AddPhoto(photo AreaPhoto) error {
+	if photo.Filename == "" {
+		return AreaError{Code: AreaPhotoErrorInvalidFilename}
+	}
+
+	if !strings.HasPrefix(photo.MimeType, "image/") {
+		return AreaError{Code: AreaPhotoErrorInvalidMimeType}
+	}
+
+	if photo.Size <= 0 {
+		return AreaError{Code: AreaPhotoErrorInvalidSize}
+	}
+
+	uid := photo.UID
+	if uid == (uuid.UUID{}) {
+		var err error
+
+		uid, err = uuid.NewV4()
+		if err != nil {
+			return err
+		}
+	}
+
+	a.TrackChange(AreaPhotoAdded{
+		AreaUID:   a.UID,
+		UID:       uid,
+		Filename:  photo.Filename,
+		MimeType:  photo.MimeType,
+		Size:      photo.Size,
+		Width:     photo.Width,
+		Height:    photo.Height,
+		Caption:   photo.Caption,
+		TakenDate: photo.TakenDate,
+	})
+
+	return nil
+}
+
+// UpdatePhoto changes the caption and the date a photo of the gallery was taken.
+func (a *Area) UpdatePhoto(uid uuid.UUID, caption string, takenDate *time.Time) error {
+	if a.FindPhotoByID(uid) == nil {
+		return AreaError{Code: AreaPhotoErrorNotFound}
+	}
+
+	a.TrackChange(AreaPhotoUpdated{
+		AreaUID:   a.UID,
+		UID:       uid,
+		Caption:   caption,
+		TakenDate: takenDate,
+	})
+
+	return nil
+}
+
+// RemovePhoto removes a photo from the gallery. Without its cover photo, the first photo becomes the cover.
+func (a *Area) RemovePhoto(uid uuid.UUID) error {
+	if a.FindPhotoByID(uid) == nil {
+		return AreaError{Code: AreaPhotoErrorNotFound}
+	}
+
+	a.TrackChange(AreaPhotoRemoved{
+		AreaUID: a.UID,
+		UID:     uid,
+	})
+
+	return nil
+}
+
+// ReorderPhotos sorts the gallery in the order of the photo UIDs, which should list every photo once.
+func (a *Area) ReorderPhotos(photoUIDs []uuid.UUID) error {
+	if len(photoUIDs) != len(a.Photos) {
+		return AreaError{Code: AreaPhotoErrorInvalidOrder}
+	}
+
+	for i, uid := range photoUIDs {
+		if a.FindPhotoByID(uid) == nil {
+			return AreaError{Code: AreaPhotoErrorInvalidOrder}
+		}
+
+		for _, v := range photoUIDs[:i] {
+			if v == uid {
+				return AreaError{Code: AreaPhotoErrorInvalidOrder}
+			}
+		}
+	}
+
+	a.TrackChange(AreaPhotosReordered{
+		AreaUID:   a.UID,
+		PhotoUIDs: photoUIDs,
+	})
+
+	return nil
+}
+
+// ChangeCoverPhoto makes a photo of the gallery the area photo.
+func (a *Area) ChangeCoverPhoto(uid uuid.UUID) error {
+	if a.FindPhotoByID(uid) == nil {
+		return AreaError{Code: AreaPhotoErrorNotFound}
+	}
+
+	a.TrackChange(AreaCoverPhotoChanged{
+		AreaUID: a.UID,
+		UID:     uid,
+	})
+
+	return nil
+}
+
+// FindPhotoByID is a photo of the gallery, or nil when the area does not have it.
+func (a Area) FindPhotoByID(uid uuid.UUID) *AreaPhoto {
+	for i, v := range a.Photos {
+		if v.UID == uid {
+			photo := a.Photos[i]
+
+			return &photo
+		}
+	}
+
+	return nil
+}
+
+// setCoverPhoto flags the cover photo of the gallery and copies it to the area photo.
+func (a *Area) setCoverPhoto(uid uuid.UUID) {
+	a.Photo = AreaPhoto{}
+
+	for i, v := range a.Photos {
+		a.Photos[i].IsCover = v.UID == uid
+
+		if a.Photos[i].IsCover {
+			a.Photo = a.Photos[i]
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	. "github.com/usetania/tania-core/src/assets/domain"
+)
+
+func TestAreaPhotoGallery(t *testing.T) {
+	t.Parallel()
+	// Given
+	farmUID, _ := uuid.NewV4()
+	farmResult := AreaFarmServiceResult{UID: farmUID}
+
+	reservoirUID, _ := uuid.NewV4()
+	reservoirResult := AreaReservoirServiceResult{UID: reservoirUID}
+
+	areaService := mockAreaService(farmResult, reservoirResult, countCropsResult{})
+
+	area, _ := CreateArea(
+		areaService,
+		farmUID,
+		reservoirUID,
+		"My Area 1",
+		AreaTypeSeeding,
+		AreaSize{Unit: GetAreaUnit(SquareMeter), Value: float32(10)},
+		AreaLocationIndoor,
+	)
+
+	frontUID, _ := uuid.NewV4()
+
+	// When
+	err1 := area.AddPhoto(AreaPhoto{
+		UID:      frontUID,
+		Filename: "front.jpg",
+		MimeType: "image/jpeg",
+		Size:     1000,
+		Caption:  "Front",
+	})
+	err2 := area.AddPhoto(AreaPhoto{Filename: "back.jpg", MimeType: "image/jpeg", Size: 1000})
+
+	// Then
+	assert.Nil(t, err1)
+	assert.Nil(t, err2)
+	assert.Len(t, area.Photos, 2)
+	assert.Equal(t, "front.jpg", area.Photo.Filename)
+	assert.True(t, area.Photos[0].IsCover)
+	assert.Equal(t, frontUID, area.Photos[0].UID)
+
+	front, back := area.Photos[0].UID, area.Photos[1].UID
+
+	// When
+	errCover := area.ChangeCoverPhoto(back)
+	errOrder := area.ReorderPhotos([]uuid.UUID{back, front})
+
+	// Then
+	assert.Nil(t, errCover)
+	assert.Nil(t, errOrder)
+	assert.Equal(t, "back.jpg", area.Photo.Filename)
+	assert.Equal(t, []uuid.UUID{back, front}, []uuid.UUID{area.Photos[0].UID, area.Photos[1].UID})
+
+	// When
+	err := area.RemovePhoto(back)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Len(t, area.Photos, 1)
+	assert.Equal(t, front, area.Photo.UID)
+	assert.True(t, area.Photos[0].IsCover)
+
+	// When
+	errType := area.AddPhoto(AreaPhoto{Filename: "notes.pdf", MimeType: "application/pdf", Size: 1000})
+	errNotFound := area.RemovePhoto(back)
+	errInvalidOrder := area.ReorderPhotos([]uuid.UUID{front, front})
+
+	// Then
+	assert.Equal(t, AreaError{Code: AreaPhotoErrorInvalidMimeType}, errType)
+	assert.Equal(t, AreaError{Code: AreaPhotoErrorNotFound}, errNotFound)
+	assert.Equal(t, AreaError{Code: AreaPhotoErrorInvalidOrder}, errInvalidOrder)
+}
+
+func TestAreaPhotoAddedBeforeGallery(t *testing.T) {
+	t.Parallel()
+	// Given
+	areaUID, _ := uuid.NewV4()
+	area := &Area{UID: areaUID}
+
+	// When
+	area.Transition(AreaPhotoAdded{AreaUID: areaUID, Filename: "old.jpg", MimeType: "image/jpeg", Size: 1000})
+	area.Transition(AreaPhotoAdded{AreaUID: areaUID, Filename: "new.jpg", MimeType: "image/jpeg", Size: 1000})
+
+	// Then
+	assert.Len(t, area.Photos, 1)
+	assert.Equal(t, "new.jpg", area.Photo.Filename)
+	assert.Equal(t, uuid.NewV5(areaUID, "new.jpg"), area.Photo.UID)
+}
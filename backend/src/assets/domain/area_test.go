@@ -31,6 +31,17 @@ func (m *AreaServiceMock) CountCropsByAreaID(areaUID uuid.UUID) (int, error) {
 	return args.Get(0).(int), nil
 }
 
+func (m *AreaServiceMock) CountPlantsByAreaID(areaUID uuid.UUID) (int, error) {
+	args := m.Called(areaUID)
+
+	return args.Get(0).(int), nil
+}
+
+type countPlantsResult struct {
+	AreaUID uuid.UUID
+	Count   int
+}
+
 type countCropsResult struct {
 	AreaUID uuid.UUID
 	Count   int
@@ -71,6 +82,32 @@ func TestCreateArea(t *testing.T) {
 	assert.Equal(t, area.UID, event.UID)
 }
 
+func TestCreateAreaOnArchivedFarm(t *testing.T) {
+	t.Parallel()
+	// Given
+	farmUID, _ := uuid.NewV4()
+	farmResult := AreaFarmServiceResult{UID: farmUID, IsArchived: true}
+
+	reservoirUID, _ := uuid.NewV4()
+	reservoirResult := AreaReservoirServiceResult{UID: reservoirUID}
+
+	areaService := mockAreaService(farmResult, reservoirResult)
+
+	// When
+	_, err := CreateArea(
+		areaService,
+		farmUID,
+		reservoirUID,
+		"My Area 1",
+		AreaTypeSeeding,
+		AreaSize{Unit: GetAreaUnit(SquareMeter), Value: float32(10)},
+		AreaLocationIndoor,
+	)
+
+	// Then
+	assert.Equal(t, AreaError{Code: AreaErrorFarmArchivedCode}, err)
+}
+
 func TestInvalidCreateArea(t *testing.T) {
 	t.Parallel()
 	// Given
@@ -253,8 +290,52 @@ func mockAreaService(results ...interface{}) *AreaServiceMock {
 			areaServiceMock.On("FindReservoirByID", res.UID).Return(res)
 		case countCropsResult:
 			areaServiceMock.On("CountCropsByAreaID", res.AreaUID).Return(res.Count)
+		case countPlantsResult:
+			areaServiceMock.On("CountPlantsByAreaID", res.AreaUID).Return(res.Count)
 		}
 	}
 
 	return areaServiceMock
 }
+
+func TestRetireArea(t *testing.T) {
+	t.Parallel()
+	// Given
+	farmUID, _ := uuid.NewV4()
+	farmResult := AreaFarmServiceResult{UID: farmUID}
+
+	reservoirUID, _ := uuid.NewV4()
+	reservoirResult := AreaReservoirServiceResult{UID: reservoirUID}
+
+	area, err := CreateArea(
+		mockAreaService(farmResult, reservoirResult),
+		farmUID,
+		reservoirUID,
+		"My Area 1",
+		AreaTypeSeeding,
+		AreaSize{Unit: GetAreaUnit(SquareMeter), Value: float32(10)},
+		AreaLocationIndoor,
+	)
+
+	// When
+	errActiveCrops := area.Retire(mockAreaService(countPlantsResult{AreaUID: area.UID, Count: 20}))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, AreaError{Code: AreaErrorHasActiveCropsCode}, errActiveCrops)
+	assert.False(t, area.IsRetired)
+
+	// When
+	errRetire := area.Retire(mockAreaService(countPlantsResult{AreaUID: area.UID, Count: 0}))
+	errRetireAgain := area.Retire(mockAreaService(countPlantsResult{AreaUID: area.UID, Count: 0}))
+
+	// Then
+	assert.Nil(t, errRetire)
+	assert.Equal(t, AreaError{Code: AreaErrorAlreadyRetiredCode}, errRetireAgain)
+	assert.True(t, area.IsRetired)
+
+	event, ok := area.UncommittedChanges[1].(AreaRetired)
+	assert.True(t, ok)
+	assert.Equal(t, area.UID, event.AreaUID)
+	assert.Equal(t, farmUID, event.FarmUID)
+}
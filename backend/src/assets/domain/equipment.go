@@ -0,0 +1,331 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// Equipment is a machine or tool of the farm, like a pump or a tractor,
+// which is serviced following its maintenance schedules.
+type Equipment struct {
+	UID          uuid.UUID
+	FarmUID      uuid.UUID
+	Name         string
+	Type         string
+	SerialNumber string
+	Location     *EquipmentLocation
+	PurchaseDate *time.Time
+	Cost         *EquipmentCost
+	CreatedDate  time.Time
+
+	MaintenanceSchedules []EquipmentMaintenanceSchedule
+	Maintenances         []EquipmentMaintenance
+
+	// Events
+	Version            int
+	UncommittedChanges []interface{}
+}
+
+type EquipmentService interface {
+	FindFarmByID(farmUID uuid.UUID) (EquipmentFarmServiceResult, error)
+	FindAreaByID(areaUID uuid.UUID) (EquipmentLocationServiceResult, error)
+	FindReservoirByID(reservoirUID uuid.UUID) (EquipmentLocationServiceResult, error)
+}
+
+type EquipmentFarmServiceResult struct {
+	UID  uuid.UUID
+	Name string
+}
+
+type EquipmentLocationServiceResult struct {
+	UID     uuid.UUID
+	Name    string
+	FarmUID uuid.UUID
+}
+
+const (
+	EquipmentTypePump      = "PUMP"
+	EquipmentTypeGrowLight = "GROW_LIGHT"
+	EquipmentTypeTractor   = "TRACTOR"
+	EquipmentTypeSprayer   = "SPRAYER"
+	EquipmentTypeOther     = "OTHER"
+)
+
+type EquipmentType struct {
+	Code  string `json:"code"`
+	Label string `json:"label"`
+}
+
+func EquipmentTypes() []EquipmentType {
+	return []EquipmentType{
+		{Code: EquipmentTypePump, Label: "Pump"},
+		{Code: EquipmentTypeGrowLight, Label: "Grow Light"},
+		{Code: EquipmentTypeTractor, Label: "Tractor"},
+		{Code: EquipmentTypeSprayer, Label: "Sprayer"},
+		{Code: EquipmentTypeOther, Label: "Other"},
+	}
+}
+
+func GetEquipmentType(code string) EquipmentType {
+	for _, v := range EquipmentTypes() {
+		if v.Code == code {
+			return v
+		}
+	}
+
+	return EquipmentType{}
+}
+
+const (
+	EquipmentLocationArea      = "AREA"
+	EquipmentLocationReservoir = "RESERVOIR"
+)
+
+// EquipmentLocation is the area or the reservoir of the farm where the equipment is installed.
+type EquipmentLocation struct {
+	Type string    `json:"type"`
+	UID  uuid.UUID `json:"uid"`
+}
+
+// EquipmentCost is the price the equipment was purchased for.
+type EquipmentCost struct {
+	Amount       float64 `json:"amount"`
+	CurrencyCode string  `json:"currency_code"`
+}
+
+// CreateEquipmentCost validates the purchase price of an equipment.
+func CreateEquipmentCost(amount float64, currencyCode string) (EquipmentCost, error) {
+	if amount < 0 || math.IsInf(amount, 0) || math.IsNaN(amount) {
+		return EquipmentCost{}, EquipmentError{EquipmentErrorInvalidCostCode}
+	}
+
+	code, err := GetCurrencyCode(currencyCode)
+	if err != nil {
+		return EquipmentCost{}, EquipmentError{EquipmentErrorInvalidCurrencyCode}
+	}
+
+	return EquipmentCost{Amount: amount, CurrencyCode: code}, nil
+}
+
+func (eq *Equipment) TrackChange(event interface{}) {
+	eq.UncommittedChanges = append(eq.UncommittedChanges, event)
+	eq.Transition(event)
+}
+
+func (eq *Equipment) Transition(event interface{}) {
+	switch e := event.(type) {
+	case EquipmentCreated:
+		eq.UID = e.UID
+		eq.FarmUID = e.FarmUID
+		eq.Name = e.Name
+		eq.Type = e.Type
+		eq.SerialNumber = e.SerialNumber
+		eq.Location = e.Location
+		eq.PurchaseDate = e.PurchaseDate
+		eq.Cost = e.Cost
+		eq.CreatedDate = e.CreatedDate
+
+	case EquipmentNameChanged:
+		eq.Name = e.Name
+
+	case EquipmentDetailsChanged:
+		eq.Type = e.Type
+		eq.SerialNumber = e.SerialNumber
+
+	case EquipmentLocationChanged:
+		eq.Location = e.Location
+
+	case EquipmentPurchaseChanged:
+		eq.PurchaseDate = e.PurchaseDate
+		eq.Cost = e.Cost
+
+	default:
+		eq.transitionMaintenance(event)
+	}
+}
+
+// CreateEquipment registers a new Equipment of the farm.
+// The location, the purchase date and the cost are optional.
+func CreateEquipment(
+	equipmentService EquipmentService,
+	farmUID uuid.UUID,
+	name, equipmentType, serialNumber string,
+	location *EquipmentLocation,
+	purchaseDate *time.Time,
+	cost *EquipmentCost,
+) (*Equipment, error) {
+	err := validateEquipmentName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateEquipmentDetails(equipmentType, serialNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateEquipmentPurchaseDate(purchaseDate)
+	if err != nil {
+		return nil, err
+	}
+
+	farm, err := equipmentService.FindFarmByID(farmUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateEquipmentLocation(equipmentService, farm.UID, location)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	initial := &Equipment{}
+
+	initial.TrackChange(EquipmentCreated{
+		UID:          uid,
+		FarmUID:      farm.UID,
+		Name:         name,
+		Type:         equipmentType,
+		SerialNumber: serialNumber,
+		Location:     location,
+		PurchaseDate: purchaseDate,
+		Cost:         cost,
+		CreatedDate:  time.Now(),
+	})
+
+	return initial, nil
+}
+
+// ChangeName is used to change Equipment Name.
+func (eq *Equipment) ChangeName(name string) error {
+	err := validateEquipmentName(name)
+	if err != nil {
+		return err
+	}
+
+	eq.TrackChange(EquipmentNameChanged{
+		EquipmentUID: eq.UID,
+		Name:         name,
+	})
+
+	return nil
+}
+
+// ChangeDetails replaces the type and the serial number of the Equipment.
+func (eq *Equipment) ChangeDetails(equipmentType, serialNumber string) error {
+	err := validateEquipmentDetails(equipmentType, serialNumber)
+	if err != nil {
+		return err
+	}
+
+	eq.TrackChange(EquipmentDetailsChanged{
+		EquipmentUID: eq.UID,
+		Type:         equipmentType,
+		SerialNumber: serialNumber,
+	})
+
+	return nil
+}
+
+// ChangeLocation moves the Equipment to another area or reservoir of its farm, or nowhere when nil.
+func (eq *Equipment) ChangeLocation(equipmentService EquipmentService, location *EquipmentLocation) error {
+	err := validateEquipmentLocation(equipmentService, eq.FarmUID, location)
+	if err != nil {
+		return err
+	}
+
+	eq.TrackChange(EquipmentLocationChanged{
+		EquipmentUID: eq.UID,
+		Location:     location,
+	})
+
+	return nil
+}
+
+// ChangePurchase replaces the purchase date and the cost of the Equipment.
+func (eq *Equipment) ChangePurchase(purchaseDate *time.Time, cost *EquipmentCost) error {
+	err := validateEquipmentPurchaseDate(purchaseDate)
+	if err != nil {
+		return err
+	}
+
+	eq.TrackChange(EquipmentPurchaseChanged{
+		EquipmentUID: eq.UID,
+		PurchaseDate: purchaseDate,
+		Cost:         cost,
+	})
+
+	return nil
+}
+
+func validateEquipmentName(name string) error {
+	if name == "" {
+		return EquipmentError{EquipmentErrorNameEmptyCode}
+	}
+
+	if len(name) > 100 {
+		return EquipmentError{EquipmentErrorNameExceedMaximunCharacterCode}
+	}
+
+	return nil
+}
+
+func validateEquipmentDetails(equipmentType, serialNumber string) error {
+	if GetEquipmentType(equipmentType) == (EquipmentType{}) {
+		return EquipmentError{EquipmentErrorInvalidTypeCode}
+	}
+
+	if len(serialNumber) > 100 {
+		return EquipmentError{EquipmentErrorSerialNumberExceedMaximunCharacterCode}
+	}
+
+	return nil
+}
+
+func validateEquipmentPurchaseDate(purchaseDate *time.Time) error {
+	if purchaseDate != nil && purchaseDate.After(time.Now()) {
+		return EquipmentError{EquipmentErrorInvalidPurchaseDateCode}
+	}
+
+	return nil
+}
+
+func validateEquipmentLocation(
+	equipmentService EquipmentService,
+	farmUID uuid.UUID,
+	location *EquipmentLocation,
+) error {
+	if location == nil {
+		return nil
+	}
+
+	found := EquipmentLocationServiceResult{}
+
+	var err error
+
+	switch location.Type {
+	case EquipmentLocationArea:
+		found, err = equipmentService.FindAreaByID(location.UID)
+	case EquipmentLocationReservoir:
+		found, err = equipmentService.FindReservoirByID(location.UID)
+	default:
+		return EquipmentError{EquipmentErrorInvalidLocationTypeCode}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if found.FarmUID != farmUID {
+		return EquipmentError{EquipmentErrorLocationNotFoundCode}
+	}
+
+	return nil
+}
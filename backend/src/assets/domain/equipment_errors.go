@@ -0,0 +1,59 @@
+package domain
+
+const (
+	EquipmentErrorNameEmptyCode = iota
+	EquipmentErrorNameExceedMaximunCharacterCode
+	EquipmentErrorInvalidTypeCode
+	EquipmentErrorSerialNumberExceedMaximunCharacterCode
+	EquipmentErrorFarmNotFoundCode
+	EquipmentErrorInvalidLocationTypeCode
+	EquipmentErrorLocationNotFoundCode
+	EquipmentErrorInvalidPurchaseDateCode
+	EquipmentErrorInvalidCostCode
+	EquipmentErrorInvalidCurrencyCode
+
+	EquipmentErrorMaintenanceTitleEmptyCode
+	EquipmentErrorInvalidMaintenanceIntervalCode
+	EquipmentErrorMaintenanceScheduleNotFoundCode
+	EquipmentErrorInvalidMaintenanceDateCode
+)
+
+// EquipmentError is a custom error from Go built-in error.
+type EquipmentError struct {
+	Code int
+}
+
+func (e EquipmentError) Error() string {
+	switch e.Code {
+	case EquipmentErrorNameEmptyCode:
+		return "Equipment name is required."
+	case EquipmentErrorNameExceedMaximunCharacterCode:
+		return "Equipment name cannot more than 100 characters"
+	case EquipmentErrorInvalidTypeCode:
+		return "Equipment type is invalid."
+	case EquipmentErrorSerialNumberExceedMaximunCharacterCode:
+		return "Equipment serial number cannot more than 100 characters"
+	case EquipmentErrorFarmNotFoundCode:
+		return "Equipment farm is not found."
+	case EquipmentErrorInvalidLocationTypeCode:
+		return "Equipment location should be an area or a reservoir."
+	case EquipmentErrorLocationNotFoundCode:
+		return "Equipment location is not found in the farm."
+	case EquipmentErrorInvalidPurchaseDateCode:
+		return "Equipment purchase date cannot be in the future."
+	case EquipmentErrorInvalidCostCode:
+		return "Equipment cost cannot be negative."
+	case EquipmentErrorInvalidCurrencyCode:
+		return "Equipment cost currency is invalid."
+	case EquipmentErrorMaintenanceTitleEmptyCode:
+		return "Equipment maintenance title is required."
+	case EquipmentErrorInvalidMaintenanceIntervalCode:
+		return "Equipment maintenance interval should be between 1 and 3650 days."
+	case EquipmentErrorMaintenanceScheduleNotFoundCode:
+		return "Equipment maintenance schedule is not found."
+	case EquipmentErrorInvalidMaintenanceDateCode:
+		return "Equipment maintenance date cannot be in the future."
+	default:
+		return "Unrecognized Equipment Error Code"
+	}
+}
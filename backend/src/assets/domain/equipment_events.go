@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+type EquipmentCreated struct {
+	UID          uuid.UUID
+	FarmUID      uuid.UUID
+	Name         string
+	Type         string
+	SerialNumber string
+	Location     *EquipmentLocation
+	PurchaseDate *time.Time
+	Cost         *EquipmentCost
+	CreatedDate  time.Time
+}
+
+type EquipmentNameChanged struct {
+	EquipmentUID uuid.UUID
+	Name         string
+}
+
+type EquipmentDetailsChanged struct {
+	EquipmentUID uuid.UUID
+	Type         string
+	SerialNumber string
+}
+
+type EquipmentLocationChanged struct {
+	EquipmentUID uuid.UUID
+	Location     *EquipmentLocation
+}
+
+type EquipmentPurchaseChanged struct {
+	EquipmentUID uuid.UUID
+	PurchaseDate *time.Time
+	Cost         *EquipmentCost
+}
+
+type EquipmentMaintenanceScheduleAdded struct {
+	EquipmentUID uuid.UUID
+	ScheduleUID  uuid.UUID
+	Title        string
+	IntervalDays int
+	NextDueDate  time.Time
+}
+
+type EquipmentMaintenanceScheduleChanged struct {
+	EquipmentUID uuid.UUID
+	ScheduleUID  uuid.UUID
+	Title        string
+	IntervalDays int
+	NextDueDate  time.Time
+}
+
+type EquipmentMaintenanceScheduleRemoved struct {
+	EquipmentUID uuid.UUID
+	ScheduleUID  uuid.UUID
+}
+
+type EquipmentMaintenanceRecorded struct {
+	EquipmentUID   uuid.UUID
+	MaintenanceUID uuid.UUID
+	ScheduleUID    uuid.UUID
+	Title          string
+	TaskUID        *uuid.UUID
+	DoneDate       time.Time
+	Notes          string
+}
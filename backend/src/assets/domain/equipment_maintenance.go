@@ -0,0 +1,239 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// EquipmentMaintenanceSchedule is a maintenance of the equipment to be done every IntervalDays,
+// counted from the day it was last done.
+type EquipmentMaintenanceSchedule struct {
+	UID          uuid.UUID  `json:"uid"`
+	Title        string     `json:"title"`
+	IntervalDays int        `json:"interval_days"`
+	LastDoneDate *time.Time `json:"last_done_date"`
+	NextDueDate  time.Time  `json:"next_due_date"`
+}
+
+// EquipmentMaintenance is a maintenance done on the equipment, by hand or by completing its task.
+type EquipmentMaintenance struct {
+	UID         uuid.UUID  `json:"uid"`
+	ScheduleUID uuid.UUID  `json:"schedule_id"`
+	Title       string     `json:"title"`
+	TaskUID     *uuid.UUID `json:"task_id"`
+	DoneDate    time.Time  `json:"done_date"`
+	Notes       string     `json:"notes"`
+}
+
+const maxMaintenanceIntervalDays = 3650
+
+func (eq *Equipment) transitionMaintenance(event interface{}) {
+	switch e := event.(type) {
+	case EquipmentMaintenanceScheduleAdded:
+		eq.MaintenanceSchedules = append(eq.MaintenanceSchedules, EquipmentMaintenanceSchedule{
+			UID:          e.ScheduleUID,
+			Title:        e.Title,
+			IntervalDays: e.IntervalDays,
+			NextDueDate:  e.NextDueDate,
+		})
+
+	case EquipmentMaintenanceScheduleChanged:
+		for i, v := range eq.MaintenanceSchedules {
+			if v.UID == e.ScheduleUID {
+				eq.MaintenanceSchedules[i].Title = e.Title
+				eq.MaintenanceSchedules[i].IntervalDays = e.IntervalDays
+				eq.MaintenanceSchedules[i].NextDueDate = e.NextDueDate
+			}
+		}
+
+	case EquipmentMaintenanceScheduleRemoved:
+		schedules := []EquipmentMaintenanceSchedule{}
+
+		for _, v := range eq.MaintenanceSchedules {
+			if v.UID != e.ScheduleUID {
+				schedules = append(schedules, v)
+			}
+		}
+
+		eq.MaintenanceSchedules = schedules
+
+	case EquipmentMaintenanceRecorded:
+		eq.Maintenances = append(eq.Maintenances, EquipmentMaintenance{
+			UID:         e.MaintenanceUID,
+			ScheduleUID: e.ScheduleUID,
+			Title:       e.Title,
+			TaskUID:     e.TaskUID,
+			DoneDate:    e.DoneDate,
+			Notes:       e.Notes,
+		})
+
+		sort.SliceStable(eq.Maintenances, func(i, j int) bool {
+			return eq.Maintenances[i].DoneDate.Before(eq.Maintenances[j].DoneDate)
+		})
+
+		for i, v := range eq.MaintenanceSchedules {
+			// A maintenance recorded late doesn't move back the schedule.
+			if v.UID != e.ScheduleUID || (v.LastDoneDate != nil && v.LastDoneDate.After(e.DoneDate)) {
+				continue
+			}
+
+			doneDate := e.DoneDate
+
+			eq.MaintenanceSchedules[i].LastDoneDate = &doneDate
+			eq.MaintenanceSchedules[i].NextDueDate = MaintenanceDueDate(doneDate, v.IntervalDays)
+		}
+	}
+}
+
+// MaintenanceDueDate is the day a maintenance is due again, the given number of days after the given date.
+func MaintenanceDueDate(date time.Time, intervalDays int) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day()+intervalDays, 0, 0, 0, 0, date.Location())
+}
+
+// AddMaintenanceSchedule schedules a maintenance every intervalDays. It is first due on the given date,
+// or once the interval passed from today when nil.
+func (eq *Equipment) AddMaintenanceSchedule(
+	title string,
+	intervalDays int,
+	firstDueDate *time.Time,
+) (EquipmentMaintenanceSchedule, error) {
+	title = strings.TrimSpace(title)
+
+	err := validateMaintenanceSchedule(title, intervalDays)
+	if err != nil {
+		return EquipmentMaintenanceSchedule{}, err
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return EquipmentMaintenanceSchedule{}, err
+	}
+
+	nextDueDate := MaintenanceDueDate(time.Now(), intervalDays)
+	if firstDueDate != nil {
+		nextDueDate = *firstDueDate
+	}
+
+	eq.TrackChange(EquipmentMaintenanceScheduleAdded{
+		EquipmentUID: eq.UID,
+		ScheduleUID:  uid,
+		Title:        title,
+		IntervalDays: intervalDays,
+		NextDueDate:  nextDueDate,
+	})
+
+	return eq.MaintenanceSchedules[len(eq.MaintenanceSchedules)-1], nil
+}
+
+// ChangeMaintenanceSchedule replaces the title, the interval and the next due date of a maintenance schedule.
+func (eq *Equipment) ChangeMaintenanceSchedule(
+	scheduleUID uuid.UUID,
+	title string,
+	intervalDays int,
+	nextDueDate time.Time,
+) error {
+	title = strings.TrimSpace(title)
+
+	if _, err := eq.MaintenanceSchedule(scheduleUID); err != nil {
+		return err
+	}
+
+	err := validateMaintenanceSchedule(title, intervalDays)
+	if err != nil {
+		return err
+	}
+
+	eq.TrackChange(EquipmentMaintenanceScheduleChanged{
+		EquipmentUID: eq.UID,
+		ScheduleUID:  scheduleUID,
+		Title:        title,
+		IntervalDays: intervalDays,
+		NextDueDate:  nextDueDate,
+	})
+
+	return nil
+}
+
+// RemoveMaintenanceSchedule stops a maintenance schedule. The maintenances done are kept.
+func (eq *Equipment) RemoveMaintenanceSchedule(scheduleUID uuid.UUID) error {
+	if _, err := eq.MaintenanceSchedule(scheduleUID); err != nil {
+		return err
+	}
+
+	eq.TrackChange(EquipmentMaintenanceScheduleRemoved{
+		EquipmentUID: eq.UID,
+		ScheduleUID:  scheduleUID,
+	})
+
+	return nil
+}
+
+// RecordMaintenance records a scheduled maintenance done on the given date, which is then due again
+// once its interval passed. The task is the one completed to do it, if any.
+func (eq *Equipment) RecordMaintenance(
+	scheduleUID uuid.UUID,
+	doneDate time.Time,
+	taskUID *uuid.UUID,
+	notes string,
+) (EquipmentMaintenance, error) {
+	schedule, err := eq.MaintenanceSchedule(scheduleUID)
+	if err != nil {
+		return EquipmentMaintenance{}, err
+	}
+
+	if doneDate.After(time.Now()) {
+		return EquipmentMaintenance{}, EquipmentError{EquipmentErrorInvalidMaintenanceDateCode}
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return EquipmentMaintenance{}, err
+	}
+
+	event := EquipmentMaintenanceRecorded{
+		EquipmentUID:   eq.UID,
+		MaintenanceUID: uid,
+		ScheduleUID:    scheduleUID,
+		Title:          schedule.Title,
+		TaskUID:        taskUID,
+		DoneDate:       doneDate,
+		Notes:          strings.TrimSpace(notes),
+	}
+
+	eq.TrackChange(event)
+
+	return EquipmentMaintenance{
+		UID:         event.MaintenanceUID,
+		ScheduleUID: event.ScheduleUID,
+		Title:       event.Title,
+		TaskUID:     event.TaskUID,
+		DoneDate:    event.DoneDate,
+		Notes:       event.Notes,
+	}, nil
+}
+
+// MaintenanceSchedule finds a maintenance schedule of the Equipment.
+func (eq *Equipment) MaintenanceSchedule(scheduleUID uuid.UUID) (EquipmentMaintenanceSchedule, error) {
+	for _, v := range eq.MaintenanceSchedules {
+		if v.UID == scheduleUID {
+			return v, nil
+		}
+	}
+
+	return EquipmentMaintenanceSchedule{}, EquipmentError{EquipmentErrorMaintenanceScheduleNotFoundCode}
+}
+
+func validateMaintenanceSchedule(title string, intervalDays int) error {
+	if title == "" {
+		return EquipmentError{EquipmentErrorMaintenanceTitleEmptyCode}
+	}
+
+	if intervalDays < 1 || intervalDays > maxMaintenanceIntervalDays {
+		return EquipmentError{EquipmentErrorInvalidMaintenanceIntervalCode}
+	}
+
+	return nil
+}
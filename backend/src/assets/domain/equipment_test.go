@@ -0,0 +1,156 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	. "github.com/usetania/tania-core/src/assets/domain"
+)
+
+type EquipmentServiceMock struct {
+	mock.Mock
+}
+
+func (m *EquipmentServiceMock) FindFarmByID(uid uuid.UUID) (EquipmentFarmServiceResult, error) {
+	args := m.Called(uid)
+
+	return args.Get(0).(EquipmentFarmServiceResult), nil
+}
+
+func (m *EquipmentServiceMock) FindAreaByID(uid uuid.UUID) (EquipmentLocationServiceResult, error) {
+	args := m.Called(uid)
+
+	return args.Get(0).(EquipmentLocationServiceResult), nil
+}
+
+func (m *EquipmentServiceMock) FindReservoirByID(uid uuid.UUID) (EquipmentLocationServiceResult, error) {
+	args := m.Called(uid)
+
+	return args.Get(0).(EquipmentLocationServiceResult), nil
+}
+
+func TestCreateEquipment(t *testing.T) {
+	t.Parallel()
+	// Given
+	farmUID, _ := uuid.NewV4()
+	otherFarmUID, _ := uuid.NewV4()
+	areaUID, _ := uuid.NewV4()
+	reservoirUID, _ := uuid.NewV4()
+
+	serviceMock := new(EquipmentServiceMock)
+	serviceMock.On("FindFarmByID", farmUID).Return(EquipmentFarmServiceResult{UID: farmUID, Name: "My Farm"})
+	serviceMock.On("FindAreaByID", areaUID).Return(EquipmentLocationServiceResult{UID: areaUID, FarmUID: farmUID})
+	serviceMock.On("FindReservoirByID", reservoirUID).
+		Return(EquipmentLocationServiceResult{UID: reservoirUID, FarmUID: otherFarmUID})
+
+	purchaseDate := time.Date(2019, time.March, 2, 0, 0, 0, 0, time.UTC)
+	cost, errCost := CreateEquipmentCost(1250, "idr")
+	_, errNegativeCost := CreateEquipmentCost(-1, "EUR")
+	tomorrow := time.Now().AddDate(0, 0, 1)
+
+	// When
+	equipment, err := CreateEquipment(serviceMock, farmUID, "Main Pump", EquipmentTypePump, "P-1234",
+		&EquipmentLocation{Type: EquipmentLocationArea, UID: areaUID}, &purchaseDate, &cost)
+	_, errName := CreateEquipment(serviceMock, farmUID, "", EquipmentTypePump, "", nil, nil, nil)
+	_, errType := CreateEquipment(serviceMock, farmUID, "Main Pump", "BOAT", "", nil, nil, nil)
+	_, errPurchaseDate := CreateEquipment(serviceMock, farmUID, "Main Pump", EquipmentTypePump, "", nil, &tomorrow, nil)
+	_, errLocationType := CreateEquipment(serviceMock, farmUID, "Main Pump", EquipmentTypePump, "",
+		&EquipmentLocation{Type: "FARM", UID: farmUID}, nil, nil)
+	_, errOtherFarm := CreateEquipment(serviceMock, farmUID, "Main Pump", EquipmentTypePump, "",
+		&EquipmentLocation{Type: EquipmentLocationReservoir, UID: reservoirUID}, nil, nil)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Nil(t, errCost)
+	assert.Equal(t, EquipmentCost{Amount: 1250, CurrencyCode: "IDR"}, *equipment.Cost)
+	assert.Equal(t, EquipmentError{EquipmentErrorInvalidCostCode}, errNegativeCost)
+	assert.Equal(t, farmUID, equipment.FarmUID)
+	assert.Equal(t, areaUID, equipment.Location.UID)
+
+	event, ok := equipment.UncommittedChanges[0].(EquipmentCreated)
+	assert.True(t, ok)
+	assert.Equal(t, equipment.UID, event.UID)
+
+	assert.Equal(t, EquipmentError{EquipmentErrorNameEmptyCode}, errName)
+	assert.Equal(t, EquipmentError{EquipmentErrorInvalidTypeCode}, errType)
+	assert.Equal(t, EquipmentError{EquipmentErrorInvalidPurchaseDateCode}, errPurchaseDate)
+	assert.Equal(t, EquipmentError{EquipmentErrorInvalidLocationTypeCode}, errLocationType)
+	assert.Equal(t, EquipmentError{EquipmentErrorLocationNotFoundCode}, errOtherFarm)
+
+	// When
+	errChangeName := equipment.ChangeName("Backup Pump")
+	errChangeDetails := equipment.ChangeDetails(EquipmentTypeSprayer, "")
+	errChangeLocation := equipment.ChangeLocation(serviceMock, nil)
+	errChangePurchase := equipment.ChangePurchase(nil, nil)
+
+	// Then
+	assert.Nil(t, errChangeName)
+	assert.Nil(t, errChangeDetails)
+	assert.Nil(t, errChangeLocation)
+	assert.Nil(t, errChangePurchase)
+	assert.Equal(t, "Backup Pump", equipment.Name)
+	assert.Equal(t, EquipmentTypeSprayer, equipment.Type)
+	assert.Nil(t, equipment.Location)
+	assert.Nil(t, equipment.Cost)
+	assert.Len(t, equipment.UncommittedChanges, 5)
+}
+
+func TestEquipmentMaintenance(t *testing.T) {
+	t.Parallel()
+	// Given
+	equipment := &Equipment{}
+	equipment.Transition(EquipmentCreated{Name: "Tractor", Type: EquipmentTypeTractor})
+
+	firstDueDate := time.Date(2019, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+	// When
+	schedule, err := equipment.AddMaintenanceSchedule(" Oil change ", 90, &firstDueDate)
+	_, errTitle := equipment.AddMaintenanceSchedule("", 90, nil)
+	_, errInterval := equipment.AddMaintenanceSchedule("Oil change", 0, nil)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "Oil change", schedule.Title)
+	assert.Equal(t, firstDueDate, schedule.NextDueDate)
+	assert.Equal(t, EquipmentError{EquipmentErrorMaintenanceTitleEmptyCode}, errTitle)
+	assert.Equal(t, EquipmentError{EquipmentErrorInvalidMaintenanceIntervalCode}, errInterval)
+
+	// When
+	doneDate := time.Date(2019, time.April, 3, 15, 30, 0, 0, time.UTC)
+	taskUID, _ := uuid.NewV4()
+	unknownUID, _ := uuid.NewV4()
+
+	maintenance, err := equipment.RecordMaintenance(schedule.UID, doneDate, &taskUID, "Filter too")
+	_, errLate := equipment.RecordMaintenance(schedule.UID, doneDate.AddDate(0, 0, -10), nil, "")
+	_, errNotFound := equipment.RecordMaintenance(unknownUID, doneDate, nil, "")
+	_, errFuture := equipment.RecordMaintenance(schedule.UID, time.Now().AddDate(0, 0, 1), nil, "")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Nil(t, errLate)
+	assert.Equal(t, "Oil change", maintenance.Title)
+	assert.Equal(t, &taskUID, maintenance.TaskUID)
+	assert.Equal(t, EquipmentError{EquipmentErrorMaintenanceScheduleNotFoundCode}, errNotFound)
+	assert.Equal(t, EquipmentError{EquipmentErrorInvalidMaintenanceDateCode}, errFuture)
+
+	// The maintenance recorded late is listed first, but the schedule counts from the last one.
+	assert.Len(t, equipment.Maintenances, 2)
+	assert.Equal(t, maintenance.UID, equipment.Maintenances[1].UID)
+	assert.Equal(t, doneDate, *equipment.MaintenanceSchedules[0].LastDoneDate)
+	assert.Equal(t, time.Date(2019, time.July, 2, 0, 0, 0, 0, time.UTC), equipment.MaintenanceSchedules[0].NextDueDate)
+
+	// When
+	errChange := equipment.ChangeMaintenanceSchedule(schedule.UID, "Oil and filter change", 30, firstDueDate)
+	errRemove := equipment.RemoveMaintenanceSchedule(schedule.UID)
+	errRemoveAgain := equipment.RemoveMaintenanceSchedule(schedule.UID)
+
+	// Then
+	assert.Nil(t, errChange)
+	assert.Nil(t, errRemove)
+	assert.Equal(t, EquipmentError{EquipmentErrorMaintenanceScheduleNotFoundCode}, errRemoveAgain)
+	assert.Empty(t, equipment.MaintenanceSchedules)
+	assert.Len(t, equipment.Maintenances, 2)
+}
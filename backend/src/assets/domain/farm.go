@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/helper/unithelper"
 )
 
 type Farm struct {
@@ -17,8 +18,12 @@ type Farm struct {
 	Country     string    `json:"country"`
 	City        string    `json:"city"`
 	IsActive    bool      `json:"is_active"`
+	UnitSystem  string    `json:"unit_system"`
+	Currency    string    `json:"currency"`
 	CreatedDate time.Time `json:"created_date"`
 
+	ExchangeRates []ExchangeRate `json:"exchange_rates"`
+
 	// Events
 	Version            int
 	UncommittedChanges []interface{}
@@ -64,6 +69,32 @@ func (f *Farm) Transition(event interface{}) {
 	case FarmRegionChanged:
 		f.Country = e.Country
 		f.City = e.City
+
+	case FarmArchived:
+		f.IsActive = false
+
+	case FarmReactivated:
+		f.IsActive = true
+
+	case FarmUnitSystemChanged:
+		f.UnitSystem = e.UnitSystem
+
+	case FarmCurrencyChanged:
+		f.Currency = e.Currency
+
+	case FarmExchangeRateAdded:
+		f.ExchangeRates = append(f.ExchangeRates, e.ExchangeRate)
+
+	case FarmExchangeRateRemoved:
+		rates := []ExchangeRate{}
+
+		for _, v := range f.ExchangeRates {
+			if v.UID != e.ExchangeRateUID {
+				rates = append(rates, v)
+			}
+		}
+
+		f.ExchangeRates = rates
 	}
 }
 
@@ -179,3 +210,47 @@ func (f *Farm) ChangeRegion(country, city string) error {
 
 	return nil
 }
+
+// Archive deactivates a farm. Archived farms are hidden from the farm list
+// and cannot have new areas or crops, but their history is kept.
+func (f *Farm) Archive() error {
+	if !f.IsActive {
+		return FarmError{FarmErrorAlreadyArchivedCode}
+	}
+
+	f.TrackChange(FarmArchived{
+		FarmUID:      f.UID,
+		ArchivedDate: time.Now(),
+	})
+
+	return nil
+}
+
+// Reactivate brings an archived farm back to use.
+func (f *Farm) Reactivate() error {
+	if f.IsActive {
+		return FarmError{FarmErrorNotArchivedCode}
+	}
+
+	f.TrackChange(FarmReactivated{
+		FarmUID:         f.UID,
+		ReactivatedDate: time.Now(),
+	})
+
+	return nil
+}
+
+// ChangeUnitSystem sets the unit system the farm areas and harvests are shown in.
+// Values are always stored in metric units.
+func (f *Farm) ChangeUnitSystem(unitSystem string) error {
+	if !unithelper.IsUnitSystem(unitSystem) {
+		return FarmError{FarmErrorInvalidUnitSystemCode}
+	}
+
+	f.TrackChange(FarmUnitSystemChanged{
+		FarmUID:    f.UID,
+		UnitSystem: unitSystem,
+	})
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"golang.org/x/text/currency"
+)
+
+// ExchangeRate is how many units of the To currency one unit of the From currency
+// is worth, from its effective date until the next rate between the same currencies.
+type ExchangeRate struct {
+	UID           uuid.UUID `json:"uid"`
+	FromCurrency  string    `json:"from_currency"`
+	ToCurrency    string    `json:"to_currency"`
+	Rate          float64   `json:"rate"`
+	EffectiveDate time.Time `json:"effective_date"`
+	CreatedDate   time.Time `json:"created_date"`
+}
+
+// BaseCurrency is the currency farm costs are reported in.
+// Farms which never picked one use EUR, the only currency materials could be priced in before.
+func BaseCurrency(currencyCode string) string {
+	if currencyCode == "" {
+		return MoneyEUR
+	}
+
+	return currencyCode
+}
+
+// ChangeCurrency sets the base currency of the farm.
+// The exchange rates to the previous base currency are removed, they cannot convert costs to the new one.
+func (f *Farm) ChangeCurrency(currencyCode string) error {
+	code, err := GetCurrencyCode(currencyCode)
+	if err != nil {
+		return FarmError{FarmErrorInvalidCurrencyCode}
+	}
+
+	if code != BaseCurrency(f.Currency) {
+		for _, v := range f.ExchangeRates {
+			f.TrackChange(FarmExchangeRateRemoved{
+				FarmUID:         f.UID,
+				ExchangeRateUID: v.UID,
+			})
+		}
+	}
+
+	f.TrackChange(FarmCurrencyChanged{
+		FarmUID:  f.UID,
+		Currency: code,
+	})
+
+	return nil
+}
+
+// AddExchangeRate adds the rate of a currency to the farm base currency, effective from the given date.
+func (f *Farm) AddExchangeRate(fromCurrency string, rate float64, effectiveDate time.Time) (ExchangeRate, error) {
+	from, err := GetCurrencyCode(fromCurrency)
+	if err != nil {
+		return ExchangeRate{}, FarmError{FarmErrorInvalidCurrencyCode}
+	}
+
+	to := BaseCurrency(f.Currency)
+
+	if from == to || rate <= 0 || math.IsInf(rate, 0) || math.IsNaN(rate) {
+		return ExchangeRate{}, FarmError{FarmErrorInvalidExchangeRateCode}
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return ExchangeRate{}, err
+	}
+
+	exchangeRate := ExchangeRate{
+		UID:           uid,
+		FromCurrency:  from,
+		ToCurrency:    to,
+		Rate:          rate,
+		EffectiveDate: effectiveDate,
+		CreatedDate:   time.Now(),
+	}
+
+	f.TrackChange(FarmExchangeRateAdded{
+		FarmUID:      f.UID,
+		ExchangeRate: exchangeRate,
+	})
+
+	return exchangeRate, nil
+}
+
+// RemoveExchangeRate removes a rate entered by mistake.
+func (f *Farm) RemoveExchangeRate(uid uuid.UUID) error {
+	found := false
+
+	for _, v := range f.ExchangeRates {
+		if v.UID == uid {
+			found = true
+		}
+	}
+
+	if !found {
+		return FarmError{FarmErrorExchangeRateNotFoundCode}
+	}
+
+	f.TrackChange(FarmExchangeRateRemoved{
+		FarmUID:         f.UID,
+		ExchangeRateUID: uid,
+	})
+
+	return nil
+}
+
+// ConvertAmount converts an amount between currencies with the latest rate effective at the date.
+// Rates are used both ways, so a rate from EUR to IDR also converts IDR to EUR.
+func ConvertAmount(amount float64, from, to string, rates []ExchangeRate, date time.Time) (float64, error) {
+	if from == to {
+		return amount, nil
+	}
+
+	sorted := make([]ExchangeRate, len(rates))
+	copy(sorted, rates)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].EffectiveDate.After(sorted[j].EffectiveDate)
+	})
+
+	for _, v := range sorted {
+		if v.EffectiveDate.After(date) {
+			continue
+		}
+
+		if v.FromCurrency == from && v.ToCurrency == to {
+			return amount * v.Rate, nil
+		}
+
+		if v.FromCurrency == to && v.ToCurrency == from {
+			return amount / v.Rate, nil
+		}
+	}
+
+	return 0, FarmError{FarmErrorExchangeRateNotFoundCode}
+}
+
+// FormatAmount formats an amount with the number of decimals used by its currency.
+func FormatAmount(amount float64, currencyCode string) string {
+	scale := 2
+
+	unit, err := currency.ParseISO(currencyCode)
+	if err == nil {
+		scale, _ = currency.Standard.Rounding(unit)
+	}
+
+	return strconv.FormatFloat(amount, 'f', scale, 64)
+}
@@ -0,0 +1,79 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	. "github.com/usetania/tania-core/src/assets/domain"
+)
+
+func TestFarmExchangeRate(t *testing.T) {
+	t.Parallel()
+	// Given
+	farm, farmErr := CreateFarm("my farm", "organic", "90.000", "100.000", "ID", "JK")
+	january := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	february := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	// When
+	currencyErr := farm.ChangeCurrency("idr")
+	invalidCurrencyErr := farm.ChangeCurrency("ABC")
+	januaryRate, januaryErr := farm.AddExchangeRate(MoneyEUR, 17000, january)
+	_, februaryErr := farm.AddExchangeRate(MoneyEUR, 17500, february)
+	_, sameCurrencyErr := farm.AddExchangeRate(MoneyIDR, 1, january)
+	_, negativeErr := farm.AddExchangeRate("USD", -1, january)
+
+	// Then
+	assert.Nil(t, farmErr)
+	assert.Nil(t, currencyErr)
+	assert.Equal(t, FarmError{FarmErrorInvalidCurrencyCode}, invalidCurrencyErr)
+	assert.Nil(t, januaryErr)
+	assert.Nil(t, februaryErr)
+	assert.Equal(t, FarmError{FarmErrorInvalidExchangeRateCode}, sameCurrencyErr)
+	assert.Equal(t, FarmError{FarmErrorInvalidExchangeRateCode}, negativeErr)
+	assert.Equal(t, MoneyIDR, farm.Currency)
+	assert.Equal(t, MoneyIDR, januaryRate.ToCurrency)
+	assert.Len(t, farm.ExchangeRates, 2)
+
+	// When
+	inJanuary, errJanuary := ConvertAmount(2, MoneyEUR, MoneyIDR, farm.ExchangeRates, january.AddDate(0, 0, 10))
+	inFebruary, errFebruary := ConvertAmount(2, MoneyEUR, MoneyIDR, farm.ExchangeRates, february)
+	inverse, errInverse := ConvertAmount(35000, MoneyIDR, MoneyEUR, farm.ExchangeRates, february)
+	_, errBefore := ConvertAmount(2, MoneyEUR, MoneyIDR, farm.ExchangeRates, january.AddDate(0, 0, -1))
+	_, errUnknown := ConvertAmount(2, "USD", MoneyIDR, farm.ExchangeRates, february)
+
+	// Then
+	assert.Nil(t, errJanuary)
+	assert.Nil(t, errFebruary)
+	assert.Nil(t, errInverse)
+	assert.InDelta(t, 34000, inJanuary, 0.0001)
+	assert.InDelta(t, 35000, inFebruary, 0.0001)
+	assert.InDelta(t, 2, inverse, 0.0001)
+	assert.Equal(t, FarmError{FarmErrorExchangeRateNotFoundCode}, errBefore)
+	assert.Equal(t, FarmError{FarmErrorExchangeRateNotFoundCode}, errUnknown)
+
+	// When
+	uid, _ := uuid.NewV4()
+	removeErr := farm.RemoveExchangeRate(januaryRate.UID)
+	notFoundErr := farm.RemoveExchangeRate(uid)
+
+	// Then
+	assert.Nil(t, removeErr)
+	assert.Equal(t, FarmError{FarmErrorExchangeRateNotFoundCode}, notFoundErr)
+	assert.Len(t, farm.ExchangeRates, 1)
+	assert.Equal(t, "34000", FormatAmount(34000.4, MoneyIDR))
+	assert.Equal(t, "1.50", FormatAmount(1.5, MoneyEUR))
+
+	// When
+	sameCurrencyChangeErr := farm.ChangeCurrency(MoneyIDR)
+	ratesAfterSameCurrency := len(farm.ExchangeRates)
+	newCurrencyErr := farm.ChangeCurrency(MoneyEUR)
+
+	// Then
+	assert.Nil(t, sameCurrencyChangeErr)
+	assert.Equal(t, 1, ratesAfterSameCurrency)
+	assert.Nil(t, newCurrencyErr)
+	assert.Equal(t, MoneyEUR, farm.Currency)
+	assert.Empty(t, farm.ExchangeRates)
+}
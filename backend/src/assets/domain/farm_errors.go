@@ -23,6 +23,15 @@ const (
 	FarmErrorInvalidLongitudeValueCode
 	FarmErrorInvalidCountry
 	FarmErrorInvalidCity
+
+	FarmErrorAlreadyArchivedCode
+	FarmErrorNotArchivedCode
+
+	FarmErrorInvalidUnitSystemCode
+
+	FarmErrorInvalidCurrencyCode
+	FarmErrorInvalidExchangeRateCode
+	FarmErrorExchangeRateNotFoundCode
 )
 
 func (e FarmError) Error() string {
@@ -53,6 +62,18 @@ func (e FarmError) Error() string {
 		return "Invalid country"
 	case FarmErrorInvalidCity:
 		return "Invalid city"
+	case FarmErrorAlreadyArchivedCode:
+		return "Farm is already archived"
+	case FarmErrorNotArchivedCode:
+		return "Farm is not archived"
+	case FarmErrorInvalidUnitSystemCode:
+		return "Invalid unit system"
+	case FarmErrorInvalidCurrencyCode:
+		return "Invalid ISO 4217 currency code"
+	case FarmErrorInvalidExchangeRateCode:
+		return "Exchange rate should be positive and between two different currencies"
+	case FarmErrorExchangeRateNotFoundCode:
+		return "Exchange rate not found"
 	default:
 		return "Unrecognized location error code"
 	}
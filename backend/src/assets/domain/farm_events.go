@@ -39,3 +39,33 @@ type FarmRegionChanged struct {
 	Country string
 	City    string
 }
+
+type FarmArchived struct {
+	FarmUID      uuid.UUID
+	ArchivedDate time.Time
+}
+
+type FarmReactivated struct {
+	FarmUID         uuid.UUID
+	ReactivatedDate time.Time
+}
+
+type FarmUnitSystemChanged struct {
+	FarmUID    uuid.UUID
+	UnitSystem string
+}
+
+type FarmCurrencyChanged struct {
+	FarmUID  uuid.UUID
+	Currency string
+}
+
+type FarmExchangeRateAdded struct {
+	FarmUID      uuid.UUID
+	ExchangeRate ExchangeRate
+}
+
+type FarmExchangeRateRemoved struct {
+	FarmUID         uuid.UUID
+	ExchangeRateUID uuid.UUID
+}
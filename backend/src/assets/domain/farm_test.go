@@ -100,3 +100,56 @@ func TestChangeRegion(t *testing.T) {
 	assert.Equal(t, farm.UID, event.FarmUID)
 	assert.Equal(t, farm.Country, event.Country)
 }
+
+func TestArchiveFarm(t *testing.T) {
+	t.Parallel()
+	// Given
+	farm, farmErr := CreateFarm("my farm", "organic", "90.000", "100.000", "ID", "JK")
+
+	// When
+	archiveErr := farm.Archive()
+	archiveAgainErr := farm.Archive()
+
+	// Then
+	assert.Nil(t, farmErr)
+	assert.Nil(t, archiveErr)
+	assert.Equal(t, FarmError{FarmErrorAlreadyArchivedCode}, archiveAgainErr)
+	assert.False(t, farm.IsActive)
+
+	event, ok := farm.UncommittedChanges[1].(FarmArchived)
+	assert.True(t, ok)
+	assert.Equal(t, farm.UID, event.FarmUID)
+
+	// When
+	reactivateErr := farm.Reactivate()
+	reactivateAgainErr := farm.Reactivate()
+
+	// Then
+	assert.Nil(t, reactivateErr)
+	assert.Equal(t, FarmError{FarmErrorNotArchivedCode}, reactivateAgainErr)
+	assert.True(t, farm.IsActive)
+
+	_, ok = farm.UncommittedChanges[2].(FarmReactivated)
+	assert.True(t, ok)
+}
+
+func TestChangeFarmUnitSystem(t *testing.T) {
+	t.Parallel()
+	// Given
+	farm, farmErr := CreateFarm("my farm", "organic", "90.000", "100.000", "ID", "JK")
+
+	// When
+	err := farm.ChangeUnitSystem("IMPERIAL")
+	errInvalid := farm.ChangeUnitSystem("NAUTICAL")
+
+	// Then
+	assert.Nil(t, farmErr)
+	assert.Nil(t, err)
+	assert.Equal(t, FarmError{FarmErrorInvalidUnitSystemCode}, errInvalid)
+	assert.Equal(t, "IMPERIAL", farm.UnitSystem)
+
+	event, ok := farm.UncommittedChanges[1].(FarmUnitSystemChanged)
+	assert.True(t, ok)
+	assert.Equal(t, farm.UID, event.FarmUID)
+	assert.Equal(t, "IMPERIAL", event.UnitSystem)
+}
@@ -2,13 +2,17 @@ package domain
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/helper/datehelper"
+	"golang.org/x/text/currency"
 )
 
 type Material struct {
 	UID            uuid.UUID        `json:"uid"`
+	FarmUID        uuid.UUID        `json:"farm_id"`
 	Name           string           `json:"name"`
 	PricePerUnit   PricePerUnit     `json:"price_per_unit"`
 	Type           MaterialType     `json:"type"`
@@ -16,8 +20,18 @@ type Material struct {
 	ExpirationDate *time.Time       `json:"expiration_date"`
 	Notes          *string          `json:"notes"`
 	ProducedBy     *string          `json:"produced_by"`
+	ReorderPoint   *float32         `json:"reorder_point"`
+	Lots           []MaterialLot    `json:"lots"`
 	CreatedDate    time.Time        `json:"created_date"`
 
+	// unlotted is the stock which was there before any receipt, like the quantity the material was created with.
+	// The quantity is the unlotted stock plus what remains of the lots.
+	unlotted float32
+	// cropLots is the net quantity each crop batch took from each lot
+	cropLots map[uuid.UUID][]MaterialLotQuantity
+	// cropTaken is the net quantity each crop batch took out of the stock, which stops at zero
+	cropTaken map[uuid.UUID]float32
+
 	// Events
 	Version            int
 	UncommittedChanges []interface{}
@@ -33,13 +47,15 @@ type PricePerUnit struct {
 	CurrencyCode string `json:"code"`
 }
 
+// Symbol is the narrow symbol of the currency, like € or Rp.
+// Currencies without a symbol are shown with their code.
 func (p PricePerUnit) Symbol() string {
-	switch p.CurrencyCode {
-	case MoneyEUR:
-		return "€"
-	default:
+	unit, err := currency.ParseISO(p.CurrencyCode)
+	if err != nil {
 		return ""
 	}
+
+	return fmt.Sprint(currency.NarrowSymbol(unit))
 }
 
 func CreatePricePerUnit(amount, currencyCode string) (PricePerUnit, error) {
@@ -54,13 +70,14 @@ func CreatePricePerUnit(amount, currencyCode string) (PricePerUnit, error) {
 	}, nil
 }
 
+// GetCurrencyCode validates an ISO 4217 currency code and returns it in upper case.
 func GetCurrencyCode(currencyCode string) (string, error) {
-	switch currencyCode {
-	case MoneyEUR:
-		return MoneyEUR, nil
-	default:
+	unit, err := currency.ParseISO(currencyCode)
+	if err != nil || unit == (currency.Unit{}) {
 		return "", errors.New("wrong currency code")
 	}
+
+	return unit.String(), nil
 }
 
 const (
@@ -154,6 +171,7 @@ func (m *Material) Transition(event interface{}) {
 		m.PricePerUnit = e.PricePerUnit
 		m.Type = e.Type
 		m.Quantity = e.Quantity
+		m.unlotted = e.Quantity.Value
 		m.ExpirationDate = e.ExpirationDate
 		m.Notes = e.Notes
 		m.ProducedBy = e.ProducedBy
@@ -169,7 +187,9 @@ func (m *Material) Transition(event interface{}) {
 		m.PricePerUnit = e.Price
 
 	case MaterialQuantityChanged:
-		m.Quantity = e.Quantity
+		m.Quantity.Unit = e.Quantity.Unit
+		m.unlotted = e.Quantity.Value - m.lotsRemaining()
+		m.deriveQuantity()
 
 	case MaterialExpirationDateChanged:
 		m.ExpirationDate = &e.ExpirationDate
@@ -179,6 +199,33 @@ func (m *Material) Transition(event interface{}) {
 
 	case MaterialProducedByChanged:
 		m.ProducedBy = &e.ProducedBy
+
+	case MaterialConsumed:
+		m.trackCropTaken(e.CropUID, e.Quantity)
+		m.takeFromLots(e.Quantity, e.Lots)
+		m.trackCropLots(e.CropUID, e.Lots)
+
+	case MaterialStockReceived:
+		m.Lots = append(m.Lots, MaterialLot{
+			UID:            e.LotUID,
+			LotNumber:      e.LotNumber,
+			SupplierUID:    e.SupplierUID,
+			Quantity:       e.Quantity,
+			Remaining:      e.Quantity,
+			UnitCost:       e.UnitCost,
+			ReceivedDate:   e.ReceivedDate,
+			ExpirationDate: e.ExpirationDate,
+		})
+		m.deriveQuantity()
+
+	case MaterialStockIssued:
+		m.takeFromLots(e.Quantity, e.Lots)
+
+	case MaterialReorderPointChanged:
+		m.ReorderPoint = e.ReorderPoint
+
+	case MaterialFarmAssigned:
+		m.FarmUID = e.FarmUID
 	}
 }
 
@@ -272,12 +319,18 @@ func (m *Material) ChangePricePerUnit(price, priceUnit string) error {
 	return nil
 }
 
+// ChangeQuantityUnit corrects the quantity and its unit. Once the material has lots, its quantity only changes
+// through the receipts and the issues of the stock, so only the unit can be changed.
 func (m *Material) ChangeQuantityUnit(quantity float32, quantityUnit string, materialType MaterialType) error {
 	err := validateQuantity(quantity)
 	if err != nil {
 		return err
 	}
 
+	if len(m.Lots) > 0 && quantity != m.Quantity.Value {
+		return MaterialError{MaterialErrorQuantityFromLots}
+	}
+
 	qu, err := validateQuantityUnit(quantityUnit, materialType)
 	if err != nil {
 		return err
@@ -329,6 +382,180 @@ func (m *Material) ChangeProducedBy(producedBy string) {
 	})
 }
 
+// ChangeReorderPoint sets the quantity under which the material should be bought again.
+// A nil reorder point stops watching the stock of the material.
+func (m *Material) ChangeReorderPoint(reorderPoint *float32) error {
+	if reorderPoint != nil && *reorderPoint < 0 {
+		return MaterialError{MaterialErrorInvalidReorderPoint}
+	}
+
+	m.TrackChange(MaterialReorderPointChanged{
+		MaterialUID:  m.UID,
+		ReorderPoint: reorderPoint,
+	})
+
+	return nil
+}
+
+// AssignFarm sets the farm which keeps the stock of the material. Materials created before the farms kept
+// their own stock have no farm until one is assigned.
+func (m *Material) AssignFarm(farmUID uuid.UUID) error {
+	if farmUID == (uuid.UUID{}) {
+		return MaterialError{MaterialErrorInvalidFarm}
+	}
+
+	if m.FarmUID == farmUID {
+		return nil
+	}
+
+	m.TrackChange(MaterialFarmAssigned{
+		MaterialUID: m.UID,
+		FarmUID:     farmUID,
+	})
+
+	return nil
+}
+
+// IsMaterialExpiring tells if an expiration date is within the given number of days after the date.
+// Materials which already expired are expiring too.
+func IsMaterialExpiring(expirationDate *time.Time, date time.Time, days int) bool {
+	if expirationDate == nil {
+		return false
+	}
+
+	return datehelper.IsExpired(expirationDate, date.AddDate(0, 0, days+1))
+}
+
+// IsLowStock tells if the quantity left is below the reorder point of the material.
+func (m Material) IsLowStock() bool {
+	return m.Quantity.IsBelow(m.ReorderPoint)
+}
+
+// Consume records the quantity of the material used by a completed task.
+func (m *Material) Consume(taskUID uuid.UUID, quantity float32) error {
+	if quantity <= 0 {
+		return MaterialError{MaterialErrorInvalidConsumedQuantity}
+	}
+
+	m.TrackChange(MaterialConsumed{
+		MaterialUID:  m.UID,
+		TaskUID:      taskUID,
+		Quantity:     quantity,
+		Lots:         m.allocateLots(quantity),
+		ConsumedDate: time.Now(),
+	})
+
+	return nil
+}
+
+// ConsumeForCrop records the quantity of the material used by a crop batch.
+// A negative quantity gives back what a corrected crop batch does not use anymore,
+// up to what the crop batch actually took out of the stock.
+func (m *Material) ConsumeForCrop(cropUID uuid.UUID, quantity float32) error {
+	if quantity == 0 {
+		return MaterialError{MaterialErrorInvalidConsumedQuantity}
+	}
+
+	if taken := m.cropTaken[cropUID]; -quantity > taken {
+		quantity = -taken
+	}
+
+	if quantity == 0 {
+		return nil
+	}
+
+	var lots []MaterialLotQuantity
+
+	if quantity > 0 {
+		lots = m.allocateLots(quantity)
+	} else {
+		lots = m.returnCropLots(cropUID, -quantity)
+	}
+
+	m.TrackChange(MaterialConsumed{
+		MaterialUID:  m.UID,
+		CropUID:      cropUID,
+		Quantity:     quantity,
+		Lots:         lots,
+		ConsumedDate: time.Now(),
+	})
+
+	return nil
+}
+
+// ConsumeForReservoir records the quantity of the material mixed into a reservoir by a dosing.
+func (m *Material) ConsumeForReservoir(reservoirUID uuid.UUID, quantity float32) error {
+	if quantity <= 0 {
+		return MaterialError{MaterialErrorInvalidConsumedQuantity}
+	}
+
+	m.TrackChange(MaterialConsumed{
+		MaterialUID:  m.UID,
+		ReservoirUID: reservoirUID,
+		Quantity:     quantity,
+		Lots:         m.allocateLots(quantity),
+		ConsumedDate: time.Now(),
+	})
+
+	return nil
+}
+
+// ReturnFromReservoir gives back the quantity a reservoir dosing took out of the stock, to the lots it was taken
+// from, when the dosing could not be saved.
+func (m *Material) ReturnFromReservoir(reservoirUID uuid.UUID, quantity float32, lots []MaterialLotQuantity) error {
+	if quantity <= 0 {
+		return MaterialError{MaterialErrorInvalidConsumedQuantity}
+	}
+
+	returned := []MaterialLotQuantity{}
+	for _, v := range lots {
+		returned = append(returned, MaterialLotQuantity{LotUID: v.LotUID, Quantity: -v.Quantity})
+	}
+
+	m.TrackChange(MaterialConsumed{
+		MaterialUID:  m.UID,
+		ReservoirUID: reservoirUID,
+		Quantity:     -quantity,
+		Lots:         returned,
+		ConsumedDate: time.Now(),
+	})
+
+	return nil
+}
+
+// ConsumeForAnimal records the quantity of the veterinary material given to an animal by a treatment.
+func (m *Material) ConsumeForAnimal(animalUID uuid.UUID, quantity float32) error {
+	if quantity <= 0 {
+		return MaterialError{MaterialErrorInvalidConsumedQuantity}
+	}
+
+	m.TrackChange(MaterialConsumed{
+		MaterialUID:  m.UID,
+		AnimalUID:    animalUID,
+		Quantity:     quantity,
+		Lots:         m.allocateLots(quantity),
+		ConsumedDate: time.Now(),
+	})
+
+	return nil
+}
+
+// Consume returns the quantity left after using some of it.
+// Tasks completed with force and crop batches can use more than what is left, so it stops at zero.
+func (q MaterialQuantity) Consume(quantity float32) MaterialQuantity {
+	q.Value -= quantity
+	if q.Value < 0 {
+		q.Value = 0
+	}
+
+	return q
+}
+
+// IsBelow tells if the quantity is below the reorder point. There is no low stock without a reorder point.
+func (q MaterialQuantity) IsBelow(reorderPoint *float32) bool {
+	return reorderPoint != nil && q.Value < *reorderPoint
+}
+
 func validateQuantity(quantity float32) error {
 	if quantity <= 0 {
 		return errors.New("cannot be empty")
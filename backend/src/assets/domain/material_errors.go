@@ -2,6 +2,20 @@ package domain
 
 const (
 	MaterialErrorInvalidMaterialType = iota
+	MaterialErrorInvalidConsumedQuantity
+	MaterialErrorInvalidReorderPoint
+	MaterialErrorInvalidReceivedQuantity
+	MaterialErrorLotNumberExists
+	MaterialErrorInvalidIssuedQuantity
+	MaterialErrorInsufficientStock
+	MaterialErrorLotNotFound
+	MaterialErrorInvalidValuationMethod
+	MaterialErrorInvalidCatalogTypeCode
+	MaterialErrorCatalogTypeCodeExists
+	MaterialErrorInvalidCatalogTypeLabel
+	MaterialErrorCatalogTypeNotFound
+	MaterialErrorQuantityFromLots
+	MaterialErrorInvalidFarm
 )
 
 // MaterialError is a custom error from Go built-in error.
@@ -13,6 +27,34 @@ func (e MaterialError) Error() string {
 	switch e.Code {
 	case MaterialErrorInvalidMaterialType:
 		return "Invalid material type"
+	case MaterialErrorInvalidConsumedQuantity:
+		return "Consumed quantity should be more than zero, or not zero for a crop batch"
+	case MaterialErrorInvalidReorderPoint:
+		return "Reorder point cannot be negative"
+	case MaterialErrorInvalidReceivedQuantity:
+		return "Received quantity should be more than zero"
+	case MaterialErrorLotNumberExists:
+		return "Lot number is already received for this material"
+	case MaterialErrorInvalidIssuedQuantity:
+		return "Issued quantity should be more than zero"
+	case MaterialErrorInsufficientStock:
+		return "Issued quantity is more than the stock left"
+	case MaterialErrorLotNotFound:
+		return "Lot is not found for this material"
+	case MaterialErrorInvalidValuationMethod:
+		return "Valuation method should be FIFO or WEIGHTED_AVERAGE"
+	case MaterialErrorInvalidCatalogTypeCode:
+		return "Type code should be 2 to 50 uppercase letters, digits or underscores, starting with a letter"
+	case MaterialErrorCatalogTypeCodeExists:
+		return "Type code already exists in the catalog"
+	case MaterialErrorInvalidCatalogTypeLabel:
+		return "Type label should be 1 to 100 characters"
+	case MaterialErrorCatalogTypeNotFound:
+		return "Type is not found in the catalog"
+	case MaterialErrorQuantityFromLots:
+		return "Quantity of a material with lots only changes by receiving or issuing stock"
+	case MaterialErrorInvalidFarm:
+		return "Farm is required"
 	default:
 		return "Unrecognized Material Error Code"
 	}
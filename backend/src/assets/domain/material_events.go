@@ -53,3 +53,50 @@ type MaterialProducedByChanged struct {
 	MaterialUID uuid.UUID
 	ProducedBy  string
 }
+
+// MaterialConsumed is a quantity of the material used by a completed task, a crop batch, a reservoir dosing
+// or an animal treatment. Crop batch corrections give some back with a negative quantity.
+type MaterialConsumed struct {
+	MaterialUID  uuid.UUID
+	TaskUID      uuid.UUID
+	CropUID      uuid.UUID
+	ReservoirUID uuid.UUID
+	AnimalUID    uuid.UUID
+	Quantity     float32
+	Lots         []MaterialLotQuantity
+	ConsumedDate time.Time
+}
+
+// MaterialReorderPointChanged is the quantity under which the material should be bought again.
+// A nil reorder point means the stock of the material is not watched.
+type MaterialReorderPointChanged struct {
+	MaterialUID  uuid.UUID
+	ReorderPoint *float32
+}
+
+// MaterialFarmAssigned is the farm which keeps the stock of the material.
+type MaterialFarmAssigned struct {
+	MaterialUID uuid.UUID
+	FarmUID     uuid.UUID
+}
+
+// MaterialStockReceived is a lot of the material received, from a supplier when SupplierUID is set.
+type MaterialStockReceived struct {
+	MaterialUID    uuid.UUID
+	LotUID         uuid.UUID
+	LotNumber      string
+	SupplierUID    uuid.UUID
+	Quantity       float32
+	UnitCost       PricePerUnit
+	ReceivedDate   time.Time
+	ExpirationDate *time.Time
+}
+
+// MaterialStockIssued is a quantity of the material which left the stock without a task or a crop batch.
+type MaterialStockIssued struct {
+	MaterialUID uuid.UUID
+	Quantity    float32
+	Lots        []MaterialLotQuantity
+	Reason      string
+	IssuedDate  time.Time
+}
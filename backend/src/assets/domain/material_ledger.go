@@ -0,0 +1,254 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// MaterialLot is a quantity of a material received at once, traced from its supplier
+// to the tasks and crop batches which used it.
+type MaterialLot struct {
+	UID            uuid.UUID    `json:"uid"`
+	LotNumber      string       `json:"lot_number"`
+	SupplierUID    uuid.UUID    `json:"supplier_id"`
+	Quantity       float32      `json:"quantity"`
+	Remaining      float32      `json:"remaining"`
+	UnitCost       PricePerUnit `json:"unit_cost"`
+	ReceivedDate   time.Time    `json:"received_date"`
+	ExpirationDate *time.Time   `json:"expiration_date"`
+}
+
+// MaterialLotQuantity is the part of an issued or consumed quantity taken from a lot.
+type MaterialLotQuantity struct {
+	LotUID   uuid.UUID
+	Quantity float32
+}
+
+// ReceiveStock records a receipt of the material as a new lot, which adds to the material quantity.
+func (m *Material) ReceiveStock(
+	lotNumber string,
+	supplierUID uuid.UUID,
+	quantity float32,
+	unitCost PricePerUnit,
+	receivedDate time.Time,
+	expirationDate *time.Time,
+) (MaterialLot, error) {
+	if quantity <= 0 {
+		return MaterialLot{}, MaterialError{MaterialErrorInvalidReceivedQuantity}
+	}
+
+	if lotNumber != "" {
+		for _, v := range m.Lots {
+			if v.LotNumber == lotNumber {
+				return MaterialLot{}, MaterialError{MaterialErrorLotNumberExists}
+			}
+		}
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return MaterialLot{}, err
+	}
+
+	m.TrackChange(MaterialStockReceived{
+		MaterialUID:    m.UID,
+		LotUID:         uid,
+		LotNumber:      lotNumber,
+		SupplierUID:    supplierUID,
+		Quantity:       quantity,
+		UnitCost:       unitCost,
+		ReceivedDate:   receivedDate,
+		ExpirationDate: expirationDate,
+	})
+
+	return m.Lots[len(m.Lots)-1], nil
+}
+
+// IssueStock records a quantity of the material leaving the stock for another reason than
+// a task or a crop batch, like a sale or a loss. It is taken from the given lot, or from the oldest stock.
+func (m *Material) IssueStock(quantity float32, lotUID *uuid.UUID, reason string) error {
+	if quantity <= 0 {
+		return MaterialError{MaterialErrorInvalidIssuedQuantity}
+	}
+
+	if quantity > m.Quantity.Value {
+		return MaterialError{MaterialErrorInsufficientStock}
+	}
+
+	lots := []MaterialLotQuantity{}
+
+	if lotUID != nil {
+		lot, ok := m.findLot(*lotUID)
+		if !ok {
+			return MaterialError{MaterialErrorLotNotFound}
+		}
+
+		if quantity > lot.Remaining {
+			return MaterialError{MaterialErrorInsufficientStock}
+		}
+
+		lots = append(lots, MaterialLotQuantity{LotUID: lot.UID, Quantity: quantity})
+	} else {
+		lots = m.allocateLots(quantity)
+	}
+
+	m.TrackChange(MaterialStockIssued{
+		MaterialUID: m.UID,
+		Quantity:    quantity,
+		Lots:        lots,
+		Reason:      reason,
+		IssuedDate:  time.Now(),
+	})
+
+	return nil
+}
+
+func (m *Material) findLot(lotUID uuid.UUID) (MaterialLot, bool) {
+	for _, v := range m.Lots {
+		if v.UID == lotUID {
+			return v, true
+		}
+	}
+
+	return MaterialLot{}, false
+}
+
+// allocateLots splits a quantity taken out of the stock between the lots, first in first out.
+// The stock which was there before any receipt is the oldest, so it is taken first and belongs to no lot.
+func (m *Material) allocateLots(quantity float32) []MaterialLotQuantity {
+	lots := []MaterialLotQuantity{}
+
+	if m.unlotted > 0 {
+		quantity -= m.unlotted
+	}
+
+	received := make([]MaterialLot, len(m.Lots))
+	copy(received, m.Lots)
+
+	sort.SliceStable(received, func(i, j int) bool {
+		return received[i].ReceivedDate.Before(received[j].ReceivedDate)
+	})
+
+	for _, v := range received {
+		if quantity <= 0 {
+			break
+		}
+
+		if v.Remaining <= 0 {
+			continue
+		}
+
+		taken := v.Remaining
+		if quantity < taken {
+			taken = quantity
+		}
+
+		lots = append(lots, MaterialLotQuantity{LotUID: v.UID, Quantity: taken})
+		quantity -= taken
+	}
+
+	return lots
+}
+
+// returnCropLots gives back a quantity to the lots a crop batch took it from, the last taken first.
+func (m *Material) returnCropLots(cropUID uuid.UUID, quantity float32) []MaterialLotQuantity {
+	lots := []MaterialLotQuantity{}
+	taken := m.cropLots[cropUID]
+
+	for i := len(taken) - 1; i >= 0 && quantity > 0; i-- {
+		if taken[i].Quantity <= 0 {
+			continue
+		}
+
+		returned := taken[i].Quantity
+		if quantity < returned {
+			returned = quantity
+		}
+
+		lots = append(lots, MaterialLotQuantity{LotUID: taken[i].LotUID, Quantity: -returned})
+		quantity -= returned
+	}
+
+	return lots
+}
+
+// takeFromLots applies a quantity issued or consumed, or given back when negative, to the lots it was
+// allocated to. The part of the quantity which no lot covers is taken from the unlotted stock, which stops at zero.
+func (m *Material) takeFromLots(quantity float32, lots []MaterialLotQuantity) {
+	for _, l := range lots {
+		quantity -= l.Quantity
+
+		for i := range m.Lots {
+			if m.Lots[i].UID == l.LotUID {
+				m.Lots[i].Remaining -= l.Quantity
+			}
+		}
+	}
+
+	m.unlotted -= quantity
+	m.deriveQuantity()
+}
+
+// lotsRemaining is the quantity left in the lots.
+func (m *Material) lotsRemaining() float32 {
+	remaining := float32(0)
+	for _, v := range m.Lots {
+		remaining += v.Remaining
+	}
+
+	return remaining
+}
+
+// deriveQuantity computes the quantity from the ledger, as the unlotted stock plus what remains of the lots.
+func (m *Material) deriveQuantity() {
+	if m.unlotted < 0 {
+		m.unlotted = 0
+	}
+
+	m.Quantity.Value = m.unlotted + m.lotsRemaining()
+}
+
+// trackCropTaken keeps the net quantity a crop batch took out of the stock. A debit takes what is left at most.
+func (m *Material) trackCropTaken(cropUID uuid.UUID, quantity float32) {
+	if cropUID == (uuid.UUID{}) {
+		return
+	}
+
+	if m.cropTaken == nil {
+		m.cropTaken = map[uuid.UUID]float32{}
+	}
+
+	if quantity > m.Quantity.Value {
+		quantity = m.Quantity.Value
+	}
+
+	m.cropTaken[cropUID] += quantity
+}
+
+// trackCropLots keeps the net quantity a crop batch took from each lot, to give it back on corrections.
+func (m *Material) trackCropLots(cropUID uuid.UUID, lots []MaterialLotQuantity) {
+	if cropUID == (uuid.UUID{}) || len(lots) == 0 {
+		return
+	}
+
+	if m.cropLots == nil {
+		m.cropLots = map[uuid.UUID][]MaterialLotQuantity{}
+	}
+
+	for _, l := range lots {
+		found := false
+
+		for i, v := range m.cropLots[cropUID] {
+			if v.LotUID == l.LotUID {
+				m.cropLots[cropUID][i].Quantity += l.Quantity
+				found = true
+			}
+		}
+
+		if !found {
+			m.cropLots[cropUID] = append(m.cropLots[cropUID], l)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	. "github.com/usetania/tania-core/src/assets/domain"
+)
+
+func TestReceiveAndIssueStock(t *testing.T) {
+	t.Parallel()
+	// Given
+	mts, _ := CreateMaterialTypeSeed(DefaultMaterialTypeCatalog(uuid.Nil), PlantTypeVegetable)
+	material, _ := CreateMaterial("Bayam Lu Hsieh", "12", MoneyEUR, mts, 10, MaterialUnitSeeds, nil, nil, nil)
+	supplierUID, _ := uuid.NewV4()
+	unitCost, _ := CreatePricePerUnit("10", MoneyEUR)
+	expirationDate := time.Date(2027, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	// When
+	lotA, errA := material.ReceiveStock("A-1", supplierUID, 20, unitCost,
+		time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC), &expirationDate)
+	lotB, errB := material.ReceiveStock("B-1", supplierUID, 30, unitCost,
+		time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC), nil)
+	_, errZero := material.ReceiveStock("C-1", supplierUID, 0, unitCost, time.Now(), nil)
+	_, errLotNumber := material.ReceiveStock("A-1", supplierUID, 5, unitCost, time.Now(), nil)
+
+	// Then
+	assert.Nil(t, errA)
+	assert.Nil(t, errB)
+	assert.Equal(t, MaterialError{MaterialErrorInvalidReceivedQuantity}, errZero)
+	assert.Equal(t, MaterialError{MaterialErrorLotNumberExists}, errLotNumber)
+	assert.InDelta(t, 60, material.Quantity.Value, 0.0001)
+	assert.Equal(t, supplierUID, lotA.SupplierUID)
+	assert.Equal(t, &expirationDate, lotA.ExpirationDate)
+	assert.Len(t, material.Lots, 2)
+
+	// When
+	lotUID, _ := uuid.NewV4()
+	errNotFound := material.IssueStock(5, &lotUID, "Sold")
+	errInsufficient := material.IssueStock(31, &lotB.UID, "Sold")
+	errIssue := material.IssueStock(20, &lotB.UID, "Sold")
+
+	// Then
+	assert.Equal(t, MaterialError{MaterialErrorLotNotFound}, errNotFound)
+	assert.Equal(t, MaterialError{MaterialErrorInsufficientStock}, errInsufficient)
+	assert.Nil(t, errIssue)
+	assert.InDelta(t, 40, material.Quantity.Value, 0.0001)
+	assert.InDelta(t, 10, material.Lots[1].Remaining, 0.0001)
+	assert.Equal(t, MaterialError{MaterialErrorInsufficientStock}, material.IssueStock(41, nil, "Lost"))
+}
+
+func TestMaterialLotsUsedByCrop(t *testing.T) {
+	t.Parallel()
+	// Given
+	mts, _ := CreateMaterialTypeSeed(DefaultMaterialTypeCatalog(uuid.Nil), PlantTypeVegetable)
+	material, _ := CreateMaterial("Bayam Lu Hsieh", "12", MoneyEUR, mts, 10, MaterialUnitSeeds, nil, nil, nil)
+	unitCost, _ := CreatePricePerUnit("10", MoneyEUR)
+	lotA, _ := material.ReceiveStock("A-1", uuid.UUID{}, 20, unitCost,
+		time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC), nil)
+	lotB, _ := material.ReceiveStock("B-1", uuid.UUID{}, 30, unitCost,
+		time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC), nil)
+	cropUID, _ := uuid.NewV4()
+
+	// When
+	errDebit := material.ConsumeForCrop(cropUID, 25)
+
+	// Then
+	assert.Nil(t, errDebit)
+	assert.Equal(t, []MaterialLotQuantity{{LotUID: lotA.UID, Quantity: 15}},
+		material.UncommittedChanges[3].(MaterialConsumed).Lots)
+	assert.InDelta(t, 5, material.Lots[0].Remaining, 0.0001)
+	assert.InDelta(t, 30, material.Lots[1].Remaining, 0.0001)
+
+	// When
+	errCredit := material.ConsumeForCrop(cropUID, -10)
+
+	// Then
+	assert.Nil(t, errCredit)
+	assert.InDelta(t, 45, material.Quantity.Value, 0.0001)
+	assert.InDelta(t, 15, material.Lots[0].Remaining, 0.0001)
+	assert.Equal(t, lotB.UID, material.Lots[1].UID)
+}
+
+func TestMaterialReturnedFromReservoir(t *testing.T) {
+	t.Parallel()
+	// Given
+	mts, _ := CreateMaterialTypeSeed(DefaultMaterialTypeCatalog(uuid.Nil), PlantTypeVegetable)
+	material, _ := CreateMaterial("Bayam Lu Hsieh", "12", MoneyEUR, mts, 10, MaterialUnitSeeds, nil, nil, nil)
+	unitCost, _ := CreatePricePerUnit("10", MoneyEUR)
+	material.ReceiveStock("A-1", uuid.UUID{}, 20, unitCost, time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC), nil)
+	reservoirUID, _ := uuid.NewV4()
+	material.ConsumeForReservoir(reservoirUID, 25)
+	consumed := material.UncommittedChanges[len(material.UncommittedChanges)-1].(MaterialConsumed)
+
+	// When
+	err := material.ReturnFromReservoir(reservoirUID, 25, consumed.Lots)
+	errZero := material.ReturnFromReservoir(reservoirUID, 0, nil)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, MaterialError{MaterialErrorInvalidConsumedQuantity}, errZero)
+	assert.InDelta(t, 30, material.Quantity.Value, 0.0001)
+	assert.InDelta(t, 20, material.Lots[0].Remaining, 0.0001)
+}
+
+func TestMaterialCreditedToCropUpToTaken(t *testing.T) {
+	t.Parallel()
+	// Given
+	mts, _ := CreateMaterialTypeSeed(DefaultMaterialTypeCatalog(uuid.Nil), PlantTypeVegetable)
+	material, _ := CreateMaterial("Bayam Lu Hsieh", "12", MoneyEUR, mts, 10, MaterialUnitSeeds, nil, nil, nil)
+	cropUID, _ := uuid.NewV4()
+
+	// When
+	errDebit := material.ConsumeForCrop(cropUID, 15)
+
+	// Then
+	assert.Nil(t, errDebit)
+	assert.InDelta(t, 0, material.Quantity.Value, 0.0001)
+
+	// When
+	errCredit := material.ConsumeForCrop(cropUID, -15)
+
+	// Then
+	credit, _ := material.UncommittedChanges[len(material.UncommittedChanges)-1].(MaterialConsumed)
+
+	assert.Nil(t, errCredit)
+	assert.InDelta(t, 10, material.Quantity.Value, 0.0001)
+	assert.Equal(t, float32(-10), credit.Quantity)
+
+	// When
+	changes := len(material.UncommittedChanges)
+	errNothingTaken := material.ConsumeForCrop(cropUID, -5)
+
+	// Then
+	assert.Nil(t, errNothingTaken)
+	assert.Len(t, material.UncommittedChanges, changes)
+	assert.InDelta(t, 10, material.Quantity.Value, 0.0001)
+}
+
+func TestMaterialQuantityFromLots(t *testing.T) {
+	t.Parallel()
+	// Given
+	mts, _ := CreateMaterialTypeSeed(DefaultMaterialTypeCatalog(uuid.Nil), PlantTypeVegetable)
+	material, _ := CreateMaterial("Bayam Lu Hsieh", "12", MoneyEUR, mts, 10, MaterialUnitSeeds, nil, nil, nil)
+	unitCost, _ := CreatePricePerUnit("10", MoneyEUR)
+
+	// When
+	errBeforeLots := material.ChangeQuantityUnit(8, MaterialUnitSeeds, mts)
+	lot, _ := material.ReceiveStock("A-1", uuid.UUID{}, 20, unitCost, time.Now(), nil)
+	errWithLots := material.ChangeQuantityUnit(50, MaterialUnitSeeds, mts)
+	errUnit := material.ChangeQuantityUnit(28, MaterialUnitPackets, mts)
+	taskUID, _ := uuid.NewV4()
+	errConsume := material.Consume(taskUID, 12)
+
+	// Then
+	assert.Nil(t, errBeforeLots)
+	assert.Equal(t, MaterialError{MaterialErrorQuantityFromLots}, errWithLots)
+	assert.Nil(t, errUnit)
+	assert.Nil(t, errConsume)
+	assert.Equal(t, MaterialUnitPackets, material.Quantity.Unit.Code)
+	assert.InDelta(t, 16, material.Quantity.Value, 0.0001)
+	assert.Equal(t, lot.UID, material.Lots[0].UID)
+	assert.InDelta(t, 16, material.Lots[0].Remaining, 0.0001)
+}
@@ -2,7 +2,9 @@ package domain_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/stretchr/testify/assert"
 	. "github.com/usetania/tania-core/src/assets/domain"
 )
@@ -11,7 +13,7 @@ func TestCreateInventorySeed(t *testing.T) {
 	t.Parallel()
 	// Given
 	// When
-	mts, err1 := CreateMaterialTypeSeed(PlantTypeVegetable)
+	mts, err1 := CreateMaterialTypeSeed(DefaultMaterialTypeCatalog(uuid.Nil), PlantTypeVegetable)
 	material1, err2 := CreateMaterial("Bayam Lu Hsieh", "12", MoneyEUR, mts, 20, MaterialUnitPackets, nil, nil, nil)
 	tp, ok := material1.Type.(MaterialTypeSeed)
 
@@ -24,7 +26,7 @@ func TestCreateInventorySeed(t *testing.T) {
 	assert.Equal(t, PlantTypeVegetable, tp.PlantType.Code)
 
 	// When
-	mta, err1 := CreateMaterialTypeAgrochemical(ChemicalTypeDisinfectant)
+	mta, err1 := CreateMaterialTypeAgrochemical(DefaultMaterialTypeCatalog(uuid.Nil), ChemicalTypeDisinfectant)
 	material2, err2 := CreateMaterial("Green Disinfectant", "5", MoneyEUR, mta, 5, MaterialUnitPackets, nil, nil, nil)
 	ta, ok := material2.Type.(MaterialTypeAgrochemical)
 
@@ -37,7 +39,7 @@ func TestCreateInventorySeed(t *testing.T) {
 	assert.Equal(t, ChemicalTypeDisinfectant, ta.ChemicalType.Code)
 
 	// When
-	mtsc, err1 := CreateMaterialTypeSeedingContainer(ContainerTypeTray)
+	mtsc, err1 := CreateMaterialTypeSeedingContainer(DefaultMaterialTypeCatalog(uuid.Nil), ContainerTypeTray)
 	material3, err2 := CreateMaterial("Soft Indoor Tray Pack", "10", MoneyEUR, mtsc, 10, MaterialUnitPieces, nil, nil, nil)
 	tsc, ok := material3.Type.(MaterialTypeSeedingContainer)
 
@@ -97,3 +99,135 @@ func TestCreateInventorySeed(t *testing.T) {
 	assert.Equal(t, true, ok)
 	assert.Equal(t, MaterialTypeOtherCode, mo.Code())
 }
+
+func TestCreatePricePerUnit(t *testing.T) {
+	t.Parallel()
+	// Given
+	// When
+	idr, errIDR := CreatePricePerUnit("15000", "idr")
+	usd, errUSD := CreatePricePerUnit("2.5", "USD")
+	_, errUnknown := CreatePricePerUnit("1", "ABC")
+	_, errUnknownCurrency := CreatePricePerUnit("1", "XXX")
+
+	// Then
+	assert.Nil(t, errIDR)
+	assert.Nil(t, errUSD)
+	assert.NotNil(t, errUnknown)
+	assert.NotNil(t, errUnknownCurrency)
+	assert.Equal(t, MoneyIDR, idr.CurrencyCode)
+	assert.Equal(t, "Rp", idr.Symbol())
+	assert.Equal(t, "$", usd.Symbol())
+	assert.Equal(t, "€", PricePerUnit{Amount: "1", CurrencyCode: MoneyEUR}.Symbol())
+}
+
+func TestConsumeMaterial(t *testing.T) {
+	t.Parallel()
+	// Given
+	mta, _ := CreateMaterialTypeAgrochemical(DefaultMaterialTypeCatalog(uuid.Nil), ChemicalTypeFertilizer)
+	material, _ := CreateMaterial("Green Fertilizer", "5", MoneyEUR, mta, 5, MaterialUnitPackets, nil, nil, nil)
+	taskUID, _ := uuid.NewV4()
+
+	// When
+	errZero := material.Consume(taskUID, 0)
+	err := material.Consume(taskUID, 2)
+
+	// Then
+	assert.Equal(t, MaterialError{MaterialErrorInvalidConsumedQuantity}, errZero)
+	assert.Nil(t, err)
+	assert.InDelta(t, 3, material.Quantity.Value, 0.0001)
+	assert.Equal(t, taskUID, material.UncommittedChanges[1].(MaterialConsumed).TaskUID)
+
+	// When
+	err = material.Consume(taskUID, 4)
+
+	// Then
+	assert.Nil(t, err)
+	assert.InDelta(t, 0, material.Quantity.Value, 0.0001)
+}
+
+func TestConsumeMaterialForCrop(t *testing.T) {
+	t.Parallel()
+	// Given
+	mts, _ := CreateMaterialTypeSeed(DefaultMaterialTypeCatalog(uuid.Nil), PlantTypeVegetable)
+	material, _ := CreateMaterial("Bayam Lu Hsieh", "12", MoneyEUR, mts, 100, MaterialUnitSeeds, nil, nil, nil)
+	cropUID, _ := uuid.NewV4()
+
+	// When
+	errZero := material.ConsumeForCrop(cropUID, 0)
+	errDebit := material.ConsumeForCrop(cropUID, 40)
+	errCredit := material.ConsumeForCrop(cropUID, -10)
+
+	// Then
+	assert.Equal(t, MaterialError{MaterialErrorInvalidConsumedQuantity}, errZero)
+	assert.Nil(t, errDebit)
+	assert.Nil(t, errCredit)
+	assert.InDelta(t, 70, material.Quantity.Value, 0.0001)
+	assert.Equal(t, cropUID, material.UncommittedChanges[2].(MaterialConsumed).CropUID)
+}
+
+func TestMaterialReorderPoint(t *testing.T) {
+	t.Parallel()
+	// Given
+	mta, _ := CreateMaterialTypeAgrochemical(DefaultMaterialTypeCatalog(uuid.Nil), ChemicalTypeFertilizer)
+	material, _ := CreateMaterial("Green Fertilizer", "5", MoneyEUR, mta, 5, MaterialUnitPackets, nil, nil, nil)
+	taskUID, _ := uuid.NewV4()
+	negative := float32(-1)
+	reorderPoint := float32(3)
+
+	// When
+	errNegative := material.ChangeReorderPoint(&negative)
+	err := material.ChangeReorderPoint(&reorderPoint)
+
+	// Then
+	assert.Equal(t, MaterialError{MaterialErrorInvalidReorderPoint}, errNegative)
+	assert.Nil(t, err)
+	assert.False(t, material.IsLowStock())
+
+	// When
+	_ = material.Consume(taskUID, 2.5)
+
+	// Then
+	assert.True(t, material.IsLowStock())
+
+	// When
+	err = material.ChangeReorderPoint(nil)
+
+	// Then
+	assert.Nil(t, err)
+	assert.False(t, material.IsLowStock())
+}
+
+func TestMaterialExpiration(t *testing.T) {
+	t.Parallel()
+	// Given
+	date := time.Date(2026, time.March, 10, 15, 0, 0, 0, time.UTC)
+	expired := time.Date(2026, time.March, 9, 0, 0, 0, 0, time.UTC)
+	inAWeek := time.Date(2026, time.March, 17, 0, 0, 0, 0, time.UTC)
+
+	// When
+	// Then
+	assert.True(t, IsMaterialExpiring(&expired, date, 7))
+	assert.True(t, IsMaterialExpiring(&inAWeek, date, 7))
+	assert.False(t, IsMaterialExpiring(&inAWeek, date, 6))
+	assert.False(t, IsMaterialExpiring(nil, date, 7))
+}
+
+func TestAssignMaterialFarm(t *testing.T) {
+	t.Parallel()
+	// Given
+	material, _ := CreateMaterial("Potting soil", "12", MoneyEUR, MaterialTypeGrowingMedium{}, 10, MaterialUnitBags,
+		nil, nil, nil)
+	farmUID, _ := uuid.NewV4()
+
+	// When
+	errEmpty := material.AssignFarm(uuid.UUID{})
+	errAssign := material.AssignFarm(farmUID)
+	errSame := material.AssignFarm(farmUID)
+
+	// Then
+	assert.Equal(t, MaterialError{MaterialErrorInvalidFarm}, errEmpty)
+	assert.Nil(t, errAssign)
+	assert.Nil(t, errSame)
+	assert.Equal(t, farmUID, material.FarmUID)
+	assert.Len(t, material.UncommittedChanges, 2)
+}
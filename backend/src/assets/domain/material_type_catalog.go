@@ -0,0 +1,284 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gofrs/uuid"
+)
+
+// The catalogs of the material type catalog.
+const (
+	CatalogPlantType     = "PLANT_TYPE"
+	CatalogChemicalType  = "CHEMICAL_TYPE"
+	CatalogContainerType = "CONTAINER_TYPE"
+)
+
+// MaterialTypeCatalog holds the plant types of the seeds and plants, the chemical types of the agrochemicals
+// and the container types of the seeding containers. Each farm configures its own, starting with the default types.
+type MaterialTypeCatalog struct {
+	FarmUID        uuid.UUID       `json:"farm_uid"`
+	PlantTypes     []PlantType     `json:"plant_types"`
+	ChemicalTypes  []ChemicalType  `json:"chemical_types"`
+	ContainerTypes []ContainerType `json:"container_types"`
+
+	// SeededDefaultTypes are the codes of the default types the catalog was seeded with, by catalog.
+	// The farm may have removed some of them since.
+	SeededDefaultTypes map[string][]string `json:"-"`
+}
+
+// MaterialCatalogType is a type of a catalog, like a plant type.
+type MaterialCatalogType struct {
+	Code  string `json:"code"`
+	Label string `json:"label"`
+}
+
+var catalogTypeCodePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]{1,49}$`)
+
+func DefaultMaterialTypeCatalog(farmUID uuid.UUID) MaterialTypeCatalog {
+	catalog := MaterialTypeCatalog{
+		FarmUID:            farmUID,
+		PlantTypes:         DefaultPlantTypes(),
+		ChemicalTypes:      DefaultChemicalTypes(),
+		ContainerTypes:     DefaultContainerTypes(),
+		SeededDefaultTypes: make(map[string][]string),
+	}
+
+	for _, c := range []string{CatalogPlantType, CatalogChemicalType, CatalogContainerType} {
+		for _, v := range catalog.Types(c) {
+			catalog.SeededDefaultTypes[c] = append(catalog.SeededDefaultTypes[c], v.Code)
+		}
+	}
+
+	return catalog
+}
+
+// IsSaved tells whether the farm has saved the catalog, which may have no type left.
+func (c MaterialTypeCatalog) IsSaved() bool {
+	return len(c.PlantTypes) > 0 || len(c.ChemicalTypes) > 0 || len(c.ContainerTypes) > 0 ||
+		len(c.SeededDefaultTypes) > 0
+}
+
+// AddMissingDefaultTypes adds the default types the catalog was not seeded with, like the ones of a newer
+// version, at the end of their catalog. The default types the farm removed are not added back, and a type
+// the farm added with the code of a new default type is kept. It tells whether the catalog changed.
+func (c *MaterialTypeCatalog) AddMissingDefaultTypes() bool {
+	changed := false
+	defaults := DefaultMaterialTypeCatalog(c.FarmUID)
+
+	if c.SeededDefaultTypes == nil {
+		c.SeededDefaultTypes = make(map[string][]string)
+	}
+
+	for _, catalog := range []string{CatalogPlantType, CatalogChemicalType, CatalogContainerType} {
+		types := c.Types(catalog)
+
+		for _, v := range defaults.Types(catalog) {
+			if containsCatalogTypeCode(c.SeededDefaultTypes[catalog], v.Code) {
+				continue
+			}
+
+			c.SeededDefaultTypes[catalog] = append(c.SeededDefaultTypes[catalog], v.Code)
+			changed = true
+
+			found := false
+
+			for _, t := range types {
+				if t.Code == v.Code {
+					found = true
+				}
+			}
+
+			if !found {
+				types = append(types, v)
+			}
+		}
+
+		c.setTypes(catalog, types)
+	}
+
+	return changed
+}
+
+func containsCatalogTypeCode(codes []string, code string) bool {
+	for _, v := range codes {
+		if v == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+func IsMaterialTypeCatalog(catalog string) bool {
+	return catalog == CatalogPlantType || catalog == CatalogChemicalType || catalog == CatalogContainerType
+}
+
+// Types are the types of the catalog, in their order.
+func (c MaterialTypeCatalog) Types(catalog string) []MaterialCatalogType {
+	types := []MaterialCatalogType{}
+
+	switch catalog {
+	case CatalogPlantType:
+		for _, v := range c.PlantTypes {
+			types = append(types, MaterialCatalogType(v))
+		}
+	case CatalogChemicalType:
+		for _, v := range c.ChemicalTypes {
+			types = append(types, MaterialCatalogType(v))
+		}
+	case CatalogContainerType:
+		for _, v := range c.ContainerTypes {
+			types = append(types, MaterialCatalogType(v))
+		}
+	}
+
+	return types
+}
+
+// AddType adds the type at the end of the catalog.
+func (c *MaterialTypeCatalog) AddType(catalog, code, label string) error {
+	if !catalogTypeCodePattern.MatchString(code) {
+		return MaterialError{MaterialErrorInvalidCatalogTypeCode}
+	}
+
+	label, err := validateCatalogTypeLabel(label)
+	if err != nil {
+		return err
+	}
+
+	types := c.Types(catalog)
+
+	for _, v := range types {
+		if v.Code == code {
+			return MaterialError{MaterialErrorCatalogTypeCodeExists}
+		}
+	}
+
+	c.setTypes(catalog, append(types, MaterialCatalogType{Code: code, Label: label}))
+
+	return nil
+}
+
+// ChangeTypeLabel relabels the type for the materials created from then on. Its code is kept.
+func (c *MaterialTypeCatalog) ChangeTypeLabel(catalog, code, label string) error {
+	label, err := validateCatalogTypeLabel(label)
+	if err != nil {
+		return err
+	}
+
+	types := c.Types(catalog)
+
+	for i, v := range types {
+		if v.Code == code {
+			types[i].Label = label
+			c.setTypes(catalog, types)
+
+			return nil
+		}
+	}
+
+	return MaterialError{MaterialErrorCatalogTypeNotFound}
+}
+
+// RemoveType removes the type. The materials of the type keep it, see RestoreCatalogType.
+func (c *MaterialTypeCatalog) RemoveType(catalog, code string) error {
+	types := c.Types(catalog)
+
+	for i, v := range types {
+		if v.Code == code {
+			c.setTypes(catalog, append(types[:i], types[i+1:]...))
+
+			return nil
+		}
+	}
+
+	return MaterialError{MaterialErrorCatalogTypeNotFound}
+}
+
+func (c *MaterialTypeCatalog) setTypes(catalog string, types []MaterialCatalogType) {
+	switch catalog {
+	case CatalogPlantType:
+		c.PlantTypes = []PlantType{}
+		for _, v := range types {
+			c.PlantTypes = append(c.PlantTypes, PlantType(v))
+		}
+	case CatalogChemicalType:
+		c.ChemicalTypes = []ChemicalType{}
+		for _, v := range types {
+			c.ChemicalTypes = append(c.ChemicalTypes, ChemicalType(v))
+		}
+	case CatalogContainerType:
+		c.ContainerTypes = []ContainerType{}
+		for _, v := range types {
+			c.ContainerTypes = append(c.ContainerTypes, ContainerType(v))
+		}
+	}
+}
+
+func (c MaterialTypeCatalog) FindPlantType(code string) PlantType {
+	for _, v := range c.PlantTypes {
+		if v.Code == code {
+			return v
+		}
+	}
+
+	return PlantType{}
+}
+
+func (c MaterialTypeCatalog) FindChemicalType(code string) ChemicalType {
+	for _, v := range c.ChemicalTypes {
+		if v.Code == code {
+			return v
+		}
+	}
+
+	return ChemicalType{}
+}
+
+func (c MaterialTypeCatalog) FindContainerType(code string) ContainerType {
+	for _, v := range c.ContainerTypes {
+		if v.Code == code {
+			return v
+		}
+	}
+
+	return ContainerType{}
+}
+
+// RestoreCatalogType is the catalog type a material was created with. The material keeps it once the type
+// is relabeled or removed from the catalog of the farm, so only its code and label are checked. A material
+// saved without the label gets the one of the default type, or its code.
+func RestoreCatalogType(catalog, code, label string) (MaterialCatalogType, error) {
+	if !catalogTypeCodePattern.MatchString(code) {
+		return MaterialCatalogType{}, InventoryMaterialError{InventoryMaterialErrorWrongType}
+	}
+
+	if label == "" {
+		label = code
+
+		for _, v := range DefaultMaterialTypeCatalog(uuid.Nil).Types(catalog) {
+			if v.Code == code {
+				label = v.Label
+			}
+		}
+	}
+
+	label, err := validateCatalogTypeLabel(label)
+	if err != nil {
+		return MaterialCatalogType{}, InventoryMaterialError{InventoryMaterialErrorWrongType}
+	}
+
+	return MaterialCatalogType{Code: code, Label: label}, nil
+}
+
+func validateCatalogTypeLabel(label string) (string, error) {
+	label = strings.TrimSpace(label)
+
+	if label == "" || utf8.RuneCountInString(label) > 100 {
+		return "", MaterialError{MaterialErrorInvalidCatalogTypeLabel}
+	}
+
+	return label, nil
+}
@@ -0,0 +1,106 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	. "github.com/usetania/tania-core/src/assets/domain"
+)
+
+func TestMaterialTypeCatalog(t *testing.T) {
+	t.Parallel()
+	// Given
+	farmUID, _ := uuid.NewV4()
+	catalog := DefaultMaterialTypeCatalog(farmUID)
+
+	// When
+	errAdd := catalog.AddType(CatalogPlantType, "MICROGREEN", " Microgreen ")
+	errExists := catalog.AddType(CatalogPlantType, PlantTypeHerb, "Herb")
+	errCode := catalog.AddType(CatalogPlantType, "micro green", "Microgreen")
+	errLabel := catalog.AddType(CatalogChemicalType, "BIOSTIMULANT", "")
+	errChange := catalog.ChangeTypeLabel(CatalogContainerType, ContainerTypePot, "Pot and Bag")
+	errRemove := catalog.RemoveType(CatalogPlantType, PlantTypeTree)
+	errNotFound := catalog.RemoveType(CatalogPlantType, PlantTypeTree)
+
+	// Then
+	assert.Nil(t, errAdd)
+	assert.Equal(t, MaterialError{MaterialErrorCatalogTypeCodeExists}, errExists)
+	assert.Equal(t, MaterialError{MaterialErrorInvalidCatalogTypeCode}, errCode)
+	assert.Equal(t, MaterialError{MaterialErrorInvalidCatalogTypeLabel}, errLabel)
+	assert.Nil(t, errChange)
+	assert.Nil(t, errRemove)
+	assert.Equal(t, MaterialError{MaterialErrorCatalogTypeNotFound}, errNotFound)
+
+	assert.Len(t, catalog.PlantTypes, 5)
+	assert.Equal(t, PlantType{Code: "MICROGREEN", Label: "Microgreen"}, catalog.PlantTypes[4])
+	assert.Len(t, catalog.ChemicalTypes, 6)
+	assert.Equal(t, "Pot and Bag", catalog.ContainerTypes[1].Label)
+	assert.Equal(t, MaterialCatalogType{Code: ContainerTypePot, Label: "Pot and Bag"},
+		catalog.Types(CatalogContainerType)[1])
+
+	// The default types are left as they are.
+	assert.Len(t, DefaultPlantTypes(), 5)
+	assert.Equal(t, PlantTypeTree, DefaultPlantTypes()[4].Code)
+}
+
+func TestCreateMaterialTypeOfCatalog(t *testing.T) {
+	t.Parallel()
+	// Given
+	farmUID, _ := uuid.NewV4()
+	catalog := DefaultMaterialTypeCatalog(farmUID)
+	catalog.AddType(CatalogPlantType, "MUSHROOM", "Mushroom")
+
+	// When
+	seed, errSeed := CreateMaterialTypeSeed(catalog, "MUSHROOM")
+	_, errUnknown := CreateMaterialTypePlant(catalog, "MICROGREEN")
+	_, errOtherFarm := CreateMaterialTypeSeed(DefaultMaterialTypeCatalog(uuid.Nil), "MUSHROOM")
+
+	// Then
+	assert.Nil(t, errSeed)
+	assert.Equal(t, PlantType{Code: "MUSHROOM", Label: "Mushroom"}, seed.PlantType)
+	assert.Equal(t, InventoryMaterialError{InventoryMaterialErrorWrongType}, errUnknown)
+	assert.Equal(t, InventoryMaterialError{InventoryMaterialErrorWrongType}, errOtherFarm)
+	assert.Equal(t, "Mushroom", catalog.FindPlantType("MUSHROOM").Label)
+}
+
+func TestRestoreCatalogType(t *testing.T) {
+	t.Parallel()
+	// When
+	removed, errRemoved := RestoreCatalogType(CatalogPlantType, "MUSHROOM", "Mushroom")
+	unlabeled, errUnlabeled := RestoreCatalogType(CatalogChemicalType, ChemicalTypeHormone, "")
+	_, errCode := RestoreCatalogType(CatalogPlantType, "", "Mushroom")
+	_, errMalformed := RestoreCatalogType(CatalogPlantType, "mushroom; DROP", "Mushroom")
+
+	// Then
+	assert.Nil(t, errRemoved)
+	assert.Equal(t, MaterialCatalogType{Code: "MUSHROOM", Label: "Mushroom"}, removed)
+	assert.Nil(t, errUnlabeled)
+	assert.Equal(t, "Hormone and Growth Agent", unlabeled.Label)
+	assert.Equal(t, InventoryMaterialError{InventoryMaterialErrorWrongType}, errCode)
+	assert.Equal(t, InventoryMaterialError{InventoryMaterialErrorWrongType}, errMalformed)
+}
+
+func TestAddMissingDefaultTypes(t *testing.T) {
+	t.Parallel()
+	// Given
+	farmUID, _ := uuid.NewV4()
+
+	// A catalog seeded before the veterinary type was a default one, whose farm removed the manure type.
+	catalog := DefaultMaterialTypeCatalog(farmUID)
+	catalog.RemoveType(CatalogChemicalType, ChemicalTypeVeterinary)
+	catalog.RemoveType(CatalogChemicalType, ChemicalTypeManure)
+	catalog.SeededDefaultTypes[CatalogChemicalType] = catalog.SeededDefaultTypes[CatalogChemicalType][:5]
+
+	// When
+	changed := catalog.AddMissingDefaultTypes()
+	changedAgain := catalog.AddMissingDefaultTypes()
+
+	// Then
+	assert.True(t, changed)
+	assert.False(t, changedAgain)
+	assert.Len(t, catalog.ChemicalTypes, 5)
+	assert.Equal(t, ChemicalTypeVeterinary, catalog.ChemicalTypes[4].Code)
+	assert.Empty(t, catalog.FindChemicalType(ChemicalTypeManure))
+	assert.Len(t, catalog.PlantTypes, 5)
+}
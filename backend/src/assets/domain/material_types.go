@@ -23,8 +23,8 @@ func (MaterialTypeSeed) Code() string {
 	return MaterialTypeSeedCode
 }
 
-func CreateMaterialTypeSeed(plantType string) (MaterialTypeSeed, error) {
-	pt := GetPlantType(plantType)
+func CreateMaterialTypeSeed(catalog MaterialTypeCatalog, plantType string) (MaterialTypeSeed, error) {
+	pt := catalog.FindPlantType(plantType)
 	if pt == (PlantType{}) {
 		return MaterialTypeSeed{}, InventoryMaterialError{InventoryMaterialErrorWrongType}
 	}
@@ -45,7 +45,8 @@ const (
 	PlantTypeTree      = "TREE"
 )
 
-func PlantTypes() []PlantType {
+// DefaultPlantTypes are the plant types the material type catalog starts with.
+func DefaultPlantTypes() []PlantType {
 	return []PlantType{
 		{Code: PlantTypeVegetable, Label: "Vegetable"},
 		{Code: PlantTypeFruit, Label: "Fruit"},
@@ -55,16 +56,6 @@ func PlantTypes() []PlantType {
 	}
 }
 
-func GetPlantType(code string) PlantType {
-	for _, v := range PlantTypes() {
-		if v.Code == code {
-			return v
-		}
-	}
-
-	return PlantType{}
-}
-
 type MaterialTypeAgrochemical struct {
 	ChemicalType ChemicalType
 }
@@ -79,6 +70,7 @@ const (
 	ChemicalTypeHormone      = "HORMONE"
 	ChemicalTypeManure       = "MANURE"
 	ChemicalTypePesticide    = "PESTICIDE"
+	ChemicalTypeVeterinary   = "VETERINARY"
 )
 
 type ChemicalType struct {
@@ -86,28 +78,23 @@ type ChemicalType struct {
 	Label string `json:"label"`
 }
 
-func ChemicalTypes() []ChemicalType {
+// DefaultChemicalTypes are the chemical types the material type catalog starts with.
+func DefaultChemicalTypes() []ChemicalType {
 	return []ChemicalType{
 		{Code: ChemicalTypeDisinfectant, Label: "Disinfectant and Sanitizer"},
 		{Code: ChemicalTypeFertilizer, Label: "Fertilizer"},
 		{Code: ChemicalTypeHormone, Label: "Hormone and Growth Agent"},
 		{Code: ChemicalTypeManure, Label: "Manure"},
 		{Code: ChemicalTypePesticide, Label: "Pesticide"},
+		{Code: ChemicalTypeVeterinary, Label: "Veterinary Medicine"},
 	}
 }
 
-func GetChemicalType(code string) ChemicalType {
-	for _, v := range ChemicalTypes() {
-		if v.Code == code {
-			return v
-		}
-	}
-
-	return ChemicalType{}
-}
-
-func CreateMaterialTypeAgrochemical(chemicalType string) (MaterialTypeAgrochemical, error) {
-	ct := GetChemicalType(chemicalType)
+func CreateMaterialTypeAgrochemical(
+	catalog MaterialTypeCatalog,
+	chemicalType string,
+) (MaterialTypeAgrochemical, error) {
+	ct := catalog.FindChemicalType(chemicalType)
 	if ct == (ChemicalType{}) {
 		return MaterialTypeAgrochemical{}, InventoryMaterialError{InventoryMaterialErrorWrongType}
 	}
@@ -145,25 +132,19 @@ type ContainerType struct {
 	Label string `json:"label"`
 }
 
-func ContainerTypes() []ContainerType {
+// DefaultContainerTypes are the container types the material type catalog starts with.
+func DefaultContainerTypes() []ContainerType {
 	return []ContainerType{
 		{Code: ContainerTypeTray, Label: "Tray"},
 		{Code: ContainerTypePot, Label: "Pot"},
 	}
 }
 
-func GetContainerType(code string) ContainerType {
-	for _, v := range ContainerTypes() {
-		if v.Code == code {
-			return v
-		}
-	}
-
-	return ContainerType{}
-}
-
-func CreateMaterialTypeSeedingContainer(containerType string) (MaterialTypeSeedingContainer, error) {
-	ct := GetContainerType(containerType)
+func CreateMaterialTypeSeedingContainer(
+	catalog MaterialTypeCatalog,
+	containerType string,
+) (MaterialTypeSeedingContainer, error) {
+	ct := catalog.FindContainerType(containerType)
 	if ct == (ContainerType{}) {
 		return MaterialTypeSeedingContainer{}, InventoryMaterialError{InventoryMaterialErrorWrongType}
 	}
@@ -191,8 +172,8 @@ func (MaterialTypePlant) Code() string {
 	return MaterialTypePlantCode
 }
 
-func CreateMaterialTypePlant(plantType string) (MaterialTypePlant, error) {
-	pt := GetPlantType(plantType)
+func CreateMaterialTypePlant(catalog MaterialTypeCatalog, plantType string) (MaterialTypePlant, error) {
+	pt := catalog.FindPlantType(plantType)
 	if pt == (PlantType{}) {
 		return MaterialTypePlant{}, InventoryMaterialError{InventoryMaterialErrorWrongType}
 	}
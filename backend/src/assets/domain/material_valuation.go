@@ -0,0 +1,102 @@
+package domain
+
+const (
+	ValuationMethodFIFO            = "FIFO"
+	ValuationMethodWeightedAverage = "WEIGHTED_AVERAGE"
+)
+
+// MaterialValuation is the cost of a material stock, built from the quantities which came in
+// and went out of it in the order they happened.
+//
+// With FIFO the stock going out is costed from the oldest quantities still in stock.
+// With the weighted average it is costed at the average unit cost of the stock.
+type MaterialValuation struct {
+	Method   string
+	Quantity float64
+	Value    float64
+
+	// layers are the quantities still in stock with their unit cost, oldest first. Only used by FIFO.
+	layers []valuationLayer
+}
+
+type valuationLayer struct {
+	quantity float64
+	unitCost float64
+}
+
+// CreateMaterialValuation starts the valuation of an empty stock.
+func CreateMaterialValuation(method string) (*MaterialValuation, error) {
+	if method != ValuationMethodFIFO && method != ValuationMethodWeightedAverage {
+		return nil, MaterialError{MaterialErrorInvalidValuationMethod}
+	}
+
+	return &MaterialValuation{Method: method}, nil
+}
+
+// In adds a quantity to the stock at its unit cost.
+func (v *MaterialValuation) In(quantity, unitCost float64) {
+	if quantity <= 0 {
+		return
+	}
+
+	v.Quantity += quantity
+	v.Value += quantity * unitCost
+
+	if v.Method == ValuationMethodFIFO {
+		v.layers = append(v.layers, valuationLayer{quantity: quantity, unitCost: unitCost})
+	}
+}
+
+// Out takes a quantity out of the stock. The stock never goes below zero.
+func (v *MaterialValuation) Out(quantity float64) {
+	if quantity <= 0 || v.Quantity <= 0 {
+		return
+	}
+
+	if quantity > v.Quantity {
+		quantity = v.Quantity
+	}
+
+	defer v.roundEmptyStock()
+
+	if v.Method == ValuationMethodWeightedAverage {
+		v.Value -= quantity * v.UnitCost()
+		v.Quantity -= quantity
+
+		return
+	}
+
+	for quantity > 0 && len(v.layers) > 0 {
+		taken := v.layers[0].quantity
+		if quantity < taken {
+			taken = quantity
+		}
+
+		v.layers[0].quantity -= taken
+		v.Quantity -= taken
+		v.Value -= taken * v.layers[0].unitCost
+		quantity -= taken
+
+		if v.layers[0].quantity <= 0 {
+			v.layers = v.layers[1:]
+		}
+	}
+}
+
+// roundEmptyStock clears the rounding errors left once all the stock went out.
+func (v *MaterialValuation) roundEmptyStock() {
+	if v.Quantity < 1e-6 {
+		v.Quantity = 0
+		v.Value = 0
+		v.layers = nil
+	}
+}
+
+// UnitCost is the average cost of a unit left in stock.
+func (v MaterialValuation) UnitCost() float64 {
+	if v.Quantity <= 0 {
+		return 0
+	}
+
+	return v.Value / v.Quantity
+}
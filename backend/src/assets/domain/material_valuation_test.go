@@ -0,0 +1,40 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	. "github.com/usetania/tania-core/src/assets/domain"
+)
+
+func TestMaterialValuation(t *testing.T) {
+	t.Parallel()
+	// Given
+	fifo, errFIFO := CreateMaterialValuation(ValuationMethodFIFO)
+	average, errAverage := CreateMaterialValuation(ValuationMethodWeightedAverage)
+	_, errMethod := CreateMaterialValuation("LIFO")
+
+	// When
+	for _, v := range []*MaterialValuation{fifo, average} {
+		v.In(10, 2)
+		v.In(10, 4)
+		v.Out(15)
+	}
+
+	// Then
+	assert.Nil(t, errFIFO)
+	assert.Nil(t, errAverage)
+	assert.Equal(t, MaterialError{MaterialErrorInvalidValuationMethod}, errMethod)
+	assert.InDelta(t, 5, fifo.Quantity, 0.0001)
+	assert.InDelta(t, 20, fifo.Value, 0.0001)
+	assert.InDelta(t, 5, average.Quantity, 0.0001)
+	assert.InDelta(t, 15, average.Value, 0.0001)
+	assert.InDelta(t, 3, average.UnitCost(), 0.0001)
+
+	// When
+	fifo.Out(10)
+
+	// Then
+	assert.InDelta(t, 0, fifo.Quantity, 0.0001)
+	assert.InDelta(t, 0, fifo.Value, 0.0001)
+}
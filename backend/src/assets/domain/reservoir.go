@@ -17,6 +17,20 @@ type Reservoir struct {
 	Notes       map[uuid.UUID]ReservoirNote
 	CreatedDate time.Time
 
+	// WaterPerWatering is the water a crop batch watering draws from the reservoir, to estimate its consumption.
+	WaterPerWatering float32
+	Levels           []ReservoirLevel
+
+	WaterQuality       []WaterQualityMeasurement
+	WaterQualityRanges map[string]WaterQualityRange
+
+	NutrientRecipe []ReservoirNutrient
+	Dosings        []ReservoirDosing
+
+	// Photo is the cover photo of the Photos gallery.
+	Photo  ReservoirPhoto
+	Photos []ReservoirPhoto
+
 	// Events
 	Version            int
 	UncommittedChanges []interface{}
@@ -24,6 +38,7 @@ type Reservoir struct {
 
 type ReservoirService interface {
 	FindFarmByID(farmUID uuid.UUID) (ReservoirFarmServiceResult, error)
+	FindMaterialByID(materialUID uuid.UUID) (ReservoirMaterialServiceResult, error)
 }
 
 type ReservoirFarmServiceResult struct {
@@ -31,6 +46,13 @@ type ReservoirFarmServiceResult struct {
 	Name string
 }
 
+type ReservoirMaterialServiceResult struct {
+	UID          uuid.UUID
+	Name         string
+	Type         MaterialType
+	QuantityUnit string
+}
+
 const (
 	BucketType = "BUCKET"
 	TapType    = "TAP"
@@ -109,6 +131,129 @@ func (r *Reservoir) Transition(event interface{}) {
 
 	case ReservoirNoteRemoved:
 		delete(r.Notes, e.UID)
+
+	case ReservoirLevelRecorded:
+		r.addLevel(ReservoirLevel{
+			Type:   ReservoirLevelReading,
+			Source: e.Source,
+			Level:  e.Level,
+			Date:   e.ReadingDate,
+		})
+
+	case ReservoirRefilled:
+		r.addLevel(ReservoirLevel{
+			Type:   ReservoirLevelRefill,
+			Level:  e.Level,
+			Volume: e.Volume,
+			Date:   e.RefillDate,
+		})
+
+	case ReservoirWaterConsumed:
+		r.addLevel(ReservoirLevel{
+			Type:    ReservoirLevelConsumption,
+			Level:   e.Level,
+			Volume:  e.Volume,
+			AreaUID: e.AreaUID,
+			CropUID: e.CropUID,
+			Date:    e.ConsumedDate,
+		})
+
+	case ReservoirWaterPerWateringChanged:
+		r.WaterPerWatering = e.WaterPerWatering
+
+	case ReservoirWaterQualityMeasured:
+		r.WaterQuality = append(r.WaterQuality, WaterQualityMeasurement{
+			Parameter:    e.Parameter,
+			Value:        e.Value,
+			Unit:         e.Unit,
+			Source:       e.Source,
+			MeasuredDate: e.MeasuredDate,
+		})
+
+	case ReservoirWaterQualityRangeChanged:
+		if r.WaterQualityRanges == nil {
+			r.WaterQualityRanges = map[string]WaterQualityRange{}
+		}
+
+		if e.Min == nil && e.Max == nil {
+			delete(r.WaterQualityRanges, e.Parameter)
+		} else {
+			r.WaterQualityRanges[e.Parameter] = WaterQualityRange{Parameter: e.Parameter, Min: e.Min, Max: e.Max}
+		}
+
+	case ReservoirNutrientRecipeChanged:
+		r.NutrientRecipe = e.Nutrients
+
+	case ReservoirDosed:
+		r.Dosings = append(r.Dosings, ReservoirDosing{
+			UID:       e.UID,
+			Volume:    e.Volume,
+			Nutrients: e.Nutrients,
+			AreaUIDs:  e.AreaUIDs,
+			DosedDate: e.DosedDate,
+		})
+
+	case ReservoirPhotoAdded:
+		r.Photos = append(r.Photos, ReservoirPhoto{
+			UID:       e.UID,
+			Filename:  e.Filename,
+			MimeType:  e.MimeType,
+			Size:      e.Size,
+			Width:     e.Width,
+			Height:    e.Height,
+			Caption:   e.Caption,
+			TakenDate: e.TakenDate,
+		})
+
+		if len(r.Photos) == 1 {
+			r.setCoverPhoto(e.UID)
+		}
+
+	case ReservoirPhotoUpdated:
+		for i, v := range r.Photos {
+			if v.UID == e.UID {
+				r.Photos[i].Caption = e.Caption
+				r.Photos[i].TakenDate = e.TakenDate
+			}
+		}
+
+		r.setCoverPhoto(r.Photo.UID)
+
+	case ReservoirPhotoRemoved:
+		photos := []ReservoirPhoto{}
+
+		for _, v := range r.Photos {
+			if v.UID != e.UID {
+				photos = append(photos, v)
+			}
+		}
+
+		r.Photos = photos
+
+		if r.Photo.UID == e.UID {
+			coverUID := uuid.UUID{}
+			if len(r.Photos) > 0 {
+				coverUID = r.Photos[0].UID
+			}
+
+			r.setCoverPhoto(coverUID)
+		}
+
+	case ReservoirPhotosReordered:
+		photos := []ReservoirPhoto{}
+
+		for _, uid := range e.PhotoUIDs {
+			for _, v := range r.Photos {
+				if v.UID == uid {
+					photos = append(photos, v)
+				}
+			}
+		}
+
+		r.Photos = photos
+
+	case ReservoirCoverPhotoChanged:
+		r.setCoverPhoto(e.UID)
 	}
 }
 
@@ -18,6 +18,31 @@ const (
 
 	ReservoirNoteErrorInvalidContent
 	ReservoirNoteErrorNotFound
+
+	ReservoirErrorLevelNotBucketCode
+	ReservoirErrorLevelInvalidCode
+	ReservoirErrorLevelSourceInvalidCode
+	ReservoirErrorRefillVolumeInvalidCode
+	ReservoirErrorWaterPerWateringInvalidCode
+
+	ReservoirErrorWaterQualityParameterInvalidCode
+	ReservoirErrorWaterQualityUnitInvalidCode
+	ReservoirErrorWaterQualityValueInvalidCode
+	ReservoirErrorWaterQualitySourceInvalidCode
+	ReservoirErrorWaterQualityRangeInvalidCode
+
+	ReservoirErrorNutrientMaterialNotFoundCode
+	ReservoirErrorNutrientNotFertilizerCode
+	ReservoirErrorNutrientAmountInvalidCode
+	ReservoirErrorNutrientDuplicatedCode
+	ReservoirErrorNutrientRecipeEmptyCode
+	ReservoirErrorDosingVolumeInvalidCode
+
+	ReservoirPhotoErrorInvalidFilename
+	ReservoirPhotoErrorInvalidMimeType
+	ReservoirPhotoErrorInvalidSize
+	ReservoirPhotoErrorNotFound
+	ReservoirPhotoErrorInvalidOrder
 )
 
 // ReservoirError is a custom error from Go built-in error.
@@ -47,6 +72,48 @@ func (e ReservoirError) Error() string {
 		return "Reservoir bucket volume is invalid."
 	case ReservoirNoteErrorInvalidContent:
 		return "Invalid reservoir notes content"
+	case ReservoirErrorLevelNotBucketCode:
+		return "Reservoir water level is only tracked for a bucket."
+	case ReservoirErrorLevelInvalidCode:
+		return "Reservoir water level should be between zero and the bucket capacity."
+	case ReservoirErrorLevelSourceInvalidCode:
+		return "Reservoir water level source should be MANUAL or AUTOMATIC."
+	case ReservoirErrorRefillVolumeInvalidCode:
+		return "Reservoir refill volume cannot be negative."
+	case ReservoirErrorWaterPerWateringInvalidCode:
+		return "Reservoir water per watering cannot be negative."
+	case ReservoirErrorWaterQualityParameterInvalidCode:
+		return "Water quality parameter should be PH, EC, TDS or TEMPERATURE."
+	case ReservoirErrorWaterQualityUnitInvalidCode:
+		return "Water quality unit is not a unit of the parameter."
+	case ReservoirErrorWaterQualityValueInvalidCode:
+		return "Water quality value is invalid."
+	case ReservoirErrorWaterQualitySourceInvalidCode:
+		return "Water quality measurement source should be MANUAL or AUTOMATIC."
+	case ReservoirErrorWaterQualityRangeInvalidCode:
+		return "Water quality range minimum cannot be more than its maximum."
+	case ReservoirErrorNutrientMaterialNotFoundCode:
+		return "Nutrient material is not found."
+	case ReservoirErrorNutrientNotFertilizerCode:
+		return "Nutrient material should be an agrochemical fertilizer."
+	case ReservoirErrorNutrientAmountInvalidCode:
+		return "Nutrient amount per liter should be more than zero."
+	case ReservoirErrorNutrientDuplicatedCode:
+		return "Nutrient material is already in the recipe."
+	case ReservoirErrorNutrientRecipeEmptyCode:
+		return "Reservoir has no nutrient recipe to dose."
+	case ReservoirErrorDosingVolumeInvalidCode:
+		return "Dosing volume should be more than zero, and is required for a tap."
+	case ReservoirPhotoErrorInvalidFilename:
+		return "Reservoir photo filename is required."
+	case ReservoirPhotoErrorInvalidMimeType:
+		return "Reservoir photo should be an image."
+	case ReservoirPhotoErrorInvalidSize:
+		return "Reservoir photo is empty."
+	case ReservoirPhotoErrorNotFound:
+		return "Reservoir photo not found."
+	case ReservoirPhotoErrorInvalidOrder:
+		return "Reservoir photo order should list every photo of the reservoir once."
 	default:
 		return "Unrecognized Reservoir Error Code"
 	}
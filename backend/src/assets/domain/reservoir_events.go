@@ -35,3 +35,106 @@ type ReservoirNoteRemoved struct {
 	ReservoirUID uuid.UUID
 	UID          uuid.UUID
 }
+
+// ReservoirLevelRecorded is a reading of the water level of a bucket, by hand or by a sensor.
+type ReservoirLevelRecorded struct {
+	ReservoirUID uuid.UUID
+	Level        float32
+	Source       string
+	ReadingDate  time.Time
+}
+
+// ReservoirRefilled is water added to a bucket, with the level it reached.
+type ReservoirRefilled struct {
+	ReservoirUID uuid.UUID
+	Volume       float32
+	Level        float32
+	RefillDate   time.Time
+}
+
+// ReservoirWaterConsumed is the water estimated to be drawn from a bucket by the watering of a crop batch
+// in an area it supplies, with the level left.
+type ReservoirWaterConsumed struct {
+	ReservoirUID uuid.UUID
+	AreaUID      uuid.UUID
+	CropUID      uuid.UUID
+	Volume       float32
+	Level        float32
+	ConsumedDate time.Time
+}
+
+type ReservoirWaterPerWateringChanged struct {
+	ReservoirUID     uuid.UUID
+	WaterPerWatering float32
+}
+
+// ReservoirWaterQualityMeasured is a measurement of a water quality parameter of a reservoir,
+// converted into the unit of the parameter.
+type ReservoirWaterQualityMeasured struct {
+	ReservoirUID uuid.UUID
+	Parameter    string
+	Value        float32
+	Unit         string
+	Source       string
+	MeasuredDate time.Time
+}
+
+// ReservoirWaterQualityRangeChanged is the acceptable range of a water quality parameter of a reservoir.
+// A nil bound is not checked.
+type ReservoirWaterQualityRangeChanged struct {
+	ReservoirUID uuid.UUID
+	Parameter    string
+	Min          *float32
+	Max          *float32
+}
+
+// ReservoirNutrientRecipeChanged is the list of fertilizers mixed into the reservoir when it is dosed.
+type ReservoirNutrientRecipeChanged struct {
+	ReservoirUID uuid.UUID
+	Nutrients    []ReservoirNutrient
+}
+
+// ReservoirDosed is the nutrients of the recipe mixed into a volume of the reservoir water,
+// with the areas the reservoir supplied at that time.
+type ReservoirDosed struct {
+	ReservoirUID uuid.UUID
+	UID          uuid.UUID
+	Volume       float32
+	Nutrients    []ReservoirDosedNutrient
+	AreaUIDs     []uuid.UUID
+	DosedDate    time.Time
+}
+
+type ReservoirPhotoAdded struct {
+	ReservoirUID uuid.UUID
+	UID          uuid.UUID
+	Filename     string
+	MimeType     string
+	Size         int
+	Width        int
+	Height       int
+	Caption      string
+	TakenDate    *time.Time
+}
+
+type ReservoirPhotoUpdated struct {
+	ReservoirUID uuid.UUID
+	UID          uuid.UUID
+	Caption      string
+	TakenDate    *time.Time
+}
+
+type ReservoirPhotoRemoved struct {
+	ReservoirUID uuid.UUID
+	UID          uuid.UUID
+}
+
+type ReservoirPhotosReordered struct {
+	ReservoirUID uuid.UUID
+	PhotoUIDs    []uuid.UUID
+}
+
+type ReservoirCoverPhotoChanged struct {
+	ReservoirUID uuid.UUID
+	UID          uuid.UUID
+}
@@ -0,0 +1,211 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+const (
+	ReservoirLevelReading     = "READING"
+	ReservoirLevelRefill      = "REFILL"
+	ReservoirLevelConsumption = "CONSUMPTION"
+
+	ReservoirLevelSourceManual    = "MANUAL"
+	ReservoirLevelSourceAutomatic = "AUTOMATIC"
+)
+
+// ReservoirConsumptionDays is how many days of level history the daily consumption is averaged on.
+const ReservoirConsumptionDays = 7
+
+// ReservoirLevel is the water level of a bucket after a reading, a refill or an estimated consumption.
+type ReservoirLevel struct {
+	Type    string    `json:"type"`
+	Source  string    `json:"source"`
+	Level   float32   `json:"level"`
+	Volume  float32   `json:"volume"`
+	AreaUID uuid.UUID `json:"area_id"`
+	CropUID uuid.UUID `json:"crop_id"`
+	Date    time.Time `json:"date"`
+}
+
+// RecordLevel records a reading of the bucket water level, entered by hand or sent by a sensor.
+func (r *Reservoir) RecordLevel(level float32, source string, readingDate time.Time) error {
+	bucket, ok := r.WaterSource.(Bucket)
+	if !ok {
+		return ReservoirError{ReservoirErrorLevelNotBucketCode}
+	}
+
+	if level < 0 || level > bucket.Capacity {
+		return ReservoirError{ReservoirErrorLevelInvalidCode}
+	}
+
+	if source != ReservoirLevelSourceManual && source != ReservoirLevelSourceAutomatic {
+		return ReservoirError{ReservoirErrorLevelSourceInvalidCode}
+	}
+
+	r.TrackChange(ReservoirLevelRecorded{
+		ReservoirUID: r.UID,
+		Level:        level,
+		Source:       source,
+		ReadingDate:  readingDate,
+	})
+
+	return nil
+}
+
+// Refill adds water to the bucket, up to its capacity. A zero volume fills the bucket up.
+// When the level was never known, the bucket is taken as empty before the refill.
+func (r *Reservoir) Refill(volume float32, refillDate time.Time) error {
+	bucket, ok := r.WaterSource.(Bucket)
+	if !ok {
+		return ReservoirError{ReservoirErrorLevelNotBucketCode}
+	}
+
+	if volume < 0 {
+		return ReservoirError{ReservoirErrorRefillVolumeInvalidCode}
+	}
+
+	level := float32(0)
+	if previous := r.levelAt(refillDate); previous != nil {
+		level = previous.Level
+	}
+
+	if volume == 0 || level+volume > bucket.Capacity {
+		volume = bucket.Capacity - level
+	}
+
+	r.TrackChange(ReservoirRefilled{
+		ReservoirUID: r.UID,
+		Volume:       volume,
+		Level:        level + volume,
+		RefillDate:   refillDate,
+	})
+
+	return nil
+}
+
+// ConsumeWater estimates the water drawn from the bucket by the watering of a crop batch in an area it supplies.
+// Nothing is estimated for a tap, without a water per watering or before the level is first known.
+func (r *Reservoir) ConsumeWater(areaUID, cropUID uuid.UUID, wateringDate time.Time) {
+	if _, ok := r.WaterSource.(Bucket); !ok || r.WaterPerWatering <= 0 {
+		return
+	}
+
+	previous := r.levelAt(wateringDate)
+	if previous == nil {
+		return
+	}
+
+	volume := r.WaterPerWatering
+	if volume > previous.Level {
+		volume = previous.Level
+	}
+
+	r.TrackChange(ReservoirWaterConsumed{
+		ReservoirUID: r.UID,
+		AreaUID:      areaUID,
+		CropUID:      cropUID,
+		Volume:       volume,
+		Level:        previous.Level - volume,
+		ConsumedDate: wateringDate,
+	})
+}
+
+// ChangeWaterPerWatering sets the water a crop batch watering draws from the reservoir.
+// Zero stops estimating the consumption.
+func (r *Reservoir) ChangeWaterPerWatering(volume float32) error {
+	if volume < 0 {
+		return ReservoirError{ReservoirErrorWaterPerWateringInvalidCode}
+	}
+
+	r.TrackChange(ReservoirWaterPerWateringChanged{
+		ReservoirUID:     r.UID,
+		WaterPerWatering: volume,
+	})
+
+	return nil
+}
+
+// CurrentLevel is the latest known level of the bucket, or nil when it is unknown or the reservoir is a tap.
+func (r Reservoir) CurrentLevel() *ReservoirLevel {
+	if _, ok := r.WaterSource.(Bucket); !ok || len(r.Levels) == 0 {
+		return nil
+	}
+
+	level := r.Levels[len(r.Levels)-1]
+
+	return &level
+}
+
+// levelAt is the last known level of the bucket at a date, or nil when it was not known yet.
+func (r Reservoir) levelAt(date time.Time) *ReservoirLevel {
+	for i := len(r.Levels) - 1; i >= 0; i-- {
+		if !r.Levels[i].Date.After(date) {
+			level := r.Levels[i]
+
+			return &level
+		}
+	}
+
+	return nil
+}
+
+// addLevel keeps the levels in the order of their dates, so a reading entered late takes its place
+// in the history. The levels of the same date stay in the order they were recorded.
+func (r *Reservoir) addLevel(level ReservoirLevel) {
+	i := len(r.Levels)
+	for i > 0 && r.Levels[i-1].Date.After(level.Date) {
+		i--
+	}
+
+	r.Levels = append(r.Levels[:i], append([]ReservoirLevel{level}, r.Levels[i:]...)...)
+}
+
+// DailyConsumption is the average water drawn from the bucket each day during the last days
+// before its latest level, from the level going down between readings and estimated consumptions.
+func (r Reservoir) DailyConsumption() float32 {
+	current := r.CurrentLevel()
+	if current == nil {
+		return 0
+	}
+
+	from := current.Date.AddDate(0, 0, -ReservoirConsumptionDays)
+	start := current.Date
+	drawn := float32(0)
+
+	for i, v := range r.Levels {
+		if v.Date.Before(from) {
+			continue
+		}
+
+		if v.Date.Before(start) {
+			start = v.Date
+		}
+
+		if i > 0 && v.Type != ReservoirLevelRefill && v.Level < r.Levels[i-1].Level {
+			drawn += r.Levels[i-1].Level - v.Level
+		}
+	}
+
+	days := float32(current.Date.Sub(start).Hours() / 24)
+	if days < 1 {
+		days = 1
+	}
+
+	return drawn / days
+}
+
+// PredictDryDate is when the bucket runs dry at its daily consumption, or nil when it is not consumed.
+func (r Reservoir) PredictDryDate() *time.Time {
+	current := r.CurrentLevel()
+
+	daily := r.DailyConsumption()
+	if current == nil || daily <= 0 {
+		return nil
+	}
+
+	dryDate := current.Date.Add(time.Duration(float64(current.Level/daily) * float64(24*time.Hour)))
+
+	return &dryDate
+}
@@ -0,0 +1,124 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	. "github.com/usetania/tania-core/src/assets/domain"
+)
+
+func TestRecordReservoirLevel(t *testing.T) {
+	t.Parallel()
+	// Given
+	farmUID, _ := uuid.NewV4()
+	serviceMock := mockReservoirService(farmUID, "My Farm")
+
+	bucket, _ := CreateReservoir(serviceMock, farmUID, "My Bucket", BucketType, float32(100))
+	tap, _ := CreateReservoir(serviceMock, farmUID, "My Tap", TapType, float32(0))
+
+	// When
+	err := bucket.RecordLevel(80, ReservoirLevelSourceManual, time.Now())
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, float32(80), bucket.CurrentLevel().Level)
+
+	event, ok := bucket.UncommittedChanges[len(bucket.UncommittedChanges)-1].(ReservoirLevelRecorded)
+	assert.True(t, ok)
+	assert.Equal(t, ReservoirLevelSourceManual, event.Source)
+
+	// When
+	errTap := tap.RecordLevel(10, ReservoirLevelSourceManual, time.Now())
+	errOver := bucket.RecordLevel(120, ReservoirLevelSourceManual, time.Now())
+	errSource := bucket.RecordLevel(50, "SENSOR", time.Now())
+
+	// Then
+	assert.Equal(t, ReservoirError{ReservoirErrorLevelNotBucketCode}, errTap)
+	assert.Equal(t, ReservoirError{ReservoirErrorLevelInvalidCode}, errOver)
+	assert.Equal(t, ReservoirError{ReservoirErrorLevelSourceInvalidCode}, errSource)
+	assert.Nil(t, tap.CurrentLevel())
+}
+
+func TestReservoirConsumptionAndDryDate(t *testing.T) {
+	t.Parallel()
+	// Given
+	farmUID, _ := uuid.NewV4()
+	serviceMock := mockReservoirService(farmUID, "My Farm")
+	areaUID, _ := uuid.NewV4()
+	cropUID, _ := uuid.NewV4()
+
+	reservoir, _ := CreateReservoir(serviceMock, farmUID, "My Bucket", BucketType, float32(100))
+	start := time.Date(2019, time.March, 1, 8, 0, 0, 0, time.UTC)
+
+	// When
+	reservoir.ConsumeWater(areaUID, cropUID, start)
+
+	// Then
+	assert.Nil(t, reservoir.CurrentLevel())
+
+	// When
+	err := reservoir.Refill(0, start)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, float32(100), reservoir.CurrentLevel().Level)
+
+	// When
+	err = reservoir.ChangeWaterPerWatering(10)
+	reservoir.ConsumeWater(areaUID, cropUID, start.AddDate(0, 0, 1))
+	reservoir.ConsumeWater(areaUID, cropUID, start.AddDate(0, 0, 2))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, float32(80), reservoir.CurrentLevel().Level)
+	assert.Equal(t, float32(10), reservoir.DailyConsumption())
+	assert.Equal(t, start.AddDate(0, 0, 10), *reservoir.PredictDryDate())
+
+	// When
+	err = reservoir.Refill(50, start.AddDate(0, 0, 2))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, float32(100), reservoir.CurrentLevel().Level)
+	assert.Equal(t, float32(20), reservoir.Levels[len(reservoir.Levels)-1].Volume)
+
+	// When
+	errVolume := reservoir.Refill(-1, start)
+	errWater := reservoir.ChangeWaterPerWatering(-1)
+
+	// Then
+	assert.Equal(t, ReservoirError{ReservoirErrorRefillVolumeInvalidCode}, errVolume)
+	assert.Equal(t, ReservoirError{ReservoirErrorWaterPerWateringInvalidCode}, errWater)
+}
+
+func TestReservoirBackdatedLevel(t *testing.T) {
+	t.Parallel()
+	// Given
+	farmUID, _ := uuid.NewV4()
+	serviceMock := mockReservoirService(farmUID, "My Farm")
+
+	reservoir, _ := CreateReservoir(serviceMock, farmUID, "My Bucket", BucketType, float32(100))
+	start := time.Date(2019, time.March, 1, 8, 0, 0, 0, time.UTC)
+
+	// When
+	errLatest := reservoir.RecordLevel(80, ReservoirLevelSourceManual, start.AddDate(0, 0, 3))
+	errBackdated := reservoir.RecordLevel(90, ReservoirLevelSourceManual, start.AddDate(0, 0, 1))
+
+	// Then
+	assert.Nil(t, errLatest)
+	assert.Nil(t, errBackdated)
+	assert.Equal(t, float32(80), reservoir.CurrentLevel().Level)
+	assert.Equal(t, start.AddDate(0, 0, 3), reservoir.CurrentLevel().Date)
+	assert.Equal(t, float32(5), reservoir.DailyConsumption())
+	assert.Equal(t, start.AddDate(0, 0, 19), *reservoir.PredictDryDate())
+
+	// When
+	err := reservoir.Refill(0, start.AddDate(0, 0, 2))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, float32(10), reservoir.Levels[1].Volume)
+	assert.Equal(t, float32(80), reservoir.CurrentLevel().Level)
+}
@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// ReservoirNutrient is a fertilizer of the nutrient recipe of a reservoir, with the amount mixed into
+// each liter of water, in the material quantity unit.
+type ReservoirNutrient struct {
+	MaterialUID    uuid.UUID `json:"material_id"`
+	MaterialName   string    `json:"material_name"`
+	AmountPerLiter float32   `json:"amount_per_liter"`
+	QuantityUnit   string    `json:"quantity_unit"`
+}
+
+// ReservoirDosedNutrient is the quantity of a fertilizer mixed into the reservoir by a dosing.
+type ReservoirDosedNutrient struct {
+	MaterialUID  uuid.UUID `json:"material_id"`
+	MaterialName string    `json:"material_name"`
+	Quantity     float32   `json:"quantity"`
+	QuantityUnit string    `json:"quantity_unit"`
+}
+
+// ReservoirDosing is the nutrients mixed into the reservoir at once, following its recipe.
+type ReservoirDosing struct {
+	UID       uuid.UUID                `json:"uid"`
+	Volume    float32                  `json:"volume"`
+	Nutrients []ReservoirDosedNutrient `json:"nutrients"`
+	AreaUIDs  []uuid.UUID              `json:"area_ids"`
+	DosedDate time.Time                `json:"dosed_date"`
+}
+
+// ChangeNutrientRecipe sets the fertilizers mixed into the reservoir and their amount per liter.
+// Only the material and the amount of the nutrients are read, the rest is taken from the material.
+// An empty recipe removes it.
+func (r *Reservoir) ChangeNutrientRecipe(rs ReservoirService, nutrients []ReservoirNutrient) error {
+	recipe := []ReservoirNutrient{}
+
+	for _, v := range nutrients {
+		if v.AmountPerLiter <= 0 {
+			return ReservoirError{ReservoirErrorNutrientAmountInvalidCode}
+		}
+
+		for _, n := range recipe {
+			if n.MaterialUID == v.MaterialUID {
+				return ReservoirError{ReservoirErrorNutrientDuplicatedCode}
+			}
+		}
+
+		material, err := rs.FindMaterialByID(v.MaterialUID)
+		if err != nil {
+			return err
+		}
+
+		if material.UID == (uuid.UUID{}) {
+			return ReservoirError{ReservoirErrorNutrientMaterialNotFoundCode}
+		}
+
+		agrochemical, ok := material.Type.(MaterialTypeAgrochemical)
+		if !ok || agrochemical.ChemicalType.Code != ChemicalTypeFertilizer {
+			return ReservoirError{ReservoirErrorNutrientNotFertilizerCode}
+		}
+
+		recipe = append(recipe, ReservoirNutrient{
+			MaterialUID:    material.UID,
+			MaterialName:   material.Name,
+			AmountPerLiter: v.AmountPerLiter,
+			QuantityUnit:   material.QuantityUnit,
+		})
+	}
+
+	r.TrackChange(ReservoirNutrientRecipeChanged{
+		ReservoirUID: r.UID,
+		Nutrients:    recipe,
+	})
+
+	return nil
+}
+
+// Dose mixes the nutrients of the recipe into a volume of the reservoir water.
+// A zero volume doses a whole bucket, from its capacity.
+func (r *Reservoir) Dose(volume float32, areaUIDs []uuid.UUID, dosedDate time.Time) (ReservoirDosing, error) {
+	if len(r.NutrientRecipe) == 0 {
+		return ReservoirDosing{}, ReservoirError{ReservoirErrorNutrientRecipeEmptyCode}
+	}
+
+	if bucket, ok := r.WaterSource.(Bucket); ok && volume == 0 {
+		volume = bucket.Capacity
+	}
+
+	if volume <= 0 {
+		return ReservoirDosing{}, ReservoirError{ReservoirErrorDosingVolumeInvalidCode}
+	}
+
+	nutrients := []ReservoirDosedNutrient{}
+
+	for _, v := range r.NutrientRecipe {
+		nutrients = append(nutrients, ReservoirDosedNutrient{
+			MaterialUID:  v.MaterialUID,
+			MaterialName: v.MaterialName,
+			Quantity:     v.AmountPerLiter * volume,
+			QuantityUnit: v.QuantityUnit,
+		})
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return ReservoirDosing{}, err
+	}
+
+	r.TrackChange(ReservoirDosed{
+		ReservoirUID: r.UID,
+		UID:          uid,
+		Volume:       volume,
+		Nutrients:    nutrients,
+		AreaUIDs:     areaUIDs,
+		DosedDate:    dosedDate,
+	})
+
+	return r.Dosings[len(r.Dosings)-1], nil
+}
@@ -0,0 +1,125 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	. "github.com/usetania/tania-core/src/assets/domain"
+)
+
+func TestChangeNutrientRecipe(t *testing.T) {
+	t.Parallel()
+	// Given
+	farmUID, _ := uuid.NewV4()
+	serviceMock := mockReservoirService(farmUID, "My Farm")
+
+	fertilizerUID, _ := uuid.NewV4()
+	fertilizerType, _ := CreateMaterialTypeAgrochemical(DefaultMaterialTypeCatalog(uuid.Nil), ChemicalTypeFertilizer)
+	serviceMock.On("FindMaterialByID", fertilizerUID).Return(ReservoirMaterialServiceResult{
+		UID:          fertilizerUID,
+		Name:         "Hydro A",
+		Type:         fertilizerType,
+		QuantityUnit: "LITRE",
+	})
+
+	disinfectantUID, _ := uuid.NewV4()
+	disinfectantType, _ := CreateMaterialTypeAgrochemical(DefaultMaterialTypeCatalog(uuid.Nil), ChemicalTypeDisinfectant)
+	serviceMock.On("FindMaterialByID", disinfectantUID).Return(ReservoirMaterialServiceResult{
+		UID:  disinfectantUID,
+		Name: "Chlorine",
+		Type: disinfectantType,
+	})
+
+	unknownUID, _ := uuid.NewV4()
+	serviceMock.On("FindMaterialByID", unknownUID).Return(ReservoirMaterialServiceResult{})
+
+	reservoir, _ := CreateReservoir(serviceMock, farmUID, "My Bucket", BucketType, float32(100))
+
+	// When
+	err := reservoir.ChangeNutrientRecipe(serviceMock, []ReservoirNutrient{
+		{MaterialUID: fertilizerUID, AmountPerLiter: 0.002},
+	})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Len(t, reservoir.NutrientRecipe, 1)
+	assert.Equal(t, "Hydro A", reservoir.NutrientRecipe[0].MaterialName)
+	assert.Equal(t, "LITRE", reservoir.NutrientRecipe[0].QuantityUnit)
+
+	// When
+	errAmount := reservoir.ChangeNutrientRecipe(serviceMock, []ReservoirNutrient{
+		{MaterialUID: fertilizerUID, AmountPerLiter: 0},
+	})
+	errDuplicated := reservoir.ChangeNutrientRecipe(serviceMock, []ReservoirNutrient{
+		{MaterialUID: fertilizerUID, AmountPerLiter: 0.002},
+		{MaterialUID: fertilizerUID, AmountPerLiter: 0.001},
+	})
+	errNotFertilizer := reservoir.ChangeNutrientRecipe(serviceMock, []ReservoirNutrient{
+		{MaterialUID: disinfectantUID, AmountPerLiter: 0.001},
+	})
+	errNotFound := reservoir.ChangeNutrientRecipe(serviceMock, []ReservoirNutrient{
+		{MaterialUID: unknownUID, AmountPerLiter: 0.001},
+	})
+
+	// Then
+	assert.Equal(t, ReservoirError{ReservoirErrorNutrientAmountInvalidCode}, errAmount)
+	assert.Equal(t, ReservoirError{ReservoirErrorNutrientDuplicatedCode}, errDuplicated)
+	assert.Equal(t, ReservoirError{ReservoirErrorNutrientNotFertilizerCode}, errNotFertilizer)
+	assert.Equal(t, ReservoirError{ReservoirErrorNutrientMaterialNotFoundCode}, errNotFound)
+	assert.Len(t, reservoir.NutrientRecipe, 1)
+}
+
+func TestDoseReservoir(t *testing.T) {
+	t.Parallel()
+	// Given
+	farmUID, _ := uuid.NewV4()
+	serviceMock := mockReservoirService(farmUID, "My Farm")
+
+	fertilizerUID, _ := uuid.NewV4()
+	fertilizerType, _ := CreateMaterialTypeAgrochemical(DefaultMaterialTypeCatalog(uuid.Nil), ChemicalTypeFertilizer)
+	serviceMock.On("FindMaterialByID", fertilizerUID).Return(ReservoirMaterialServiceResult{
+		UID:          fertilizerUID,
+		Name:         "Hydro A",
+		Type:         fertilizerType,
+		QuantityUnit: "LITRE",
+	})
+
+	bucket, _ := CreateReservoir(serviceMock, farmUID, "My Bucket", BucketType, float32(100))
+	tap, _ := CreateReservoir(serviceMock, farmUID, "My Tap", TapType, float32(0))
+
+	areaUID, _ := uuid.NewV4()
+	date := time.Date(2019, time.March, 1, 8, 0, 0, 0, time.UTC)
+
+	// When
+	_, errEmpty := bucket.Dose(0, nil, date)
+
+	// Then
+	assert.Equal(t, ReservoirError{ReservoirErrorNutrientRecipeEmptyCode}, errEmpty)
+
+	// When
+	bucket.ChangeNutrientRecipe(serviceMock, []ReservoirNutrient{{MaterialUID: fertilizerUID, AmountPerLiter: 0.002}})
+	dosing, err := bucket.Dose(0, []uuid.UUID{areaUID}, date)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, float32(100), dosing.Volume)
+	assert.Equal(t, float32(0.2), dosing.Nutrients[0].Quantity)
+	assert.Equal(t, []uuid.UUID{areaUID}, dosing.AreaUIDs)
+	assert.Len(t, bucket.Dosings, 1)
+
+	event, ok := bucket.UncommittedChanges[len(bucket.UncommittedChanges)-1].(ReservoirDosed)
+	assert.True(t, ok)
+	assert.Equal(t, dosing.UID, event.UID)
+
+	// When
+	tap.ChangeNutrientRecipe(serviceMock, []ReservoirNutrient{{MaterialUID: fertilizerUID, AmountPerLiter: 0.002}})
+	_, errVolume := tap.Dose(0, nil, date)
+	tapDosing, err := tap.Dose(50, nil, date)
+
+	// Then
+	assert.Equal(t, ReservoirError{ReservoirErrorDosingVolumeInvalidCode}, errVolume)
+	assert.Nil(t, err)
+	assert.Equal(t, float32(0.1), tapDosing.Nutrients[0].Quantity)
+}
@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// ReservoirPhoto is a photo of the reservoir gallery.
+type ReservoirPhoto struct {
+	UID       uuid.UUID  `json:"uid"`
+	Filename  string     `json:"filename"`
+	MimeType  string     `json:"mime_type"`
+	Size      int        `json:"size"`
+	Width     int        `json:"width"`
+	Height    int        `json:"height"`
+	Caption   string     `json:"caption"`
+	TakenDate *time.Time `json:"taken_date"`
+	IsCover   bool       `json:"is_cover"`
+}
+
+// AddPhoto adds a photo to the end of the reservoir gallery. The first photo becomes the cover photo.
+// A photo without UID gets a new one.
+func (r *Reservoir) AddPhoto(photo ReservoirPhoto) error {
+	if photo.Filename == "" {
+		return ReservoirError{ReservoirPhotoErrorInvalidFilename}
+	}
+
+	if !strings.HasPrefix(photo.MimeType, "image/") {
+		return ReservoirError{ReservoirPhotoErrorInvalidMimeType}
+	}
+
+	if photo.Size <= 0 {
+		return ReservoirError{ReservoirPhotoErrorInvalidSize}
+	}
+
+	uid := photo.UID
+	if uid == (uuid.UUID{}) {
+		var err error
+
+		uid, err = uuid.NewV4()
+		if err != nil {
+			return err
+		}
+	}
+
+	r.TrackChange(ReservoirPhotoAdded{
+		ReservoirUID: r.UID,
+		UID:          uid,
+		Filename:     photo.Filename,
+		MimeType:     photo.MimeType,
+		Size:         photo.Size,
+		Width:        photo.Width,
+		Height:       photo.Height,
+		Caption:      photo.Caption,
+		TakenDate:    photo.TakenDate,
+	})
+
+	return nil
+}
+
+// UpdatePhoto changes the caption and the date a photo of the gallery was taken.
+func (r *Reservoir) UpdatePhoto(uid uuid.UUID, caption string, takenDate *time.Time) error {
+	if r.FindPhotoByID(uid) == nil {
+		return ReservoirError{ReservoirPhotoErrorNotFound}
+	}
+
+	r.TrackChange(ReservoirPhotoUpdated{
+		ReservoirUID: r.UID,
+		UID:          uid,
+		Caption:      caption,
+		TakenDate:    takenDate,
+	})
+
+	return nil
+}
+
+// RemovePhoto removes a photo from the gallery. Without its cover photo, the first photo becomes the cover.
+func (r *Reservoir) RemovePhoto(uid uuid.UUID) error {
+	if r.FindPhotoByID(uid) == nil {
+		return ReservoirError{ReservoirPhotoErrorNotFound}
+	}
+
+	r.TrackChange(ReservoirPhotoRemoved{
+		ReservoirUID: r.UID,
+		UID:          uid,
+	})
+
+	return nil
+}
+
+// ReorderPhotos sorts the gallery in the order of the photo UIDs, which should list every photo once.
+func (r *Reservoir) ReorderPhotos(photoUIDs []uuid.UUID) error {
+	if len(photoUIDs) != len(r.Photos) {
+		return ReservoirError{ReservoirPhotoErrorInvalidOrder}
+	}
+
+	for i, uid := range photoUIDs {
+		if r.FindPhotoByID(uid) == nil {
+			return ReservoirError{ReservoirPhotoErrorInvalidOrder}
+		}
+
+		for _, v := range photoUIDs[:i] {
+			if v == uid {
+				return ReservoirError{ReservoirPhotoErrorInvalidOrder}
+			}
+		}
+	}
+
+	r.TrackChange(ReservoirPhotosReordered{
+		ReservoirUID: r.UID,
+		PhotoUIDs:    photoUIDs,
+	})
+
+	return nil
+}
+
+// ChangeCoverPhoto makes a photo of the gallery the reservoir photo.
+func (r *Reservoir) ChangeCoverPhoto(uid uuid.UUID) error {
+	if r.FindPhotoByID(uid) == nil {
+		return ReservoirError{ReservoirPhotoErrorNotFound}
+	}
+
+	r.TrackChange(ReservoirCoverPhotoChanged{
+		ReservoirUID: r.UID,
+		UID:          uid,
+	})
+
+	return nil
+}
+
+// FindPhotoByID is a photo of the gallery, or nil when the reservoir does not have it.
+func (r Reservoir) FindPhotoByID(uid uuid.UUID) *ReservoirPhoto {
+	for i, v := range r.Photos {
+		if v.UID == uid {
+			photo := r.Photos[i]
+
+			return &photo
+		}
+	}
+
+	return nil
+}
+
+// setCoverPhoto flags the cover photo of the gallery and copies it to the reservoir photo.
+func (r *Reservoir) setCoverPhoto(uid uuid.UUID) {
+	r.Photo = ReservoirPhoto{}
+
+	for i, v := range r.Photos {
+		r.Photos[i].IsCover = v.UID == uid
+
+		if r.Photos[i].IsCover {
+			r.Photo = r.Photos[i]
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	. "github.com/usetania/tania-core/src/assets/domain"
+)
+
+func TestReservoirPhotoGallery(t *testing.T) {
+	t.Parallel()
+	// Given
+	farmUID, _ := uuid.NewV4()
+	serviceMock := mockReservoirService(farmUID, "My Farm")
+
+	reservoir, _ := CreateReservoir(serviceMock, farmUID, "My Bucket", BucketType, float32(100))
+	takenDate := time.Date(2019, time.March, 1, 8, 0, 0, 0, time.UTC)
+
+	// When
+	reservoir.AddPhoto(ReservoirPhoto{Filename: "tank.jpg", MimeType: "image/jpeg", Size: 1000})
+	reservoir.AddPhoto(ReservoirPhoto{Filename: "pump.jpg", MimeType: "image/png", Size: 1000})
+
+	pump := reservoir.Photos[1].UID
+	err := reservoir.UpdatePhoto(pump, "New pump", &takenDate)
+	errCover := reservoir.ChangeCoverPhoto(pump)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Nil(t, errCover)
+	assert.Equal(t, "New pump", reservoir.Photo.Caption)
+	assert.Equal(t, &takenDate, reservoir.Photo.TakenDate)
+	assert.False(t, reservoir.Photos[0].IsCover)
+
+	// When
+	reservoir.RemovePhoto(pump)
+	errNotFound := reservoir.ChangeCoverPhoto(pump)
+	errSize := reservoir.AddPhoto(ReservoirPhoto{Filename: "empty.jpg", MimeType: "image/jpeg"})
+
+	// Then
+	assert.Equal(t, "tank.jpg", reservoir.Photo.Filename)
+	assert.Equal(t, ReservoirError{ReservoirPhotoErrorNotFound}, errNotFound)
+	assert.Equal(t, ReservoirError{ReservoirPhotoErrorInvalidSize}, errSize)
+}
@@ -19,6 +19,12 @@ func (m *ReservoirServiceMock) FindFarmByID(uid uuid.UUID) (ReservoirFarmService
 	return args.Get(0).(ReservoirFarmServiceResult), nil
 }
 
+func (m *ReservoirServiceMock) FindMaterialByID(uid uuid.UUID) (ReservoirMaterialServiceResult, error) {
+	args := m.Called(uid)
+
+	return args.Get(0).(ReservoirMaterialServiceResult), nil
+}
+
 func mockReservoirService(farmUID uuid.UUID, farmName string) *ReservoirServiceMock {
 	reservoirServiceMock := new(ReservoirServiceMock)
 
@@ -0,0 +1,265 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	WaterQualityPH          = "PH"
+	WaterQualityEC          = "EC"
+	WaterQualityTDS         = "TDS"
+	WaterQualityTemperature = "TEMPERATURE"
+
+	WaterQualityUnitPH         = "PH"
+	WaterQualityUnitMSCM       = "MS_CM"
+	WaterQualityUnitUSCM       = "US_CM"
+	WaterQualityUnitPPM        = "PPM"
+	WaterQualityUnitMGL        = "MG_L"
+	WaterQualityUnitCelsius    = "CELSIUS"
+	WaterQualityUnitFahrenheit = "FAHRENHEIT"
+)
+
+// WaterQualityParameter is a measured property of the water. Unit is the unit its values are kept in,
+// and Units are the units a measurement can be sent in.
+type WaterQualityParameter struct {
+	Code  string   `json:"code"`
+	Name  string   `json:"name"`
+	Unit  string   `json:"unit"`
+	Units []string `json:"units"`
+}
+
+// WaterQualityMeasurement is a value of a water quality parameter measured in a reservoir.
+type WaterQualityMeasurement struct {
+	Parameter    string    `json:"parameter"`
+	Value        float32   `json:"value"`
+	Unit         string    `json:"unit"`
+	Source       string    `json:"source"`
+	MeasuredDate time.Time `json:"measured_date"`
+}
+
+// WaterQualityRange is the acceptable range of a water quality parameter in a reservoir.
+type WaterQualityRange struct {
+	Parameter string   `json:"parameter"`
+	Min       *float32 `json:"min"`
+	Max       *float32 `json:"max"`
+}
+
+// WaterQualityDailyAggregate sums up the measurements of a parameter during a day.
+type WaterQualityDailyAggregate struct {
+	Date  time.Time `json:"date"`
+	Min   float32   `json:"min"`
+	Max   float32   `json:"max"`
+	Avg   float32   `json:"avg"`
+	Count int       `json:"count"`
+}
+
+func FindAllWaterQualityParameters() []WaterQualityParameter {
+	return []WaterQualityParameter{
+		{Code: WaterQualityPH, Name: "pH", Unit: WaterQualityUnitPH, Units: []string{WaterQualityUnitPH}},
+		{
+			Code:  WaterQualityEC,
+			Name:  "Electrical Conductivity",
+			Unit:  WaterQualityUnitMSCM,
+			Units: []string{WaterQualityUnitMSCM, WaterQualityUnitUSCM},
+		},
+		{
+			Code:  WaterQualityTDS,
+			Name:  "Total Dissolved Solids",
+			Unit:  WaterQualityUnitPPM,
+			Units: []string{WaterQualityUnitPPM, WaterQualityUnitMGL},
+		},
+		{
+			Code:  WaterQualityTemperature,
+			Name:  "Temperature",
+			Unit:  WaterQualityUnitCelsius,
+			Units: []string{WaterQualityUnitCelsius, WaterQualityUnitFahrenheit},
+		},
+	}
+}
+
+func FindWaterQualityParameterByCode(code string) (WaterQualityParameter, error) {
+	items := FindAllWaterQualityParameters()
+
+	for _, item := range items {
+		if item.Code == code {
+			return item, nil
+		}
+	}
+
+	return WaterQualityParameter{}, ReservoirError{ReservoirErrorWaterQualityParameterInvalidCode}
+}
+
+// ConvertValue converts a value measured in one of the parameter units into the parameter unit.
+func (p WaterQualityParameter) ConvertValue(value float32, unit string) (float32, error) {
+	switch unit {
+	case "", p.Unit:
+		return value, nil
+	case WaterQualityUnitUSCM:
+		if p.Code == WaterQualityEC {
+			return value / 1000, nil
+		}
+	case WaterQualityUnitMGL:
+		if p.Code == WaterQualityTDS {
+			return value, nil
+		}
+	case WaterQualityUnitFahrenheit:
+		if p.Code == WaterQualityTemperature {
+			return (value - 32) * 5 / 9, nil
+		}
+	}
+
+	return 0, ReservoirError{ReservoirErrorWaterQualityUnitInvalidCode}
+}
+
+// Contains tells whether a value is within the range.
+func (wr WaterQualityRange) Contains(value float32) bool {
+	if wr.Min != nil && value < *wr.Min {
+		return false
+	}
+
+	if wr.Max != nil && value > *wr.Max {
+		return false
+	}
+
+	return true
+}
+
+// MeasureWaterQuality records a value of a water quality parameter, entered by hand or sent by a sensor.
+// The value is kept in the parameter unit.
+func (r *Reservoir) MeasureWaterQuality(parameter string, value float32, unit, source string, date time.Time) error {
+	p, err := FindWaterQualityParameterByCode(parameter)
+	if err != nil {
+		return err
+	}
+
+	value, err = p.ConvertValue(value, unit)
+	if err != nil {
+		return err
+	}
+
+	switch p.Code {
+	case WaterQualityPH:
+		if value < 0 || value > 14 {
+			return ReservoirError{ReservoirErrorPHInvalidCode}
+		}
+	case WaterQualityEC:
+		if value < 0 {
+			return ReservoirError{ReservoirErrorECInvalidCode}
+		}
+	case WaterQualityTDS:
+		if value < 0 {
+			return ReservoirError{ReservoirErrorWaterQualityValueInvalidCode}
+		}
+	}
+
+	if source != ReservoirLevelSourceManual && source != ReservoirLevelSourceAutomatic {
+		return ReservoirError{ReservoirErrorWaterQualitySourceInvalidCode}
+	}
+
+	r.TrackChange(ReservoirWaterQualityMeasured{
+		ReservoirUID: r.UID,
+		Parameter:    p.Code,
+		Value:        value,
+		Unit:         p.Unit,
+		Source:       source,
+		MeasuredDate: date,
+	})
+
+	return nil
+}
+
+// ChangeWaterQualityRange sets the acceptable range of a water quality parameter, in the parameter unit.
+// Without both bounds, the parameter is no longer checked.
+func (r *Reservoir) ChangeWaterQualityRange(parameter string, minValue, maxValue *float32) error {
+	p, err := FindWaterQualityParameterByCode(parameter)
+	if err != nil {
+		return err
+	}
+
+	if minValue != nil && maxValue != nil && *minValue > *maxValue {
+		return ReservoirError{ReservoirErrorWaterQualityRangeInvalidCode}
+	}
+
+	r.TrackChange(ReservoirWaterQualityRangeChanged{
+		ReservoirUID: r.UID,
+		Parameter:    p.Code,
+		Min:          minValue,
+		Max:          maxValue,
+	})
+
+	return nil
+}
+
+// LatestWaterQuality is the last measurement of a parameter, or nil when it was never measured.
+func (r Reservoir) LatestWaterQuality(parameter string) *WaterQualityMeasurement {
+	var latest *WaterQualityMeasurement
+
+	for i, v := range r.WaterQuality {
+		if v.Parameter != parameter {
+			continue
+		}
+
+		if latest == nil || !v.MeasuredDate.Before(latest.MeasuredDate) {
+			latest = &r.WaterQuality[i]
+		}
+	}
+
+	if latest == nil {
+		return nil
+	}
+
+	measurement := *latest
+
+	return &measurement
+}
+
+// IsWaterQualityOutOfRange tells whether the last measurement of a parameter is outside its acceptable range.
+func (r Reservoir) IsWaterQualityOutOfRange(parameter string) bool {
+	wr, ok := r.WaterQualityRanges[parameter]
+	if !ok {
+		return false
+	}
+
+	latest := r.LatestWaterQuality(parameter)
+
+	return latest != nil && !wr.Contains(latest.Value)
+}
+
+// AggregateWaterQualityDaily sums up the measurements of a parameter by day, in the order of the days.
+func AggregateWaterQualityDaily(measurements []WaterQualityMeasurement) []WaterQualityDailyAggregate {
+	aggregates := []WaterQualityDailyAggregate{}
+	sums := []float32{}
+
+	for _, v := range measurements {
+		d := v.MeasuredDate
+		day := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, d.Location())
+
+		i := 0
+		for i < len(aggregates) && aggregates[i].Date.Before(day) {
+			i++
+		}
+
+		if i == len(aggregates) || !aggregates[i].Date.Equal(day) {
+			aggregates = append(aggregates[:i], append([]WaterQualityDailyAggregate{{
+				Date: day,
+				Min:  v.Value,
+				Max:  v.Value,
+			}}, aggregates[i:]...)...)
+			sums = append(sums[:i], append([]float32{0}, sums[i:]...)...)
+		}
+
+		if v.Value < aggregates[i].Min {
+			aggregates[i].Min = v.Value
+		}
+
+		if v.Value > aggregates[i].Max {
+			aggregates[i].Max = v.Value
+		}
+
+		sums[i] += v.Value
+		aggregates[i].Count++
+		aggregates[i].Avg = sums[i] / float32(aggregates[i].Count)
+	}
+
+	return aggregates
+}
@@ -0,0 +1,110 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	. "github.com/usetania/tania-core/src/assets/domain"
+)
+
+func TestMeasureWaterQuality(t *testing.T) {
+	t.Parallel()
+	// Given
+	farmUID, _ := uuid.NewV4()
+	serviceMock := mockReservoirService(farmUID, "My Farm")
+
+	reservoir, _ := CreateReservoir(serviceMock, farmUID, "My Bucket", BucketType, float32(100))
+	date := time.Date(2019, time.March, 1, 8, 0, 0, 0, time.UTC)
+
+	// When
+	errEC := reservoir.MeasureWaterQuality(WaterQualityEC, 1800, WaterQualityUnitUSCM, ReservoirLevelSourceAutomatic, date)
+	errTemp := reservoir.MeasureWaterQuality(WaterQualityTemperature, 68, WaterQualityUnitFahrenheit, "MANUAL", date)
+
+	// Then
+	assert.Nil(t, errEC)
+	assert.Nil(t, errTemp)
+	assert.Equal(t, float32(1.8), reservoir.LatestWaterQuality(WaterQualityEC).Value)
+	assert.Equal(t, WaterQualityUnitMSCM, reservoir.LatestWaterQuality(WaterQualityEC).Unit)
+	assert.Equal(t, float32(20), reservoir.LatestWaterQuality(WaterQualityTemperature).Value)
+	assert.Nil(t, reservoir.LatestWaterQuality(WaterQualityPH))
+
+	// When
+	errParameter := reservoir.MeasureWaterQuality("DO", 8, "", ReservoirLevelSourceManual, date)
+	errUnit := reservoir.MeasureWaterQuality(WaterQualityPH, 6, WaterQualityUnitPPM, ReservoirLevelSourceManual, date)
+	errPH := reservoir.MeasureWaterQuality(WaterQualityPH, 15, "", ReservoirLevelSourceManual, date)
+	errSource := reservoir.MeasureWaterQuality(WaterQualityPH, 6, "", "SENSOR", date)
+
+	// Then
+	assert.Equal(t, ReservoirError{ReservoirErrorWaterQualityParameterInvalidCode}, errParameter)
+	assert.Equal(t, ReservoirError{ReservoirErrorWaterQualityUnitInvalidCode}, errUnit)
+	assert.Equal(t, ReservoirError{ReservoirErrorPHInvalidCode}, errPH)
+	assert.Equal(t, ReservoirError{ReservoirErrorWaterQualitySourceInvalidCode}, errSource)
+}
+
+func TestWaterQualityRange(t *testing.T) {
+	t.Parallel()
+	// Given
+	farmUID, _ := uuid.NewV4()
+	serviceMock := mockReservoirService(farmUID, "My Farm")
+
+	reservoir, _ := CreateReservoir(serviceMock, farmUID, "My Bucket", BucketType, float32(100))
+	date := time.Date(2019, time.March, 1, 8, 0, 0, 0, time.UTC)
+	minPH, maxPH := float32(5.5), float32(6.5)
+
+	// When
+	err := reservoir.ChangeWaterQualityRange(WaterQualityPH, &minPH, &maxPH)
+	reservoir.MeasureWaterQuality(WaterQualityPH, 6, "", ReservoirLevelSourceManual, date)
+
+	// Then
+	assert.Nil(t, err)
+	assert.False(t, reservoir.IsWaterQualityOutOfRange(WaterQualityPH))
+
+	// When
+	reservoir.MeasureWaterQuality(WaterQualityPH, 7.2, "", ReservoirLevelSourceManual, date.Add(time.Hour))
+
+	// Then
+	assert.True(t, reservoir.IsWaterQualityOutOfRange(WaterQualityPH))
+
+	// When
+	err = reservoir.ChangeWaterQualityRange(WaterQualityPH, nil, nil)
+
+	// Then
+	assert.Nil(t, err)
+	assert.False(t, reservoir.IsWaterQualityOutOfRange(WaterQualityPH))
+
+	// When
+	err = reservoir.ChangeWaterQualityRange(WaterQualityPH, &maxPH, &minPH)
+
+	// Then
+	assert.Equal(t, ReservoirError{ReservoirErrorWaterQualityRangeInvalidCode}, err)
+}
+
+func TestAggregateWaterQualityDaily(t *testing.T) {
+	t.Parallel()
+	// Given
+	farmUID, _ := uuid.NewV4()
+	serviceMock := mockReservoirService(farmUID, "My Farm")
+
+	reservoir, _ := CreateReservoir(serviceMock, farmUID, "My Bucket", BucketType, float32(100))
+	day := time.Date(2019, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	reservoir.MeasureWaterQuality(WaterQualityPH, 6.4, "", ReservoirLevelSourceManual, day.Add(26*time.Hour))
+	reservoir.MeasureWaterQuality(WaterQualityPH, 6, "", ReservoirLevelSourceManual, day.Add(8*time.Hour))
+	reservoir.MeasureWaterQuality(WaterQualityPH, 5, "", ReservoirLevelSourceManual, day.Add(20*time.Hour))
+	reservoir.MeasureWaterQuality(WaterQualityEC, 2, "", ReservoirLevelSourceManual, day.Add(9*time.Hour))
+
+	// When
+	aggregates := AggregateWaterQualityDaily(reservoir.WaterQuality[:3])
+
+	// Then
+	assert.Len(t, aggregates, 2)
+	assert.Equal(t, day, aggregates[0].Date)
+	assert.Equal(t, float32(5), aggregates[0].Min)
+	assert.Equal(t, float32(6), aggregates[0].Max)
+	assert.Equal(t, float32(5.5), aggregates[0].Avg)
+	assert.Equal(t, 2, aggregates[0].Count)
+	assert.Equal(t, day.AddDate(0, 0, 1), aggregates[1].Date)
+	assert.Equal(t, float32(6.4), aggregates[1].Avg)
+}
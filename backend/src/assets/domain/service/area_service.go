@@ -28,13 +28,14 @@ func (s AreaServiceInMemory) FindFarmByID(uid uuid.UUID) (domain.AreaFarmService
 		return domain.AreaFarmServiceResult{}, domain.AreaError{Code: domain.AreaErrorFarmNotFound}
 	}
 
-	if farm == (storage.FarmRead{}) {
+	if farm.UID == (uuid.UUID{}) {
 		return domain.AreaFarmServiceResult{}, domain.AreaError{Code: domain.AreaErrorFarmNotFound}
 	}
 
 	return domain.AreaFarmServiceResult{
-		UID:  farm.UID,
-		Name: farm.Name,
+		UID:        farm.UID,
+		Name:       farm.Name,
+		IsArchived: !farm.IsActive,
 	}, nil
 }
 
@@ -74,3 +75,17 @@ func (s AreaServiceInMemory) CountCropsByAreaID(areaUID uuid.UUID) (int, error)
 
 	return totals.TotalCropBatch, nil
 }
+
+func (s AreaServiceInMemory) CountPlantsByAreaID(areaUID uuid.UUID) (int, error) {
+	result := <-s.CropReadQuery.CountCropsByArea(areaUID)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	totals, ok := result.Result.(query.CountAreaCropResult)
+	if !ok {
+		return 0, errors.New("internal server error")
+	}
+
+	return totals.PlantQuantity, nil
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/assets/domain"
+	"github.com/usetania/tania-core/src/assets/query"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+type EquipmentServiceInMemory struct {
+	FarmReadQuery      query.FarmRead
+	AreaReadQuery      query.AreaRead
+	ReservoirReadQuery query.ReservoirRead
+}
+
+func (s EquipmentServiceInMemory) FindFarmByID(uid uuid.UUID) (domain.EquipmentFarmServiceResult, error) {
+	result := <-s.FarmReadQuery.FindByID(uid)
+
+	if result.Error != nil {
+		return domain.EquipmentFarmServiceResult{}, result.Error
+	}
+
+	farm, ok := result.Result.(storage.FarmRead)
+
+	if !ok || farm.UID == (uuid.UUID{}) {
+		return domain.EquipmentFarmServiceResult{}, domain.EquipmentError{Code: domain.EquipmentErrorFarmNotFoundCode}
+	}
+
+	return domain.EquipmentFarmServiceResult{
+		UID:  farm.UID,
+		Name: farm.Name,
+	}, nil
+}
+
+func (s EquipmentServiceInMemory) FindAreaByID(uid uuid.UUID) (domain.EquipmentLocationServiceResult, error) {
+	result := <-s.AreaReadQuery.FindByID(uid)
+
+	if result.Error != nil {
+		return domain.EquipmentLocationServiceResult{}, result.Error
+	}
+
+	area, ok := result.Result.(storage.AreaRead)
+
+	if !ok || area.UID == (uuid.UUID{}) {
+		return domain.EquipmentLocationServiceResult{}, domain.EquipmentError{
+			Code: domain.EquipmentErrorLocationNotFoundCode,
+		}
+	}
+
+	return domain.EquipmentLocationServiceResult{
+		UID:     area.UID,
+		Name:    area.Name,
+		FarmUID: area.Farm.UID,
+	}, nil
+}
+
+func (s EquipmentServiceInMemory) FindReservoirByID(uid uuid.UUID) (domain.EquipmentLocationServiceResult, error) {
+	result := <-s.ReservoirReadQuery.FindByID(uid)
+
+	if result.Error != nil {
+		return domain.EquipmentLocationServiceResult{}, result.Error
+	}
+
+	reservoir, ok := result.Result.(storage.ReservoirRead)
+
+	if !ok || reservoir.UID == (uuid.UUID{}) {
+		return domain.EquipmentLocationServiceResult{}, domain.EquipmentError{
+			Code: domain.EquipmentErrorLocationNotFoundCode,
+		}
+	}
+
+	return domain.EquipmentLocationServiceResult{
+		UID:     reservoir.UID,
+		Name:    reservoir.Name,
+		FarmUID: reservoir.Farm.UID,
+	}, nil
+}
@@ -8,7 +8,8 @@ import (
 )
 
 type ReservoirServiceInMemory struct {
-	FarmReadQuery query.FarmRead
+	FarmReadQuery     query.FarmRead
+	MaterialReadQuery query.MaterialRead
 }
 
 func (s ReservoirServiceInMemory) FindFarmByID(uid uuid.UUID) (domain.ReservoirFarmServiceResult, error) {
@@ -24,7 +25,7 @@ func (s ReservoirServiceInMemory) FindFarmByID(uid uuid.UUID) (domain.ReservoirF
 		return domain.ReservoirFarmServiceResult{}, domain.ReservoirError{Code: domain.ReservoirErrorFarmNotFound}
 	}
 
-	if farm == (storage.FarmRead{}) {
+	if farm.UID == (uuid.UUID{}) {
 		return domain.ReservoirFarmServiceResult{}, domain.ReservoirError{Code: domain.ReservoirErrorFarmNotFound}
 	}
 
@@ -33,3 +34,26 @@ func (s ReservoirServiceInMemory) FindFarmByID(uid uuid.UUID) (domain.ReservoirF
 		Name: farm.Name,
 	}, nil
 }
+
+func (s ReservoirServiceInMemory) FindMaterialByID(uid uuid.UUID) (domain.ReservoirMaterialServiceResult, error) {
+	result := <-s.MaterialReadQuery.FindByID(uid)
+
+	if result.Error != nil {
+		return domain.ReservoirMaterialServiceResult{}, result.Error
+	}
+
+	material, ok := result.Result.(storage.MaterialRead)
+
+	if !ok || material.UID == (uuid.UUID{}) {
+		return domain.ReservoirMaterialServiceResult{}, domain.ReservoirError{
+			Code: domain.ReservoirErrorNutrientMaterialNotFoundCode,
+		}
+	}
+
+	return domain.ReservoirMaterialServiceResult{
+		UID:          material.UID,
+		Name:         material.Name,
+		Type:         material.Type,
+		QuantityUnit: material.Quantity.Unit.Code,
+	}, nil
+}
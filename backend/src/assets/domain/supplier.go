@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// Supplier is a seller the farm buys its materials from.
+type Supplier struct {
+	UID         uuid.UUID
+	Name        string
+	Phone       string
+	Email       string
+	Address     string
+	CreatedDate time.Time
+
+	// Events
+	Version            int
+	UncommittedChanges []interface{}
+}
+
+func (s *Supplier) TrackChange(event interface{}) {
+	s.UncommittedChanges = append(s.UncommittedChanges, event)
+	s.Transition(event)
+}
+
+func (s *Supplier) Transition(event interface{}) {
+	switch e := event.(type) {
+	case SupplierCreated:
+		s.UID = e.UID
+		s.Name = e.Name
+		s.Phone = e.Phone
+		s.Email = e.Email
+		s.Address = e.Address
+		s.CreatedDate = e.CreatedDate
+
+	case SupplierNameChanged:
+		s.Name = e.Name
+
+	case SupplierContactChanged:
+		s.Phone = e.Phone
+		s.Email = e.Email
+		s.Address = e.Address
+	}
+}
+
+// CreateSupplier registers a new Supplier.
+func CreateSupplier(name, phone, email, address string) (*Supplier, error) {
+	err := validateSupplierName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateSupplierEmail(email)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	initial := &Supplier{}
+
+	initial.TrackChange(SupplierCreated{
+		UID:         uid,
+		Name:        name,
+		Phone:       phone,
+		Email:       email,
+		Address:     address,
+		CreatedDate: time.Now(),
+	})
+
+	return initial, nil
+}
+
+// ChangeName is used to change Supplier Name.
+func (s *Supplier) ChangeName(name string) error {
+	err := validateSupplierName(name)
+	if err != nil {
+		return err
+	}
+
+	s.TrackChange(SupplierNameChanged{
+		SupplierUID: s.UID,
+		Name:        name,
+	})
+
+	return nil
+}
+
+// ChangeContact replaces the phone, email and address of the Supplier.
+func (s *Supplier) ChangeContact(phone, email, address string) error {
+	err := validateSupplierEmail(email)
+	if err != nil {
+		return err
+	}
+
+	s.TrackChange(SupplierContactChanged{
+		SupplierUID: s.UID,
+		Phone:       phone,
+		Email:       email,
+		Address:     address,
+	})
+
+	return nil
+}
+
+func validateSupplierName(name string) error {
+	if name == "" {
+		return SupplierError{SupplierErrorNameEmptyCode}
+	}
+
+	if len(name) > 100 {
+		return SupplierError{SupplierErrorNameExceedMaximunCharacterCode}
+	}
+
+	return nil
+}
+
+func validateSupplierEmail(email string) error {
+	if email != "" && !strings.Contains(email, "@") {
+		return SupplierError{SupplierErrorInvalidEmailCode}
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package domain
+
+const (
+	SupplierErrorNameEmptyCode = iota
+	SupplierErrorNameExceedMaximunCharacterCode
+	SupplierErrorInvalidEmailCode
+)
+
+// SupplierError is a custom error from Go built-in error.
+type SupplierError struct {
+	Code int
+}
+
+func (e SupplierError) Error() string {
+	switch e.Code {
+	case SupplierErrorNameEmptyCode:
+		return "Supplier name is required."
+	case SupplierErrorNameExceedMaximunCharacterCode:
+		return "Supplier name cannot more than 100 characters"
+	case SupplierErrorInvalidEmailCode:
+		return "Supplier email is invalid."
+	default:
+		return "Unrecognized Supplier Error Code"
+	}
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+type SupplierCreated struct {
+	UID         uuid.UUID
+	Name        string
+	Phone       string
+	Email       string
+	Address     string
+	CreatedDate time.Time
+}
+
+type SupplierNameChanged struct {
+	SupplierUID uuid.UUID
+	Name        string
+}
+
+type SupplierContactChanged struct {
+	SupplierUID uuid.UUID
+	Phone       string
+	Email       string
+	Address     string
+}
@@ -0,0 +1,35 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	. "github.com/usetania/tania-core/src/assets/domain"
+)
+
+func TestCreateSupplier(t *testing.T) {
+	t.Parallel()
+	// Given
+	// When
+	supplier, err := CreateSupplier("Benih Nusantara", "+62 21 555 0101", "sales@benih.example", "Jakarta")
+	_, errName := CreateSupplier("", "", "", "")
+	_, errEmail := CreateSupplier("Benih Nusantara", "", "sales", "")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "Benih Nusantara", supplier.Name)
+	assert.Equal(t, "sales@benih.example", supplier.Email)
+	assert.Equal(t, SupplierError{SupplierErrorNameEmptyCode}, errName)
+	assert.Equal(t, SupplierError{SupplierErrorInvalidEmailCode}, errEmail)
+
+	// When
+	errChangeName := supplier.ChangeName("Benih Jaya")
+	errChangeContact := supplier.ChangeContact("", "orders@benih.example", "Bogor")
+
+	// Then
+	assert.Nil(t, errChangeName)
+	assert.Nil(t, errChangeContact)
+	assert.Equal(t, "Benih Jaya", supplier.Name)
+	assert.Equal(t, "Bogor", supplier.Address)
+	assert.Len(t, supplier.UncommittedChanges, 3)
+}
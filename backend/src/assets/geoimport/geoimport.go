@@ -0,0 +1,106 @@
+// Package geoimport reads polygon features from the survey files farms already have,
+// so their fields can be imported as areas. It supports GeoJSON, KML and zipped Shapefile.
+// Coordinates are expected in WGS 84 longitude and latitude.
+package geoimport
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	FormatGeoJSON   = "GEOJSON"
+	FormatKML       = "KML"
+	FormatShapefile = "SHAPEFILE"
+)
+
+var (
+	ErrUnsupportedFormat  = errors.New("unsupported file format, use GeoJSON, KML or zipped Shapefile")
+	ErrNoPolygon          = errors.New("file has no polygon features")
+	ErrProjectedShapefile = errors.New(
+		"shapefile uses a projected coordinate system, export it in WGS 84 longitude and latitude",
+	)
+)
+
+// Feature is a single polygon with the properties of the feature it comes from.
+// Features with several polygons are split, and share the same properties.
+type Feature struct {
+	Properties map[string]string
+	// Polygon are the rings of the polygon, the first ring is the exterior.
+	Polygon [][][]float64
+}
+
+// DetectFormat finds the file format from its file name.
+func DetectFormat(filename string) (string, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".geojson", ".json":
+		return FormatGeoJSON, nil
+	case ".kml":
+		return FormatKML, nil
+	case ".zip":
+		return FormatShapefile, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// Parse reads the polygon features of a file. Other geometries, like points and lines, are skipped.
+func Parse(filename string, data []byte) ([]Feature, error) {
+	format, err := DetectFormat(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var features []Feature
+
+	switch format {
+	case FormatGeoJSON:
+		features, err = parseGeoJSON(data)
+	case FormatKML:
+		features, err = parseKML(data)
+	case FormatShapefile:
+		features, err = parseShapefile(data)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s file: %w", strings.ToLower(format), err)
+	}
+
+	if len(features) == 0 {
+		return nil, ErrNoPolygon
+	}
+
+	return features, nil
+}
+
+// Property returns the first non empty property among the keys. Keys are case insensitive.
+func (f Feature) Property(keys ...string) string {
+	for _, key := range keys {
+		for k, v := range f.Properties {
+			if strings.EqualFold(k, key) && strings.TrimSpace(v) != "" {
+				return strings.TrimSpace(v)
+			}
+		}
+	}
+
+	return ""
+}
+
+func splitMultiPolygon(properties map[string]string, polygons [][][][]float64) []Feature {
+	features := []Feature{}
+
+	for _, v := range polygons {
+		if len(v) == 0 {
+			continue
+		}
+
+		features = append(features, Feature{
+			Properties: properties,
+			Polygon:    v,
+		})
+	}
+
+	return features
+}
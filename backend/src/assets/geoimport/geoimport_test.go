@@ -0,0 +1,250 @@
+package geoimport_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/usetania/tania-core/src/assets/geoimport"
+)
+
+func TestParseGeoJSON(t *testing.T) {
+	t.Parallel()
+	// Given
+	data := []byte(`{"type":"FeatureCollection","features":[
+		{"type":"Feature","properties":{"name":"North Field","hectares":1.2},
+		 "geometry":{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}},
+		{"type":"Feature","properties":{"name":"Twin Fields"},
+		 "geometry":{"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[1,1],[0,0]]],[[[2,2],[3,2],[3,3],[2,2]]]]}},
+		{"type":"Feature","properties":{"name":"Well"},"geometry":{"type":"Point","coordinates":[0,0]}}
+	]}`)
+
+	// When
+	features, err := geoimport.Parse("survey.geojson", data)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Len(t, features, 3)
+	assert.Equal(t, "North Field", features[0].Property("NAME"))
+	assert.Equal(t, "1.2", features[0].Property("hectares"))
+	assert.Equal(t, "Twin Fields", features[2].Property("name"))
+	assert.Equal(t, []float64{2, 2}, features[2].Polygon[0][0])
+}
+
+func TestParseKML(t *testing.T) {
+	t.Parallel()
+	// Given
+	data := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<kml xmlns="http://www.opengis.net/kml/2.2"><Document><Folder>
+	<Placemark>
+		<name>South Field</name>
+		<ExtendedData><Data name="crop"><value>Rice</value></Data></ExtendedData>
+		<Polygon>
+			<outerBoundaryIs><LinearRing><coordinates>
+				110.36,-7.8,0 110.37,-7.8,0 110.37,-7.79,0 110.36,-7.8,0
+			</coordinates></LinearRing></outerBoundaryIs>
+			<innerBoundaryIs><LinearRing><coordinates>
+				110.365,-7.795 110.366,-7.795 110.366,-7.794 110.365,-7.795
+			</coordinates></LinearRing></innerBoundaryIs>
+		</Polygon>
+	</Placemark>
+	<Placemark><name>Gate</name><Point><coordinates>110.36,-7.8</coordinates></Point></Placemark>
+</Folder></Document></kml>`)
+
+	// When
+	features, err := geoimport.Parse("survey.kml", data)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Len(t, features, 1)
+	assert.Equal(t, "South Field", features[0].Property("name"))
+	assert.Equal(t, "Rice", features[0].Property("crop"))
+	assert.Len(t, features[0].Polygon, 2)
+	assert.Equal(t, []float64{110.37, -7.8}, features[0].Polygon[0][1])
+}
+
+func TestParseShapefile(t *testing.T) {
+	t.Parallel()
+	// Given
+	// Clockwise exterior with a counterclockwise hole.
+	rings := [][][]float64{
+		{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}},
+		{{0.2, 0.2}, {0.4, 0.2}, {0.4, 0.4}, {0.2, 0.2}},
+	}
+
+	data := zipShapefile(t, map[string][]byte{
+		"fields/fields.shp": shpPolygon(rings),
+		"fields/fields.dbf": dbfNames("East Field"),
+		"fields/fields.prj": []byte(`GEOGCS["GCS_WGS_1984",DATUM["D_WGS_1984"]]`),
+	})
+
+	projected := zipShapefile(t, map[string][]byte{
+		"fields.shp": shpPolygon(rings),
+		"fields.prj": []byte(`PROJCS["WGS_1984_UTM_Zone_49S"]`),
+	})
+
+	// When
+	features, err := geoimport.Parse("fields.zip", data)
+	_, errProjected := geoimport.Parse("fields.zip", projected)
+	_, errFormat := geoimport.Parse("fields.dxf", data)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Len(t, features, 1)
+	assert.Equal(t, "East Field", features[0].Property("name"))
+	assert.Len(t, features[0].Polygon, 2)
+	assert.ErrorIs(t, errProjected, geoimport.ErrProjectedShapefile)
+	assert.ErrorIs(t, errFormat, geoimport.ErrUnsupportedFormat)
+}
+
+func TestParseShapefileInvalidDbf(t *testing.T) {
+	t.Parallel()
+	// Given
+	rings := [][][]float64{{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}}
+	dbf := dbfNames("East Field")
+
+	zeroRecordLength := dbfNames("East Field")
+	binary.LittleEndian.PutUint16(zeroRecordLength[10:], 0)
+
+	shortRecordLength := dbfNames("East Field")
+	binary.LittleEndian.PutUint16(shortRecordLength[10:], 5)
+
+	longHeader := dbfNames("East Field")
+	binary.LittleEndian.PutUint16(longHeader[8:], uint16(len(longHeader)+1))
+
+	invalid := [][]byte{{}, zeroRecordLength, shortRecordLength, longHeader}
+
+	// Every truncation of the dbf file.
+	for i := 0; i < len(dbf); i++ {
+		invalid = append(invalid, dbf[:i])
+	}
+
+	for _, v := range invalid {
+		data := zipShapefile(t, map[string][]byte{
+			"fields.shp": shpPolygon(rings),
+			"fields.dbf": v,
+		})
+
+		// When
+		features, err := geoimport.Parse("fields.zip", data)
+
+		// Then
+		assert.NotNil(t, err)
+		assert.Nil(t, features)
+	}
+}
+
+func FuzzParseShapefileDbf(f *testing.F) {
+	rings := [][][]float64{{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}}
+	dbf := dbfNames("East Field", "West Field")
+
+	f.Add(dbf)
+	f.Add(dbf[:40])
+	f.Add([]byte{})
+
+	f.Fuzz(func(t *testing.T, dbf []byte) {
+		data := zipShapefile(t, map[string][]byte{
+			"fields.shp": shpPolygon(rings),
+			"fields.dbf": dbf,
+		})
+
+		// Parsing must not panic, the file is either read or rejected.
+		_, _ = geoimport.Parse("fields.zip", data)
+	})
+}
+
+func zipShapefile(t *testing.T, files map[string][]byte) []byte {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	w := zip.NewWriter(buf)
+
+	for name, content := range files {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = f.Write(content)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err := w.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+// shpPolygon writes a shp file with a single polygon record.
+func shpPolygon(rings [][][]float64) []byte {
+	content := &bytes.Buffer{}
+	numPoints := 0
+
+	for _, v := range rings {
+		numPoints += len(v)
+	}
+
+	_ = binary.Write(content, binary.LittleEndian, int32(5))
+	_ = binary.Write(content, binary.LittleEndian, [4]float64{})
+	_ = binary.Write(content, binary.LittleEndian, int32(len(rings)))
+	_ = binary.Write(content, binary.LittleEndian, int32(numPoints))
+
+	start := 0
+
+	for _, v := range rings {
+		_ = binary.Write(content, binary.LittleEndian, int32(start))
+		start += len(v)
+	}
+
+	for _, ring := range rings {
+		for _, p := range ring {
+			_ = binary.Write(content, binary.LittleEndian, math.Float64bits(p[0]))
+			_ = binary.Write(content, binary.LittleEndian, math.Float64bits(p[1]))
+		}
+	}
+
+	header := make([]byte, 100)
+	binary.BigEndian.PutUint32(header[0:], 9994)
+	binary.BigEndian.PutUint32(header[24:], uint32((100+8+content.Len())/2))
+	binary.LittleEndian.PutUint32(header[28:], 1000)
+	binary.LittleEndian.PutUint32(header[32:], 5)
+
+	record := make([]byte, 8)
+	binary.BigEndian.PutUint32(record[0:], 1)
+	binary.BigEndian.PutUint32(record[4:], uint32(content.Len()/2))
+
+	return append(append(header, record...), content.Bytes()...)
+}
+
+// dbfNames writes a dbf file with a NAME character field.
+func dbfNames(names ...string) []byte {
+	const fieldLength = 20
+
+	header := make([]byte, 32)
+	header[0] = 3
+	binary.LittleEndian.PutUint32(header[4:], uint32(len(names)))
+	binary.LittleEndian.PutUint16(header[8:], 32+32+1)
+	binary.LittleEndian.PutUint16(header[10:], 1+fieldLength)
+
+	field := make([]byte, 32)
+	copy(field, "NAME")
+	field[11] = 'C'
+	field[16] = fieldLength
+
+	data := append(append(header, field...), 0x0D)
+
+	for _, v := range names {
+		record := bytes.Repeat([]byte(" "), 1+fieldLength)
+		copy(record[1:], v)
+		data = append(data, record...)
+	}
+
+	return data
+}
@@ -0,0 +1,108 @@
+package geoimport
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type geoJSONObject struct {
+	Type        string                 `json:"type"`
+	Features    []geoJSONObject        `json:"features"`
+	Geometry    *geoJSONObject         `json:"geometry"`
+	Geometries  []geoJSONObject        `json:"geometries"`
+	Properties  map[string]interface{} `json:"properties"`
+	Coordinates json.RawMessage        `json:"coordinates"`
+}
+
+func parseGeoJSON(data []byte) ([]Feature, error) {
+	root := geoJSONObject{}
+
+	err := json.Unmarshal(data, &root)
+	if err != nil {
+		return nil, err
+	}
+
+	switch root.Type {
+	case "FeatureCollection":
+		features := []Feature{}
+
+		for _, v := range root.Features {
+			f, err := geoJSONFeature(v)
+			if err != nil {
+				return nil, err
+			}
+
+			features = append(features, f...)
+		}
+
+		return features, nil
+
+	case "Feature":
+		return geoJSONFeature(root)
+
+	default:
+		return geoJSONFeature(geoJSONObject{Type: "Feature", Geometry: &root})
+	}
+}
+
+func geoJSONFeature(feature geoJSONObject) ([]Feature, error) {
+	properties := map[string]string{}
+
+	for k, v := range feature.Properties {
+		if v != nil {
+			properties[k] = fmt.Sprint(v)
+		}
+	}
+
+	if feature.Geometry == nil {
+		return nil, nil
+	}
+
+	polygons, err := geoJSONPolygons(*feature.Geometry)
+	if err != nil {
+		return nil, err
+	}
+
+	return splitMultiPolygon(properties, polygons), nil
+}
+
+func geoJSONPolygons(geometry geoJSONObject) ([][][][]float64, error) {
+	switch geometry.Type {
+	case "Polygon":
+		polygon := [][][]float64{}
+
+		err := json.Unmarshal(geometry.Coordinates, &polygon)
+		if err != nil {
+			return nil, err
+		}
+
+		return [][][][]float64{polygon}, nil
+
+	case "MultiPolygon":
+		polygons := [][][][]float64{}
+
+		err := json.Unmarshal(geometry.Coordinates, &polygons)
+		if err != nil {
+			return nil, err
+		}
+
+		return polygons, nil
+
+	case "GeometryCollection":
+		polygons := [][][][]float64{}
+
+		for _, v := range geometry.Geometries {
+			p, err := geoJSONPolygons(v)
+			if err != nil {
+				return nil, err
+			}
+
+			polygons = append(polygons, p...)
+		}
+
+		return polygons, nil
+
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,134 @@
+package geoimport
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+type kmlPlacemark struct {
+	Name         string       `xml:"name"`
+	Description  string       `xml:"description"`
+	Data         []kmlData    `xml:"ExtendedData>Data"`
+	SchemaData   []kmlData    `xml:"ExtendedData>SchemaData>SimpleData"`
+	Polygons     []kmlPolygon `xml:"Polygon"`
+	MultiPolygon []kmlPolygon `xml:"MultiGeometry>Polygon"`
+}
+
+type kmlData struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value"`
+	Text  string `xml:",chardata"`
+}
+
+type kmlPolygon struct {
+	Outer  string   `xml:"outerBoundaryIs>LinearRing>coordinates"`
+	Inners []string `xml:"innerBoundaryIs>LinearRing>coordinates"`
+}
+
+// parseKML reads the polygons of every placemark, wherever they are in the document and folders.
+func parseKML(data []byte) ([]Feature, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	features := []Feature{}
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "Placemark" {
+			continue
+		}
+
+		placemark := kmlPlacemark{}
+
+		err = decoder.DecodeElement(&placemark, &start)
+		if err != nil {
+			return nil, err
+		}
+
+		properties := map[string]string{}
+
+		if placemark.Name != "" {
+			properties["name"] = strings.TrimSpace(placemark.Name)
+		}
+
+		if placemark.Description != "" {
+			properties["description"] = strings.TrimSpace(placemark.Description)
+		}
+
+		for _, v := range append(placemark.Data, placemark.SchemaData...) {
+			value := v.Value
+			if value == "" {
+				value = v.Text
+			}
+
+			properties[v.Name] = strings.TrimSpace(value)
+		}
+
+		polygons := [][][][]float64{}
+
+		for _, v := range append(placemark.Polygons, placemark.MultiPolygon...) {
+			polygon, err := kmlRings(v)
+			if err != nil {
+				return nil, err
+			}
+
+			polygons = append(polygons, polygon)
+		}
+
+		features = append(features, splitMultiPolygon(properties, polygons)...)
+	}
+
+	return features, nil
+}
+
+func kmlRings(polygon kmlPolygon) ([][][]float64, error) {
+	rings := [][][]float64{}
+
+	for _, v := range append([]string{polygon.Outer}, polygon.Inners...) {
+		ring, err := kmlCoordinates(v)
+		if err != nil {
+			return nil, err
+		}
+
+		rings = append(rings, ring)
+	}
+
+	return rings, nil
+}
+
+// kmlCoordinates reads the `longitude,latitude[,altitude]` tuples separated by whitespaces.
+func kmlCoordinates(coordinates string) ([][]float64, error) {
+	ring := [][]float64{}
+
+	for _, tuple := range strings.Fields(coordinates) {
+		values := strings.Split(tuple, ",")
+		if len(values) < 2 {
+			return nil, errors.New("invalid kml coordinates " + tuple)
+		}
+
+		longitude, err := strconv.ParseFloat(values[0], 64)
+		if err != nil {
+			return nil, err
+		}
+
+		latitude, err := strconv.ParseFloat(values[1], 64)
+		if err != nil {
+			return nil, err
+		}
+
+		ring = append(ring, []float64{longitude, latitude})
+	}
+
+	return ring, nil
+}
@@ -0,0 +1,286 @@
+package geoimport
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"path/filepath"
+	"strings"
+
+	"github.com/usetania/tania-core/src/helper/geohelper"
+)
+
+const (
+	shpFileCode   = 9994
+	shpHeaderSize = 100
+
+	shpNull     = 0
+	shpPolygon  = 5
+	shpPolygonZ = 15
+	shpPolygonM = 25
+
+	dbfHeaderTerminator = 0x0D
+	dbfDeletedRecord    = '*'
+	dbfFieldSize        = 32
+
+	// maxShapefileEntrySize protects against zip bombs.
+	maxShapefileEntrySize = 64 << 20
+)
+
+// parseShapefile reads the first polygon shapefile of a zip archive, and its attributes from the dbf file.
+func parseShapefile(data []byte) ([]Feature, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]*zip.File{}
+
+	for _, f := range archive.File {
+		files[strings.ToLower(f.Name)] = f
+	}
+
+	for _, f := range archive.File {
+		name := strings.ToLower(f.Name)
+		if filepath.Ext(name) != ".shp" {
+			continue
+		}
+
+		base := strings.TrimSuffix(name, ".shp")
+
+		if prj, ok := files[base+".prj"]; ok {
+			wkt, err := readZipFile(prj)
+			if err != nil {
+				return nil, err
+			}
+
+			if strings.HasPrefix(strings.TrimSpace(strings.ToUpper(string(wkt))), "PROJCS") {
+				return nil, ErrProjectedShapefile
+			}
+		}
+
+		shp, err := readZipFile(f)
+		if err != nil {
+			return nil, err
+		}
+
+		polygons, err := readShp(shp)
+		if err != nil {
+			return nil, err
+		}
+
+		attributes := make([]map[string]string, len(polygons))
+
+		if dbf, ok := files[base+".dbf"]; ok {
+			b, err := readZipFile(dbf)
+			if err != nil {
+				return nil, err
+			}
+
+			records, err := readDbf(b)
+			if err != nil {
+				return nil, err
+			}
+
+			copy(attributes, records)
+		}
+
+		features := []Feature{}
+
+		for i, v := range polygons {
+			features = append(features, splitMultiPolygon(attributes[i], v)...)
+		}
+
+		return features, nil
+	}
+
+	return nil, errors.New("zip file has no .shp file")
+}
+
+func readZipFile(f *zip.File) ([]byte, error) {
+	r, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return io.ReadAll(io.LimitReader(r, maxShapefileEntrySize))
+}
+
+// readShp returns the polygons of each record. Null shapes have no polygon,
+// so the records stay aligned with the dbf records.
+func readShp(data []byte) ([][][][][]float64, error) {
+	if len(data) < shpHeaderSize || binary.BigEndian.Uint32(data[0:4]) != shpFileCode {
+		return nil, errors.New("invalid shp file")
+	}
+
+	shapeType := binary.LittleEndian.Uint32(data[32:36])
+	if shapeType != shpPolygon && shapeType != shpPolygonZ && shapeType != shpPolygonM {
+		return nil, errors.New("shapefile has no polygons")
+	}
+
+	records := [][][][][]float64{}
+	offset := shpHeaderSize
+
+	for offset+8 <= len(data) {
+		// The content length is counted in 16 bit words.
+		length := int(binary.BigEndian.Uint32(data[offset+4:offset+8])) * 2
+		offset += 8
+
+		if length < 4 || offset+length > len(data) {
+			return nil, errors.New("invalid shp record")
+		}
+
+		polygons, err := readShpPolygon(data[offset : offset+length])
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, polygons)
+		offset += length
+	}
+
+	return records, nil
+}
+
+func readShpPolygon(content []byte) ([][][][]float64, error) {
+	if binary.LittleEndian.Uint32(content[0:4]) == shpNull {
+		return nil, nil
+	}
+
+	// Shape type, bounding box, number of parts and number of points.
+	if len(content) < 44 {
+		return nil, errors.New("invalid shp polygon")
+	}
+
+	numParts := int(binary.LittleEndian.Uint32(content[36:40]))
+	numPoints := int(binary.LittleEndian.Uint32(content[40:44]))
+	pointsOffset := 44 + numParts*4
+
+	if numParts < 1 || numPoints < 1 || len(content) < pointsOffset+numPoints*16 {
+		return nil, errors.New("invalid shp polygon")
+	}
+
+	rings := [][][]float64{}
+
+	for i := 0; i < numParts; i++ {
+		start := int(binary.LittleEndian.Uint32(content[44+i*4:]))
+		end := numPoints
+
+		if i+1 < numParts {
+			end = int(binary.LittleEndian.Uint32(content[44+(i+1)*4:]))
+		}
+
+		if start < 0 || end > numPoints || start >= end {
+			return nil, errors.New("invalid shp polygon part")
+		}
+
+		ring := [][]float64{}
+
+		for j := start; j < end; j++ {
+			p := pointsOffset + j*16
+			ring = append(ring, []float64{
+				math.Float64frombits(binary.LittleEndian.Uint64(content[p:])),
+				math.Float64frombits(binary.LittleEndian.Uint64(content[p+8:])),
+			})
+		}
+
+		rings = append(rings, ring)
+	}
+
+	return groupShpRings(rings), nil
+}
+
+// groupShpRings splits the rings into polygons. Shapefile exterior rings are clockwise
+// and holes are counterclockwise, holes are given to the exterior ring before them.
+func groupShpRings(rings [][][]float64) [][][][]float64 {
+	polygons := [][][][]float64{}
+
+	for _, ring := range rings {
+		isHole := geohelper.RingArea(ring) > 0
+
+		if isHole && len(polygons) > 0 {
+			polygons[len(polygons)-1] = append(polygons[len(polygons)-1], ring)
+
+			continue
+		}
+
+		polygons = append(polygons, [][][]float64{ring})
+	}
+
+	return polygons
+}
+
+func readDbf(data []byte) ([]map[string]string, error) {
+	if len(data) < dbfFieldSize+1 {
+		return nil, errors.New("invalid dbf file")
+	}
+
+	numRecords := int(binary.LittleEndian.Uint32(data[4:8]))
+	headerLength := int(binary.LittleEndian.Uint16(data[8:10]))
+	recordLength := int(binary.LittleEndian.Uint16(data[10:12]))
+
+	// The header holds the file header, the field descriptors and the terminator.
+	if headerLength < dbfFieldSize+1 || headerLength > len(data) {
+		return nil, errors.New("invalid dbf header")
+	}
+
+	type dbfField struct {
+		Name   string
+		Length int
+	}
+
+	fields := []dbfField{}
+	fieldsLength := 0
+
+	for offset := dbfFieldSize; offset < headerLength; offset += dbfFieldSize {
+		if data[offset] == dbfHeaderTerminator {
+			break
+		}
+
+		if offset+dbfFieldSize > headerLength {
+			return nil, errors.New("invalid dbf field")
+		}
+
+		field := dbfField{
+			Name:   strings.TrimRight(string(data[offset:offset+11]), "\x00 "),
+			Length: int(data[offset+16]),
+		}
+
+		fields = append(fields, field)
+		fieldsLength += field.Length
+	}
+
+	// Each record starts with its deletion flag, followed by the fields.
+	if recordLength < 1+fieldsLength {
+		return nil, errors.New("invalid dbf record length")
+	}
+
+	records := []map[string]string{}
+
+	for i := 0; i < numRecords; i++ {
+		offset := headerLength + i*recordLength
+		if offset+recordLength > len(data) {
+			return nil, errors.New("invalid dbf record")
+		}
+
+		record := map[string]string{}
+
+		// The deleted records keep their place.
+		if data[offset] != dbfDeletedRecord {
+			position := offset + 1
+
+			for _, f := range fields {
+				record[f.Name] = strings.TrimSpace(string(data[position : position+f.Length]))
+				position += f.Length
+			}
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
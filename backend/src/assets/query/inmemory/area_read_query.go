@@ -47,7 +47,7 @@ func (s AreaReadQueryInMemory) FindAllByFarm(farmUID uuid.UUID) <-chan query.Res
 		areas := []storage.AreaRead{}
 
 		for _, val := range s.Storage.AreaReadMap {
-			if val.Farm.UID == farmUID {
+			if val.Farm.UID == farmUID && val.RetiredDate == nil {
 				areas = append(areas, val)
 			}
 		}
@@ -93,7 +93,7 @@ func (s AreaReadQueryInMemory) FindAreasByReservoirID(reservoirUID uuid.UUID) <-
 		areas := []storage.AreaRead{}
 
 		for _, val := range s.Storage.AreaReadMap {
-			if val.Reservoir.UID == reservoirUID {
+			if val.Reservoir.UID == reservoirUID && val.RetiredDate == nil {
 				areas = append(areas, val)
 			}
 		}
@@ -116,7 +116,7 @@ func (s AreaReadQueryInMemory) CountAreas(farmUID uuid.UUID) <-chan query.Result
 		total := 0
 
 		for _, val := range s.Storage.AreaReadMap {
-			if val.Farm.UID == farmUID {
+			if val.Farm.UID == farmUID && val.RetiredDate == nil {
 				total++
 			}
 		}
@@ -116,3 +116,42 @@ func (q CropReadQueryInMemory) FindAllCropByArea(areaUID uuid.UUID) <-chan query
 
 	return result
 }
+
+func (q CropReadQueryInMemory) FindAllPlantsByArea(areaUID uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		q.Storage.Lock.RLock()
+		defer q.Storage.Lock.RUnlock()
+
+		plants := []query.AreaPlantResult{}
+
+		for _, val := range q.Storage.CropReadMap {
+			if val.InitialArea.AreaUID == areaUID {
+				plants = append(plants, query.AreaPlantResult{
+					PlantType:     val.Inventory.PlantType,
+					ContainerType: val.Container.Type,
+					ContainerCell: val.Container.Cell,
+					Quantity:      val.InitialArea.CurrentQuantity,
+				})
+			}
+
+			for _, v := range val.MovedArea {
+				if v.AreaUID == areaUID {
+					plants = append(plants, query.AreaPlantResult{
+						PlantType:     val.Inventory.PlantType,
+						ContainerType: val.Container.Type,
+						ContainerCell: val.Container.Cell,
+						Quantity:      v.CurrentQuantity,
+					})
+				}
+			}
+		}
+
+		result <- query.Result{Result: plants}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,44 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/assets/query"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+type EquipmentEventQueryInMemory struct {
+	Storage *storage.EquipmentEventStorage
+}
+
+func NewEquipmentEventQueryInMemory(s *storage.EquipmentEventStorage) query.EquipmentEvent {
+	return &EquipmentEventQueryInMemory{Storage: s}
+}
+
+func (f *EquipmentEventQueryInMemory) FindAllByID(uid uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		events := []storage.EquipmentEvent{}
+
+		for _, v := range f.Storage.EquipmentEvents {
+			if v.EquipmentUID == uid {
+				events = append(events, v)
+			}
+		}
+
+		sort.Slice(events, func(i, j int) bool {
+			return events[i].Version < events[j].Version
+		})
+
+		result <- query.Result{Result: events}
+
+		close(result)
+	}()
+
+	return result
+}
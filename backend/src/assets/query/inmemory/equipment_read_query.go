@@ -0,0 +1,59 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/assets/query"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+type EquipmentReadQueryInMemory struct {
+	Storage *storage.EquipmentReadStorage
+}
+
+func NewEquipmentReadQueryInMemory(s *storage.EquipmentReadStorage) query.EquipmentRead {
+	return EquipmentReadQueryInMemory{Storage: s}
+}
+
+func (q EquipmentReadQueryInMemory) FindByID(uid uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		q.Storage.Lock.RLock()
+		defer q.Storage.Lock.RUnlock()
+
+		result <- query.Result{Result: q.Storage.EquipmentReadMap[uid]}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (q EquipmentReadQueryInMemory) FindAllByFarm(farmUID uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		q.Storage.Lock.RLock()
+		defer q.Storage.Lock.RUnlock()
+
+		equipments := []storage.EquipmentRead{}
+
+		for _, val := range q.Storage.EquipmentReadMap {
+			if val.Farm.UID == farmUID {
+				equipments = append(equipments, val)
+			}
+		}
+
+		sort.Slice(equipments, func(i, j int) bool {
+			return equipments[i].Name < equipments[j].Name
+		})
+
+		result <- query.Result{Result: equipments}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,36 @@
+package inmemory
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/assets/domain"
+	"github.com/usetania/tania-core/src/assets/query"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+type MaterialTypeCatalogQueryInMemory struct {
+	Storage *storage.MaterialTypeCatalogStorage
+}
+
+func NewMaterialTypeCatalogQueryInMemory(s *storage.MaterialTypeCatalogStorage) query.MaterialTypeCatalog {
+	return MaterialTypeCatalogQueryInMemory{Storage: s}
+}
+
+func (q MaterialTypeCatalogQueryInMemory) FindByFarmID(farmUID uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		q.Storage.Lock.RLock()
+		defer q.Storage.Lock.RUnlock()
+
+		catalog, ok := q.Storage.CatalogsByFarmUID[farmUID]
+		if !ok {
+			catalog = domain.MaterialTypeCatalog{FarmUID: farmUID}
+		}
+
+		result <- query.Result{Result: catalog}
+
+		close(result)
+	}()
+
+	return result
+}
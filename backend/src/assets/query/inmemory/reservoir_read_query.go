@@ -1,6 +1,9 @@
 package inmemory
 
 import (
+	"sort"
+	"time"
+
 	"github.com/gofrs/uuid"
 	"github.com/usetania/tania-core/src/assets/query"
 	"github.com/usetania/tania-core/src/assets/storage"
@@ -59,3 +62,65 @@ func (s ReservoirReadQueryInMemory) FindAllByFarm(farmUID uuid.UUID) <-chan quer
 
 	return result
 }
+
+func (s ReservoirReadQueryInMemory) FindLevelsByReservoirID(reservoirUID uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		levels := append([]storage.ReservoirLevelEntry{}, s.Storage.LevelMap[reservoirUID]...)
+
+		sort.SliceStable(levels, func(i, j int) bool {
+			return levels[i].Date.Before(levels[j].Date)
+		})
+
+		result <- query.Result{Result: levels}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (s ReservoirReadQueryInMemory) FindWaterQualityByReservoirID(
+	reservoirUID uuid.UUID,
+	parameter string,
+	from, to time.Time,
+) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		measurements := []storage.ReservoirWaterQualityMeasurement{}
+
+		for _, val := range s.Storage.WaterQualityMap[reservoirUID] {
+			if parameter != "" && val.Parameter != parameter {
+				continue
+			}
+
+			if !from.IsZero() && val.MeasuredDate.Before(from) {
+				continue
+			}
+
+			if !to.IsZero() && !val.MeasuredDate.Before(to) {
+				continue
+			}
+
+			measurements = append(measurements, val)
+		}
+
+		sort.SliceStable(measurements, func(i, j int) bool {
+			return measurements[i].MeasuredDate.Before(measurements[j].MeasuredDate)
+		})
+
+		result <- query.Result{Result: measurements}
+
+		close(result)
+	}()
+
+	return result
+}
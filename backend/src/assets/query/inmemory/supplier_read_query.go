@@ -0,0 +1,56 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/assets/query"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+type SupplierReadQueryInMemory struct {
+	Storage *storage.SupplierReadStorage
+}
+
+func NewSupplierReadQueryInMemory(s *storage.SupplierReadStorage) query.SupplierRead {
+	return SupplierReadQueryInMemory{Storage: s}
+}
+
+func (q SupplierReadQueryInMemory) FindAll() <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		q.Storage.Lock.RLock()
+		defer q.Storage.Lock.RUnlock()
+
+		suppliers := []storage.SupplierRead{}
+		for _, val := range q.Storage.SupplierReadMap {
+			suppliers = append(suppliers, val)
+		}
+
+		sort.Slice(suppliers, func(i, j int) bool {
+			return suppliers[i].Name < suppliers[j].Name
+		})
+
+		result <- query.Result{Result: suppliers}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (q SupplierReadQueryInMemory) FindByID(uid uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		q.Storage.Lock.RLock()
+		defer q.Storage.Lock.RUnlock()
+
+		result <- query.Result{Result: q.Storage.SupplierReadMap[uid]}
+
+		close(result)
+	}()
+
+	return result
+}
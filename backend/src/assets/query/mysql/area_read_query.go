@@ -2,6 +2,7 @@ package mysql
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -135,6 +136,22 @@ func (s AreaReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.Result {
 			result <- query.Result{Error: domain.AreaError{Code: domain.AreaErrorInvalidAreaLocationCode}}
 		}
 
+		var retiredDate *time.Time
+
+		retired := sql.NullTime{}
+
+		err = s.DB.QueryRow("SELECT RETIRED_DATE FROM AREA_READ_RETIRED WHERE AREA_UID = ?", uid.Bytes()).Scan(&retired)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			result <- query.Result{Error: err}
+			close(result)
+
+			return
+		}
+
+		if retired.Valid {
+			retiredDate = &retired.Time
+		}
+
 		areaRead = storage.AreaRead{
 			UID:  areaUID,
 			Name: rowsData.Name,
@@ -161,6 +178,22 @@ func (s AreaReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.Result {
 				UID:  reservoirUID,
 				Name: rowsData.ReservoirName,
 			},
+			RetiredDate: retiredDate,
+		}
+
+		err = s.loadBoundary(&areaRead)
+		if err != nil {
+			result <- query.Result{Error: err}
+		}
+
+		err = s.loadCapacity(&areaRead)
+		if err != nil {
+			result <- query.Result{Error: err}
+		}
+
+		err = s.loadPhotos(&areaRead)
+		if err != nil {
+			result <- query.Result{Error: err}
 		}
 
 		result <- query.Result{Result: areaRead}
@@ -176,7 +209,8 @@ func (s AreaReadQueryMysql) FindAllByFarm(farmUID uuid.UUID) <-chan query.Result
 	go func() {
 		areaReads := []storage.AreaRead{}
 
-		rows, err := s.DB.Query("SELECT * FROM AREA_READ WHERE FARM_UID = ?", farmUID.Bytes())
+		rows, err := s.DB.Query(`SELECT * FROM AREA_READ WHERE FARM_UID = ?
+			AND UID NOT IN (SELECT AREA_UID FROM AREA_READ_RETIRED)`, farmUID.Bytes())
 		if err != nil {
 			result <- query.Result{Error: err}
 		}
@@ -286,6 +320,21 @@ func (s AreaReadQueryMysql) FindAllByFarm(farmUID uuid.UUID) <-chan query.Result
 					Name: rowsData.ReservoirName,
 				},
 			})
+
+			err = s.loadBoundary(&areaReads[len(areaReads)-1])
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			err = s.loadCapacity(&areaReads[len(areaReads)-1])
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			err = s.loadPhotos(&areaReads[len(areaReads)-1])
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
 		}
 
 		result <- query.Result{Result: areaReads}
@@ -413,6 +462,21 @@ func (s AreaReadQueryMysql) FindByIDAndFarm(areaUID, farmUID uuid.UUID) <-chan q
 			},
 		}
 
+		err = s.loadBoundary(&areaRead)
+		if err != nil {
+			result <- query.Result{Error: err}
+		}
+
+		err = s.loadCapacity(&areaRead)
+		if err != nil {
+			result <- query.Result{Error: err}
+		}
+
+		err = s.loadPhotos(&areaRead)
+		if err != nil {
+			result <- query.Result{Error: err}
+		}
+
 		result <- query.Result{Result: areaRead}
 		close(result)
 	}()
@@ -426,7 +490,8 @@ func (s AreaReadQueryMysql) FindAreasByReservoirID(reservoirUID uuid.UUID) <-cha
 	go func() {
 		areaReads := []storage.AreaRead{}
 
-		rows, err := s.DB.Query("SELECT * FROM AREA_READ WHERE RESERVOIR_UID = ?", reservoirUID.Bytes())
+		rows, err := s.DB.Query(`SELECT * FROM AREA_READ WHERE RESERVOIR_UID = ?
+			AND UID NOT IN (SELECT AREA_UID FROM AREA_READ_RETIRED)`, reservoirUID.Bytes())
 		if err != nil {
 			result <- query.Result{Error: err}
 		}
@@ -551,7 +616,8 @@ func (s AreaReadQueryMysql) CountAreas(farmUID uuid.UUID) <-chan query.Result {
 	go func() {
 		total := 0
 
-		err := s.DB.QueryRow(`SELECT COUNT(*) FROM AREA_READ WHERE FARM_UID = ?`, farmUID.Bytes()).Scan(&total)
+		err := s.DB.QueryRow(`SELECT COUNT(*) FROM AREA_READ WHERE FARM_UID = ?
+			AND UID NOT IN (SELECT AREA_UID FROM AREA_READ_RETIRED)`, farmUID.Bytes()).Scan(&total)
 		if err != nil {
 			result <- query.Result{Error: err}
 		}
@@ -563,3 +629,112 @@ func (s AreaReadQueryMysql) CountAreas(farmUID uuid.UUID) <-chan query.Result {
 
 	return result
 }
+
+// loadBoundary reads the area boundary, which is only stored for areas that have one.
+func (s AreaReadQueryMysql) loadBoundary(areaRead *storage.AreaRead) error {
+	boundary := sql.NullString{}
+
+	err := s.DB.QueryRow("SELECT BOUNDARY FROM AREA_READ_BOUNDARY WHERE AREA_UID = ?", areaRead.UID.Bytes()).
+		Scan(&boundary)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	if !boundary.Valid {
+		return nil
+	}
+
+	areaRead.Boundary = &storage.AreaBoundary{}
+
+	return json.Unmarshal([]byte(boundary.String), areaRead.Boundary)
+}
+
+// loadCapacity reads the area capacity, which is only stored for areas that declare one.
+func (s AreaReadQueryMysql) loadCapacity(areaRead *storage.AreaRead) error {
+	capacity := sql.NullString{}
+
+	err := s.DB.QueryRow("SELECT CAPACITY FROM AREA_READ_CAPACITY WHERE AREA_UID = ?", areaRead.UID.Bytes()).
+		Scan(&capacity)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	if !capacity.Valid {
+		return nil
+	}
+
+	areaRead.Capacity = &storage.AreaCapacity{}
+
+	return json.Unmarshal([]byte(capacity.String), areaRead.Capacity)
+}
+
+// loadPhotos reads the area gallery in its order, and the cover photo with it.
+func (s AreaReadQueryMysql) loadPhotos(areaRead *storage.AreaRead) error {
+	areaRead.Photos = []storage.AreaPhoto{}
+
+	rows, err := s.DB.Query(`SELECT UID, FILENAME, MIME_TYPE, SIZE, WIDTH, HEIGHT, CAPTION, TAKEN_DATE, IS_COVER
+		FROM AREA_READ_PHOTO WHERE AREA_UID = ? ORDER BY POSITION`, areaRead.UID.Bytes())
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		rowsData := struct {
+			UID       []byte
+			Filename  string
+			MimeType  string
+			Size      int
+			Width     int
+			Height    int
+			Caption   string
+			TakenDate sql.NullTime
+			IsCover   bool
+		}{}
+
+		err = rows.Scan(
+			&rowsData.UID,
+			&rowsData.Filename,
+			&rowsData.MimeType,
+			&rowsData.Size,
+			&rowsData.Width,
+			&rowsData.Height,
+			&rowsData.Caption,
+			&rowsData.TakenDate,
+			&rowsData.IsCover,
+		)
+		if err != nil {
+			return err
+		}
+
+		photoUID, err := uuid.FromBytes(rowsData.UID)
+		if err != nil {
+			return err
+		}
+
+		photo := storage.AreaPhoto{
+			UID:      photoUID,
+			Filename: rowsData.Filename,
+			MimeType: rowsData.MimeType,
+			Size:     rowsData.Size,
+			Width:    rowsData.Width,
+			Height:   rowsData.Height,
+			Caption:  rowsData.Caption,
+			IsCover:  rowsData.IsCover,
+		}
+
+		if rowsData.TakenDate.Valid {
+			takenDate := rowsData.TakenDate.Time
+			photo.TakenDate = &takenDate
+		}
+
+		if photo.IsCover {
+			areaRead.Photo = photo
+		}
+
+		areaRead.Photos = append(areaRead.Photos, photo)
+	}
+
+	return rows.Err()
+}
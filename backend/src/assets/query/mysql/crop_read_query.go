@@ -437,3 +437,45 @@ func (q CropReadQueryMysql) populateCropMovedArea(uid uuid.UUID, cropRead *stora
 
 	return nil
 }
+
+func (q CropReadQueryMysql) FindAllPlantsByArea(areaUID uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		plants := []query.AreaPlantResult{}
+
+		rows, err := q.DB.Query(`SELECT INVENTORY_PLANT_TYPE, CONTAINER_TYPE, CONTAINER_CELL, INITIAL_AREA_CURRENT_QUANTITY
+			FROM CROP_READ WHERE INITIAL_AREA_UID = ?
+			UNION ALL
+			SELECT CROP_READ.INVENTORY_PLANT_TYPE, CROP_READ.CONTAINER_TYPE, CROP_READ.CONTAINER_CELL,
+			CROP_READ_MOVED_AREA.CURRENT_QUANTITY
+			FROM CROP_READ_MOVED_AREA
+			INNER JOIN CROP_READ ON CROP_READ.UID = CROP_READ_MOVED_AREA.CROP_UID
+			WHERE CROP_READ_MOVED_AREA.AREA_UID = ?`, areaUID.Bytes(), areaUID.Bytes())
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			plant := query.AreaPlantResult{}
+
+			err = rows.Scan(&plant.PlantType, &plant.ContainerType, &plant.ContainerCell, &plant.Quantity)
+			if err != nil {
+				result <- query.Result{Error: err}
+
+				return
+			}
+
+			plants = append(plants, plant)
+		}
+
+		result <- query.Result{Result: plants}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,243 @@
+package mysql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/assets/query"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+type EquipmentReadQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewEquipmentReadQueryMysql(db *sql.DB) query.EquipmentRead {
+	return EquipmentReadQueryMysql{DB: db}
+}
+
+type equipmentReadResult struct {
+	UID          []byte
+	Name         string
+	Type         string
+	SerialNumber string
+	FarmUID      []byte
+	FarmName     string
+	LocationType sql.NullString
+	LocationUID  []byte
+	LocationName sql.NullString
+	PurchaseDate sql.NullTime
+	CostAmount   sql.NullFloat64
+	CostCurrency sql.NullString
+	CreatedDate  time.Time
+}
+
+const equipmentReadColumns = `UID, NAME, TYPE, SERIAL_NUMBER, FARM_UID, FARM_NAME,
+	LOCATION_TYPE, LOCATION_UID, LOCATION_NAME, PURCHASE_DATE, COST_AMOUNT, COST_CURRENCY, CREATED_DATE`
+
+func (s EquipmentReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		defer close(result)
+
+		rows, err := s.DB.Query("SELECT "+equipmentReadColumns+" FROM EQUIPMENT_READ WHERE UID = ?", uid.Bytes())
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		equipment, err := s.scanEquipment(rows)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		if len(equipment) == 0 {
+			result <- query.Result{Result: storage.EquipmentRead{}}
+
+			return
+		}
+
+		result <- query.Result{Result: equipment[0]}
+	}()
+
+	return result
+}
+
+func (s EquipmentReadQueryMysql) FindAllByFarm(farmUID uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		defer close(result)
+
+		rows, err := s.DB.Query("SELECT "+equipmentReadColumns+" FROM EQUIPMENT_READ WHERE FARM_UID = ? ORDER BY NAME",
+			farmUID.Bytes())
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		equipment, err := s.scanEquipment(rows)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		result <- query.Result{Result: equipment}
+	}()
+
+	return result
+}
+
+// scanEquipment reads the equipment rows, then their maintenance schedules.
+func (s EquipmentReadQueryMysql) scanEquipment(rows *sql.Rows) ([]storage.EquipmentRead, error) {
+	equipment := []storage.EquipmentRead{}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		rowsData := equipmentReadResult{}
+
+		err := rows.Scan(
+			&rowsData.UID,
+			&rowsData.Name,
+			&rowsData.Type,
+			&rowsData.SerialNumber,
+			&rowsData.FarmUID,
+			&rowsData.FarmName,
+			&rowsData.LocationType,
+			&rowsData.LocationUID,
+			&rowsData.LocationName,
+			&rowsData.PurchaseDate,
+			&rowsData.CostAmount,
+			&rowsData.CostCurrency,
+			&rowsData.CreatedDate,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		equipmentRead, err := rowsData.equipmentRead()
+		if err != nil {
+			return nil, err
+		}
+
+		equipment = append(equipment, equipmentRead)
+	}
+
+	err := rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range equipment {
+		err := s.loadMaintenanceSchedules(&equipment[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return equipment, nil
+}
+
+func (s EquipmentReadQueryMysql) loadMaintenanceSchedules(equipmentRead *storage.EquipmentRead) error {
+	rows, err := s.DB.Query(`SELECT UID, TITLE, INTERVAL_DAYS, LAST_DONE_DATE, NEXT_DUE_DATE
+		FROM EQUIPMENT_READ_MAINTENANCE_SCHEDULE WHERE EQUIPMENT_UID = ? ORDER BY POSITION`, equipmentRead.UID.Bytes())
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+
+	equipmentRead.MaintenanceSchedules = []storage.EquipmentMaintenanceSchedule{}
+
+	for rows.Next() {
+		rowsData := struct {
+			UID          []byte
+			Title        string
+			IntervalDays int
+			LastDoneDate sql.NullTime
+			NextDueDate  time.Time
+		}{}
+
+		err = rows.Scan(&rowsData.UID, &rowsData.Title, &rowsData.IntervalDays, &rowsData.LastDoneDate, &rowsData.NextDueDate)
+		if err != nil {
+			return err
+		}
+
+		scheduleUID, err := uuid.FromBytes(rowsData.UID)
+		if err != nil {
+			return err
+		}
+
+		schedule := storage.EquipmentMaintenanceSchedule{
+			UID:          scheduleUID,
+			Title:        rowsData.Title,
+			IntervalDays: rowsData.IntervalDays,
+			NextDueDate:  rowsData.NextDueDate,
+		}
+
+		if rowsData.LastDoneDate.Valid {
+			lastDoneDate := rowsData.LastDoneDate.Time
+			schedule.LastDoneDate = &lastDoneDate
+		}
+
+		equipmentRead.MaintenanceSchedules = append(equipmentRead.MaintenanceSchedules, schedule)
+	}
+
+	return rows.Err()
+}
+
+func (rowsData equipmentReadResult) equipmentRead() (storage.EquipmentRead, error) {
+	equipmentUID, err := uuid.FromBytes(rowsData.UID)
+	if err != nil {
+		return storage.EquipmentRead{}, err
+	}
+
+	farmUID, err := uuid.FromBytes(rowsData.FarmUID)
+	if err != nil {
+		return storage.EquipmentRead{}, err
+	}
+
+	equipmentRead := storage.EquipmentRead{
+		UID:          equipmentUID,
+		Name:         rowsData.Name,
+		Type:         rowsData.Type,
+		SerialNumber: rowsData.SerialNumber,
+		Farm:         storage.EquipmentFarm{UID: farmUID, Name: rowsData.FarmName},
+		CreatedDate:  rowsData.CreatedDate,
+	}
+
+	if rowsData.LocationUID != nil {
+		locationUID, err := uuid.FromBytes(rowsData.LocationUID)
+		if err != nil {
+			return storage.EquipmentRead{}, err
+		}
+
+		equipmentRead.Location = &storage.EquipmentLocation{
+			Type: rowsData.LocationType.String,
+			UID:  locationUID,
+			Name: rowsData.LocationName.String,
+		}
+	}
+
+	if rowsData.PurchaseDate.Valid {
+		purchaseDate := rowsData.PurchaseDate.Time
+		equipmentRead.PurchaseDate = &purchaseDate
+	}
+
+	if rowsData.CostAmount.Valid {
+		equipmentRead.Cost = &storage.EquipmentCost{
+			Amount:       rowsData.CostAmount.Float64,
+			CurrencyCode: rowsData.CostCurrency.String,
+		}
+	}
+
+	return equipmentRead, nil
+}
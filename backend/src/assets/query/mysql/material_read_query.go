@@ -21,6 +21,10 @@ func NewMaterialReadQueryMysql(db *sql.DB) query.MaterialRead {
 	return MaterialReadQueryMysql{DB: db}
 }
 
+// materialReadSelect reads the materials with the label of their catalog type, like their plant type.
+const materialReadSelect = `SELECT MATERIAL_READ.*, MATERIAL_READ_TYPE_LABEL.LABEL FROM MATERIAL_READ
+	LEFT JOIN MATERIAL_READ_TYPE_LABEL ON MATERIAL_READ_TYPE_LABEL.MATERIAL_UID = MATERIAL_READ.UID`
+
 type materialReadResult struct {
 	UID            []byte
 	Name           string
@@ -34,6 +38,7 @@ type materialReadResult struct {
 	Notes          sql.NullString
 	ProducedBy     sql.NullString
 	CreatedDate    time.Time
+	TypeLabel      sql.NullString
 }
 
 func (q MaterialReadQueryMysql) FindAll(materialType, materialTypeDetail string, page, limit int) <-chan query.Result {
@@ -45,7 +50,7 @@ func (q MaterialReadQueryMysql) FindAll(materialType, materialTypeDetail string,
 
 		var params []interface{}
 
-		sql := "SELECT * FROM MATERIAL_READ WHERE 1 = 1"
+		sql := materialReadSelect + " WHERE 1 = 1"
 
 		if materialType != "" {
 			t := strings.Split(materialType, ",")
@@ -102,6 +107,7 @@ func (q MaterialReadQueryMysql) FindAll(materialType, materialTypeDetail string,
 				&rowsData.Notes,
 				&rowsData.ProducedBy,
 				&rowsData.CreatedDate,
+				&rowsData.TypeLabel,
 			)
 
 			if err != nil {
@@ -131,31 +137,53 @@ func (q MaterialReadQueryMysql) FindAll(materialType, materialTypeDetail string,
 
 			var materialType storage.MaterialType
 
+			typeLabel := rowsData.TypeLabel.String
+
 			switch rowsData.Type {
 			case domain.MaterialTypePlantCode:
-				materialType, err = domain.CreateMaterialTypePlant(rowsData.TypeData)
+				catalogType, err := domain.RestoreCatalogType(domain.CatalogPlantType, rowsData.TypeData, typeLabel)
 				if err != nil {
 					result <- query.Result{Error: err}
+					close(result)
+
+					return
 				}
+
+				materialType = domain.MaterialTypePlant{PlantType: domain.PlantType(catalogType)}
 			case domain.MaterialTypeSeedCode:
-				materialType, err = domain.CreateMaterialTypeSeed(rowsData.TypeData)
+				catalogType, err := domain.RestoreCatalogType(domain.CatalogPlantType, rowsData.TypeData, typeLabel)
 				if err != nil {
 					result <- query.Result{Error: err}
+					close(result)
+
+					return
 				}
+
+				materialType = domain.MaterialTypeSeed{PlantType: domain.PlantType(catalogType)}
 			case domain.MaterialTypeGrowingMediumCode:
 				materialType = domain.MaterialTypeGrowingMedium{}
 			case domain.MaterialTypeAgrochemicalCode:
-				materialType, err = domain.CreateMaterialTypeAgrochemical(rowsData.TypeData)
+				catalogType, err := domain.RestoreCatalogType(domain.CatalogChemicalType, rowsData.TypeData, typeLabel)
 				if err != nil {
 					result <- query.Result{Error: err}
+					close(result)
+
+					return
 				}
+
+				materialType = domain.MaterialTypeAgrochemical{ChemicalType: domain.ChemicalType(catalogType)}
 			case domain.MaterialTypeLabelAndCropSupportCode:
 				materialType = domain.MaterialTypeLabelAndCropSupport{}
 			case domain.MaterialTypeSeedingContainerCode:
-				materialType, err = domain.CreateMaterialTypeSeedingContainer(rowsData.TypeData)
+				catalogType, err := domain.RestoreCatalogType(domain.CatalogContainerType, rowsData.TypeData, typeLabel)
 				if err != nil {
 					result <- query.Result{Error: err}
+					close(result)
+
+					return
 				}
+
+				materialType = domain.MaterialTypeSeedingContainer{ContainerType: domain.ContainerType(catalogType)}
 			case domain.MaterialTypePostHarvestSupplyCode:
 				materialType = domain.MaterialTypePostHarvestSupply{}
 			case domain.MaterialTypeOtherCode:
@@ -193,6 +221,14 @@ func (q MaterialReadQueryMysql) FindAll(materialType, materialTypeDetail string,
 				ProducedBy:     producedBy,
 				CreatedDate:    rowsData.CreatedDate,
 			})
+
+			err = q.loadReorderPoint(&materialReads[len(materialReads)-1])
+			if err != nil {
+				result <- query.Result{Error: err}
+				close(result)
+
+				return
+			}
 		}
 
 		result <- query.Result{Result: materialReads}
@@ -259,7 +295,7 @@ func (q MaterialReadQueryMysql) FindByID(materialUID uuid.UUID) <-chan query.Res
 		materialRead := storage.MaterialRead{}
 		rowsData := materialReadResult{}
 
-		err := q.DB.QueryRow(`SELECT * FROM MATERIAL_READ WHERE UID = ?`, materialUID.Bytes()).Scan(
+		err := q.DB.QueryRow(materialReadSelect+" WHERE UID = ?", materialUID.Bytes()).Scan(
 			&rowsData.UID,
 			&rowsData.Name,
 			&rowsData.PricePerUnit,
@@ -272,6 +308,7 @@ func (q MaterialReadQueryMysql) FindByID(materialUID uuid.UUID) <-chan query.Res
 			&rowsData.Notes,
 			&rowsData.ProducedBy,
 			&rowsData.CreatedDate,
+			&rowsData.TypeLabel,
 		)
 
 		if err != nil && !errors.Is(err, sql.ErrNoRows) {
@@ -305,31 +342,53 @@ func (q MaterialReadQueryMysql) FindByID(materialUID uuid.UUID) <-chan query.Res
 
 		var materialType storage.MaterialType
 
+		typeLabel := rowsData.TypeLabel.String
+
 		switch rowsData.Type {
 		case domain.MaterialTypePlantCode:
-			materialType, err = domain.CreateMaterialTypePlant(rowsData.TypeData)
+			catalogType, err := domain.RestoreCatalogType(domain.CatalogPlantType, rowsData.TypeData, typeLabel)
 			if err != nil {
 				result <- query.Result{Error: err}
+				close(result)
+
+				return
 			}
+
+			materialType = domain.MaterialTypePlant{PlantType: domain.PlantType(catalogType)}
 		case domain.MaterialTypeSeedCode:
-			materialType, err = domain.CreateMaterialTypeSeed(rowsData.TypeData)
+			catalogType, err := domain.RestoreCatalogType(domain.CatalogPlantType, rowsData.TypeData, typeLabel)
 			if err != nil {
 				result <- query.Result{Error: err}
+				close(result)
+
+				return
 			}
+
+			materialType = domain.MaterialTypeSeed{PlantType: domain.PlantType(catalogType)}
 		case domain.MaterialTypeGrowingMediumCode:
 			materialType = domain.MaterialTypeGrowingMedium{}
 		case domain.MaterialTypeAgrochemicalCode:
-			materialType, err = domain.CreateMaterialTypeAgrochemical(rowsData.TypeData)
+			catalogType, err := domain.RestoreCatalogType(domain.CatalogChemicalType, rowsData.TypeData, typeLabel)
 			if err != nil {
 				result <- query.Result{Error: err}
+				close(result)
+
+				return
 			}
+
+			materialType = domain.MaterialTypeAgrochemical{ChemicalType: domain.ChemicalType(catalogType)}
 		case domain.MaterialTypeLabelAndCropSupportCode:
 			materialType = domain.MaterialTypeLabelAndCropSupport{}
 		case domain.MaterialTypeSeedingContainerCode:
-			materialType, err = domain.CreateMaterialTypeSeedingContainer(rowsData.TypeData)
+			catalogType, err := domain.RestoreCatalogType(domain.CatalogContainerType, rowsData.TypeData, typeLabel)
 			if err != nil {
 				result <- query.Result{Error: err}
+				close(result)
+
+				return
 			}
+
+			materialType = domain.MaterialTypeSeedingContainer{ContainerType: domain.ContainerType(catalogType)}
 		case domain.MaterialTypePostHarvestSupplyCode:
 			materialType = domain.MaterialTypePostHarvestSupply{}
 		case domain.MaterialTypeOtherCode:
@@ -368,9 +427,39 @@ func (q MaterialReadQueryMysql) FindByID(materialUID uuid.UUID) <-chan query.Res
 			CreatedDate:    rowsData.CreatedDate,
 		}
 
+		err = q.loadReorderPoint(&materialRead)
+		if err != nil {
+			result <- query.Result{Error: err}
+			close(result)
+
+			return
+		}
+
 		result <- query.Result{Result: materialRead}
 		close(result)
 	}()
 
 	return result
 }
+
+// loadReorderPoint reads the material reorder point, which is only stored for materials whose stock is watched.
+func (q MaterialReadQueryMysql) loadReorderPoint(materialRead *storage.MaterialRead) error {
+	reorderPoint := sql.NullFloat64{}
+
+	err := q.DB.QueryRow(
+		"SELECT REORDER_POINT FROM MATERIAL_READ_REORDER_POINT WHERE MATERIAL_UID = ?",
+		materialRead.UID.Bytes(),
+	).Scan(&reorderPoint)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	materialRead.ReorderPoint = nil
+
+	if reorderPoint.Valid {
+		value := float32(reorderPoint.Float64)
+		materialRead.ReorderPoint = &value
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/usetania/tania-core/src/assets/domain"
+	"github.com/usetania/tania-core/src/assets/query"
+)
+
+type MaterialTypeCatalogQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewMaterialTypeCatalogQueryMysql(db *sql.DB) query.MaterialTypeCatalog {
+	return MaterialTypeCatalogQueryMysql{DB: db}
+}
+
+func (q MaterialTypeCatalogQueryMysql) FindByFarmID(farmUID uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		catalog := domain.MaterialTypeCatalog{FarmUID: farmUID}
+
+		rows, err := q.DB.Query(`SELECT CATALOG, CODE, LABEL FROM MATERIAL_TYPE_CATALOG
+			WHERE FARM_UID = ? ORDER BY CATALOG, POSITION`, farmUID.Bytes())
+		if err != nil {
+			result <- query.Result{Error: err}
+			close(result)
+
+			return
+		}
+
+		defer rows.Close()
+
+		for rows.Next() {
+			rowsData := struct {
+				Catalog string
+				Code    string
+				Label   string
+			}{}
+
+			err = rows.Scan(&rowsData.Catalog, &rowsData.Code, &rowsData.Label)
+			if err != nil {
+				result <- query.Result{Error: err}
+				close(result)
+
+				return
+			}
+
+			switch rowsData.Catalog {
+			case domain.CatalogPlantType:
+				catalog.PlantTypes = append(
+					catalog.PlantTypes,
+					domain.PlantType{Code: rowsData.Code, Label: rowsData.Label},
+				)
+			case domain.CatalogChemicalType:
+				catalog.ChemicalTypes = append(
+					catalog.ChemicalTypes,
+					domain.ChemicalType{Code: rowsData.Code, Label: rowsData.Label},
+				)
+			case domain.CatalogContainerType:
+				catalog.ContainerTypes = append(
+					catalog.ContainerTypes,
+					domain.ContainerType{Code: rowsData.Code, Label: rowsData.Label},
+				)
+			}
+		}
+
+		catalog.SeededDefaultTypes, err = q.findSeededDefaultTypes(farmUID)
+		if err != nil {
+			result <- query.Result{Error: err}
+			close(result)
+
+			return
+		}
+
+		result <- query.Result{Result: catalog}
+		close(result)
+	}()
+
+	return result
+}
+
+func (q MaterialTypeCatalogQueryMysql) findSeededDefaultTypes(farmUID uuid.UUID) (map[string][]string, error) {
+	seeded := make(map[string][]string)
+
+	rows, err := q.DB.Query(`SELECT CATALOG, CODE FROM MATERIAL_TYPE_CATALOG_DEFAULT WHERE FARM_UID = ?`, farmUID.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var catalog, code string
+
+		err = rows.Scan(&catalog, &code)
+		if err != nil {
+			return nil, err
+		}
+
+		seeded[catalog] = append(seeded[catalog], code)
+	}
+
+	return seeded, rows.Err()
+}
@@ -0,0 +1,128 @@
+package mysql
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/assets/query"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+type SupplierReadQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewSupplierReadQueryMysql(db *sql.DB) query.SupplierRead {
+	return SupplierReadQueryMysql{DB: db}
+}
+
+type supplierReadResult struct {
+	UID         []byte
+	Name        string
+	Phone       string
+	Email       string
+	Address     string
+	CreatedDate time.Time
+}
+
+func (s SupplierReadQueryMysql) FindAll() <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		suppliers := []storage.SupplierRead{}
+
+		rows, err := s.DB.Query("SELECT * FROM SUPPLIER_READ ORDER BY NAME")
+		if err != nil {
+			result <- query.Result{Error: err}
+		}
+
+		for rows.Next() {
+			rowsData := supplierReadResult{}
+
+			err = rows.Scan(
+				&rowsData.UID,
+				&rowsData.Name,
+				&rowsData.Phone,
+				&rowsData.Email,
+				&rowsData.Address,
+				&rowsData.CreatedDate,
+			)
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			supplierRead, err := rowsData.supplierRead()
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			suppliers = append(suppliers, supplierRead)
+		}
+
+		result <- query.Result{Result: suppliers}
+		close(result)
+	}()
+
+	return result
+}
+
+func (s SupplierReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		defer close(result)
+
+		rowsData := supplierReadResult{}
+
+		err := s.DB.QueryRow("SELECT * FROM SUPPLIER_READ WHERE UID = ?", uid.Bytes()).Scan(
+			&rowsData.UID,
+			&rowsData.Name,
+			&rowsData.Phone,
+			&rowsData.Email,
+			&rowsData.Address,
+			&rowsData.CreatedDate,
+		)
+		if errors.Is(err, sql.ErrNoRows) {
+			result <- query.Result{Result: storage.SupplierRead{}}
+
+			return
+		}
+
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		supplierRead, err := rowsData.supplierRead()
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		result <- query.Result{Result: supplierRead}
+	}()
+
+	return result
+}
+
+func (rowsData supplierReadResult) supplierRead() (storage.SupplierRead, error) {
+	supplierUID, err := uuid.FromBytes(rowsData.UID)
+	if err != nil {
+		return storage.SupplierRead{}, err
+	}
+
+	createdDate := rowsData.CreatedDate
+
+	return storage.SupplierRead{
+		UID:         supplierUID,
+		Name:        rowsData.Name,
+		Phone:       rowsData.Phone,
+		Email:       rowsData.Email,
+		Address:     rowsData.Address,
+		CreatedDate: createdDate,
+	}, nil
+}
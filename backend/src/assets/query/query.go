@@ -22,6 +22,8 @@ type ReservoirEvent interface {
 type ReservoirRead interface {
 	FindByID(reservoirUID uuid.UUID) <-chan Result
 	FindAllByFarm(farmUID uuid.UUID) <-chan Result
+	FindLevelsByReservoirID(reservoirUID uuid.UUID) <-chan Result
+	FindWaterQualityByReservoirID(reservoirUID uuid.UUID, parameter string, from, to time.Time) <-chan Result
 }
 
 type AreaEvent interface {
@@ -39,6 +41,7 @@ type AreaRead interface {
 type CropRead interface {
 	FindAllCropByArea(areaUID uuid.UUID) <-chan Result
 	CountCropsByArea(areaUID uuid.UUID) <-chan Result
+	FindAllPlantsByArea(areaUID uuid.UUID) <-chan Result
 }
 
 type MaterialEvent interface {
@@ -51,6 +54,29 @@ type MaterialRead interface {
 	FindByID(materialUID uuid.UUID) <-chan Result
 }
 
+type SupplierEvent interface {
+	FindAllByID(supplierUID uuid.UUID) <-chan Result
+}
+
+type SupplierRead interface {
+	FindAll() <-chan Result
+	FindByID(supplierUID uuid.UUID) <-chan Result
+}
+
+// MaterialTypeCatalog finds the saved catalog of the farm, which is empty until it is first saved.
+type MaterialTypeCatalog interface {
+	FindByFarmID(farmUID uuid.UUID) <-chan Result
+}
+
+type EquipmentEvent interface {
+	FindAllByID(equipmentUID uuid.UUID) <-chan Result
+}
+
+type EquipmentRead interface {
+	FindByID(equipmentUID uuid.UUID) <-chan Result
+	FindAllByFarm(farmUID uuid.UUID) <-chan Result
+}
+
 type Result struct {
 	Result interface{}
 	Error  error
@@ -92,6 +118,14 @@ type CountAreaCropResult struct {
 	TotalCropBatch int
 }
 
+// AreaPlantResult is the part of a crop batch currently in an area.
+type AreaPlantResult struct {
+	PlantType     string
+	ContainerType string
+	ContainerCell int
+	Quantity      int
+}
+
 type AreaCropResult struct {
 	CropUID          uuid.UUID   `json:"uid"`
 	BatchID          string      `json:"batch_id"`
@@ -0,0 +1,77 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/assets/decoder"
+	"github.com/usetania/tania-core/src/assets/query"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+type EquipmentEventQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewEquipmentEventQuerySqlite(db *sql.DB) query.EquipmentEvent {
+	return &EquipmentEventQuerySqlite{DB: db}
+}
+
+func (f *EquipmentEventQuerySqlite) FindAllByID(uid uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		events := []storage.EquipmentEvent{}
+
+		rows, err := f.DB.Query("SELECT * FROM EQUIPMENT_EVENT WHERE EQUIPMENT_UID = ? ORDER BY VERSION ASC", uid)
+		if err != nil {
+			result <- query.Result{Error: err}
+		}
+
+		rowsData := struct {
+			ID           int
+			EquipmentUID string
+			Version      int
+			CreatedDate  string
+			Event        []byte
+		}{}
+
+		for rows.Next() {
+			err := rows.Scan(&rowsData.ID, &rowsData.EquipmentUID, &rowsData.Version, &rowsData.CreatedDate, &rowsData.Event)
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			wrapper := decoder.EquipmentEventWrapper{}
+
+			err = json.Unmarshal(rowsData.Event, &wrapper)
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			equipmentUID, err := uuid.FromString(rowsData.EquipmentUID)
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			events = append(events, storage.EquipmentEvent{
+				EquipmentUID: equipmentUID,
+				Version:      rowsData.Version,
+				CreatedDate:  createdDate,
+				Event:        wrapper.EventData,
+			})
+		}
+
+		result <- query.Result{Result: events}
+		close(result)
+	}()
+
+	return result
+}
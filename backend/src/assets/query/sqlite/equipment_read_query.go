@@ -0,0 +1,261 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/assets/query"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+type EquipmentReadQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewEquipmentReadQuerySqlite(db *sql.DB) query.EquipmentRead {
+	return EquipmentReadQuerySqlite{DB: db}
+}
+
+type equipmentReadResult struct {
+	UID          string
+	Name         string
+	Type         string
+	SerialNumber string
+	FarmUID      string
+	FarmName     string
+	LocationType sql.NullString
+	LocationUID  sql.NullString
+	LocationName sql.NullString
+	PurchaseDate sql.NullString
+	CostAmount   sql.NullFloat64
+	CostCurrency sql.NullString
+	CreatedDate  string
+}
+
+const equipmentReadColumns = `UID, NAME, TYPE, SERIAL_NUMBER, FARM_UID, FARM_NAME,
+	LOCATION_TYPE, LOCATION_UID, LOCATION_NAME, PURCHASE_DATE, COST_AMOUNT, COST_CURRENCY, CREATED_DATE`
+
+func (s EquipmentReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		defer close(result)
+
+		rows, err := s.DB.Query("SELECT "+equipmentReadColumns+" FROM EQUIPMENT_READ WHERE UID = ?", uid)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		equipment, err := s.scanEquipment(rows)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		if len(equipment) == 0 {
+			result <- query.Result{Result: storage.EquipmentRead{}}
+
+			return
+		}
+
+		result <- query.Result{Result: equipment[0]}
+	}()
+
+	return result
+}
+
+func (s EquipmentReadQuerySqlite) FindAllByFarm(farmUID uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		defer close(result)
+
+		rows, err := s.DB.Query("SELECT "+equipmentReadColumns+" FROM EQUIPMENT_READ WHERE FARM_UID = ? ORDER BY NAME",
+			farmUID)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		equipment, err := s.scanEquipment(rows)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		result <- query.Result{Result: equipment}
+	}()
+
+	return result
+}
+
+// scanEquipment reads the equipment rows, then their maintenance schedules.
+func (s EquipmentReadQuerySqlite) scanEquipment(rows *sql.Rows) ([]storage.EquipmentRead, error) {
+	equipment := []storage.EquipmentRead{}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		rowsData := equipmentReadResult{}
+
+		err := rows.Scan(
+			&rowsData.UID,
+			&rowsData.Name,
+			&rowsData.Type,
+			&rowsData.SerialNumber,
+			&rowsData.FarmUID,
+			&rowsData.FarmName,
+			&rowsData.LocationType,
+			&rowsData.LocationUID,
+			&rowsData.LocationName,
+			&rowsData.PurchaseDate,
+			&rowsData.CostAmount,
+			&rowsData.CostCurrency,
+			&rowsData.CreatedDate,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		equipmentRead, err := rowsData.equipmentRead()
+		if err != nil {
+			return nil, err
+		}
+
+		equipment = append(equipment, equipmentRead)
+	}
+
+	err := rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range equipment {
+		err := s.loadMaintenanceSchedules(&equipment[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return equipment, nil
+}
+
+func (s EquipmentReadQuerySqlite) loadMaintenanceSchedules(equipmentRead *storage.EquipmentRead) error {
+	rows, err := s.DB.Query(`SELECT UID, TITLE, INTERVAL_DAYS, LAST_DONE_DATE, NEXT_DUE_DATE
+		FROM EQUIPMENT_READ_MAINTENANCE_SCHEDULE WHERE EQUIPMENT_UID = ? ORDER BY POSITION`, equipmentRead.UID)
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+
+	equipmentRead.MaintenanceSchedules = []storage.EquipmentMaintenanceSchedule{}
+
+	for rows.Next() {
+		rowsData := struct {
+			UID          string
+			Title        string
+			IntervalDays int
+			LastDoneDate sql.NullString
+			NextDueDate  string
+		}{}
+
+		err = rows.Scan(&rowsData.UID, &rowsData.Title, &rowsData.IntervalDays, &rowsData.LastDoneDate, &rowsData.NextDueDate)
+		if err != nil {
+			return err
+		}
+
+		scheduleUID, err := uuid.FromString(rowsData.UID)
+		if err != nil {
+			return err
+		}
+
+		nextDueDate, err := time.Parse(time.RFC3339, rowsData.NextDueDate)
+		if err != nil {
+			return err
+		}
+
+		schedule := storage.EquipmentMaintenanceSchedule{
+			UID:          scheduleUID,
+			Title:        rowsData.Title,
+			IntervalDays: rowsData.IntervalDays,
+			NextDueDate:  nextDueDate,
+		}
+
+		if rowsData.LastDoneDate.Valid {
+			lastDoneDate, err := time.Parse(time.RFC3339, rowsData.LastDoneDate.String)
+			if err != nil {
+				return err
+			}
+
+			schedule.LastDoneDate = &lastDoneDate
+		}
+
+		equipmentRead.MaintenanceSchedules = append(equipmentRead.MaintenanceSchedules, schedule)
+	}
+
+	return rows.Err()
+}
+
+func (rowsData equipmentReadResult) equipmentRead() (storage.EquipmentRead, error) {
+	equipmentUID, err := uuid.FromString(rowsData.UID)
+	if err != nil {
+		return storage.EquipmentRead{}, err
+	}
+
+	farmUID, err := uuid.FromString(rowsData.FarmUID)
+	if err != nil {
+		return storage.EquipmentRead{}, err
+	}
+
+	createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+	if err != nil {
+		return storage.EquipmentRead{}, err
+	}
+
+	equipmentRead := storage.EquipmentRead{
+		UID:          equipmentUID,
+		Name:         rowsData.Name,
+		Type:         rowsData.Type,
+		SerialNumber: rowsData.SerialNumber,
+		Farm:         storage.EquipmentFarm{UID: farmUID, Name: rowsData.FarmName},
+		CreatedDate:  createdDate,
+	}
+
+	if rowsData.LocationUID.Valid {
+		locationUID, err := uuid.FromString(rowsData.LocationUID.String)
+		if err != nil {
+			return storage.EquipmentRead{}, err
+		}
+
+		equipmentRead.Location = &storage.EquipmentLocation{
+			Type: rowsData.LocationType.String,
+			UID:  locationUID,
+			Name: rowsData.LocationName.String,
+		}
+	}
+
+	if rowsData.PurchaseDate.Valid {
+		purchaseDate, err := time.Parse(time.RFC3339, rowsData.PurchaseDate.String)
+		if err != nil {
+			return storage.EquipmentRead{}, err
+		}
+
+		equipmentRead.PurchaseDate = &purchaseDate
+	}
+
+	if rowsData.CostAmount.Valid {
+		equipmentRead.Cost = &storage.EquipmentCost{
+			Amount:       rowsData.CostAmount.Float64,
+			CurrencyCode: rowsData.CostCurrency.String,
+		}
+	}
+
+	return equipmentRead, nil
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/assets/domain"
 	"github.com/usetania/tania-core/src/assets/query"
 	"github.com/usetania/tania-core/src/assets/storage"
 )
@@ -79,6 +80,16 @@ func (s FarmReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.Result {
 			CreatedDate: createdDate,
 		}
 
+		err = s.loadUnitSystem(&farmRead)
+		if err != nil {
+			result <- query.Result{Error: err}
+		}
+
+		err = s.loadCurrency(&farmRead)
+		if err != nil {
+			result <- query.Result{Error: err}
+		}
+
 		result <- query.Result{Result: farmRead}
 		close(result)
 	}()
@@ -136,6 +147,16 @@ func (s FarmReadQuerySqlite) FindAll() <-chan query.Result {
 				IsActive:    rowsData.IsActive != 0,
 				CreatedDate: createdDate,
 			})
+
+			err = s.loadUnitSystem(&farmReads[len(farmReads)-1])
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			err = s.loadCurrency(&farmReads[len(farmReads)-1])
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
 		}
 
 		result <- query.Result{Result: farmReads}
@@ -144,3 +165,88 @@ func (s FarmReadQuerySqlite) FindAll() <-chan query.Result {
 
 	return result
 }
+
+// loadUnitSystem reads the farm unit system, which is only stored for farms that picked one.
+func (s FarmReadQuerySqlite) loadUnitSystem(farmRead *storage.FarmRead) error {
+	unitSystem := sql.NullString{}
+
+	err := s.DB.QueryRow("SELECT UNIT_SYSTEM FROM FARM_READ_UNIT_SYSTEM WHERE FARM_UID = ?", farmRead.UID).
+		Scan(&unitSystem)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	farmRead.UnitSystem = unitSystem.String
+
+	return nil
+}
+
+// loadCurrency reads the farm base currency and its exchange rates.
+func (s FarmReadQuerySqlite) loadCurrency(farmRead *storage.FarmRead) error {
+	currency := sql.NullString{}
+
+	err := s.DB.QueryRow("SELECT CURRENCY FROM FARM_READ_CURRENCY WHERE FARM_UID = ?", farmRead.UID).
+		Scan(&currency)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	farmRead.Currency = domain.BaseCurrency(currency.String)
+	farmRead.ExchangeRates = []storage.FarmExchangeRate{}
+
+	rows, err := s.DB.Query(`SELECT UID, FROM_CURRENCY, TO_CURRENCY, RATE, EFFECTIVE_DATE, CREATED_DATE
+		FROM FARM_READ_EXCHANGE_RATE WHERE FARM_UID = ? ORDER BY EFFECTIVE_DATE DESC`, farmRead.UID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		rateData := struct {
+			UID           string
+			FromCurrency  string
+			ToCurrency    string
+			Rate          float64
+			EffectiveDate string
+			CreatedDate   string
+		}{}
+
+		err = rows.Scan(
+			&rateData.UID,
+			&rateData.FromCurrency,
+			&rateData.ToCurrency,
+			&rateData.Rate,
+			&rateData.EffectiveDate,
+			&rateData.CreatedDate,
+		)
+		if err != nil {
+			return err
+		}
+
+		uid, err := uuid.FromString(rateData.UID)
+		if err != nil {
+			return err
+		}
+
+		effectiveDate, err := time.Parse(time.RFC3339, rateData.EffectiveDate)
+		if err != nil {
+			return err
+		}
+
+		createdDate, err := time.Parse(time.RFC3339, rateData.CreatedDate)
+		if err != nil {
+			return err
+		}
+
+		farmRead.ExchangeRates = append(farmRead.ExchangeRates, storage.FarmExchangeRate{
+			UID:           uid,
+			FromCurrency:  rateData.FromCurrency,
+			ToCurrency:    rateData.ToCurrency,
+			Rate:          rateData.Rate,
+			EffectiveDate: effectiveDate,
+			CreatedDate:   createdDate,
+		})
+	}
+
+	return rows.Err()
+}
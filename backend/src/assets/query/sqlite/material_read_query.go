@@ -21,6 +21,10 @@ func NewMaterialReadQuerySqlite(db *sql.DB) query.MaterialRead {
 	return MaterialReadQuerySqlite{DB: db}
 }
 
+// materialReadSelect reads the materials with the label of their catalog type, like their plant type.
+const materialReadSelect = `SELECT MATERIAL_READ.*, MATERIAL_READ_TYPE_LABEL.LABEL FROM MATERIAL_READ
+	LEFT JOIN MATERIAL_READ_TYPE_LABEL ON MATERIAL_READ_TYPE_LABEL.MATERIAL_UID = MATERIAL_READ.UID`
+
 type materialReadResult struct {
 	UID            string
 	Name           string
@@ -34,6 +38,7 @@ type materialReadResult struct {
 	Notes          sql.NullString
 	ProducedBy     sql.NullString
 	CreatedDate    string
+	TypeLabel      sql.NullString
 }
 
 func (q MaterialReadQuerySqlite) FindAll(materialType, materialTypeDetail string, page, limit int) <-chan query.Result {
@@ -44,7 +49,7 @@ func (q MaterialReadQuerySqlite) FindAll(materialType, materialTypeDetail string
 
 		var params []interface{}
 
-		sql := "SELECT * FROM MATERIAL_READ WHERE 1 = 1"
+		sql := materialReadSelect + " WHERE 1 = 1"
 
 		if materialType != "" {
 			t := strings.Split(materialType, ",")
@@ -103,6 +108,7 @@ func (q MaterialReadQuerySqlite) FindAll(materialType, materialTypeDetail string
 				&rowsData.Notes,
 				&rowsData.ProducedBy,
 				&rowsData.CreatedDate,
+				&rowsData.TypeLabel,
 			)
 
 			if err != nil {
@@ -137,31 +143,53 @@ func (q MaterialReadQuerySqlite) FindAll(materialType, materialTypeDetail string
 
 			var materialType storage.MaterialType
 
+			typeLabel := rowsData.TypeLabel.String
+
 			switch rowsData.Type {
 			case domain.MaterialTypePlantCode:
-				materialType, err = domain.CreateMaterialTypePlant(rowsData.TypeData)
+				catalogType, err := domain.RestoreCatalogType(domain.CatalogPlantType, rowsData.TypeData, typeLabel)
 				if err != nil {
 					result <- query.Result{Error: err}
+					close(result)
+
+					return
 				}
+
+				materialType = domain.MaterialTypePlant{PlantType: domain.PlantType(catalogType)}
 			case domain.MaterialTypeSeedCode:
-				materialType, err = domain.CreateMaterialTypeSeed(rowsData.TypeData)
+				catalogType, err := domain.RestoreCatalogType(domain.CatalogPlantType, rowsData.TypeData, typeLabel)
 				if err != nil {
 					result <- query.Result{Error: err}
+					close(result)
+
+					return
 				}
+
+				materialType = domain.MaterialTypeSeed{PlantType: domain.PlantType(catalogType)}
 			case domain.MaterialTypeGrowingMediumCode:
 				materialType = domain.MaterialTypeGrowingMedium{}
 			case domain.MaterialTypeAgrochemicalCode:
-				materialType, err = domain.CreateMaterialTypeAgrochemical(rowsData.TypeData)
+				catalogType, err := domain.RestoreCatalogType(domain.CatalogChemicalType, rowsData.TypeData, typeLabel)
 				if err != nil {
 					result <- query.Result{Error: err}
+					close(result)
+
+					return
 				}
+
+				materialType = domain.MaterialTypeAgrochemical{ChemicalType: domain.ChemicalType(catalogType)}
 			case domain.MaterialTypeLabelAndCropSupportCode:
 				materialType = domain.MaterialTypeLabelAndCropSupport{}
 			case domain.MaterialTypeSeedingContainerCode:
-				materialType, err = domain.CreateMaterialTypeSeedingContainer(rowsData.TypeData)
+				catalogType, err := domain.RestoreCatalogType(domain.CatalogContainerType, rowsData.TypeData, typeLabel)
 				if err != nil {
 					result <- query.Result{Error: err}
+					close(result)
+
+					return
 				}
+
+				materialType = domain.MaterialTypeSeedingContainer{ContainerType: domain.ContainerType(catalogType)}
 			case domain.MaterialTypePostHarvestSupplyCode:
 				materialType = domain.MaterialTypePostHarvestSupply{}
 			case domain.MaterialTypeOtherCode:
@@ -199,6 +227,14 @@ func (q MaterialReadQuerySqlite) FindAll(materialType, materialTypeDetail string
 				ProducedBy:     producedBy,
 				CreatedDate:    mCreatedDate,
 			})
+
+			err = q.loadReorderPoint(&materialReads[len(materialReads)-1])
+			if err != nil {
+				result <- query.Result{Error: err}
+				close(result)
+
+				return
+			}
 		}
 
 		result <- query.Result{Result: materialReads}
@@ -265,7 +301,7 @@ func (q MaterialReadQuerySqlite) FindByID(materialUID uuid.UUID) <-chan query.Re
 		materialRead := storage.MaterialRead{}
 		rowsData := materialReadResult{}
 
-		err := q.DB.QueryRow(`SELECT * FROM MATERIAL_READ WHERE UID = ?`, materialUID).Scan(
+		err := q.DB.QueryRow(materialReadSelect+" WHERE UID = ?", materialUID).Scan(
 			&rowsData.UID,
 			&rowsData.Name,
 			&rowsData.PricePerUnit,
@@ -278,6 +314,7 @@ func (q MaterialReadQuerySqlite) FindByID(materialUID uuid.UUID) <-chan query.Re
 			&rowsData.Notes,
 			&rowsData.ProducedBy,
 			&rowsData.CreatedDate,
+			&rowsData.TypeLabel,
 		)
 
 		if err != nil && !errors.Is(err, sql.ErrNoRows) {
@@ -316,31 +353,53 @@ func (q MaterialReadQuerySqlite) FindByID(materialUID uuid.UUID) <-chan query.Re
 
 		var materialType storage.MaterialType
 
+		typeLabel := rowsData.TypeLabel.String
+
 		switch rowsData.Type {
 		case domain.MaterialTypePlantCode:
-			materialType, err = domain.CreateMaterialTypePlant(rowsData.TypeData)
+			catalogType, err := domain.RestoreCatalogType(domain.CatalogPlantType, rowsData.TypeData, typeLabel)
 			if err != nil {
 				result <- query.Result{Error: err}
+				close(result)
+
+				return
 			}
+
+			materialType = domain.MaterialTypePlant{PlantType: domain.PlantType(catalogType)}
 		case domain.MaterialTypeSeedCode:
-			materialType, err = domain.CreateMaterialTypeSeed(rowsData.TypeData)
+			catalogType, err := domain.RestoreCatalogType(domain.CatalogPlantType, rowsData.TypeData, typeLabel)
 			if err != nil {
 				result <- query.Result{Error: err}
+				close(result)
+
+				return
 			}
+
+			materialType = domain.MaterialTypeSeed{PlantType: domain.PlantType(catalogType)}
 		case domain.MaterialTypeGrowingMediumCode:
 			materialType = domain.MaterialTypeGrowingMedium{}
 		case domain.MaterialTypeAgrochemicalCode:
-			materialType, err = domain.CreateMaterialTypeAgrochemical(rowsData.TypeData)
+			catalogType, err := domain.RestoreCatalogType(domain.CatalogChemicalType, rowsData.TypeData, typeLabel)
 			if err != nil {
 				result <- query.Result{Error: err}
+				close(result)
+
+				return
 			}
+
+			materialType = domain.MaterialTypeAgrochemical{ChemicalType: domain.ChemicalType(catalogType)}
 		case domain.MaterialTypeLabelAndCropSupportCode:
 			materialType = domain.MaterialTypeLabelAndCropSupport{}
 		case domain.MaterialTypeSeedingContainerCode:
-			materialType, err = domain.CreateMaterialTypeSeedingContainer(rowsData.TypeData)
+			catalogType, err := domain.RestoreCatalogType(domain.CatalogContainerType, rowsData.TypeData, typeLabel)
 			if err != nil {
 				result <- query.Result{Error: err}
+				close(result)
+
+				return
 			}
+
+			materialType = domain.MaterialTypeSeedingContainer{ContainerType: domain.ContainerType(catalogType)}
 		case domain.MaterialTypePostHarvestSupplyCode:
 			materialType = domain.MaterialTypePostHarvestSupply{}
 		case domain.MaterialTypeOtherCode:
@@ -379,9 +438,37 @@ func (q MaterialReadQuerySqlite) FindByID(materialUID uuid.UUID) <-chan query.Re
 			CreatedDate:    mCreatedDate,
 		}
 
+		err = q.loadReorderPoint(&materialRead)
+		if err != nil {
+			result <- query.Result{Error: err}
+			close(result)
+
+			return
+		}
+
 		result <- query.Result{Result: materialRead}
 		close(result)
 	}()
 
 	return result
 }
+
+// loadReorderPoint reads the material reorder point, which is only stored for materials whose stock is watched.
+func (q MaterialReadQuerySqlite) loadReorderPoint(materialRead *storage.MaterialRead) error {
+	reorderPoint := sql.NullFloat64{}
+
+	err := q.DB.QueryRow("SELECT REORDER_POINT FROM MATERIAL_READ_REORDER_POINT WHERE MATERIAL_UID = ?", materialRead.UID).
+		Scan(&reorderPoint)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	materialRead.ReorderPoint = nil
+
+	if reorderPoint.Valid {
+		value := float32(reorderPoint.Float64)
+		materialRead.ReorderPoint = &value
+	}
+
+	return nil
+}
@@ -126,6 +126,26 @@ func (s ReservoirReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.Result {
 			Notes:       notes,
 		}
 
+		err = s.loadLevel(&reservoirRead)
+		if err != nil {
+			result <- query.Result{Error: err}
+		}
+
+		err = s.loadWaterQuality(&reservoirRead)
+		if err != nil {
+			result <- query.Result{Error: err}
+		}
+
+		err = s.loadNutrientRecipe(&reservoirRead)
+		if err != nil {
+			result <- query.Result{Error: err}
+		}
+
+		err = s.loadPhotos(&reservoirRead)
+		if err != nil {
+			result <- query.Result{Error: err}
+		}
+
 		result <- query.Result{Result: reservoirRead}
 		close(result)
 	}()
@@ -226,6 +246,26 @@ func (s ReservoirReadQuerySqlite) FindAllByFarm(farmUID uuid.UUID) <-chan query.
 				CreatedDate: resCreatedDate,
 				Notes:       notes,
 			})
+
+			err = s.loadLevel(&reservoirReads[len(reservoirReads)-1])
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			err = s.loadWaterQuality(&reservoirReads[len(reservoirReads)-1])
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			err = s.loadNutrientRecipe(&reservoirReads[len(reservoirReads)-1])
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			err = s.loadPhotos(&reservoirReads[len(reservoirReads)-1])
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
 		}
 
 		result <- query.Result{Result: reservoirReads}
@@ -234,3 +274,423 @@ func (s ReservoirReadQuerySqlite) FindAllByFarm(farmUID uuid.UUID) <-chan query.
 
 	return result
 }
+
+// loadLevel reads the reservoir water per watering and latest water level, which are only stored once set.
+func (s ReservoirReadQuerySqlite) loadLevel(reservoirRead *storage.ReservoirRead) error {
+	rowsData := struct {
+		WaterPerWatering sql.NullFloat64
+		Level            sql.NullFloat64
+		LevelDate        sql.NullString
+		DailyConsumption sql.NullFloat64
+		PredictedDryDate sql.NullString
+	}{}
+
+	err := s.DB.QueryRow(`SELECT WATER_PER_WATERING, LEVEL, LEVEL_DATE, DAILY_CONSUMPTION, PREDICTED_DRY_DATE
+		FROM RESERVOIR_READ_LEVEL WHERE RESERVOIR_UID = ?`, reservoirRead.UID).Scan(
+		&rowsData.WaterPerWatering,
+		&rowsData.Level,
+		&rowsData.LevelDate,
+		&rowsData.DailyConsumption,
+		&rowsData.PredictedDryDate,
+	)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	reservoirRead.WaterPerWatering = float32(rowsData.WaterPerWatering.Float64)
+	reservoirRead.Level = nil
+
+	if !rowsData.Level.Valid {
+		return nil
+	}
+
+	levelDate, err := time.Parse(time.RFC3339, rowsData.LevelDate.String)
+	if err != nil {
+		return err
+	}
+
+	reservoirRead.Level = &storage.ReservoirLevel{
+		Level:            float32(rowsData.Level.Float64),
+		Date:             levelDate,
+		DailyConsumption: float32(rowsData.DailyConsumption.Float64),
+	}
+
+	if rowsData.PredictedDryDate.Valid {
+		predictedDryDate, err := time.Parse(time.RFC3339, rowsData.PredictedDryDate.String)
+		if err != nil {
+			return err
+		}
+
+		reservoirRead.Level.PredictedDryDate = &predictedDryDate
+	}
+
+	return nil
+}
+
+// loadWaterQuality reads the latest measurement and the acceptable range of each water quality parameter.
+func (s ReservoirReadQuerySqlite) loadWaterQuality(reservoirRead *storage.ReservoirRead) error {
+	reservoirRead.WaterQuality = []storage.ReservoirWaterQuality{}
+
+	rows, err := s.DB.Query(`SELECT PARAMETER, UNIT, VALUE, MEASURED_DATE, MIN, MAX, OUT_OF_RANGE
+		FROM RESERVOIR_READ_WATER_QUALITY WHERE RESERVOIR_UID = ? ORDER BY PARAMETER`, reservoirRead.UID)
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		rowsData := struct {
+			Parameter    string
+			Unit         string
+			Value        sql.NullFloat64
+			MeasuredDate sql.NullString
+			Min          sql.NullFloat64
+			Max          sql.NullFloat64
+			OutOfRange   bool
+		}{}
+
+		err = rows.Scan(
+			&rowsData.Parameter,
+			&rowsData.Unit,
+			&rowsData.Value,
+			&rowsData.MeasuredDate,
+			&rowsData.Min,
+			&rowsData.Max,
+			&rowsData.OutOfRange,
+		)
+		if err != nil {
+			return err
+		}
+
+		waterQuality := storage.ReservoirWaterQuality{
+			Parameter:  rowsData.Parameter,
+			Unit:       rowsData.Unit,
+			Value:      nullFloat32(rowsData.Value),
+			Min:        nullFloat32(rowsData.Min),
+			Max:        nullFloat32(rowsData.Max),
+			OutOfRange: rowsData.OutOfRange,
+		}
+
+		if rowsData.MeasuredDate.Valid {
+			measuredDate, err := time.Parse(time.RFC3339, rowsData.MeasuredDate.String)
+			if err != nil {
+				return err
+			}
+
+			waterQuality.MeasuredDate = &measuredDate
+		}
+
+		reservoirRead.WaterQuality = append(reservoirRead.WaterQuality, waterQuality)
+	}
+
+	return rows.Err()
+}
+
+// loadNutrientRecipe reads the fertilizers mixed into the reservoir when it is dosed.
+func (s ReservoirReadQuerySqlite) loadNutrientRecipe(reservoirRead *storage.ReservoirRead) error {
+	reservoirRead.NutrientRecipe = []storage.ReservoirNutrient{}
+
+	rows, err := s.DB.Query(`SELECT MATERIAL_UID, MATERIAL_NAME, AMOUNT_PER_LITER, QUANTITY_UNIT
+		FROM RESERVOIR_READ_NUTRIENT_RECIPE WHERE RESERVOIR_UID = ?`, reservoirRead.UID)
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		rowsData := struct {
+			MaterialUID    string
+			MaterialName   string
+			AmountPerLiter float32
+			QuantityUnit   string
+		}{}
+
+		err = rows.Scan(&rowsData.MaterialUID, &rowsData.MaterialName, &rowsData.AmountPerLiter, &rowsData.QuantityUnit)
+		if err != nil {
+			return err
+		}
+
+		materialUID, err := uuid.FromString(rowsData.MaterialUID)
+		if err != nil {
+			return err
+		}
+
+		reservoirRead.NutrientRecipe = append(reservoirRead.NutrientRecipe, storage.ReservoirNutrient{
+			MaterialUID:    materialUID,
+			MaterialName:   rowsData.MaterialName,
+			AmountPerLiter: rowsData.AmountPerLiter,
+			QuantityUnit:   rowsData.QuantityUnit,
+		})
+	}
+
+	return rows.Err()
+}
+
+// loadPhotos reads the reservoir gallery in its order, and the cover photo with it.
+func (s ReservoirReadQuerySqlite) loadPhotos(reservoirRead *storage.ReservoirRead) error {
+	reservoirRead.Photos = []storage.ReservoirPhoto{}
+
+	rows, err := s.DB.Query(`SELECT UID, FILENAME, MIME_TYPE, SIZE, WIDTH, HEIGHT, CAPTION, TAKEN_DATE, IS_COVER
+		FROM RESERVOIR_READ_PHOTO WHERE RESERVOIR_UID = ? ORDER BY POSITION`, reservoirRead.UID)
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		rowsData := struct {
+			UID       string
+			Filename  string
+			MimeType  string
+			Size      int
+			Width     int
+			Height    int
+			Caption   string
+			TakenDate sql.NullString
+			IsCover   bool
+		}{}
+
+		err = rows.Scan(
+			&rowsData.UID,
+			&rowsData.Filename,
+			&rowsData.MimeType,
+			&rowsData.Size,
+			&rowsData.Width,
+			&rowsData.Height,
+			&rowsData.Caption,
+			&rowsData.TakenDate,
+			&rowsData.IsCover,
+		)
+		if err != nil {
+			return err
+		}
+
+		photoUID, err := uuid.FromString(rowsData.UID)
+		if err != nil {
+			return err
+		}
+
+		photo := storage.ReservoirPhoto{
+			UID:      photoUID,
+			Filename: rowsData.Filename,
+			MimeType: rowsData.MimeType,
+			Size:     rowsData.Size,
+			Width:    rowsData.Width,
+			Height:   rowsData.Height,
+			Caption:  rowsData.Caption,
+			IsCover:  rowsData.IsCover,
+		}
+
+		if rowsData.TakenDate.Valid {
+			takenDate, err := time.Parse(time.RFC3339, rowsData.TakenDate.String)
+			if err != nil {
+				return err
+			}
+
+			photo.TakenDate = &takenDate
+		}
+
+		if photo.IsCover {
+			reservoirRead.Photo = photo
+		}
+
+		reservoirRead.Photos = append(reservoirRead.Photos, photo)
+	}
+
+	return rows.Err()
+}
+
+func nullFloat32(value sql.NullFloat64) *float32 {
+	if !value.Valid {
+		return nil
+	}
+
+	v := float32(value.Float64)
+
+	return &v
+}
+
+// FindLevelsByReservoirID lists the level history of a bucket in the order of the dates.
+// The levels of the same date stay in the order they were recorded.
+func (s ReservoirReadQuerySqlite) FindLevelsByReservoirID(reservoirUID uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		levels := []storage.ReservoirLevelEntry{}
+
+		rows, err := s.DB.Query(`SELECT TYPE, SOURCE, LEVEL, VOLUME, AREA_UID, CROP_UID, LEVEL_DATE
+			FROM RESERVOIR_READ_LEVEL_HISTORY WHERE RESERVOIR_UID = ? ORDER BY LEVEL_DATE, ID`, reservoirUID)
+		if err != nil {
+			result <- query.Result{Error: err}
+			close(result)
+
+			return
+		}
+
+		defer rows.Close()
+
+		for rows.Next() {
+			rowsData := struct {
+				Type      string
+				Source    string
+				Level     float32
+				Volume    float32
+				AreaUID   string
+				CropUID   string
+				LevelDate string
+			}{}
+
+			err = rows.Scan(
+				&rowsData.Type,
+				&rowsData.Source,
+				&rowsData.Level,
+				&rowsData.Volume,
+				&rowsData.AreaUID,
+				&rowsData.CropUID,
+				&rowsData.LevelDate,
+			)
+			if err != nil {
+				result <- query.Result{Error: err}
+				close(result)
+
+				return
+			}
+
+			areaUID, err := uuid.FromString(rowsData.AreaUID)
+			if err != nil {
+				result <- query.Result{Error: err}
+				close(result)
+
+				return
+			}
+
+			cropUID, err := uuid.FromString(rowsData.CropUID)
+			if err != nil {
+				result <- query.Result{Error: err}
+				close(result)
+
+				return
+			}
+
+			levelDate, err := time.Parse(time.RFC3339, rowsData.LevelDate)
+			if err != nil {
+				result <- query.Result{Error: err}
+				close(result)
+
+				return
+			}
+
+			levels = append(levels, storage.ReservoirLevelEntry{
+				Type:    rowsData.Type,
+				Source:  rowsData.Source,
+				Level:   rowsData.Level,
+				Volume:  rowsData.Volume,
+				AreaUID: areaUID,
+				CropUID: cropUID,
+				Date:    levelDate,
+			})
+		}
+
+		result <- query.Result{Result: levels}
+		close(result)
+	}()
+
+	return result
+}
+
+// FindWaterQualityByReservoirID lists the measurements of a reservoir from a date until another, excluded,
+// in the order they were measured. An empty parameter lists all of them and a zero date is not bounded.
+func (s ReservoirReadQuerySqlite) FindWaterQualityByReservoirID(
+	reservoirUID uuid.UUID,
+	parameter string,
+	from, to time.Time,
+) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		measurements := []storage.ReservoirWaterQualityMeasurement{}
+
+		sqlQuery := `SELECT PARAMETER, VALUE, UNIT, SOURCE, MEASURED_DATE
+			FROM RESERVOIR_READ_WATER_QUALITY_HISTORY WHERE RESERVOIR_UID = ?`
+
+		params := []interface{}{reservoirUID}
+
+		if parameter != "" {
+			sqlQuery += ` AND PARAMETER = ?`
+
+			params = append(params, parameter)
+		}
+
+		if !from.IsZero() {
+			sqlQuery += ` AND MEASURED_DATE >= ?`
+
+			params = append(params, from.UTC().Format(time.RFC3339))
+		}
+
+		if !to.IsZero() {
+			sqlQuery += ` AND MEASURED_DATE < ?`
+
+			params = append(params, to.UTC().Format(time.RFC3339))
+		}
+
+		sqlQuery += ` ORDER BY MEASURED_DATE`
+
+		rows, err := s.DB.Query(sqlQuery, params...)
+		if err != nil {
+			result <- query.Result{Error: err}
+			close(result)
+
+			return
+		}
+
+		defer rows.Close()
+
+		for rows.Next() {
+			rowsData := struct {
+				Parameter    string
+				Value        float32
+				Unit         string
+				Source       string
+				MeasuredDate string
+			}{}
+
+			err = rows.Scan(
+				&rowsData.Parameter,
+				&rowsData.Value,
+				&rowsData.Unit,
+				&rowsData.Source,
+				&rowsData.MeasuredDate,
+			)
+			if err != nil {
+				result <- query.Result{Error: err}
+				close(result)
+
+				return
+			}
+
+			measuredDate, err := time.Parse(time.RFC3339, rowsData.MeasuredDate)
+			if err != nil {
+				result <- query.Result{Error: err}
+				close(result)
+
+				return
+			}
+
+			measurements = append(measurements, storage.ReservoirWaterQualityMeasurement{
+				Parameter:    rowsData.Parameter,
+				Value:        rowsData.Value,
+				Unit:         rowsData.Unit,
+				Source:       rowsData.Source,
+				MeasuredDate: measuredDate,
+			})
+		}
+
+		result <- query.Result{Result: measurements}
+		close(result)
+	}()
+
+	return result
+}
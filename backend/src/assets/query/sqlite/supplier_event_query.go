@@ -0,0 +1,77 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/assets/decoder"
+	"github.com/usetania/tania-core/src/assets/query"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+type SupplierEventQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewSupplierEventQuerySqlite(db *sql.DB) query.SupplierEvent {
+	return &SupplierEventQuerySqlite{DB: db}
+}
+
+func (f *SupplierEventQuerySqlite) FindAllByID(uid uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		events := []storage.SupplierEvent{}
+
+		rows, err := f.DB.Query("SELECT * FROM SUPPLIER_EVENT WHERE SUPPLIER_UID = ? ORDER BY VERSION ASC", uid)
+		if err != nil {
+			result <- query.Result{Error: err}
+		}
+
+		rowsData := struct {
+			ID          int
+			SupplierUID string
+			Version     int
+			CreatedDate string
+			Event       []byte
+		}{}
+
+		for rows.Next() {
+			err := rows.Scan(&rowsData.ID, &rowsData.SupplierUID, &rowsData.Version, &rowsData.CreatedDate, &rowsData.Event)
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			wrapper := decoder.SupplierEventWrapper{}
+
+			err = json.Unmarshal(rowsData.Event, &wrapper)
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			supplierUID, err := uuid.FromString(rowsData.SupplierUID)
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			events = append(events, storage.SupplierEvent{
+				SupplierUID: supplierUID,
+				Version:     rowsData.Version,
+				CreatedDate: createdDate,
+				Event:       wrapper.EventData,
+			})
+		}
+
+		result <- query.Result{Result: events}
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,40 @@
+package inmemory
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/assets/repository"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+type EquipmentEventRepositoryInMemory struct {
+	Storage *storage.EquipmentEventStorage
+}
+
+func NewEquipmentEventRepositoryInMemory(s *storage.EquipmentEventStorage) repository.EquipmentEvent {
+	return &EquipmentEventRepositoryInMemory{Storage: s}
+}
+
+func (f *EquipmentEventRepositoryInMemory) Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		for _, v := range events {
+			latestVersion++
+
+			f.Storage.EquipmentEvents = append(f.Storage.EquipmentEvents, storage.EquipmentEvent{
+				EquipmentUID: uid,
+				Version:      latestVersion,
+				Event:        v,
+			})
+		}
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
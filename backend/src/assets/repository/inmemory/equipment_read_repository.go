@@ -0,0 +1,31 @@
+package inmemory
+
+import (
+	"github.com/usetania/tania-core/src/assets/repository"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+type EquipmentReadRepositoryInMemory struct {
+	Storage *storage.EquipmentReadStorage
+}
+
+func NewEquipmentReadRepositoryInMemory(s *storage.EquipmentReadStorage) repository.EquipmentRead {
+	return &EquipmentReadRepositoryInMemory{Storage: s}
+}
+
+func (f *EquipmentReadRepositoryInMemory) Save(equipmentRead *storage.EquipmentRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.EquipmentReadMap[equipmentRead.UID] = *equipmentRead
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
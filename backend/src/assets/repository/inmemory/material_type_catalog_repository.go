@@ -0,0 +1,39 @@
+package inmemory
+
+import (
+	"github.com/usetania/tania-core/src/assets/domain"
+	"github.com/usetania/tania-core/src/assets/repository"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+type MaterialTypeCatalogRepositoryInMemory struct {
+	Storage *storage.MaterialTypeCatalogStorage
+}
+
+func NewMaterialTypeCatalogRepositoryInMemory(s *storage.MaterialTypeCatalogStorage) repository.MaterialTypeCatalog {
+	return &MaterialTypeCatalogRepositoryInMemory{Storage: s}
+}
+
+func (f *MaterialTypeCatalogRepositoryInMemory) Save(catalog *domain.MaterialTypeCatalog) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		saved := *catalog
+		saved.SeededDefaultTypes = make(map[string][]string)
+
+		for c, codes := range catalog.SeededDefaultTypes {
+			saved.SeededDefaultTypes[c] = append([]string{}, codes...)
+		}
+
+		f.Storage.CatalogsByFarmUID[catalog.FarmUID] = saved
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
@@ -21,6 +21,12 @@ func (m *ReservoirServiceMock) FindFarmByID(uid uuid.UUID) (domain.ReservoirFarm
 	return args.Get(0).(domain.ReservoirFarmServiceResult), nil
 }
 
+func (m *ReservoirServiceMock) FindMaterialByID(uid uuid.UUID) (domain.ReservoirMaterialServiceResult, error) {
+	args := m.Called(uid)
+
+	return args.Get(0).(domain.ReservoirMaterialServiceResult), nil
+}
+
 func TestReservoirEventInMemorySave(t *testing.T) {
 	t.Parallel()
 	// Given
@@ -1,6 +1,7 @@
 package inmemory
 
 import (
+	"github.com/gofrs/uuid"
 	"github.com/usetania/tania-core/src/assets/repository"
 	"github.com/usetania/tania-core/src/assets/storage"
 )
@@ -29,3 +30,43 @@ func (f *ReservoirReadRepositoryInMemory) Save(reservoirRead *storage.ReservoirR
 
 	return result
 }
+
+func (f *ReservoirReadRepositoryInMemory) SaveLevel(
+	reservoirUID uuid.UUID,
+	level *storage.ReservoirLevelEntry,
+) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.LevelMap[reservoirUID] = append(f.Storage.LevelMap[reservoirUID], *level)
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *ReservoirReadRepositoryInMemory) SaveWaterQualityMeasurement(
+	reservoirUID uuid.UUID,
+	measurement *storage.ReservoirWaterQualityMeasurement,
+) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.WaterQualityMap[reservoirUID] = append(f.Storage.WaterQualityMap[reservoirUID], *measurement)
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,40 @@
+package inmemory
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/assets/repository"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+type SupplierEventRepositoryInMemory struct {
+	Storage *storage.SupplierEventStorage
+}
+
+func NewSupplierEventRepositoryInMemory(s *storage.SupplierEventStorage) repository.SupplierEvent {
+	return &SupplierEventRepositoryInMemory{Storage: s}
+}
+
+func (f *SupplierEventRepositoryInMemory) Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		for _, v := range events {
+			latestVersion++
+
+			f.Storage.SupplierEvents = append(f.Storage.SupplierEvents, storage.SupplierEvent{
+				SupplierUID: uid,
+				Version:     latestVersion,
+				Event:       v,
+			})
+		}
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,31 @@
+package inmemory
+
+import (
+	"github.com/usetania/tania-core/src/assets/repository"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+type SupplierReadRepositoryInMemory struct {
+	Storage *storage.SupplierReadStorage
+}
+
+func NewSupplierReadRepositoryInMemory(s *storage.SupplierReadStorage) repository.SupplierRead {
+	return &SupplierReadRepositoryInMemory{Storage: s}
+}
+
+func (f *SupplierReadRepositoryInMemory) Save(supplierRead *storage.SupplierRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.SupplierReadMap[supplierRead.UID] = *supplierRead
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,50 @@
+package mysql
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/assets/decoder"
+	"github.com/usetania/tania-core/src/assets/repository"
+	"github.com/usetania/tania-core/src/helper/structhelper"
+)
+
+type EquipmentEventRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewEquipmentEventRepositoryMysql(db *sql.DB) repository.EquipmentEvent {
+	return &EquipmentEventRepositoryMysql{DB: db}
+}
+
+func (f *EquipmentEventRepositoryMysql) Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		for _, v := range events {
+			latestVersion++
+
+			e, err := json.Marshal(decoder.EventWrapper{
+				EventName: structhelper.GetName(v),
+				EventData: v,
+			})
+			if err != nil {
+				result <- err
+			}
+
+			_, err = f.DB.Exec(`INSERT INTO EQUIPMENT_EVENT
+				(EQUIPMENT_UID, VERSION, CREATED_DATE, EVENT) VALUES (?, ?, ?, ?)`,
+				uid.Bytes(), latestVersion, time.Now(), e)
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
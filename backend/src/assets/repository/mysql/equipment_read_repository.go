@@ -0,0 +1,92 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/usetania/tania-core/src/assets/repository"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+type EquipmentReadRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewEquipmentReadRepositoryMysql(db *sql.DB) repository.EquipmentRead {
+	return &EquipmentReadRepositoryMysql{DB: db}
+}
+
+func (f *EquipmentReadRepositoryMysql) Save(equipmentRead *storage.EquipmentRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		defer close(result)
+
+		var locationType, locationUID, locationName, purchaseDate, costAmount, costCurrency interface{}
+
+		if equipmentRead.Location != nil {
+			locationType = equipmentRead.Location.Type
+			locationUID = equipmentRead.Location.UID.Bytes()
+			locationName = equipmentRead.Location.Name
+		}
+
+		if equipmentRead.PurchaseDate != nil {
+			purchaseDate = *equipmentRead.PurchaseDate
+		}
+
+		if equipmentRead.Cost != nil {
+			costAmount = equipmentRead.Cost.Amount
+			costCurrency = equipmentRead.Cost.CurrencyCode
+		}
+
+		_, err := f.DB.Exec(`INSERT INTO EQUIPMENT_READ
+			(UID, NAME, TYPE, SERIAL_NUMBER, FARM_UID, FARM_NAME, LOCATION_TYPE, LOCATION_UID, LOCATION_NAME,
+			PURCHASE_DATE, COST_AMOUNT, COST_CURRENCY, CREATED_DATE)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE NAME = VALUES(NAME), TYPE = VALUES(TYPE), SERIAL_NUMBER = VALUES(SERIAL_NUMBER),
+			FARM_NAME = VALUES(FARM_NAME), LOCATION_TYPE = VALUES(LOCATION_TYPE), LOCATION_UID = VALUES(LOCATION_UID),
+			LOCATION_NAME = VALUES(LOCATION_NAME), PURCHASE_DATE = VALUES(PURCHASE_DATE),
+			COST_AMOUNT = VALUES(COST_AMOUNT), COST_CURRENCY = VALUES(COST_CURRENCY)`,
+			equipmentRead.UID.Bytes(),
+			equipmentRead.Name,
+			equipmentRead.Type,
+			equipmentRead.SerialNumber,
+			equipmentRead.Farm.UID.Bytes(),
+			equipmentRead.Farm.Name,
+			locationType,
+			locationUID,
+			locationName,
+			purchaseDate,
+			costAmount,
+			costCurrency,
+			equipmentRead.CreatedDate)
+		if err != nil {
+			result <- err
+
+			return
+		}
+
+		_, err = f.DB.Exec(`DELETE FROM EQUIPMENT_READ_MAINTENANCE_SCHEDULE WHERE EQUIPMENT_UID = ?`,
+			equipmentRead.UID.Bytes())
+		if err != nil {
+			result <- err
+
+			return
+		}
+
+		for i, v := range equipmentRead.MaintenanceSchedules {
+			_, err = f.DB.Exec(`INSERT INTO EQUIPMENT_READ_MAINTENANCE_SCHEDULE
+				(UID, EQUIPMENT_UID, TITLE, INTERVAL_DAYS, LAST_DONE_DATE, NEXT_DUE_DATE, POSITION)
+				VALUES (?, ?, ?, ?, ?, ?, ?)`,
+				v.UID.Bytes(), equipmentRead.UID.Bytes(), v.Title, v.IntervalDays, v.LastDoneDate, v.NextDueDate, i)
+			if err != nil {
+				result <- err
+
+				return
+			}
+		}
+
+		result <- nil
+	}()
+
+	return result
+}
@@ -48,6 +48,38 @@ func (f *FarmReadRepositoryMysql) Save(farmRead *storage.FarmRead) <-chan error
 			}
 		}
 
+		if farmRead.UnitSystem != "" {
+			_, err := f.DB.Exec(`INSERT INTO FARM_READ_UNIT_SYSTEM (FARM_UID, UNIT_SYSTEM)
+				VALUES (?, ?) ON DUPLICATE KEY UPDATE UNIT_SYSTEM = VALUES(UNIT_SYSTEM)`, farmRead.UID.Bytes(), farmRead.UnitSystem)
+			if err != nil {
+				result <- err
+			}
+		}
+
+		if farmRead.Currency != "" {
+			_, err := f.DB.Exec(`INSERT INTO FARM_READ_CURRENCY (FARM_UID, CURRENCY)
+				VALUES (?, ?) ON DUPLICATE KEY UPDATE CURRENCY = VALUES(CURRENCY)`, farmRead.UID.Bytes(), farmRead.Currency)
+			if err != nil {
+				result <- err
+			}
+		}
+
+		_, err = f.DB.Exec(`DELETE FROM FARM_READ_EXCHANGE_RATE WHERE FARM_UID = ?`, farmRead.UID.Bytes())
+		if err != nil {
+			result <- err
+		}
+
+		for _, v := range farmRead.ExchangeRates {
+			_, err := f.DB.Exec(`INSERT INTO FARM_READ_EXCHANGE_RATE
+				(UID, FARM_UID, FROM_CURRENCY, TO_CURRENCY, RATE, EFFECTIVE_DATE, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?, ?)`,
+				v.UID.Bytes(), farmRead.UID.Bytes(), v.FromCurrency, v.ToCurrency, v.Rate,
+				v.EffectiveDate, v.CreatedDate)
+			if err != nil {
+				result <- err
+			}
+		}
+
 		result <- nil
 		close(result)
 	}()
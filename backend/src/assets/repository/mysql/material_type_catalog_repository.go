@@ -0,0 +1,70 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/usetania/tania-core/src/assets/domain"
+	"github.com/usetania/tania-core/src/assets/repository"
+)
+
+type MaterialTypeCatalogRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewMaterialTypeCatalogRepositoryMysql(db *sql.DB) repository.MaterialTypeCatalog {
+	return &MaterialTypeCatalogRepositoryMysql{DB: db}
+}
+
+// Save replaces the saved catalog of the farm, keeping the order of the types, and its seeded default types.
+func (f *MaterialTypeCatalogRepositoryMysql) Save(catalog *domain.MaterialTypeCatalog) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`DELETE FROM MATERIAL_TYPE_CATALOG WHERE FARM_UID = ?`, catalog.FarmUID.Bytes())
+		if err != nil {
+			result <- err
+			close(result)
+
+			return
+		}
+
+		for _, c := range []string{domain.CatalogPlantType, domain.CatalogChemicalType, domain.CatalogContainerType} {
+			for i, v := range catalog.Types(c) {
+				_, err = f.DB.Exec(`INSERT INTO MATERIAL_TYPE_CATALOG (FARM_UID, CATALOG, CODE, LABEL, POSITION)
+					VALUES (?, ?, ?, ?, ?)`, catalog.FarmUID.Bytes(), c, v.Code, v.Label, i)
+				if err != nil {
+					result <- err
+					close(result)
+
+					return
+				}
+			}
+		}
+
+		_, err = f.DB.Exec(`DELETE FROM MATERIAL_TYPE_CATALOG_DEFAULT WHERE FARM_UID = ?`, catalog.FarmUID.Bytes())
+		if err != nil {
+			result <- err
+			close(result)
+
+			return
+		}
+
+		for c, codes := range catalog.SeededDefaultTypes {
+			for _, code := range codes {
+				_, err = f.DB.Exec(`INSERT INTO MATERIAL_TYPE_CATALOG_DEFAULT (FARM_UID, CATALOG, CODE)
+					VALUES (?, ?, ?)`, catalog.FarmUID.Bytes(), c, code)
+				if err != nil {
+					result <- err
+					close(result)
+
+					return
+				}
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
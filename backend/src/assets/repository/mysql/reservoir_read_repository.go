@@ -3,6 +3,7 @@ package mysql
 import (
 	"database/sql"
 
+	"github.com/gofrs/uuid"
 	"github.com/usetania/tania-core/src/assets/repository"
 	"github.com/usetania/tania-core/src/assets/storage"
 )
@@ -76,9 +77,132 @@ func (f *ReservoirReadRepositoryMysql) Save(reservoirRead *storage.ReservoirRead
 			}
 		}
 
+		var level, levelDate, dailyConsumption, predictedDryDate interface{}
+
+		if reservoirRead.Level != nil {
+			level = reservoirRead.Level.Level
+			levelDate = reservoirRead.Level.Date
+			dailyConsumption = reservoirRead.Level.DailyConsumption
+
+			if reservoirRead.Level.PredictedDryDate != nil {
+				predictedDryDate = *reservoirRead.Level.PredictedDryDate
+			}
+		}
+
+		_, err = f.DB.Exec(`INSERT INTO RESERVOIR_READ_LEVEL
+			(RESERVOIR_UID, WATER_PER_WATERING, LEVEL, LEVEL_DATE, DAILY_CONSUMPTION, PREDICTED_DRY_DATE)
+			VALUES (?, ?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE WATER_PER_WATERING = VALUES(WATER_PER_WATERING), LEVEL = VALUES(LEVEL),
+			LEVEL_DATE = VALUES(LEVEL_DATE), DAILY_CONSUMPTION = VALUES(DAILY_CONSUMPTION),
+			PREDICTED_DRY_DATE = VALUES(PREDICTED_DRY_DATE)`,
+			reservoirRead.UID.Bytes(), reservoirRead.WaterPerWatering, level, levelDate, dailyConsumption, predictedDryDate)
+		if err != nil {
+			result <- err
+		}
+
+		_, err = f.DB.Exec(`DELETE FROM RESERVOIR_READ_WATER_QUALITY WHERE RESERVOIR_UID = ?`, reservoirRead.UID.Bytes())
+		if err != nil {
+			result <- err
+		}
+
+		for _, v := range reservoirRead.WaterQuality {
+			var measuredDate interface{}
+
+			if v.MeasuredDate != nil {
+				measuredDate = *v.MeasuredDate
+			}
+
+			_, err = f.DB.Exec(`INSERT INTO RESERVOIR_READ_WATER_QUALITY
+				(RESERVOIR_UID, PARAMETER, UNIT, VALUE, MEASURED_DATE, MIN, MAX, OUT_OF_RANGE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+				reservoirRead.UID.Bytes(), v.Parameter, v.Unit, v.Value, measuredDate, v.Min, v.Max, v.OutOfRange)
+			if err != nil {
+				result <- err
+			}
+		}
+
+		_, err = f.DB.Exec(`DELETE FROM RESERVOIR_READ_NUTRIENT_RECIPE WHERE RESERVOIR_UID = ?`, reservoirRead.UID.Bytes())
+		if err != nil {
+			result <- err
+		}
+
+		for _, v := range reservoirRead.NutrientRecipe {
+			_, err = f.DB.Exec(`INSERT INTO RESERVOIR_READ_NUTRIENT_RECIPE
+				(RESERVOIR_UID, MATERIAL_UID, MATERIAL_NAME, AMOUNT_PER_LITER, QUANTITY_UNIT)
+				VALUES (?, ?, ?, ?, ?)`,
+				reservoirRead.UID.Bytes(), v.MaterialUID.Bytes(), v.MaterialName, v.AmountPerLiter, v.QuantityUnit)
+			if err != nil {
+				result <- err
+			}
+		}
+
+		_, err = f.DB.Exec(`DELETE FROM RESERVOIR_READ_PHOTO WHERE RESERVOIR_UID = ?`, reservoirRead.UID.Bytes())
+		if err != nil {
+			result <- err
+		}
+
+		for i, v := range reservoirRead.Photos {
+			var takenDate interface{}
+
+			if v.TakenDate != nil {
+				takenDate = *v.TakenDate
+			}
+
+			_, err = f.DB.Exec(`INSERT INTO RESERVOIR_READ_PHOTO
+				(UID, RESERVOIR_UID, FILENAME, MIME_TYPE, SIZE, WIDTH, HEIGHT, CAPTION, TAKEN_DATE, POSITION, IS_COVER)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				v.UID.Bytes(), reservoirRead.UID.Bytes(), v.Filename, v.MimeType, v.Size, v.Width, v.Height,
+				v.Caption, takenDate, i, v.IsCover)
+			if err != nil {
+				result <- err
+			}
+		}
+
 		result <- nil
 		close(result)
 	}()
 
 	return result
 }
+
+// SaveLevel adds a reading, a refill or an estimated consumption to the level history of the bucket.
+func (f *ReservoirReadRepositoryMysql) SaveLevel(
+	reservoirUID uuid.UUID,
+	level *storage.ReservoirLevelEntry,
+) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`INSERT INTO RESERVOIR_READ_LEVEL_HISTORY
+			(RESERVOIR_UID, TYPE, SOURCE, LEVEL, VOLUME, AREA_UID, CROP_UID, LEVEL_DATE)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			reservoirUID.Bytes(), level.Type, level.Source, level.Level, level.Volume, level.AreaUID.Bytes(),
+			level.CropUID.Bytes(), level.Date)
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
+
+// SaveWaterQualityMeasurement adds a measurement to the water quality history of the reservoir.
+func (f *ReservoirReadRepositoryMysql) SaveWaterQualityMeasurement(
+	reservoirUID uuid.UUID,
+	measurement *storage.ReservoirWaterQualityMeasurement,
+) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`INSERT INTO RESERVOIR_READ_WATER_QUALITY_HISTORY
+			(RESERVOIR_UID, PARAMETER, VALUE, UNIT, SOURCE, MEASURED_DATE)
+			VALUES (?, ?, ?, ?, ?, ?)`,
+			reservoirUID.Bytes(), measurement.Parameter, measurement.Value, measurement.Unit, measurement.Source,
+			measurement.MeasuredDate)
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
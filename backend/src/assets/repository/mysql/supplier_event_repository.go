@@ -0,0 +1,50 @@
+package mysql
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/assets/decoder"
+	"github.com/usetania/tania-core/src/assets/repository"
+	"github.com/usetania/tania-core/src/helper/structhelper"
+)
+
+type SupplierEventRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewSupplierEventRepositoryMysql(db *sql.DB) repository.SupplierEvent {
+	return &SupplierEventRepositoryMysql{DB: db}
+}
+
+func (f *SupplierEventRepositoryMysql) Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		for _, v := range events {
+			latestVersion++
+
+			e, err := json.Marshal(decoder.EventWrapper{
+				EventName: structhelper.GetName(v),
+				EventData: v,
+			})
+			if err != nil {
+				result <- err
+			}
+
+			_, err = f.DB.Exec(`INSERT INTO SUPPLIER_EVENT
+				(SUPPLIER_UID, VERSION, CREATED_DATE, EVENT) VALUES (?, ?, ?, ?)`,
+				uid.Bytes(), latestVersion, time.Now(), e)
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,42 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/usetania/tania-core/src/assets/repository"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+type SupplierReadRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewSupplierReadRepositoryMysql(db *sql.DB) repository.SupplierRead {
+	return &SupplierReadRepositoryMysql{DB: db}
+}
+
+func (f *SupplierReadRepositoryMysql) Save(supplierRead *storage.SupplierRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`INSERT INTO SUPPLIER_READ
+				(UID, NAME, PHONE, EMAIL, ADDRESS, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?)
+				ON DUPLICATE KEY UPDATE NAME = VALUES(NAME), PHONE = VALUES(PHONE), EMAIL = VALUES(EMAIL),
+				ADDRESS = VALUES(ADDRESS)`,
+			supplierRead.UID.Bytes(),
+			supplierRead.Name,
+			supplierRead.Phone,
+			supplierRead.Email,
+			supplierRead.Address,
+			supplierRead.CreatedDate)
+		if err != nil {
+			result <- err
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
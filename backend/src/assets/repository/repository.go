@@ -55,6 +55,8 @@ type ReservoirEvent interface {
 
 type ReservoirRead interface {
 	Save(reservoirRead *storage.ReservoirRead) <-chan error
+	SaveLevel(reservoirUID uuid.UUID, level *storage.ReservoirLevelEntry) <-chan error
+	SaveWaterQualityMeasurement(reservoirUID uuid.UUID, measurement *storage.ReservoirWaterQualityMeasurement) <-chan error
 }
 
 func NewReservoirFromHistory(events []storage.ReservoirEvent) *domain.Reservoir {
@@ -93,3 +95,43 @@ func (w MaterialEventTypeWrapper) Code() string {
 type MaterialRead interface {
 	Save(materialRead *storage.MaterialRead) <-chan error
 }
+
+type SupplierEvent interface {
+	Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error
+}
+
+type SupplierRead interface {
+	Save(supplierRead *storage.SupplierRead) <-chan error
+}
+
+type MaterialTypeCatalog interface {
+	Save(catalog *domain.MaterialTypeCatalog) <-chan error
+}
+
+type EquipmentEvent interface {
+	Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error
+}
+
+type EquipmentRead interface {
+	Save(equipmentRead *storage.EquipmentRead) <-chan error
+}
+
+func NewSupplierFromHistory(events []storage.SupplierEvent) *domain.Supplier {
+	state := &domain.Supplier{}
+	for _, v := range events {
+		state.Transition(v.Event)
+		state.Version++
+	}
+
+	return state
+}
+
+func NewEquipmentFromHistory(events []storage.EquipmentEvent) *domain.Equipment {
+	state := &domain.Equipment{}
+	for _, v := range events {
+		state.Transition(v.Event)
+		state.Version++
+	}
+
+	return state
+}
@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/usetania/tania-core/src/assets/repository"
@@ -57,6 +58,70 @@ func (f *AreaReadRepositorySqlite) Save(areaRead *storage.AreaRead) <-chan error
 					}
 				}
 			}
+
+			if areaRead.RetiredDate != nil {
+				_, err := f.DB.Exec(`INSERT OR REPLACE INTO AREA_READ_RETIRED (AREA_UID, RETIRED_DATE)
+					VALUES (?, ?)`, areaRead.UID, areaRead.RetiredDate.Format(time.RFC3339))
+				if err != nil {
+					result <- err
+					close(result)
+
+					return
+				}
+			}
+
+			if areaRead.Boundary != nil {
+				boundary, err := json.Marshal(areaRead.Boundary)
+				if err != nil {
+					result <- err
+				}
+
+				_, err = f.DB.Exec(`INSERT OR REPLACE INTO AREA_READ_BOUNDARY (AREA_UID, BOUNDARY)
+					VALUES (?, ?)`, areaRead.UID, string(boundary))
+				if err != nil {
+					result <- err
+				}
+			}
+
+			if areaRead.Capacity != nil {
+				capacity, err := json.Marshal(areaRead.Capacity)
+				if err != nil {
+					result <- err
+				}
+
+				_, err = f.DB.Exec(`INSERT OR REPLACE INTO AREA_READ_CAPACITY (AREA_UID, CAPACITY)
+					VALUES (?, ?)`, areaRead.UID, string(capacity))
+				if err != nil {
+					result <- err
+				}
+			} else {
+				_, err := f.DB.Exec(`DELETE FROM AREA_READ_CAPACITY WHERE AREA_UID = ?`, areaRead.UID)
+				if err != nil {
+					result <- err
+				}
+			}
+
+			_, err = f.DB.Exec(`DELETE FROM AREA_READ_PHOTO WHERE AREA_UID = ?`, areaRead.UID)
+			if err != nil {
+				result <- err
+			}
+
+			for i, v := range areaRead.Photos {
+				var takenDate interface{}
+
+				if v.TakenDate != nil {
+					takenDate = v.TakenDate.Format(time.RFC3339)
+				}
+
+				_, err = f.DB.Exec(`INSERT INTO AREA_READ_PHOTO
+					(UID, AREA_UID, FILENAME, MIME_TYPE, SIZE, WIDTH, HEIGHT, CAPTION, TAKEN_DATE, POSITION, IS_COVER)
+					VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+					v.UID, areaRead.UID, v.Filename, v.MimeType, v.Size, v.Width, v.Height,
+					v.Caption, takenDate, i, v.IsCover)
+				if err != nil {
+					result <- err
+				}
+			}
 		} else {
 			_, err := f.DB.Exec(`INSERT INTO AREA_READ
 				(UID, NAME, SIZE_UNIT, SIZE, TYPE, LOCATION, PHOTO_FILENAME, PHOTO_MIMETYPE,
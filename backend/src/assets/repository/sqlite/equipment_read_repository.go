@@ -0,0 +1,94 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/usetania/tania-core/src/assets/repository"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+type EquipmentReadRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewEquipmentReadRepositorySqlite(db *sql.DB) repository.EquipmentRead {
+	return &EquipmentReadRepositorySqlite{DB: db}
+}
+
+func (f *EquipmentReadRepositorySqlite) Save(equipmentRead *storage.EquipmentRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		defer close(result)
+
+		var locationType, locationUID, locationName, purchaseDate, costAmount, costCurrency interface{}
+
+		if equipmentRead.Location != nil {
+			locationType = equipmentRead.Location.Type
+			locationUID = equipmentRead.Location.UID
+			locationName = equipmentRead.Location.Name
+		}
+
+		if equipmentRead.PurchaseDate != nil {
+			purchaseDate = equipmentRead.PurchaseDate.Format(time.RFC3339)
+		}
+
+		if equipmentRead.Cost != nil {
+			costAmount = equipmentRead.Cost.Amount
+			costCurrency = equipmentRead.Cost.CurrencyCode
+		}
+
+		_, err := f.DB.Exec(`INSERT OR REPLACE INTO EQUIPMENT_READ
+			(UID, NAME, TYPE, SERIAL_NUMBER, FARM_UID, FARM_NAME, LOCATION_TYPE, LOCATION_UID, LOCATION_NAME,
+			PURCHASE_DATE, COST_AMOUNT, COST_CURRENCY, CREATED_DATE)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			equipmentRead.UID,
+			equipmentRead.Name,
+			equipmentRead.Type,
+			equipmentRead.SerialNumber,
+			equipmentRead.Farm.UID,
+			equipmentRead.Farm.Name,
+			locationType,
+			locationUID,
+			locationName,
+			purchaseDate,
+			costAmount,
+			costCurrency,
+			equipmentRead.CreatedDate.Format(time.RFC3339))
+		if err != nil {
+			result <- err
+
+			return
+		}
+
+		_, err = f.DB.Exec(`DELETE FROM EQUIPMENT_READ_MAINTENANCE_SCHEDULE WHERE EQUIPMENT_UID = ?`, equipmentRead.UID)
+		if err != nil {
+			result <- err
+
+			return
+		}
+
+		for i, v := range equipmentRead.MaintenanceSchedules {
+			var lastDoneDate interface{}
+
+			if v.LastDoneDate != nil {
+				lastDoneDate = v.LastDoneDate.Format(time.RFC3339)
+			}
+
+			_, err = f.DB.Exec(`INSERT INTO EQUIPMENT_READ_MAINTENANCE_SCHEDULE
+				(UID, EQUIPMENT_UID, TITLE, INTERVAL_DAYS, LAST_DONE_DATE, NEXT_DUE_DATE, POSITION)
+				VALUES (?, ?, ?, ?, ?, ?, ?)`,
+				v.UID, equipmentRead.UID, v.Title, v.IntervalDays, lastDoneDate, v.NextDueDate.Format(time.RFC3339), i)
+			if err != nil {
+				result <- err
+
+				return
+			}
+		}
+
+		result <- nil
+	}()
+
+	return result
+}
@@ -49,6 +49,38 @@ func (f *FarmReadRepositorySqlite) Save(farmRead *storage.FarmRead) <-chan error
 			}
 		}
 
+		if farmRead.UnitSystem != "" {
+			_, err := f.DB.Exec(`INSERT OR REPLACE INTO FARM_READ_UNIT_SYSTEM (FARM_UID, UNIT_SYSTEM)
+				VALUES (?, ?)`, farmRead.UID, farmRead.UnitSystem)
+			if err != nil {
+				result <- err
+			}
+		}
+
+		if farmRead.Currency != "" {
+			_, err := f.DB.Exec(`INSERT OR REPLACE INTO FARM_READ_CURRENCY (FARM_UID, CURRENCY)
+				VALUES (?, ?)`, farmRead.UID, farmRead.Currency)
+			if err != nil {
+				result <- err
+			}
+		}
+
+		_, err = f.DB.Exec(`DELETE FROM FARM_READ_EXCHANGE_RATE WHERE FARM_UID = ?`, farmRead.UID)
+		if err != nil {
+			result <- err
+		}
+
+		for _, v := range farmRead.ExchangeRates {
+			_, err := f.DB.Exec(`INSERT INTO FARM_READ_EXCHANGE_RATE
+				(UID, FARM_UID, FROM_CURRENCY, TO_CURRENCY, RATE, EFFECTIVE_DATE, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?, ?)`,
+				v.UID, farmRead.UID, v.FromCurrency, v.ToCurrency, v.Rate,
+				v.EffectiveDate.Format(time.RFC3339), v.CreatedDate.Format(time.RFC3339))
+			if err != nil {
+				result <- err
+			}
+		}
+
 		result <- nil
 		close(result)
 	}()
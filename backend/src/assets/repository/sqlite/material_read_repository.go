@@ -28,16 +28,16 @@ func (f *MaterialReadRepositorySqlite) Save(materialRead *storage.MaterialRead)
 			result <- err
 		}
 
-		var typeData string
+		var typeData, typeLabel string
 		switch t := materialRead.Type.(type) {
 		case domain.MaterialTypeSeed:
-			typeData = t.PlantType.Code
+			typeData, typeLabel = t.PlantType.Code, t.PlantType.Label
 		case domain.MaterialTypePlant:
-			typeData = t.PlantType.Code
+			typeData, typeLabel = t.PlantType.Code, t.PlantType.Label
 		case domain.MaterialTypeAgrochemical:
-			typeData = t.ChemicalType.Code
+			typeData, typeLabel = t.ChemicalType.Code, t.ChemicalType.Label
 		case domain.MaterialTypeSeedingContainer:
-			typeData = t.ContainerType.Code
+			typeData, typeLabel = t.ContainerType.Code, t.ContainerType.Label
 		}
 
 		expirationDate := ""
@@ -90,6 +90,39 @@ func (f *MaterialReadRepositorySqlite) Save(materialRead *storage.MaterialRead)
 			}
 		}
 
+		if materialRead.ReorderPoint != nil {
+			_, err := f.DB.Exec(`INSERT OR REPLACE INTO MATERIAL_READ_REORDER_POINT (MATERIAL_UID, REORDER_POINT)
+				VALUES (?, ?)`, materialRead.UID, *materialRead.ReorderPoint)
+			if err != nil {
+				result <- err
+			}
+		} else {
+			_, err := f.DB.Exec(`DELETE FROM MATERIAL_READ_REORDER_POINT WHERE MATERIAL_UID = ?`, materialRead.UID)
+			if err != nil {
+				result <- err
+			}
+		}
+
+		// The label of the catalog type is kept, as the catalog of the farm may change it.
+		if typeLabel != "" {
+			_, err := f.DB.Exec(`INSERT OR REPLACE INTO MATERIAL_READ_TYPE_LABEL (MATERIAL_UID, LABEL)
+				VALUES (?, ?)`, materialRead.UID, typeLabel)
+			if err != nil {
+				result <- err
+				close(result)
+
+				return
+			}
+		} else {
+			_, err := f.DB.Exec(`DELETE FROM MATERIAL_READ_TYPE_LABEL WHERE MATERIAL_UID = ?`, materialRead.UID)
+			if err != nil {
+				result <- err
+				close(result)
+
+				return
+			}
+		}
+
 		result <- nil
 		close(result)
 	}()
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/usetania/tania-core/src/assets/repository"
 	"github.com/usetania/tania-core/src/assets/storage"
 )
@@ -77,9 +78,130 @@ func (f *ReservoirReadRepositorySqlite) Save(reservoirRead *storage.ReservoirRea
 			}
 		}
 
+		var level, levelDate, dailyConsumption, predictedDryDate interface{}
+
+		if reservoirRead.Level != nil {
+			level = reservoirRead.Level.Level
+			levelDate = reservoirRead.Level.Date.Format(time.RFC3339)
+			dailyConsumption = reservoirRead.Level.DailyConsumption
+
+			if reservoirRead.Level.PredictedDryDate != nil {
+				predictedDryDate = reservoirRead.Level.PredictedDryDate.Format(time.RFC3339)
+			}
+		}
+
+		_, err = f.DB.Exec(`INSERT OR REPLACE INTO RESERVOIR_READ_LEVEL
+			(RESERVOIR_UID, WATER_PER_WATERING, LEVEL, LEVEL_DATE, DAILY_CONSUMPTION, PREDICTED_DRY_DATE)
+			VALUES (?, ?, ?, ?, ?, ?)`,
+			reservoirRead.UID, reservoirRead.WaterPerWatering, level, levelDate, dailyConsumption, predictedDryDate)
+		if err != nil {
+			result <- err
+		}
+
+		_, err = f.DB.Exec(`DELETE FROM RESERVOIR_READ_WATER_QUALITY WHERE RESERVOIR_UID = ?`, reservoirRead.UID)
+		if err != nil {
+			result <- err
+		}
+
+		for _, v := range reservoirRead.WaterQuality {
+			var measuredDate interface{}
+
+			if v.MeasuredDate != nil {
+				measuredDate = v.MeasuredDate.Format(time.RFC3339)
+			}
+
+			_, err = f.DB.Exec(`INSERT INTO RESERVOIR_READ_WATER_QUALITY
+				(RESERVOIR_UID, PARAMETER, UNIT, VALUE, MEASURED_DATE, MIN, MAX, OUT_OF_RANGE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+				reservoirRead.UID, v.Parameter, v.Unit, v.Value, measuredDate, v.Min, v.Max, v.OutOfRange)
+			if err != nil {
+				result <- err
+			}
+		}
+
+		_, err = f.DB.Exec(`DELETE FROM RESERVOIR_READ_NUTRIENT_RECIPE WHERE RESERVOIR_UID = ?`, reservoirRead.UID)
+		if err != nil {
+			result <- err
+		}
+
+		for _, v := range reservoirRead.NutrientRecipe {
+			_, err = f.DB.Exec(`INSERT INTO RESERVOIR_READ_NUTRIENT_RECIPE
+				(RESERVOIR_UID, MATERIAL_UID, MATERIAL_NAME, AMOUNT_PER_LITER, QUANTITY_UNIT)
+				VALUES (?, ?, ?, ?, ?)`,
+				reservoirRead.UID, v.MaterialUID, v.MaterialName, v.AmountPerLiter, v.QuantityUnit)
+			if err != nil {
+				result <- err
+			}
+		}
+
+		_, err = f.DB.Exec(`DELETE FROM RESERVOIR_READ_PHOTO WHERE RESERVOIR_UID = ?`, reservoirRead.UID)
+		if err != nil {
+			result <- err
+		}
+
+		for i, v := range reservoirRead.Photos {
+			var takenDate interface{}
+
+			if v.TakenDate != nil {
+				takenDate = v.TakenDate.Format(time.RFC3339)
+			}
+
+			_, err = f.DB.Exec(`INSERT INTO RESERVOIR_READ_PHOTO
+				(UID, RESERVOIR_UID, FILENAME, MIME_TYPE, SIZE, WIDTH, HEIGHT, CAPTION, TAKEN_DATE, POSITION, IS_COVER)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				v.UID, reservoirRead.UID, v.Filename, v.MimeType, v.Size, v.Width, v.Height, v.Caption, takenDate, i, v.IsCover)
+			if err != nil {
+				result <- err
+			}
+		}
+
 		result <- nil
 		close(result)
 	}()
 
 	return result
 }
+
+// SaveLevel adds a reading, a refill or an estimated consumption to the level history of the bucket.
+// Its date is kept in UTC, so the history is listed in the order of the dates.
+func (f *ReservoirReadRepositorySqlite) SaveLevel(
+	reservoirUID uuid.UUID,
+	level *storage.ReservoirLevelEntry,
+) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`INSERT INTO RESERVOIR_READ_LEVEL_HISTORY
+			(RESERVOIR_UID, TYPE, SOURCE, LEVEL, VOLUME, AREA_UID, CROP_UID, LEVEL_DATE)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			reservoirUID, level.Type, level.Source, level.Level, level.Volume, level.AreaUID, level.CropUID,
+			level.Date.UTC().Format(time.RFC3339))
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
+
+// SaveWaterQualityMeasurement adds a measurement to the water quality history of the reservoir.
+// Its date is kept in UTC, so the history can be listed between two dates.
+func (f *ReservoirReadRepositorySqlite) SaveWaterQualityMeasurement(
+	reservoirUID uuid.UUID,
+	measurement *storage.ReservoirWaterQualityMeasurement,
+) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`INSERT INTO RESERVOIR_READ_WATER_QUALITY_HISTORY
+			(RESERVOIR_UID, PARAMETER, VALUE, UNIT, SOURCE, MEASURED_DATE)
+			VALUES (?, ?, ?, ?, ?, ?)`,
+			reservoirUID, measurement.Parameter, measurement.Value, measurement.Unit, measurement.Source,
+			measurement.MeasuredDate.UTC().Format(time.RFC3339))
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,50 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/assets/decoder"
+	"github.com/usetania/tania-core/src/assets/repository"
+	"github.com/usetania/tania-core/src/helper/structhelper"
+)
+
+type SupplierEventRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewSupplierEventRepositorySqlite(db *sql.DB) repository.SupplierEvent {
+	return &SupplierEventRepositorySqlite{DB: db}
+}
+
+func (f *SupplierEventRepositorySqlite) Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		for _, v := range events {
+			latestVersion++
+
+			e, err := json.Marshal(decoder.EventWrapper{
+				EventName: structhelper.GetName(v),
+				EventData: v,
+			})
+			if err != nil {
+				result <- err
+			}
+
+			_, err = f.DB.Exec(`INSERT INTO SUPPLIER_EVENT
+				(SUPPLIER_UID, VERSION, CREATED_DATE, EVENT) VALUES (?, ?, ?, ?)`,
+				uid, latestVersion, time.Now().Format(time.RFC3339), e)
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
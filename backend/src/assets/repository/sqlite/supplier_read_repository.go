@@ -0,0 +1,41 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/usetania/tania-core/src/assets/repository"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+type SupplierReadRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewSupplierReadRepositorySqlite(db *sql.DB) repository.SupplierRead {
+	return &SupplierReadRepositorySqlite{DB: db}
+}
+
+func (f *SupplierReadRepositorySqlite) Save(supplierRead *storage.SupplierRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`INSERT OR REPLACE INTO SUPPLIER_READ
+				(UID, NAME, PHONE, EMAIL, ADDRESS, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?)`,
+			supplierRead.UID,
+			supplierRead.Name,
+			supplierRead.Phone,
+			supplierRead.Email,
+			supplierRead.Address,
+			supplierRead.CreatedDate.Format(time.RFC3339))
+		if err != nil {
+			result <- err
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,168 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/usetania/tania-core/src/assets/domain"
+	"github.com/usetania/tania-core/src/assets/query"
+	"github.com/usetania/tania-core/src/assets/repository"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+// AreaCapacityStatus is the capacity of an area with what it currently holds.
+// The used ratio is only given when the area declares a capacity, 1 means the area is full.
+type AreaCapacityStatus struct {
+	AreaUID   uuid.UUID             `json:"area_id"`
+	Capacity  *storage.AreaCapacity `json:"capacity"`
+	Occupancy domain.AreaOccupancy  `json:"occupancy"`
+	UsedRatio *float64              `json:"used_ratio"`
+}
+
+// GetAreaCapacity is a FarmServer's handler to get the capacity and the occupancy of an area.
+func (s *FarmServer) GetAreaCapacity(c echo.Context) error {
+	data := make(map[string]AreaCapacityStatus)
+
+	areaUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	queryResult := <-s.AreaReadQuery.FindByID(areaUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	areaRead, ok := queryResult.Result.(storage.AreaRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if areaRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NotFound, "id"))
+	}
+
+	occupancy, err := s.findAreaOccupancy(areaRead.UID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	status := AreaCapacityStatus{
+		AreaUID:   areaRead.UID,
+		Capacity:  areaRead.Capacity,
+		Occupancy: occupancy,
+	}
+
+	if areaRead.Capacity != nil {
+		ratio := domain.AreaCapacity(*areaRead.Capacity).UsedRatio(domain.AreaSize(areaRead.Size), occupancy)
+		status.UsedRatio = &ratio
+	}
+
+	data["data"] = status
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// UpdateAreaCapacity is a FarmServer's handler to declare how many plants or trays an area can hold,
+// or the spacing of the plants to derive it from the area size.
+func (s *FarmServer) UpdateAreaCapacity(c echo.Context) error {
+	validation := RequestValidation{}
+
+	capacity, err := validation.ValidateAreaCapacity(
+		c.FormValue("capacity_type"),
+		c.FormValue("capacity_value"),
+		c.FormValue("spacing"),
+		c.FormValue("plant_type_spacings"),
+	)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.changeAreaCapacity(c, &capacity)
+}
+
+// RemoveAreaCapacity is a FarmServer's handler to remove the capacity of an area, so it is not limited.
+func (s *FarmServer) RemoveAreaCapacity(c echo.Context) error {
+	return s.changeAreaCapacity(c, nil)
+}
+
+func (s *FarmServer) changeAreaCapacity(c echo.Context, capacity *domain.AreaCapacity) error {
+	data := make(map[string]DetailArea)
+
+	areaUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	eventQueryResult := <-s.AreaEventQuery.FindAllByID(areaUID)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.AreaEvent)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if len(events) == 0 {
+		return Error(c, NewRequestValidationError(NotFound, "id"))
+	}
+
+	area := repository.NewAreaFromHistory(events)
+
+	catalog, err := s.findMaterialTypeCatalog(area.FarmUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = area.ChangeCapacity(capacity, catalog)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	err = <-s.AreaEventRepo.Save(area.UID, area.Version, area.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Publish //
+	s.publishUncommittedEvents(area)
+
+	detailArea, err := MapToDetailArea(s, *area)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data["data"] = detailArea
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// findAreaOccupancy counts the plants and the trays currently in an area.
+func (s *FarmServer) findAreaOccupancy(areaUID uuid.UUID) (domain.AreaOccupancy, error) {
+	queryResult := <-s.CropReadQuery.FindAllPlantsByArea(areaUID)
+	if queryResult.Error != nil {
+		return domain.AreaOccupancy{}, queryResult.Error
+	}
+
+	plants, ok := queryResult.Result.([]query.AreaPlantResult)
+	if !ok {
+		return domain.AreaOccupancy{}, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	occupancy := domain.AreaOccupancy{PlantsByType: make(map[string]int)}
+
+	for _, v := range plants {
+		trayCells := 0
+		if v.ContainerType == domain.ContainerTypeTray {
+			trayCells = v.ContainerCell
+		}
+
+		occupancy.AddPlants(v.PlantType, v.Quantity, trayCells)
+	}
+
+	return occupancy, nil
+}
@@ -0,0 +1,258 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/usetania/tania-core/src/assets/domain"
+	"github.com/usetania/tania-core/src/assets/geoimport"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+const (
+	maxImportFileSize = 20 << 20
+	maxAreaNameLength = 100
+)
+
+// AreaImportResult is a feature of the imported file with the area created from it.
+// The area UID is only given once the area is saved.
+type AreaImportResult struct {
+	Index      int                  `json:"index"`
+	UID        uuid.UUID            `json:"uid"`
+	Name       string               `json:"name"`
+	Size       storage.AreaSize     `json:"size"`
+	Boundary   storage.AreaBoundary `json:"boundary"`
+	Properties map[string]string    `json:"properties"`
+	Error      string               `json:"error,omitempty"`
+}
+
+// ImportAreas creates areas from the polygons of a GeoJSON, KML or zipped Shapefile file.
+// With `preview=true` nothing is saved, the parsed features are returned with the validation error
+// of each area. Otherwise the selected `features`, or all of them, are created only when all are valid.
+func (s *FarmServer) ImportAreas(c echo.Context) error {
+	validation := RequestValidation{}
+
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	reservoirUID, err := uuid.FromString(c.FormValue("reservoir_id"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(ParseFailed, "reservoir_id"))
+	}
+
+	// Validation //
+	reservoir, err := validation.ValidateReservoir(*s, reservoirUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	farm, err := validation.ValidateFarm(*s, farmUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	location, err := validation.ValidateAreaLocation(c.FormValue("location"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	sizeUnit := c.FormValue("size_unit")
+	if sizeUnit == "" {
+		sizeUnit = domain.SquareMeter
+	}
+
+	unit := domain.GetAreaUnit(sizeUnit)
+	if unit == (domain.AreaUnit{}) {
+		return Error(c, NewRequestValidationError(InvalidOption, "size_unit"))
+	}
+
+	features, err := s.readImportFile(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	selected, err := selectImportFeatures(c.FormValue("features"), len(features))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	preview := c.FormValue("preview") == "true"
+	areas := []*domain.Area{}
+	results := []AreaImportResult{}
+
+	for _, i := range selected {
+		result := AreaImportResult{
+			Index:      i,
+			Name:       importedAreaName(features[i], c.FormValue("name_property"), i),
+			Properties: features[i].Properties,
+		}
+
+		area, err := s.createImportedArea(
+			farm.UID,
+			reservoir.UID,
+			result.Name,
+			c.FormValue("type"),
+			location,
+			unit,
+			features[i],
+		)
+		if err != nil {
+			if !preview {
+				return Error(c, fmt.Errorf("feature %d %s: %w", i, result.Name, err))
+			}
+
+			result.Error = err.Error()
+		} else {
+			result.Size = storage.AreaSize(area.Size)
+			result.Boundary = storage.AreaBoundary(*area.Boundary)
+
+			areas = append(areas, area)
+		}
+
+		results = append(results, result)
+	}
+
+	if !preview {
+		// Persists //
+		for i, area := range areas {
+			err = <-s.AreaEventRepo.Save(area.UID, area.Version, area.UncommittedChanges)
+			if err != nil {
+				return Error(c, err)
+			}
+
+			// Publish //
+			s.publishUncommittedEvents(area)
+
+			results[i].UID = area.UID
+		}
+	}
+
+	data := make(map[string][]AreaImportResult)
+	data["data"] = results
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *FarmServer) readImportFile(c echo.Context) ([]geoimport.Feature, error) {
+	file, err := c.FormFile("file")
+	if err != nil {
+		return nil, NewRequestValidationError(Required, "file")
+	}
+
+	if file.Size > maxImportFileSize {
+		return nil, RequestValidationError{
+			FieldName:    "file",
+			ErrorCode:    InvalidOption,
+			ErrorMessage: "File is too large",
+		}
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	b, err := io.ReadAll(io.LimitReader(src, maxImportFileSize))
+	if err != nil {
+		return nil, err
+	}
+
+	features, err := geoimport.Parse(file.Filename, b)
+	if err != nil {
+		return nil, RequestValidationError{
+			FieldName:    "file",
+			ErrorCode:    ParseFailed,
+			ErrorMessage: err.Error(),
+		}
+	}
+
+	return features, nil
+}
+
+func (s *FarmServer) createImportedArea(
+	farmUID, reservoirUID uuid.UUID,
+	name, areaType, location string,
+	unit domain.AreaUnit,
+	feature geoimport.Feature,
+) (*domain.Area, error) {
+	boundary, err := domain.NewAreaBoundary(feature.Polygon)
+	if err != nil {
+		return nil, err
+	}
+
+	area, err := domain.CreateArea(s.AreaService, farmUID, reservoirUID, name, areaType, boundary.Size(unit), location)
+	if err != nil {
+		return nil, err
+	}
+
+	err = area.ChangeBoundary(boundary)
+	if err != nil {
+		return nil, err
+	}
+
+	return area, nil
+}
+
+// selectImportFeatures reads the comma separated feature indexes. All features are selected when empty,
+// an index can only be selected once.
+func selectImportFeatures(param string, total int) ([]int, error) {
+	selected := []int{}
+	seen := map[int]bool{}
+
+	if param == "" {
+		for i := 0; i < total; i++ {
+			selected = append(selected, i)
+		}
+
+		return selected, nil
+	}
+
+	for _, v := range strings.Split(param, ",") {
+		i, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return nil, NewRequestValidationError(ParseFailed, "features")
+		}
+
+		if i < 0 || i >= total || seen[i] {
+			return nil, NewRequestValidationError(InvalidOption, "features")
+		}
+
+		seen[i] = true
+		selected = append(selected, i)
+	}
+
+	return selected, nil
+}
+
+// importedAreaName reads the area name from the feature properties. Area names only allow
+// letters, numbers, spaces, hyphens and underscores, so the other characters are removed.
+func importedAreaName(feature geoimport.Feature, nameProperty string, index int) string {
+	keys := []string{"name", "title", "label"}
+	if nameProperty != "" {
+		keys = append([]string{nameProperty}, keys...)
+	}
+
+	words := strings.FieldsFunc(feature.Property(keys...), func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_')
+	})
+
+	name := strings.Trim(strings.Join(words, " "), "-_ ")
+	if name == "" {
+		return fmt.Sprintf("Imported Area %d", index+1)
+	}
+
+	if len(name) > maxAreaNameLength {
+		name = strings.TrimRight(name[:maxAreaNameLength], "-_ ")
+	}
+
+	return name
+}
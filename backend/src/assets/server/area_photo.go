@@ -0,0 +1,286 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/usetania/tania-core/config"
+	"github.com/usetania/tania-core/src/assets/domain"
+	"github.com/usetania/tania-core/src/assets/repository"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+// SaveAreaPhoto is a FarmServer's handler to add a photo to the area gallery,
+// with its caption and the date it was taken.
+func (s *FarmServer) SaveAreaPhoto(c echo.Context) error {
+	areaUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	photo, err := c.FormFile("photo")
+	if err != nil {
+		return Error(c, NewRequestValidationError(Required, "photo"))
+	}
+
+	takenDate, err := parsePhotoTakenDate(c.FormValue("taken_date"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	area, err := s.findAreaFromHistory(areaUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	uploaded, err := s.uploadGalleryPhoto(photo, *config.Config.UploadPathArea)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = area.AddPhoto(domain.AreaPhoto{
+		UID:       uploaded.UID,
+		Filename:  uploaded.Filename,
+		MimeType:  uploaded.MimeType,
+		Size:      uploaded.Size,
+		Width:     uploaded.Width,
+		Height:    uploaded.Height,
+		Caption:   c.FormValue("caption"),
+		TakenDate: takenDate,
+	})
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveAreaChanges(c, area)
+}
+
+// GetAreaGallery is a FarmServer's handler to list the photos of the area gallery in their order.
+func (s *FarmServer) GetAreaGallery(c echo.Context) error {
+	data := make(map[string][]storage.AreaPhoto)
+
+	areaUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	area, err := s.findAreaFromHistory(areaUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data["data"] = MapToAreaPhotos(*area)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// GetAreaPhotoByID is a FarmServer's handler to serve the file of a photo of the area gallery,
+// as a thumb, medium or original size.
+func (s *FarmServer) GetAreaPhotoByID(c echo.Context) error {
+	areaUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	photoUID, err := uuid.FromString(c.Param("photo_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	area, err := s.findAreaFromHistory(areaUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	photo := area.FindPhotoByID(photoUID)
+	if photo == nil {
+		return Error(c, NewRequestValidationError(NotFound, "photo_id"))
+	}
+
+	return s.serveGalleryPhoto(c, *config.Config.UploadPathArea, photo.Filename)
+}
+
+// UpdateAreaPhoto is a FarmServer's handler to change the caption and the taken date of a photo.
+func (s *FarmServer) UpdateAreaPhoto(c echo.Context) error {
+	areaUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	photoUID, err := uuid.FromString(c.Param("photo_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	takenDate, err := parsePhotoTakenDate(c.FormValue("taken_date"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	area, err := s.findAreaFromHistory(areaUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = area.UpdatePhoto(photoUID, c.FormValue("caption"), takenDate)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveAreaChanges(c, area)
+}
+
+// RemoveAreaPhoto is a FarmServer's handler to remove a photo from the area gallery.
+func (s *FarmServer) RemoveAreaPhoto(c echo.Context) error {
+	areaUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	photoUID, err := uuid.FromString(c.Param("photo_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	area, err := s.findAreaFromHistory(areaUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	photo := area.FindPhotoByID(photoUID)
+	if photo == nil {
+		return Error(c, NewRequestValidationError(NotFound, "photo_id"))
+	}
+
+	filename := photo.Filename
+
+	err = area.RemovePhoto(photoUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = s.commitAreaChanges(area)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	s.removeGalleryPhotoFiles(*config.Config.UploadPathArea, filename)
+
+	return s.respondAreaDetail(c, area)
+}
+
+// ChangeAreaCoverPhoto is a FarmServer's handler to make a photo of the gallery the area photo.
+func (s *FarmServer) ChangeAreaCoverPhoto(c echo.Context) error {
+	areaUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	photoUID, err := uuid.FromString(c.Param("photo_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	area, err := s.findAreaFromHistory(areaUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = area.ChangeCoverPhoto(photoUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveAreaChanges(c, area)
+}
+
+// ReorderAreaPhotos is a FarmServer's handler to sort the area gallery.
+// Every photo_id of the gallery is sent once, in the new order.
+func (s *FarmServer) ReorderAreaPhotos(c echo.Context) error {
+	areaUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	photoUIDs, err := parsePhotoOrder(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	area, err := s.findAreaFromHistory(areaUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = area.ReorderPhotos(photoUIDs)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveAreaChanges(c, area)
+}
+
+func (s *FarmServer) findAreaFromHistory(areaUID uuid.UUID) (*domain.Area, error) {
+	eventQueryResult := <-s.AreaEventQuery.FindAllByID(areaUID)
+	if eventQueryResult.Error != nil {
+		return nil, eventQueryResult.Error
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.AreaEvent)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	if len(events) == 0 {
+		return nil, NewRequestValidationError(NotFound, "id")
+	}
+
+	return repository.NewAreaFromHistory(events), nil
+}
+
+// saveAreaChanges persists and publishes the changes of the area, then responds with its detail.
+func (s *FarmServer) saveAreaChanges(c echo.Context, area *domain.Area) error {
+	err := s.commitAreaChanges(area)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.respondAreaDetail(c, area)
+}
+
+// commitAreaChanges persists and publishes the changes of the area.
+func (s *FarmServer) commitAreaChanges(area *domain.Area) error {
+	err := <-s.AreaEventRepo.Save(area.UID, area.Version, area.UncommittedChanges)
+	if err != nil {
+		return err
+	}
+
+	s.publishUncommittedEvents(area)
+
+	return nil
+}
+
+func (s *FarmServer) respondAreaDetail(c echo.Context, area *domain.Area) error {
+	data := make(map[string]DetailArea)
+
+	detailArea, err := MapToDetailArea(s, *area)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data["data"] = detailArea
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// MapToAreaPhotos is the photo gallery of the area read model.
+func MapToAreaPhotos(area domain.Area) []storage.AreaPhoto {
+	photos := []storage.AreaPhoto{}
+
+	for _, v := range area.Photos {
+		photos = append(photos, storage.AreaPhoto(v))
+	}
+
+	return photos
+}
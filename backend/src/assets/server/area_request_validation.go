@@ -1,6 +1,7 @@
 package server
 
 import (
+	"encoding/json"
 	"strconv"
 
 	"github.com/gofrs/uuid"
@@ -55,6 +56,70 @@ func (*RequestValidation) ValidateAreaSize(size, sizeUnit string) (domain.AreaSi
 	}, nil
 }
 
+// ValidateAreaBoundary parses the GeoJSON boundary and computes the area size from it.
+// The size unit is optional and defaults to square meter.
+func (*RequestValidation) ValidateAreaBoundary(
+	boundary, sizeUnit string,
+) (domain.AreaBoundary, domain.AreaSize, error) {
+	if sizeUnit == "" {
+		sizeUnit = domain.SquareMeter
+	}
+
+	unit := domain.GetAreaUnit(sizeUnit)
+	if unit == (domain.AreaUnit{}) {
+		return domain.AreaBoundary{}, domain.AreaSize{}, NewRequestValidationError(InvalidOption, "size_unit")
+	}
+
+	areaBoundary, err := domain.ParseAreaBoundary(boundary)
+	if err != nil {
+		return domain.AreaBoundary{}, domain.AreaSize{}, err
+	}
+
+	return areaBoundary, areaBoundary.Size(unit), nil
+}
+
+// ValidateAreaCapacity reads the area capacity form fields.
+// The plant type spacings are a JSON object of spacings in centimeters by plant type code.
+func (*RequestValidation) ValidateAreaCapacity(
+	capacityType, value, spacing, plantTypeSpacings string,
+) (domain.AreaCapacity, error) {
+	if capacityType == "" {
+		return domain.AreaCapacity{}, NewRequestValidationError(Required, "capacity_type")
+	}
+
+	capacity := domain.AreaCapacity{Type: capacityType}
+
+	switch capacityType {
+	case domain.AreaCapacityPlant, domain.AreaCapacityTray:
+		v, err := strconv.Atoi(value)
+		if err != nil {
+			return domain.AreaCapacity{}, NewRequestValidationError(Numeric, "capacity_value")
+		}
+
+		capacity.Value = v
+	case domain.AreaCapacitySpacing:
+		if spacing != "" {
+			v, err := strconv.ParseFloat(spacing, 32)
+			if err != nil {
+				return domain.AreaCapacity{}, NewRequestValidationError(Float, "spacing")
+			}
+
+			capacity.Spacing = float32(v)
+		}
+
+		if plantTypeSpacings != "" {
+			err := json.Unmarshal([]byte(plantTypeSpacings), &capacity.PlantTypeSpacings)
+			if err != nil {
+				return domain.AreaCapacity{}, NewRequestValidationError(ParseFailed, "plant_type_spacings")
+			}
+		}
+	default:
+		return domain.AreaCapacity{}, NewRequestValidationError(InvalidOption, "capacity_type")
+	}
+
+	return capacity, nil
+}
+
 func (*RequestValidation) ValidateAreaLocation(location string) (string, error) {
 	if location == "" {
 		return "", NewRequestValidationError(Required, "location")
@@ -0,0 +1,611 @@
+package server
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/usetania/tania-core/src/assets/domain"
+	"github.com/usetania/tania-core/src/assets/repository"
+	"github.com/usetania/tania-core/src/assets/storage"
+	"github.com/usetania/tania-core/src/helper/eventhelper"
+)
+
+// GetEquipmentTypes is a FarmServer's handler to list the equipment types.
+func (*FarmServer) GetEquipmentTypes(c echo.Context) error {
+	data := make(map[string][]domain.EquipmentType)
+
+	data["data"] = domain.EquipmentTypes()
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// GetFarmEquipment is a FarmServer's handler to list the equipment of a farm.
+func (s *FarmServer) GetFarmEquipment(c echo.Context) error {
+	data := make(map[string][]storage.EquipmentRead)
+
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	queryResult := <-s.EquipmentReadQuery.FindAllByFarm(farmUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	equipments, ok := queryResult.Result.([]storage.EquipmentRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	data["data"] = equipments
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// GetEquipmentByID is a FarmServer's handler to get an equipment with its maintenance schedules.
+func (s *FarmServer) GetEquipmentByID(c echo.Context) error {
+	data := make(map[string]storage.EquipmentRead)
+
+	equipmentUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	queryResult := <-s.EquipmentReadQuery.FindByID(equipmentUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	equipment, ok := queryResult.Result.(storage.EquipmentRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if equipment.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NotFound, "id"))
+	}
+
+	data["data"] = equipment
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// SaveEquipment is a FarmServer's handler to register an equipment of the farm.
+// The cost is in the farm currency unless another currency_code is given.
+func (s *FarmServer) SaveEquipment(c echo.Context) error {
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	farm, err := s.EquipmentService.FindFarmByID(farmUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	location, err := parseEquipmentLocation(c.FormValue("location_type"), c.FormValue("location_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	purchaseDate, err := parseEquipmentPurchaseDate(c.FormValue("purchase_date"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	cost, err := s.parseEquipmentCost(farm.UID, c.FormValue("cost"), c.FormValue("currency_code"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	equipment, err := domain.CreateEquipment(
+		s.EquipmentService,
+		farm.UID,
+		c.FormValue("name"),
+		c.FormValue("type"),
+		c.FormValue("serial_number"),
+		location,
+		purchaseDate,
+		cost,
+	)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveEquipmentChanges(c, equipment)
+}
+
+// UpdateEquipment is a FarmServer's handler to change an equipment.
+// Only the fields sent are changed, so the location, the purchase date and the cost can be cleared
+// with an empty value.
+func (s *FarmServer) UpdateEquipment(c echo.Context) error {
+	equipment, err := s.findEquipmentFromHistory(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	params, err := c.FormParams()
+	if err != nil {
+		return Error(c, err)
+	}
+
+	name := c.FormValue("name")
+	if name != "" && name != equipment.Name {
+		err = equipment.ChangeName(name)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	equipmentType, serialNumber := equipment.Type, equipment.SerialNumber
+
+	if v := c.FormValue("type"); v != "" {
+		equipmentType = v
+	}
+
+	if _, ok := params["serial_number"]; ok {
+		serialNumber = c.FormValue("serial_number")
+	}
+
+	if equipmentType != equipment.Type || serialNumber != equipment.SerialNumber {
+		err = equipment.ChangeDetails(equipmentType, serialNumber)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	if _, ok := params["location_type"]; ok {
+		location, err := parseEquipmentLocation(c.FormValue("location_type"), c.FormValue("location_id"))
+		if err != nil {
+			return Error(c, err)
+		}
+
+		err = equipment.ChangeLocation(s.EquipmentService, location)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	_, hasPurchaseDate := params["purchase_date"]
+	_, hasCost := params["cost"]
+
+	if hasPurchaseDate || hasCost {
+		purchaseDate, cost := equipment.PurchaseDate, equipment.Cost
+
+		if hasPurchaseDate {
+			purchaseDate, err = parseEquipmentPurchaseDate(c.FormValue("purchase_date"))
+			if err != nil {
+				return Error(c, err)
+			}
+		}
+
+		if hasCost {
+			cost, err = s.parseEquipmentCost(equipment.FarmUID, c.FormValue("cost"), c.FormValue("currency_code"))
+			if err != nil {
+				return Error(c, err)
+			}
+		}
+
+		err = equipment.ChangePurchase(purchaseDate, cost)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	return s.saveEquipmentChanges(c, equipment)
+}
+
+// SaveEquipmentMaintenanceSchedule is a FarmServer's handler to schedule a maintenance of an equipment
+// every interval_days. It is first due on first_due_date, or once the interval passed from today.
+func (s *FarmServer) SaveEquipmentMaintenanceSchedule(c echo.Context) error {
+	equipment, err := s.findEquipmentFromHistory(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	intervalDays, err := strconv.Atoi(c.FormValue("interval_days"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(Numeric, "interval_days"))
+	}
+
+	var firstDueDate *time.Time
+
+	if v := c.FormValue("first_due_date"); v != "" {
+		date, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			return Error(c, NewRequestValidationError(ParseFailed, "first_due_date"))
+		}
+
+		firstDueDate = &date
+	}
+
+	_, err = equipment.AddMaintenanceSchedule(c.FormValue("title"), intervalDays, firstDueDate)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveEquipmentChanges(c, equipment)
+}
+
+// UpdateEquipmentMaintenanceSchedule is a FarmServer's handler to change a maintenance schedule of an equipment.
+func (s *FarmServer) UpdateEquipmentMaintenanceSchedule(c echo.Context) error {
+	equipment, err := s.findEquipmentFromHistory(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	scheduleUID, err := uuid.FromString(c.Param("schedule_id"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(NotFound, "schedule_id"))
+	}
+
+	schedule, err := equipment.MaintenanceSchedule(scheduleUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	title, intervalDays, nextDueDate := schedule.Title, schedule.IntervalDays, schedule.NextDueDate
+
+	if v := c.FormValue("title"); v != "" {
+		title = v
+	}
+
+	if v := c.FormValue("interval_days"); v != "" {
+		intervalDays, err = strconv.Atoi(v)
+		if err != nil {
+			return Error(c, NewRequestValidationError(Numeric, "interval_days"))
+		}
+	}
+
+	if v := c.FormValue("next_due_date"); v != "" {
+		nextDueDate, err = time.Parse("2006-01-02", v)
+		if err != nil {
+			return Error(c, NewRequestValidationError(ParseFailed, "next_due_date"))
+		}
+	}
+
+	err = equipment.ChangeMaintenanceSchedule(scheduleUID, title, intervalDays, nextDueDate)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveEquipmentChanges(c, equipment)
+}
+
+// RemoveEquipmentMaintenanceSchedule is a FarmServer's handler to stop a maintenance schedule of an equipment.
+func (s *FarmServer) RemoveEquipmentMaintenanceSchedule(c echo.Context) error {
+	equipment, err := s.findEquipmentFromHistory(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	scheduleUID, err := uuid.FromString(c.Param("schedule_id"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(NotFound, "schedule_id"))
+	}
+
+	err = equipment.RemoveMaintenanceSchedule(scheduleUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveEquipmentChanges(c, equipment)
+}
+
+// SaveEquipmentMaintenance is a FarmServer's handler to record a scheduled maintenance done by hand,
+// today unless a done_date is given.
+func (s *FarmServer) SaveEquipmentMaintenance(c echo.Context) error {
+	equipment, err := s.findEquipmentFromHistory(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	scheduleID := c.FormValue("schedule_id")
+	if scheduleID == "" {
+		return Error(c, NewRequestValidationError(Required, "schedule_id"))
+	}
+
+	scheduleUID, err := uuid.FromString(scheduleID)
+	if err != nil {
+		return Error(c, NewRequestValidationError(NotFound, "schedule_id"))
+	}
+
+	doneDate := time.Now()
+
+	if v := c.FormValue("done_date"); v != "" {
+		doneDate, err = time.Parse("2006-01-02", v)
+		if err != nil {
+			return Error(c, NewRequestValidationError(ParseFailed, "done_date"))
+		}
+	}
+
+	_, err = equipment.RecordMaintenance(scheduleUID, doneDate, nil, c.FormValue("notes"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveEquipmentChanges(c, equipment)
+}
+
+// GetEquipmentMaintenances is a FarmServer's handler to list the maintenances done on an equipment,
+// the latest first.
+func (s *FarmServer) GetEquipmentMaintenances(c echo.Context) error {
+	data := make(map[string][]domain.EquipmentMaintenance)
+
+	equipment, err := s.findEquipmentFromHistory(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	maintenances := append([]domain.EquipmentMaintenance{}, equipment.Maintenances...)
+
+	sort.SliceStable(maintenances, func(i, j int) bool {
+		return maintenances[i].DoneDate.After(maintenances[j].DoneDate)
+	})
+
+	data["data"] = maintenances
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// RecordTaskMaintenance is a subscriber which records the equipment maintenance done by a completed task.
+func (s *FarmServer) RecordTaskMaintenance(event interface{}) error {
+	e := taskCompleted{}
+
+	err := eventhelper.Decode(event, &e)
+	if err != nil {
+		log.Println(err)
+
+		return err
+	}
+
+	if e.EquipmentID == nil || e.MaintenanceScheduleID == nil {
+		return nil
+	}
+
+	equipment, err := s.findEquipmentFromHistory(e.EquipmentID.String())
+	if err != nil {
+		log.Println("maintained equipment not found:", e.EquipmentID)
+
+		return nil
+	}
+
+	doneDate := time.Now()
+	if e.CompletedDate != nil {
+		doneDate = *e.CompletedDate
+	}
+
+	taskUID := e.UID
+
+	_, err = equipment.RecordMaintenance(*e.MaintenanceScheduleID, doneDate, &taskUID, "")
+	if err != nil {
+		// The schedule may have been removed since the task was created.
+		log.Println(err)
+
+		return nil
+	}
+
+	err = eventhelper.SaveFromSubscriber(s.EquipmentEventRepo, equipment.UID, equipment.Version,
+		equipment.UncommittedChanges, s.SaveToEquipmentReadModel)
+	if err != nil {
+		log.Println(err)
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *FarmServer) findEquipmentFromHistory(id string) (*domain.Equipment, error) {
+	equipmentUID, err := uuid.FromString(id)
+	if err != nil {
+		return nil, NewRequestValidationError(NotFound, "id")
+	}
+
+	eventQueryResult := <-s.EquipmentEventQuery.FindAllByID(equipmentUID)
+	if eventQueryResult.Error != nil {
+		return nil, eventQueryResult.Error
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.EquipmentEvent)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	if len(events) == 0 {
+		return nil, NewRequestValidationError(NotFound, "id")
+	}
+
+	return repository.NewEquipmentFromHistory(events), nil
+}
+
+// saveEquipmentChanges persists and publishes the changes of the equipment, then responds with it.
+func (s *FarmServer) saveEquipmentChanges(c echo.Context, equipment *domain.Equipment) error {
+	data := make(map[string]storage.EquipmentRead)
+
+	err := <-s.EquipmentEventRepo.Save(equipment.UID, equipment.Version, equipment.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	s.publishUncommittedEvents(equipment)
+
+	equipmentRead, err := MapToEquipmentRead(s, *equipment)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data["data"] = equipmentRead
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func parseEquipmentLocation(locationType, locationID string) (*domain.EquipmentLocation, error) {
+	if locationType == "" {
+		return nil, nil
+	}
+
+	if locationID == "" {
+		return nil, NewRequestValidationError(Required, "location_id")
+	}
+
+	locationUID, err := uuid.FromString(locationID)
+	if err != nil {
+		return nil, NewRequestValidationError(NotFound, "location_id")
+	}
+
+	return &domain.EquipmentLocation{Type: locationType, UID: locationUID}, nil
+}
+
+func parseEquipmentPurchaseDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, NewRequestValidationError(ParseFailed, "purchase_date")
+	}
+
+	return &date, nil
+}
+
+func (s *FarmServer) parseEquipmentCost(farmUID uuid.UUID, value, currencyCode string) (*domain.EquipmentCost, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, NewRequestValidationError(Float, "cost")
+	}
+
+	if currencyCode == "" {
+		queryResult := <-s.FarmReadQuery.FindByID(farmUID)
+		if queryResult.Error != nil {
+			return nil, queryResult.Error
+		}
+
+		farm, ok := queryResult.Result.(storage.FarmRead)
+		if !ok {
+			return nil, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+		}
+
+		currencyCode = farm.Currency
+	}
+
+	cost, err := domain.CreateEquipmentCost(amount, currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cost, nil
+}
+
+// MapToEquipmentRead maps the equipment with the names of its farm and location.
+func MapToEquipmentRead(s *FarmServer, equipment domain.Equipment) (storage.EquipmentRead, error) {
+	farm, err := s.EquipmentService.FindFarmByID(equipment.FarmUID)
+	if err != nil {
+		return storage.EquipmentRead{}, err
+	}
+
+	equipmentRead := storage.EquipmentRead{
+		UID:          equipment.UID,
+		Name:         equipment.Name,
+		Type:         equipment.Type,
+		SerialNumber: equipment.SerialNumber,
+		Farm:         storage.EquipmentFarm{UID: farm.UID, Name: farm.Name},
+		PurchaseDate: equipment.PurchaseDate,
+		Cost:         (*storage.EquipmentCost)(equipment.Cost),
+		CreatedDate:  equipment.CreatedDate,
+
+		MaintenanceSchedules: []storage.EquipmentMaintenanceSchedule{},
+	}
+
+	if equipment.Location != nil {
+		location := domain.EquipmentLocationServiceResult{}
+
+		switch equipment.Location.Type {
+		case domain.EquipmentLocationArea:
+			location, err = s.EquipmentService.FindAreaByID(equipment.Location.UID)
+		case domain.EquipmentLocationReservoir:
+			location, err = s.EquipmentService.FindReservoirByID(equipment.Location.UID)
+		}
+
+		if err != nil {
+			return storage.EquipmentRead{}, err
+		}
+
+		equipmentRead.Location = &storage.EquipmentLocation{
+			Type: equipment.Location.Type,
+			UID:  equipment.Location.UID,
+			Name: location.Name,
+		}
+	}
+
+	for _, v := range equipment.MaintenanceSchedules {
+		equipmentRead.MaintenanceSchedules = append(equipmentRead.MaintenanceSchedules,
+			storage.EquipmentMaintenanceSchedule(v))
+	}
+
+	return equipmentRead, nil
+}
+
+// SaveToEquipmentReadModel is a subscriber which updates the equipment read model from the equipment history,
+// since the maintenance schedules are moved by the maintenances recorded.
+func (s *FarmServer) SaveToEquipmentReadModel(event interface{}) error {
+	var equipmentUID uuid.UUID
+
+	switch e := event.(type) {
+	case domain.EquipmentCreated:
+		equipmentUID = e.UID
+	case domain.EquipmentNameChanged:
+		equipmentUID = e.EquipmentUID
+	case domain.EquipmentDetailsChanged:
+		equipmentUID = e.EquipmentUID
+	case domain.EquipmentLocationChanged:
+		equipmentUID = e.EquipmentUID
+	case domain.EquipmentPurchaseChanged:
+		equipmentUID = e.EquipmentUID
+	case domain.EquipmentMaintenanceScheduleAdded:
+		equipmentUID = e.EquipmentUID
+	case domain.EquipmentMaintenanceScheduleChanged:
+		equipmentUID = e.EquipmentUID
+	case domain.EquipmentMaintenanceScheduleRemoved:
+		equipmentUID = e.EquipmentUID
+	case domain.EquipmentMaintenanceRecorded:
+		equipmentUID = e.EquipmentUID
+	default:
+		log.Println(errors.New("internal server error. unknown equipment event"))
+
+		return nil
+	}
+
+	equipment, err := s.findEquipmentFromHistory(equipmentUID.String())
+	if err != nil {
+		log.Println(err)
+
+		return nil
+	}
+
+	equipmentRead, err := MapToEquipmentRead(s, *equipment)
+	if err != nil {
+		log.Println(err)
+
+		return nil
+	}
+
+	err = <-s.EquipmentReadRepo.Save(&equipmentRead)
+	if err != nil {
+		log.Println(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/usetania/tania-core/src/assets/domain"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+// MaterialCost is the value of a material in stock, in its own currency and in the farm base currency.
+// The converted amount is empty when no exchange rate is known for the material currency.
+type MaterialCost struct {
+	MaterialUID     uuid.UUID `json:"material_id"`
+	Name            string    `json:"name"`
+	Quantity        float32   `json:"quantity"`
+	QuantityUnit    string    `json:"quantity_unit"`
+	PricePerUnit    string    `json:"price_per_unit"`
+	Currency        string    `json:"currency"`
+	Amount          string    `json:"amount"`
+	ConvertedAmount *string   `json:"converted_amount"`
+}
+
+// MaterialCostReport is the value of the materials in stock converted into the farm base currency.
+type MaterialCostReport struct {
+	FarmUID         uuid.UUID      `json:"farm_id"`
+	Currency        string         `json:"currency"`
+	Date            time.Time      `json:"date"`
+	Total           string         `json:"total"`
+	Materials       []MaterialCost `json:"materials"`
+	MissingRateFor  []string       `json:"missing_rate_for"`
+	InvalidPriceFor []uuid.UUID    `json:"invalid_price_for"`
+}
+
+// SaveExchangeRate is a FarmServer's handler to add the rate of a currency to the farm base currency.
+func (s *FarmServer) SaveExchangeRate(c echo.Context) error {
+	fromCurrency := c.FormValue("from_currency")
+	if fromCurrency == "" {
+		return Error(c, NewRequestValidationError(Required, "from_currency"))
+	}
+
+	rate, err := strconv.ParseFloat(c.FormValue("rate"), 64)
+	if err != nil {
+		return Error(c, NewRequestValidationError(Float, "rate"))
+	}
+
+	effectiveDate := time.Now()
+
+	if v := c.FormValue("effective_date"); v != "" {
+		effectiveDate, err = time.Parse("2006-01-02", v)
+		if err != nil {
+			return Error(c, NewRequestValidationError(ParseFailed, "effective_date"))
+		}
+	}
+
+	return s.changeFarm(c, func(farm *domain.Farm) error {
+		_, err := farm.AddExchangeRate(fromCurrency, rate, effectiveDate)
+
+		return err
+	})
+}
+
+// RemoveExchangeRate is a FarmServer's handler to remove an exchange rate entered by mistake.
+func (s *FarmServer) RemoveExchangeRate(c echo.Context) error {
+	rateUID, err := uuid.FromString(c.Param("rate_id"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(NotFound, "rate_id"))
+	}
+
+	return s.changeFarm(c, func(farm *domain.Farm) error {
+		return farm.RemoveExchangeRate(rateUID)
+	})
+}
+
+// GetMaterialCosts is a FarmServer's handler to report the value of the materials in stock
+// in the farm base currency, with the exchange rates effective at the given date.
+func (s *FarmServer) GetMaterialCosts(c echo.Context) error {
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	date := time.Now()
+
+	if v := c.QueryParam("date"); v != "" {
+		date, err = time.Parse("2006-01-02", v)
+		if err != nil {
+			return Error(c, NewRequestValidationError(ParseFailed, "date"))
+		}
+	}
+
+	queryResult := <-s.FarmReadQuery.FindByID(farmUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	farm, ok := queryResult.Result.(storage.FarmRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if farm.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NotFound, "id"))
+	}
+
+	queryResult = <-s.MaterialReadQuery.FindAll("", "", 0, 0)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	materials, ok := queryResult.Result.([]storage.MaterialRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	data := make(map[string]MaterialCostReport)
+	data["data"] = MapToMaterialCostReport(farm, materials, date)
+
+	return c.JSON(http.StatusOK, data)
+}
@@ -2,7 +2,9 @@ package server
 
 import (
 	"database/sql"
+	"encoding/json"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -23,6 +25,7 @@ import (
 	"github.com/usetania/tania-core/src/assets/storage"
 	"github.com/usetania/tania-core/src/eventbus"
 	growthstorage "github.com/usetania/tania-core/src/growth/storage"
+	"github.com/usetania/tania-core/src/helper/filehelper"
 	"github.com/usetania/tania-core/src/helper/imagehelper"
 	"github.com/usetania/tania-core/src/helper/paginationhelper"
 	"github.com/usetania/tania-core/src/helper/stringhelper"
@@ -31,27 +34,38 @@ import (
 
 // FarmServer ties the routes and handlers with injected dependencies.
 type FarmServer struct {
-	FarmEventRepo       repository.FarmEvent
-	FarmEventQuery      query.FarmEvent
-	FarmReadRepo        repository.FarmRead
-	FarmReadQuery       query.FarmRead
-	ReservoirEventRepo  repository.ReservoirEvent
-	ReservoirEventQuery query.ReservoirEvent
-	ReservoirReadRepo   repository.ReservoirRead
-	ReservoirReadQuery  query.ReservoirRead
-	ReservoirService    domain.ReservoirService
-	AreaEventRepo       repository.AreaEvent
-	AreaReadRepo        repository.AreaRead
-	AreaEventQuery      query.AreaEvent
-	AreaReadQuery       query.AreaRead
-	AreaService         domain.AreaService
-	MaterialEventRepo   repository.MaterialEvent
-	MaterialEventQuery  query.MaterialEvent
-	MaterialReadRepo    repository.MaterialRead
-	MaterialReadQuery   query.MaterialRead
-	CropReadQuery       query.CropRead
-	File                File
-	EventBus            eventbus.TaniaEventBus
+	FarmEventRepo            repository.FarmEvent
+	FarmEventQuery           query.FarmEvent
+	FarmReadRepo             repository.FarmRead
+	FarmReadQuery            query.FarmRead
+	ReservoirEventRepo       repository.ReservoirEvent
+	ReservoirEventQuery      query.ReservoirEvent
+	ReservoirReadRepo        repository.ReservoirRead
+	ReservoirReadQuery       query.ReservoirRead
+	ReservoirService         domain.ReservoirService
+	AreaEventRepo            repository.AreaEvent
+	AreaReadRepo             repository.AreaRead
+	AreaEventQuery           query.AreaEvent
+	AreaReadQuery            query.AreaRead
+	AreaService              domain.AreaService
+	MaterialEventRepo        repository.MaterialEvent
+	MaterialEventQuery       query.MaterialEvent
+	MaterialReadRepo         repository.MaterialRead
+	MaterialReadQuery        query.MaterialRead
+	SupplierEventRepo        repository.SupplierEvent
+	SupplierEventQuery       query.SupplierEvent
+	SupplierReadRepo         repository.SupplierRead
+	SupplierReadQuery        query.SupplierRead
+	MaterialTypeCatalogRepo  repository.MaterialTypeCatalog
+	MaterialTypeCatalogQuery query.MaterialTypeCatalog
+	EquipmentEventRepo       repository.EquipmentEvent
+	EquipmentEventQuery      query.EquipmentEvent
+	EquipmentReadRepo        repository.EquipmentRead
+	EquipmentReadQuery       query.EquipmentRead
+	EquipmentService         domain.EquipmentService
+	CropReadQuery            query.CropRead
+	File                     filehelper.File
+	EventBus                 eventbus.TaniaEventBus
 }
 
 // NewFarmServer initializes FarmServer's dependencies and create new FarmServer struct.
@@ -65,11 +79,16 @@ func NewFarmServer(
 	reservoirReadStorage *storage.ReservoirReadStorage,
 	materialEventStorage *storage.MaterialEventStorage,
 	materialReadStorage *storage.MaterialReadStorage,
+	supplierEventStorage *storage.SupplierEventStorage,
+	supplierReadStorage *storage.SupplierReadStorage,
+	catalogStorage *storage.MaterialTypeCatalogStorage,
+	equipmentEventStorage *storage.EquipmentEventStorage,
+	equipmentReadStorage *storage.EquipmentReadStorage,
 	cropReadStorage *growthstorage.CropReadStorage,
 	eventBus eventbus.TaniaEventBus,
 ) (*FarmServer, error) {
 	farmServer := &FarmServer{
-		File:     LocalFile{},
+		File:     filehelper.NewFile(),
 		EventBus: eventBus,
 	}
 
@@ -95,6 +114,19 @@ func NewFarmServer(
 		farmServer.MaterialReadRepo = repoInMem.NewMaterialReadRepositoryInMemory(materialReadStorage)
 		farmServer.MaterialReadQuery = queryInMem.NewMaterialReadQueryInMemory(materialReadStorage)
 
+		farmServer.SupplierEventRepo = repoInMem.NewSupplierEventRepositoryInMemory(supplierEventStorage)
+		farmServer.SupplierEventQuery = queryInMem.NewSupplierEventQueryInMemory(supplierEventStorage)
+		farmServer.SupplierReadRepo = repoInMem.NewSupplierReadRepositoryInMemory(supplierReadStorage)
+		farmServer.SupplierReadQuery = queryInMem.NewSupplierReadQueryInMemory(supplierReadStorage)
+
+		farmServer.MaterialTypeCatalogRepo = repoInMem.NewMaterialTypeCatalogRepositoryInMemory(catalogStorage)
+		farmServer.MaterialTypeCatalogQuery = queryInMem.NewMaterialTypeCatalogQueryInMemory(catalogStorage)
+
+		farmServer.EquipmentEventRepo = repoInMem.NewEquipmentEventRepositoryInMemory(equipmentEventStorage)
+		farmServer.EquipmentEventQuery = queryInMem.NewEquipmentEventQueryInMemory(equipmentEventStorage)
+		farmServer.EquipmentReadRepo = repoInMem.NewEquipmentReadRepositoryInMemory(equipmentReadStorage)
+		farmServer.EquipmentReadQuery = queryInMem.NewEquipmentReadQueryInMemory(equipmentReadStorage)
+
 		farmServer.CropReadQuery = queryInMem.NewCropReadQueryInMemory(cropReadStorage)
 
 		// TODO: AreaServiceInMemory should be renamed. It doesn't need InMemory name
@@ -105,7 +137,13 @@ func NewFarmServer(
 		}
 		// TODO: ReservoirServiceInMemory should be renamed. It doesn't need InMemory name
 		farmServer.ReservoirService = service.ReservoirServiceInMemory{
-			FarmReadQuery: farmServer.FarmReadQuery,
+			FarmReadQuery:     farmServer.FarmReadQuery,
+			MaterialReadQuery: farmServer.MaterialReadQuery,
+		}
+		farmServer.EquipmentService = service.EquipmentServiceInMemory{
+			FarmReadQuery:      farmServer.FarmReadQuery,
+			AreaReadQuery:      farmServer.AreaReadQuery,
+			ReservoirReadQuery: farmServer.ReservoirReadQuery,
 		}
 
 	case config.DBSqlite:
@@ -129,6 +167,19 @@ func NewFarmServer(
 		farmServer.MaterialReadRepo = repoSqlite.NewMaterialReadRepositorySqlite(db)
 		farmServer.MaterialReadQuery = querySqlite.NewMaterialReadQuerySqlite(db)
 
+		farmServer.SupplierEventRepo = repoSqlite.NewSupplierEventRepositorySqlite(db)
+		farmServer.SupplierEventQuery = querySqlite.NewSupplierEventQuerySqlite(db)
+		farmServer.SupplierReadRepo = repoSqlite.NewSupplierReadRepositorySqlite(db)
+		farmServer.SupplierReadQuery = querySqlite.NewSupplierReadQuerySqlite(db)
+
+		farmServer.MaterialTypeCatalogRepo = repoSqlite.NewMaterialTypeCatalogRepositorySqlite(db)
+		farmServer.MaterialTypeCatalogQuery = querySqlite.NewMaterialTypeCatalogQuerySqlite(db)
+
+		farmServer.EquipmentEventRepo = repoSqlite.NewEquipmentEventRepositorySqlite(db)
+		farmServer.EquipmentEventQuery = querySqlite.NewEquipmentEventQuerySqlite(db)
+		farmServer.EquipmentReadRepo = repoSqlite.NewEquipmentReadRepositorySqlite(db)
+		farmServer.EquipmentReadQuery = querySqlite.NewEquipmentReadQuerySqlite(db)
+
 		farmServer.CropReadQuery = querySqlite.NewCropReadQuerySqlite(db)
 
 		// TODO: AreaServiceInMemory should be renamed. It doesn't need InMemory name
@@ -139,7 +190,13 @@ func NewFarmServer(
 		}
 		// TODO: ReservoirServiceInMemory should be renamed. It doesn't need InMemory name
 		farmServer.ReservoirService = service.ReservoirServiceInMemory{
-			FarmReadQuery: farmServer.FarmReadQuery,
+			FarmReadQuery:     farmServer.FarmReadQuery,
+			MaterialReadQuery: farmServer.MaterialReadQuery,
+		}
+		farmServer.EquipmentService = service.EquipmentServiceInMemory{
+			FarmReadQuery:      farmServer.FarmReadQuery,
+			AreaReadQuery:      farmServer.AreaReadQuery,
+			ReservoirReadQuery: farmServer.ReservoirReadQuery,
 		}
 
 	case config.DBMysql:
@@ -163,6 +220,19 @@ func NewFarmServer(
 		farmServer.MaterialReadRepo = repoMysql.NewMaterialReadRepositoryMysql(db)
 		farmServer.MaterialReadQuery = queryMysql.NewMaterialReadQueryMysql(db)
 
+		farmServer.SupplierEventRepo = repoMysql.NewSupplierEventRepositoryMysql(db)
+		farmServer.SupplierEventQuery = queryMysql.NewSupplierEventQueryMysql(db)
+		farmServer.SupplierReadRepo = repoMysql.NewSupplierReadRepositoryMysql(db)
+		farmServer.SupplierReadQuery = queryMysql.NewSupplierReadQueryMysql(db)
+
+		farmServer.MaterialTypeCatalogRepo = repoMysql.NewMaterialTypeCatalogRepositoryMysql(db)
+		farmServer.MaterialTypeCatalogQuery = queryMysql.NewMaterialTypeCatalogQueryMysql(db)
+
+		farmServer.EquipmentEventRepo = repoMysql.NewEquipmentEventRepositoryMysql(db)
+		farmServer.EquipmentEventQuery = queryMysql.NewEquipmentEventQueryMysql(db)
+		farmServer.EquipmentReadRepo = repoMysql.NewEquipmentReadRepositoryMysql(db)
+		farmServer.EquipmentReadQuery = queryMysql.NewEquipmentReadQueryMysql(db)
+
 		farmServer.CropReadQuery = queryMysql.NewCropReadQueryMysql(db)
 
 		// TODO: AreaServiceInMemory should be renamed. It doesn't need InMemory name
@@ -173,10 +243,21 @@ func NewFarmServer(
 		}
 		// TODO: ReservoirServiceInMemory should be renamed. It doesn't need InMemory name
 		farmServer.ReservoirService = service.ReservoirServiceInMemory{
-			FarmReadQuery: farmServer.FarmReadQuery,
+			FarmReadQuery:     farmServer.FarmReadQuery,
+			MaterialReadQuery: farmServer.MaterialReadQuery,
+		}
+		farmServer.EquipmentService = service.EquipmentServiceInMemory{
+			FarmReadQuery:      farmServer.FarmReadQuery,
+			AreaReadQuery:      farmServer.AreaReadQuery,
+			ReservoirReadQuery: farmServer.ReservoirReadQuery,
 		}
 	}
 
+	err := farmServer.addMissingMaterialTypeDefaults()
+	if err != nil {
+		return nil, err
+	}
+
 	farmServer.InitSubscriber()
 
 	return farmServer, nil
@@ -189,12 +270,30 @@ func (s *FarmServer) InitSubscriber() {
 	s.EventBus.Subscribe("FarmTypeChanged", s.SaveToFarmReadModel)
 	s.EventBus.Subscribe("FarmGeolocationChanged", s.SaveToFarmReadModel)
 	s.EventBus.Subscribe("FarmRegionChanged", s.SaveToFarmReadModel)
+	s.EventBus.Subscribe("FarmArchived", s.SaveToFarmReadModel)
+	s.EventBus.Subscribe("FarmReactivated", s.SaveToFarmReadModel)
+	s.EventBus.Subscribe("FarmUnitSystemChanged", s.SaveToFarmReadModel)
+	s.EventBus.Subscribe("FarmCurrencyChanged", s.SaveToFarmReadModel)
+	s.EventBus.Subscribe("FarmExchangeRateAdded", s.SaveToFarmReadModel)
+	s.EventBus.Subscribe("FarmExchangeRateRemoved", s.SaveToFarmReadModel)
 
 	s.EventBus.Subscribe("ReservoirCreated", s.SaveToReservoirReadModel)
 	s.EventBus.Subscribe("ReservoirNameChanged", s.SaveToReservoirReadModel)
 	s.EventBus.Subscribe("ReservoirWaterSourceChanged", s.SaveToReservoirReadModel)
 	s.EventBus.Subscribe("ReservoirNoteAdded", s.SaveToReservoirReadModel)
 	s.EventBus.Subscribe("ReservoirNoteRemoved", s.SaveToReservoirReadModel)
+	s.EventBus.Subscribe("ReservoirLevelRecorded", s.SaveToReservoirReadModel)
+	s.EventBus.Subscribe("ReservoirRefilled", s.SaveToReservoirReadModel)
+	s.EventBus.Subscribe("ReservoirWaterConsumed", s.SaveToReservoirReadModel)
+	s.EventBus.Subscribe("ReservoirWaterPerWateringChanged", s.SaveToReservoirReadModel)
+	s.EventBus.Subscribe("ReservoirWaterQualityMeasured", s.SaveToReservoirReadModel)
+	s.EventBus.Subscribe("ReservoirWaterQualityRangeChanged", s.SaveToReservoirReadModel)
+	s.EventBus.Subscribe("ReservoirNutrientRecipeChanged", s.SaveToReservoirReadModel)
+	s.EventBus.Subscribe("ReservoirPhotoAdded", s.SaveToReservoirReadModel)
+	s.EventBus.Subscribe("ReservoirPhotoUpdated", s.SaveToReservoirReadModel)
+	s.EventBus.Subscribe("ReservoirPhotoRemoved", s.SaveToReservoirReadModel)
+	s.EventBus.Subscribe("ReservoirPhotosReordered", s.SaveToReservoirReadModel)
+	s.EventBus.Subscribe("ReservoirCoverPhotoChanged", s.SaveToReservoirReadModel)
 
 	s.EventBus.Subscribe("AreaCreated", s.SaveToAreaReadModel)
 	s.EventBus.Subscribe("AreaNameChanged", s.SaveToAreaReadModel)
@@ -203,8 +302,15 @@ func (s *FarmServer) InitSubscriber() {
 	s.EventBus.Subscribe("AreaLocationChanged", s.SaveToAreaReadModel)
 	s.EventBus.Subscribe("AreaReservoirChanged", s.SaveToAreaReadModel)
 	s.EventBus.Subscribe("AreaPhotoAdded", s.SaveToAreaReadModel)
+	s.EventBus.Subscribe("AreaPhotoUpdated", s.SaveToAreaReadModel)
+	s.EventBus.Subscribe("AreaPhotoRemoved", s.SaveToAreaReadModel)
+	s.EventBus.Subscribe("AreaPhotosReordered", s.SaveToAreaReadModel)
+	s.EventBus.Subscribe("AreaCoverPhotoChanged", s.SaveToAreaReadModel)
 	s.EventBus.Subscribe("AreaNoteAdded", s.SaveToAreaReadModel)
 	s.EventBus.Subscribe("AreaNoteRemoved", s.SaveToAreaReadModel)
+	s.EventBus.Subscribe("AreaRetired", s.SaveToAreaReadModel)
+	s.EventBus.Subscribe("AreaBoundaryChanged", s.SaveToAreaReadModel)
+	s.EventBus.Subscribe("AreaCapacityChanged", s.SaveToAreaReadModel)
 
 	s.EventBus.Subscribe("MaterialCreated", s.SaveToMaterialReadModel)
 	s.EventBus.Subscribe("MaterialNameChanged", s.SaveToMaterialReadModel)
@@ -214,6 +320,30 @@ func (s *FarmServer) InitSubscriber() {
 	s.EventBus.Subscribe("MaterialExpirationDateChanged", s.SaveToMaterialReadModel)
 	s.EventBus.Subscribe("MaterialNotesChanged", s.SaveToMaterialReadModel)
 	s.EventBus.Subscribe("MaterialProducedByChanged", s.SaveToMaterialReadModel)
+	s.EventBus.Subscribe("MaterialConsumed", s.SaveToMaterialReadModel)
+	s.EventBus.Subscribe("MaterialReorderPointChanged", s.SaveToMaterialReadModel)
+	s.EventBus.Subscribe("MaterialStockReceived", s.SaveToMaterialReadModel)
+	s.EventBus.Subscribe("MaterialStockIssued", s.SaveToMaterialReadModel)
+
+	s.EventBus.Subscribe("SupplierCreated", s.SaveToSupplierReadModel)
+	s.EventBus.Subscribe("SupplierNameChanged", s.SaveToSupplierReadModel)
+	s.EventBus.Subscribe("SupplierContactChanged", s.SaveToSupplierReadModel)
+
+	s.EventBus.Subscribe("EquipmentCreated", s.SaveToEquipmentReadModel)
+	s.EventBus.Subscribe("EquipmentNameChanged", s.SaveToEquipmentReadModel)
+	s.EventBus.Subscribe("EquipmentDetailsChanged", s.SaveToEquipmentReadModel)
+	s.EventBus.Subscribe("EquipmentLocationChanged", s.SaveToEquipmentReadModel)
+	s.EventBus.Subscribe("EquipmentPurchaseChanged", s.SaveToEquipmentReadModel)
+	s.EventBus.Subscribe("EquipmentMaintenanceScheduleAdded", s.SaveToEquipmentReadModel)
+	s.EventBus.Subscribe("EquipmentMaintenanceScheduleChanged", s.SaveToEquipmentReadModel)
+	s.EventBus.Subscribe("EquipmentMaintenanceScheduleRemoved", s.SaveToEquipmentReadModel)
+	s.EventBus.Subscribe("EquipmentMaintenanceRecorded", s.SaveToEquipmentReadModel)
+
+	s.EventBus.Subscribe("TaskCompleted", s.ConsumeTaskMaterial)
+	s.EventBus.Subscribe("TaskCompleted", s.RecordTaskMaintenance)
+	s.EventBus.Subscribe("CropBatchInventoryUsed", s.ConsumeCropMaterial)
+	s.EventBus.Subscribe("AnimalTreated", s.ConsumeAnimalMaterial)
+	s.EventBus.Subscribe("CropBatchWatered", s.ConsumeReservoirWater)
 }
 
 // Mount defines the FarmServer's endpoints with its handlers.
@@ -221,32 +351,94 @@ func (s *FarmServer) Mount(g *echo.Group) {
 	g.GET("/types", s.GetTypes)
 	g.GET("/inventories/materials", s.GetMaterials)
 	g.GET("/inventories/materials/simple", s.GetMaterialsSimple)
+	g.GET("/inventories/materials/low_stock", s.GetLowStockMaterials)
+	g.GET("/inventories/materials/expiring", s.GetExpiringMaterials)
 	g.GET("/inventories/plant_types", s.GetInventoryPlantTypes)
+	g.GET("/:id/inventories/catalogs/:catalog", s.GetMaterialCatalogTypes)
+	g.POST("/:id/inventories/catalogs/:catalog", s.SaveMaterialCatalogType)
+	g.PUT("/:id/inventories/catalogs/:catalog/:code", s.UpdateMaterialCatalogType)
+	g.DELETE("/:id/inventories/catalogs/:catalog/:code", s.RemoveMaterialCatalogType)
 	g.GET("/inventories/materials/available_plant_type", s.GetAvailableMaterialPlantType)
 	g.POST("/inventories/materials/:type", s.SaveMaterial)
 	g.PUT("/inventories/materials/:type/:id", s.UpdateMaterial)
 	g.GET("/inventories/materials/:id", s.GetMaterialByID)
+	g.GET("/inventories/materials/:id/consumptions", s.GetMaterialConsumptions)
+	g.POST("/inventories/materials/:id/receipts", s.SaveMaterialReceipt)
+	g.POST("/inventories/materials/:id/issues", s.SaveMaterialIssue)
+	g.GET("/inventories/materials/:id/ledger", s.GetMaterialLedger)
+	g.GET("/inventories/materials/:id/lots", s.GetMaterialLots)
+	g.GET("/inventories/suppliers", s.GetSuppliers)
+	g.POST("/inventories/suppliers", s.SaveSupplier)
+	g.GET("/inventories/suppliers/:id", s.GetSupplierByID)
+	g.PUT("/inventories/suppliers/:id", s.UpdateSupplier)
 
 	g.POST("", s.SaveFarm)
 	g.PUT("/:id", s.UpdateFarm)
 	g.GET("", s.FindAllFarm)
 	g.GET("/:id", s.FindFarmByID)
+	g.POST("/:id/archive", s.ArchiveFarm)
+	g.POST("/:id/reactivate", s.ReactivateFarm)
+	g.GET("/:id/map.geojson", s.GetFarmMap)
+	g.POST("/:id/exchange_rates", s.SaveExchangeRate)
+	g.DELETE("/:id/exchange_rates/:rate_id", s.RemoveExchangeRate)
+	g.GET("/:id/material_costs", s.GetMaterialCosts)
+	g.GET("/:id/material_valuation", s.GetMaterialValuation)
 
 	g.POST("/:id/reservoirs", s.SaveReservoir)
 	g.PUT("/reservoirs/:id", s.UpdateReservoir)
 	g.POST("/reservoirs/:id/notes", s.SaveReservoirNotes)
 	g.DELETE("/reservoirs/:reservoir_id/notes/:note_id", s.RemoveReservoirNotes)
+	g.POST("/reservoirs/:id/levels", s.SaveReservoirLevel)
+	g.POST("/reservoirs/:id/refills", s.SaveReservoirRefill)
+	g.GET("/reservoirs/:id/levels", s.GetReservoirLevels)
+	g.GET("/reservoirs/water_quality_parameters", s.GetWaterQualityParameters)
+	g.POST("/reservoirs/:id/water_quality", s.SaveReservoirWaterQuality)
+	g.GET("/reservoirs/:id/water_quality", s.GetReservoirWaterQuality)
+	g.PUT("/reservoirs/:id/water_quality_ranges", s.UpdateReservoirWaterQualityRange)
+	g.PUT("/reservoirs/:id/nutrient_recipe", s.UpdateReservoirNutrientRecipe)
+	g.POST("/reservoirs/:id/dosings", s.SaveReservoirDosing)
+	g.GET("/reservoirs/:id/dosings", s.GetReservoirDosings)
+	g.POST("/reservoirs/:id/photos", s.SaveReservoirPhoto)
+	g.GET("/reservoirs/:id/photos", s.GetReservoirGallery)
+	g.PUT("/reservoirs/:id/photos/order", s.ReorderReservoirPhotos)
+	g.GET("/reservoirs/:id/photos/:photo_id", s.GetReservoirPhotoByID)
+	g.PUT("/reservoirs/:id/photos/:photo_id", s.UpdateReservoirPhoto)
+	g.DELETE("/reservoirs/:id/photos/:photo_id", s.RemoveReservoirPhoto)
+	g.PUT("/reservoirs/:id/photos/:photo_id/cover", s.ChangeReservoirCoverPhoto)
 	g.GET("/:id/reservoirs", s.GetFarmReservoirs)
 	g.GET("/:farm_id/reservoirs/:reservoir_id", s.GetReservoirsByID)
 
 	g.POST("/:id/areas", s.SaveArea)
+	g.POST("/:id/areas/import", s.ImportAreas)
 	g.PUT("/areas/:id", s.UpdateArea)
+	g.DELETE("/areas/:id", s.RetireArea)
+	g.GET("/areas/:id/capacity", s.GetAreaCapacity)
+	g.PUT("/areas/:id/capacity", s.UpdateAreaCapacity)
+	g.DELETE("/areas/:id/capacity", s.RemoveAreaCapacity)
 	g.POST("/areas/:id/notes", s.SaveAreaNotes)
 	g.DELETE("/areas/:area_id/notes/:note_id", s.RemoveAreaNotes)
+	g.POST("/areas/:id/photos", s.SaveAreaPhoto)
+	g.GET("/areas/:id/photos", s.GetAreaGallery)
+	g.PUT("/areas/:id/photos/order", s.ReorderAreaPhotos)
+	g.GET("/areas/:id/photos/:photo_id", s.GetAreaPhotoByID)
+	g.PUT("/areas/:id/photos/:photo_id", s.UpdateAreaPhoto)
+	g.DELETE("/areas/:id/photos/:photo_id", s.RemoveAreaPhoto)
+	g.PUT("/areas/:id/photos/:photo_id/cover", s.ChangeAreaCoverPhoto)
 	g.GET("/:id/areas/total", s.GetTotalAreas)
 	g.GET("/:id/areas", s.GetFarmAreas)
 	g.GET("/:farm_id/areas/:area_id", s.GetAreasByID)
 	g.GET("/:farm_id/areas/:area_id/photos", s.GetAreaPhotos)
+
+	g.GET("/equipment/types", s.GetEquipmentTypes)
+	g.POST("/:id/equipment", s.SaveEquipment)
+	g.GET("/:id/equipment", s.GetFarmEquipment)
+	g.GET("/equipment/:id", s.GetEquipmentByID)
+	g.PUT("/equipment/:id", s.UpdateEquipment)
+	g.POST("/equipment/:id/maintenance_schedules", s.SaveEquipmentMaintenanceSchedule)
+	g.PUT("/equipment/:id/maintenance_schedules/:schedule_id", s.UpdateEquipmentMaintenanceSchedule)
+	g.DELETE("/equipment/:id/maintenance_schedules/:schedule_id", s.RemoveEquipmentMaintenanceSchedule)
+	g.POST("/equipment/:id/maintenances", s.SaveEquipmentMaintenance)
+	g.GET("/equipment/:id/maintenances", s.GetEquipmentMaintenances)
 }
 
 // GetTypes is a FarmServer's handle to get farm types.
@@ -267,6 +459,19 @@ func (s FarmServer) FindAllFarm(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
 	}
 
+	// Archived farms are only listed on request
+	if c.QueryParam("include_archived") != "true" {
+		activeFarms := []storage.FarmRead{}
+
+		for _, v := range farms {
+			if v.IsActive {
+				activeFarms = append(activeFarms, v)
+			}
+		}
+
+		farms = activeFarms
+	}
+
 	data := make(map[string][]storage.FarmRead)
 	data["data"] = farms
 
@@ -291,6 +496,22 @@ func (s *FarmServer) SaveFarm(c echo.Context) error {
 		return Error(c, err)
 	}
 
+	unitSystem := c.FormValue("unit_system")
+	if unitSystem != "" {
+		err = farm.ChangeUnitSystem(unitSystem)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	currency := c.FormValue("currency")
+	if currency != "" {
+		err = farm.ChangeCurrency(currency)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
 	err = <-s.FarmEventRepo.Save(farm.UID, farm.Version, farm.UncommittedChanges)
 	if err != nil {
 		return Error(c, err)
@@ -316,6 +537,8 @@ func (s *FarmServer) UpdateFarm(c echo.Context) error {
 	longitude := c.FormValue("longitude")
 	country := c.FormValue("country")
 	city := c.FormValue("city")
+	unitSystem := c.FormValue("unit_system")
+	currency := c.FormValue("currency")
 
 	// Validate //
 	queryResult := <-s.FarmReadQuery.FindByID(farmUID)
@@ -389,6 +612,71 @@ func (s *FarmServer) UpdateFarm(c echo.Context) error {
 		}
 	}
 
+	if unitSystem != "" {
+		err = farm.ChangeUnitSystem(unitSystem)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	if currency != "" {
+		err = farm.ChangeCurrency(currency)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	err = <-s.FarmEventRepo.Save(farm.UID, farm.Version, farm.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	s.publishUncommittedEvents(farm)
+
+	data := make(map[string]*storage.FarmRead)
+	data["data"] = MapToFarmRead(farm)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// ArchiveFarm is a FarmServer's handler to deactivate a farm.
+func (s *FarmServer) ArchiveFarm(c echo.Context) error {
+	return s.changeFarm(c, (*domain.Farm).Archive)
+}
+
+// ReactivateFarm is a FarmServer's handler to bring back an archived farm.
+func (s *FarmServer) ReactivateFarm(c echo.Context) error {
+	return s.changeFarm(c, (*domain.Farm).Reactivate)
+}
+
+// changeFarm applies a change to the farm from its history, then saves and publishes it.
+func (s *FarmServer) changeFarm(c echo.Context, change func(*domain.Farm) error) error {
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	queryResult := <-s.FarmEventQuery.FindAllByID(farmUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	events, ok := queryResult.Result.([]storage.FarmEvent)
+	if !ok {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	if len(events) == 0 {
+		return Error(c, NewRequestValidationError(NotFound, "id"))
+	}
+
+	farm := repository.NewFarmFromHistory(events)
+
+	err = change(farm)
+	if err != nil {
+		return Error(c, err)
+	}
+
 	err = <-s.FarmEventRepo.Save(farm.UID, farm.Version, farm.UncommittedChanges)
 	if err != nil {
 		return Error(c, err)
@@ -424,6 +712,56 @@ func (s *FarmServer) FindFarmByID(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
+// GetFarmMap is a FarmServer's handler to export the farm, its areas and its reservoirs
+// as a GeoJSON FeatureCollection for GIS tools.
+func (s *FarmServer) GetFarmMap(c echo.Context) error {
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	queryResult := <-s.FarmReadQuery.FindByID(farmUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	farm, ok := queryResult.Result.(storage.FarmRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if farm.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NotFound, "id"))
+	}
+
+	queryResult = <-s.AreaReadQuery.FindAllByFarm(farmUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	areas, ok := queryResult.Result.([]storage.AreaRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	queryResult = <-s.ReservoirReadQuery.FindAllByFarm(farmUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	reservoirs, ok := queryResult.Result.([]storage.ReservoirRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	b, err := json.Marshal(MapToFarmMap(farm, areas, reservoirs))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return c.Blob(http.StatusOK, "application/geo+json", b)
+}
+
 // SaveReservoir is a FarmServer's handler to save new Reservoir and place it to a Farm.
 func (s *FarmServer) SaveReservoir(c echo.Context) error {
 	validation := RequestValidation{}
@@ -486,6 +824,7 @@ func (s *FarmServer) UpdateReservoir(c echo.Context) error {
 	name := c.FormValue("name")
 	resType := c.FormValue("type")
 	capacity := c.FormValue("capacity")
+	waterPerWatering := c.FormValue("water_per_watering")
 
 	// Validate //
 	queryResult := <-s.ReservoirReadQuery.FindByID(reservoirUID)
@@ -521,6 +860,18 @@ func (s *FarmServer) UpdateReservoir(c echo.Context) error {
 		}
 	}
 
+	var waterPerWateringFloat *float32
+
+	if waterPerWatering != "" {
+		v, err := strconv.ParseFloat(waterPerWatering, 32)
+		if err != nil {
+			return Error(c, NewRequestValidationError(Float, "water_per_watering"))
+		}
+
+		w := float32(v)
+		waterPerWateringFloat = &w
+	}
+
 	// Process //
 	eventQueryResult := <-s.ReservoirEventQuery.FindAllByID(reservoirRead.UID)
 	if eventQueryResult.Error != nil {
@@ -544,6 +895,13 @@ func (s *FarmServer) UpdateReservoir(c echo.Context) error {
 		}
 	}
 
+	if waterPerWateringFloat != nil && *waterPerWateringFloat != reservoir.WaterPerWatering {
+		err = reservoir.ChangeWaterPerWatering(*waterPerWateringFloat)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
 	// Persists //
 	resultSave := <-s.ReservoirEventRepo.Save(reservoir.UID, reservoir.Version, reservoir.UncommittedChanges)
 	if resultSave != nil {
@@ -782,7 +1140,17 @@ func (s *FarmServer) SaveArea(c echo.Context) error {
 		return Error(c, err)
 	}
 
-	size, err := validation.ValidateAreaSize(c.FormValue("size"), c.FormValue("size_unit"))
+	var (
+		boundary domain.AreaBoundary
+		size     domain.AreaSize
+	)
+
+	if c.FormValue("boundary") != "" {
+		boundary, size, err = validation.ValidateAreaBoundary(c.FormValue("boundary"), c.FormValue("size_unit"))
+	} else {
+		size, err = validation.ValidateAreaSize(c.FormValue("size"), c.FormValue("size_unit"))
+	}
+
 	if err != nil {
 		return Error(c, err)
 	}
@@ -806,29 +1174,33 @@ func (s *FarmServer) SaveArea(c echo.Context) error {
 		return Error(c, err)
 	}
 
-	photo, err := c.FormFile("photo")
-	if err == nil {
-		destPath := stringhelper.Join(*config.Config.UploadPathArea, "/", photo.Filename)
-		err = s.File.Upload(photo, destPath)
-
+	if boundary.Type != "" {
+		err = area.ChangeBoundary(boundary)
 		if err != nil {
 			return Error(c, err)
 		}
+	}
 
-		width, height, err := imagehelper.GetImageDimension(destPath)
+	photo, err := c.FormFile("photo")
+	if err == nil {
+		uploaded, err := s.uploadGalleryPhoto(photo, *config.Config.UploadPathArea)
 		if err != nil {
 			return Error(c, err)
 		}
 
 		areaPhoto := domain.AreaPhoto{
-			Filename: photo.Filename,
-			MimeType: photo.Header["Content-Type"][0],
-			Size:     int(photo.Size),
-			Width:    width,
-			Height:   height,
+			UID:      uploaded.UID,
+			Filename: uploaded.Filename,
+			MimeType: uploaded.MimeType,
+			Size:     uploaded.Size,
+			Width:    uploaded.Width,
+			Height:   uploaded.Height,
 		}
 
-		area.ChangePhoto(areaPhoto)
+		err = area.ChangePhoto(areaPhoto)
+		if err != nil {
+			return Error(c, err)
+		}
 	}
 
 	// Persists //
@@ -866,6 +1238,7 @@ func (s *FarmServer) UpdateArea(c echo.Context) error {
 	areaType := c.FormValue("type")
 	location := c.FormValue("location")
 	reservoirID := c.FormValue("reservoir_id")
+	boundary := c.FormValue("boundary")
 	photo, photoErr := c.FormFile("photo")
 
 	// Validate //
@@ -920,6 +1293,18 @@ func (s *FarmServer) UpdateArea(c echo.Context) error {
 		}
 	}
 
+	if boundary != "" {
+		areaBoundary, err := domain.ParseAreaBoundary(boundary)
+		if err != nil {
+			return Error(c, err)
+		}
+
+		err = area.ChangeBoundary(areaBoundary)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
 	if areaType != "" {
 		err = area.ChangeType(s.AreaService, areaType)
 		if err != nil {
@@ -947,27 +1332,24 @@ func (s *FarmServer) UpdateArea(c echo.Context) error {
 	}
 
 	if photoErr == nil {
-		destPath := stringhelper.Join(*config.Config.UploadPathArea, "/", photo.Filename)
-		err = s.File.Upload(photo, destPath)
-
-		if err != nil {
-			return Error(c, err)
-		}
-
-		width, height, err := imagehelper.GetImageDimension(destPath)
+		uploaded, err := s.uploadGalleryPhoto(photo, *config.Config.UploadPathArea)
 		if err != nil {
 			return Error(c, err)
 		}
 
 		areaPhoto := domain.AreaPhoto{
-			Filename: photo.Filename,
-			MimeType: photo.Header["Content-Type"][0],
-			Size:     int(photo.Size),
-			Width:    width,
-			Height:   height,
+			UID:      uploaded.UID,
+			Filename: uploaded.Filename,
+			MimeType: uploaded.MimeType,
+			Size:     uploaded.Size,
+			Width:    uploaded.Width,
+			Height:   uploaded.Height,
 		}
 
-		area.ChangePhoto(areaPhoto)
+		err = area.ChangePhoto(areaPhoto)
+		if err != nil {
+			return Error(c, err)
+		}
 	}
 
 	// Persists //
@@ -1124,6 +1506,68 @@ func (s *FarmServer) RemoveAreaNotes(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
+// RetireArea is a FarmServer's handler to remove an area from use.
+// The area is kept in the read model, so the crop history can still show its name.
+func (s *FarmServer) RetireArea(c echo.Context) error {
+	data := make(map[string]DetailArea)
+
+	areaUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Validate //
+	queryResult := <-s.AreaReadQuery.FindByID(areaUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	areaRead, ok := queryResult.Result.(storage.AreaRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if areaRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NotFound, "id"))
+	}
+
+	// Process //
+	eventQueryResult := <-s.AreaEventQuery.FindAllByID(areaRead.UID)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.AreaEvent)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	area := repository.NewAreaFromHistory(events)
+
+	err = area.Retire(s.AreaService)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	err = <-s.AreaEventRepo.Save(area.UID, area.Version, area.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Publish //
+	s.publishUncommittedEvents(area)
+
+	detailArea, err := MapToDetailArea(s, *area)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data["data"] = detailArea
+
+	return c.JSON(http.StatusOK, data)
+}
+
 func (s *FarmServer) GetFarmAreas(c echo.Context) error {
 	farmUID, err := uuid.FromString(c.Param("id"))
 	if err != nil {
@@ -1247,9 +1691,21 @@ func (s *FarmServer) GetAreaPhotos(c echo.Context) error {
 	}
 
 	// Process //
-	srcPath := stringhelper.Join(*config.Config.UploadPathArea, "/", areaRead.Photo.Filename)
+	size := c.QueryParam("size")
+	if size != "" && !imagehelper.IsPhotoSize(size) {
+		return Error(c, NewRequestValidationError(InvalidOption, "size"))
+	}
 
-	return c.File(srcPath)
+	srcPath, err := imagehelper.GetRenditionPath(
+		s.File,
+		stringhelper.Join(*config.Config.UploadPathArea, "/", areaRead.Photo.Filename),
+		size,
+	)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return serveFile(c, s.File, srcPath)
 }
 
 func (s *FarmServer) GetTotalAreas(c echo.Context) error {
@@ -1274,10 +1730,15 @@ func (s *FarmServer) GetTotalAreas(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
-func (*FarmServer) GetInventoryPlantTypes(c echo.Context) error {
+func (s *FarmServer) GetInventoryPlantTypes(c echo.Context) error {
 	data := make(map[string][]string)
 
-	plantTypes := MapToPlantType(domain.PlantTypes())
+	catalog, err := s.materialTypeCatalogOfFarm(c.QueryParam("farm_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	plantTypes := MapToPlantType(catalog.PlantTypes)
 
 	data["data"] = plantTypes
 
@@ -1328,6 +1789,97 @@ func (s *FarmServer) GetMaterials(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
+// GetLowStockMaterials is a FarmServer's handler to list the materials whose quantity is below their reorder point.
+func (s *FarmServer) GetLowStockMaterials(c echo.Context) error {
+	queryResult := <-s.MaterialReadQuery.FindAll("", "", 0, 0)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	results, ok := queryResult.Result.([]storage.MaterialRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	materials := []Material{}
+
+	for _, v := range results {
+		m := MapToMaterialFromRead(v)
+		if m.LowStock {
+			materials = append(materials, m)
+		}
+	}
+
+	data := make(map[string][]Material)
+	data["data"] = materials
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// GetExpiringMaterials is a FarmServer's handler to list the materials which expired or expire
+// within the given number of days, the soonest first.
+func (s *FarmServer) GetExpiringMaterials(c echo.Context) error {
+	days := *config.Config.ExpirationWarningDays
+
+	if v := c.QueryParam("days"); v != "" {
+		d, err := strconv.Atoi(v)
+		if err != nil || d < 0 {
+			return Error(c, NewRequestValidationError(Numeric, "days"))
+		}
+
+		days = d
+	}
+
+	queryResult := <-s.MaterialReadQuery.FindAll("", "", 0, 0)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	results, ok := queryResult.Result.([]storage.MaterialRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	now := time.Now()
+	materials := []Material{}
+
+	for _, v := range results {
+		m := MapToMaterialFromRead(v)
+		if domain.IsMaterialExpiring(m.ExpirationDate, now, days) {
+			materials = append(materials, m)
+		}
+	}
+
+	sort.SliceStable(materials, func(i, j int) bool {
+		return materials[i].ExpirationDate.Before(*materials[j].ExpirationDate)
+	})
+
+	data := make(map[string][]Material)
+	data["data"] = materials
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// parseReorderPoint reads the reorder point of a material form.
+// An empty or zero reorder point is nil, which stops watching the stock of the material.
+func parseReorderPoint(value string) (*float32, error) {
+	var reorderPoint *float32
+
+	if value != "" {
+		rp, err := strconv.ParseFloat(value, 32)
+		if err != nil {
+			return reorderPoint, NewRequestValidationError(Float, "reorder_point")
+		}
+
+		if rp != 0 {
+			v := float32(rp)
+			reorderPoint = &v
+		}
+	}
+
+	return reorderPoint, nil
+}
+
 func (s *FarmServer) GetMaterialsSimple(c echo.Context) error {
 	materialType := c.QueryParam("type")
 	materialTypeDetail := c.QueryParam("type_detail")
@@ -1372,6 +1924,11 @@ func (s *FarmServer) SaveMaterial(c echo.Context) error {
 		return Error(c, NewRequestValidationError(InvalidOption, "quantity"))
 	}
 
+	reorderPoint, err := parseReorderPoint(c.FormValue("reorder_point"))
+	if err != nil {
+		return Error(c, err)
+	}
+
 	var expDate *time.Time
 
 	if expirationDate != "" {
@@ -1393,27 +1950,36 @@ func (s *FarmServer) SaveMaterial(c echo.Context) error {
 		pb = &producedBy
 	}
 
+	if c.FormValue("farm_id") == "" {
+		return Error(c, NewRequestValidationError(Required, "farm_id"))
+	}
+
+	catalog, err := s.materialTypeCatalogOfFarm(c.FormValue("farm_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
 	// Process //
 	var mt domain.MaterialType
 
 	switch materialTypeParam {
 	case strings.ToLower(domain.MaterialTypeSeedCode):
-		pt := domain.GetPlantType(plantType)
+		pt := catalog.FindPlantType(plantType)
 		if pt == (domain.PlantType{}) {
 			return Error(c, NewRequestValidationError(InvalidOption, "plant_type"))
 		}
 
-		mt, err = domain.CreateMaterialTypeSeed(pt.Code)
+		mt, err = domain.CreateMaterialTypeSeed(catalog, pt.Code)
 		if err != nil {
 			return Error(c, NewRequestValidationError(InvalidOption, "type"))
 		}
 	case strings.ToLower(domain.MaterialTypeAgrochemicalCode):
-		ct := domain.GetChemicalType(chemicalType)
+		ct := catalog.FindChemicalType(chemicalType)
 		if ct == (domain.ChemicalType{}) {
 			return Error(c, NewRequestValidationError(InvalidOption, "chemical_type"))
 		}
 
-		mt, err = domain.CreateMaterialTypeAgrochemical(ct.Code)
+		mt, err = domain.CreateMaterialTypeAgrochemical(catalog, ct.Code)
 		if err != nil {
 			return Error(c, NewRequestValidationError(InvalidOption, "type"))
 		}
@@ -1422,12 +1988,12 @@ func (s *FarmServer) SaveMaterial(c echo.Context) error {
 	case strings.ToLower(domain.MaterialTypeLabelAndCropSupportCode):
 		mt = domain.MaterialTypeLabelAndCropSupport{}
 	case strings.ToLower(domain.MaterialTypeSeedingContainerCode):
-		ct := domain.GetContainerType(containerType)
+		ct := catalog.FindContainerType(containerType)
 		if ct == (domain.ContainerType{}) {
 			return Error(c, NewRequestValidationError(InvalidOption, "container_type"))
 		}
 
-		mt, err = domain.CreateMaterialTypeSeedingContainer(ct.Code)
+		mt, err = domain.CreateMaterialTypeSeedingContainer(catalog, ct.Code)
 		if err != nil {
 			return Error(c, NewRequestValidationError(InvalidOption, "type"))
 		}
@@ -1436,12 +2002,12 @@ func (s *FarmServer) SaveMaterial(c echo.Context) error {
 	case strings.ToLower(domain.MaterialTypeOtherCode):
 		mt = domain.MaterialTypeOther{}
 	case strings.ToLower(domain.MaterialTypePlantCode):
-		pt := domain.GetPlantType(plantType)
+		pt := catalog.FindPlantType(plantType)
 		if pt == (domain.PlantType{}) {
 			return Error(c, NewRequestValidationError(InvalidOption, "plant_type"))
 		}
 
-		mt, err = domain.CreateMaterialTypePlant(pt.Code)
+		mt, err = domain.CreateMaterialTypePlant(catalog, pt.Code)
 		if err != nil {
 			return Error(c, NewRequestValidationError(InvalidOption, "type"))
 		}
@@ -1454,6 +2020,18 @@ func (s *FarmServer) SaveMaterial(c echo.Context) error {
 		return Error(c, err)
 	}
 
+	err = material.AssignFarm(catalog.FarmUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	if reorderPoint != nil {
+		err = material.ChangeReorderPoint(reorderPoint)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
 	// Persist //
 	err = <-s.MaterialEventRepo.Save(material.UID, material.Version, material.UncommittedChanges)
 	if err != nil {
@@ -1508,6 +2086,11 @@ func (s *FarmServer) UpdateMaterial(c echo.Context) error {
 		return Error(c, NewRequestValidationError(Required, "quantity"))
 	}
 
+	reorderPoint, err := parseReorderPoint(c.FormValue("reorder_point"))
+	if err != nil {
+		return Error(c, err)
+	}
+
 	var expDate *time.Time
 
 	if expirationDate != "" {
@@ -1543,18 +2126,27 @@ func (s *FarmServer) UpdateMaterial(c echo.Context) error {
 		return Error(c, NewRequestValidationError(NotFound, "id"))
 	}
 
+	if c.FormValue("farm_id") == "" {
+		return Error(c, NewRequestValidationError(Required, "farm_id"))
+	}
+
+	catalog, err := s.materialTypeCatalogOfFarm(c.FormValue("farm_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
 	// Process //
 	var mt domain.MaterialType
 
 	switch materialTypeParam {
 	case strings.ToLower(domain.MaterialTypeSeedCode):
 		if plantType != "" {
-			pt := domain.GetPlantType(plantType)
+			pt := catalog.FindPlantType(plantType)
 			if pt == (domain.PlantType{}) {
 				return Error(c, NewRequestValidationError(InvalidOption, "plant_type"))
 			}
 
-			mt, err = domain.CreateMaterialTypeSeed(pt.Code)
+			mt, err = domain.CreateMaterialTypeSeed(catalog, pt.Code)
 			if err != nil {
 				return Error(c, NewRequestValidationError(InvalidOption, "type"))
 			}
@@ -1563,12 +2155,12 @@ func (s *FarmServer) UpdateMaterial(c echo.Context) error {
 		}
 	case strings.ToLower(domain.MaterialTypeAgrochemicalCode):
 		if chemicalType != "" {
-			ct := domain.GetChemicalType(chemicalType)
+			ct := catalog.FindChemicalType(chemicalType)
 			if ct == (domain.ChemicalType{}) {
 				return Error(c, NewRequestValidationError(InvalidOption, "chemical_type"))
 			}
 
-			mt, err = domain.CreateMaterialTypeAgrochemical(ct.Code)
+			mt, err = domain.CreateMaterialTypeAgrochemical(catalog, ct.Code)
 			if err != nil {
 				return Error(c, NewRequestValidationError(InvalidOption, "type"))
 			}
@@ -1577,12 +2169,12 @@ func (s *FarmServer) UpdateMaterial(c echo.Context) error {
 		}
 	case strings.ToLower(domain.MaterialTypeSeedingContainerCode):
 		if containerType != "" {
-			ct := domain.GetContainerType(containerType)
+			ct := catalog.FindContainerType(containerType)
 			if ct == (domain.ContainerType{}) {
 				return Error(c, NewRequestValidationError(InvalidOption, "container_type"))
 			}
 
-			mt, err = domain.CreateMaterialTypeSeedingContainer(ct.Code)
+			mt, err = domain.CreateMaterialTypeSeedingContainer(catalog, ct.Code)
 			if err != nil {
 				return Error(c, NewRequestValidationError(InvalidOption, "type"))
 			}
@@ -1591,12 +2183,12 @@ func (s *FarmServer) UpdateMaterial(c echo.Context) error {
 		}
 	case strings.ToLower(domain.MaterialTypePlantCode):
 		if plantType != "" {
-			pt := domain.GetPlantType(plantType)
+			pt := catalog.FindPlantType(plantType)
 			if pt == (domain.PlantType{}) {
 				return Error(c, NewRequestValidationError(InvalidOption, "plant_type"))
 			}
 
-			mt, err = domain.CreateMaterialTypePlant(pt.Code)
+			mt, err = domain.CreateMaterialTypePlant(catalog, pt.Code)
 			if err != nil {
 				return Error(c, NewRequestValidationError(InvalidOption, "type"))
 			}
@@ -1613,6 +2205,17 @@ func (s *FarmServer) UpdateMaterial(c echo.Context) error {
 	events := eventQueryResult.Result.([]storage.MaterialEvent)
 	material := repository.NewMaterialFromHistory(events)
 
+	// The types come from the catalog of the farm which keeps the material stock.
+	// Materials created before the farms kept their own stock are assigned the farm_id.
+	if material.FarmUID != (uuid.UUID{}) && material.FarmUID != catalog.FarmUID {
+		return Error(c, NewRequestValidationError(InvalidOption, "farm_id"))
+	}
+
+	err = material.AssignFarm(catalog.FarmUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
 	if name != "" {
 		material.ChangeName(name)
 	}
@@ -1631,7 +2234,10 @@ func (s *FarmServer) UpdateMaterial(c echo.Context) error {
 			return Error(c, err)
 		}
 
-		material.ChangeQuantityUnit(float32(q), quantityUnit, materialRead.Type)
+		err = material.ChangeQuantityUnit(float32(q), quantityUnit, materialRead.Type)
+		if err != nil {
+			return Error(c, err)
+		}
 	}
 
 	if expDate != nil {
@@ -1646,6 +2252,13 @@ func (s *FarmServer) UpdateMaterial(c echo.Context) error {
 		material.ChangeProducedBy(*pb)
 	}
 
+	if c.FormValue("reorder_point") != "" {
+		err = material.ChangeReorderPoint(reorderPoint)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
 	// Persist //
 	err = <-s.MaterialEventRepo.Save(material.UID, material.Version, material.UncommittedChanges)
 	if err != nil {
@@ -1724,5 +2337,15 @@ func (s *FarmServer) publishUncommittedEvents(entity interface{}) {
 			name := structhelper.GetName(v)
 			s.EventBus.Publish(name, v)
 		}
+	case *domain.Supplier:
+		for _, v := range e.UncommittedChanges {
+			name := structhelper.GetName(v)
+			s.EventBus.Publish(name, v)
+		}
+	case *domain.Equipment:
+		for _, v := range e.UncommittedChanges {
+			name := structhelper.GetName(v)
+			s.EventBus.Publish(name, v)
+		}
 	}
 }
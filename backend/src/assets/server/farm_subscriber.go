@@ -4,7 +4,9 @@ import (
 	"errors"
 	"log"
 
+	"github.com/gofrs/uuid"
 	"github.com/usetania/tania-core/src/assets/domain"
+	"github.com/usetania/tania-core/src/assets/repository"
 	"github.com/usetania/tania-core/src/assets/storage"
 )
 
@@ -21,6 +23,7 @@ func (s *FarmServer) SaveToFarmReadModel(event interface{}) error {
 		farmRead.Country = e.Country
 		farmRead.City = e.City
 		farmRead.IsActive = e.IsActive
+		farmRead.Currency = domain.BaseCurrency("")
 		farmRead.CreatedDate = e.CreatedDate
 
 	case domain.FarmNameChanged:
@@ -84,6 +87,104 @@ func (s *FarmServer) SaveToFarmReadModel(event interface{}) error {
 
 		farm.Country = e.Country
 		farm.City = e.City
+
+	case domain.FarmArchived:
+		queryResult := <-s.FarmReadQuery.FindByID(e.FarmUID)
+		if queryResult.Error != nil {
+			log.Println(queryResult.Error)
+		}
+
+		farm, ok := queryResult.Result.(storage.FarmRead)
+		if !ok {
+			log.Println(errors.New("internal server error. error type assertion"))
+		}
+
+		farmRead = &farm
+
+		farm.IsActive = false
+
+	case domain.FarmReactivated:
+		queryResult := <-s.FarmReadQuery.FindByID(e.FarmUID)
+		if queryResult.Error != nil {
+			log.Println(queryResult.Error)
+		}
+
+		farm, ok := queryResult.Result.(storage.FarmRead)
+		if !ok {
+			log.Println(errors.New("internal server error. error type assertion"))
+		}
+
+		farmRead = &farm
+
+		farm.IsActive = true
+
+	case domain.FarmUnitSystemChanged:
+		queryResult := <-s.FarmReadQuery.FindByID(e.FarmUID)
+		if queryResult.Error != nil {
+			log.Println(queryResult.Error)
+		}
+
+		farm, ok := queryResult.Result.(storage.FarmRead)
+		if !ok {
+			log.Println(errors.New("internal server error. error type assertion"))
+		}
+
+		farmRead = &farm
+
+		farm.UnitSystem = e.UnitSystem
+
+	case domain.FarmCurrencyChanged:
+		queryResult := <-s.FarmReadQuery.FindByID(e.FarmUID)
+		if queryResult.Error != nil {
+			log.Println(queryResult.Error)
+		}
+
+		farm, ok := queryResult.Result.(storage.FarmRead)
+		if !ok {
+			log.Println(errors.New("internal server error. error type assertion"))
+		}
+
+		farmRead = &farm
+
+		farm.Currency = e.Currency
+
+	case domain.FarmExchangeRateAdded:
+		queryResult := <-s.FarmReadQuery.FindByID(e.FarmUID)
+		if queryResult.Error != nil {
+			log.Println(queryResult.Error)
+		}
+
+		farm, ok := queryResult.Result.(storage.FarmRead)
+		if !ok {
+			log.Println(errors.New("internal server error. error type assertion"))
+		}
+
+		farmRead = &farm
+
+		farm.ExchangeRates = append(farm.ExchangeRates, storage.FarmExchangeRate(e.ExchangeRate))
+
+	case domain.FarmExchangeRateRemoved:
+		queryResult := <-s.FarmReadQuery.FindByID(e.FarmUID)
+		if queryResult.Error != nil {
+			log.Println(queryResult.Error)
+		}
+
+		farm, ok := queryResult.Result.(storage.FarmRead)
+		if !ok {
+			log.Println(errors.New("internal server error. error type assertion"))
+		}
+
+		farmRead = &farm
+
+		rates := []storage.FarmExchangeRate{}
+
+		for _, v := range farm.ExchangeRates {
+			if v.UID != e.ExchangeRateUID {
+				rates = append(rates, v)
+			}
+		}
+
+		farm.ExchangeRates = rates
 	}
 
 	err := <-s.FarmReadRepo.Save(farmRead)
@@ -215,6 +316,90 @@ func (s *FarmServer) SaveToReservoirReadModel(event interface{}) error {
 		}
 
 		reservoirRead.Notes = notes
+
+	case domain.ReservoirWaterPerWateringChanged:
+		queryResult := <-s.ReservoirReadQuery.FindByID(e.ReservoirUID)
+		if queryResult.Error != nil {
+			log.Println(queryResult.Error)
+		}
+
+		r, ok := queryResult.Result.(storage.ReservoirRead)
+		if !ok {
+			log.Println(errors.New("internal server error. error type assertion"))
+		}
+
+		reservoirRead = &r
+
+		reservoirRead.WaterPerWatering = e.WaterPerWatering
+
+	case domain.ReservoirLevelRecorded:
+		s.saveReservoirLevel(e.ReservoirUID, storage.ReservoirLevelEntry{
+			Type:   domain.ReservoirLevelReading,
+			Source: e.Source,
+			Level:  e.Level,
+			Date:   e.ReadingDate,
+		})
+
+		reservoirRead = s.findReservoirReadFromHistory(e.ReservoirUID)
+
+	case domain.ReservoirRefilled:
+		s.saveReservoirLevel(e.ReservoirUID, storage.ReservoirLevelEntry{
+			Type:   domain.ReservoirLevelRefill,
+			Level:  e.Level,
+			Volume: e.Volume,
+			Date:   e.RefillDate,
+		})
+
+		reservoirRead = s.findReservoirReadFromHistory(e.ReservoirUID)
+
+	case domain.ReservoirWaterConsumed:
+		s.saveReservoirLevel(e.ReservoirUID, storage.ReservoirLevelEntry{
+			Type:    domain.ReservoirLevelConsumption,
+			Level:   e.Level,
+			Volume:  e.Volume,
+			AreaUID: e.AreaUID,
+			CropUID: e.CropUID,
+			Date:    e.ConsumedDate,
+		})
+
+		reservoirRead = s.findReservoirReadFromHistory(e.ReservoirUID)
+
+	case domain.ReservoirWaterQualityMeasured:
+		measurement := storage.ReservoirWaterQualityMeasurement{
+			Parameter:    e.Parameter,
+			Value:        e.Value,
+			Unit:         e.Unit,
+			Source:       e.Source,
+			MeasuredDate: e.MeasuredDate,
+		}
+
+		err := <-s.ReservoirReadRepo.SaveWaterQualityMeasurement(e.ReservoirUID, &measurement)
+		if err != nil {
+			log.Println(err)
+		}
+
+		reservoirRead = s.findReservoirReadFromHistory(e.ReservoirUID)
+
+	case domain.ReservoirWaterQualityRangeChanged:
+		reservoirRead = s.findReservoirReadFromHistory(e.ReservoirUID)
+
+	case domain.ReservoirNutrientRecipeChanged:
+		reservoirRead = s.findReservoirReadFromHistory(e.ReservoirUID)
+
+	case domain.ReservoirPhotoAdded:
+		reservoirRead = s.findReservoirReadFromHistory(e.ReservoirUID)
+
+	case domain.ReservoirPhotoUpdated:
+		reservoirRead = s.findReservoirReadFromHistory(e.ReservoirUID)
+
+	case domain.ReservoirPhotoRemoved:
+		reservoirRead = s.findReservoirReadFromHistory(e.ReservoirUID)
+
+	case domain.ReservoirPhotosReordered:
+		reservoirRead = s.findReservoirReadFromHistory(e.ReservoirUID)
+
+	case domain.ReservoirCoverPhotoChanged:
+		reservoirRead = s.findReservoirReadFromHistory(e.ReservoirUID)
 	}
 
 	err := <-s.ReservoirReadRepo.Save(reservoirRead)
@@ -225,6 +410,79 @@ func (s *FarmServer) SaveToReservoirReadModel(event interface{}) error {
 	return nil
 }
 
+// saveReservoirLevel adds a reading, a refill or an estimated consumption to the level history read model.
+func (s *FarmServer) saveReservoirLevel(reservoirUID uuid.UUID, level storage.ReservoirLevelEntry) {
+	err := <-s.ReservoirReadRepo.SaveLevel(reservoirUID, &level)
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// findReservoirReadFromHistory gets the reservoir read model with its level, water quality, nutrient recipe
+// and photos updated from the reservoir history, since the daily consumption is averaged on the previous levels.
+func (s *FarmServer) findReservoirReadFromHistory(reservoirUID uuid.UUID) *storage.ReservoirRead {
+	queryResult := <-s.ReservoirReadQuery.FindByID(reservoirUID)
+	if queryResult.Error != nil {
+		log.Println(queryResult.Error)
+	}
+
+	reservoirRead, ok := queryResult.Result.(storage.ReservoirRead)
+	if !ok {
+		log.Println(errors.New("internal server error. error type assertion"))
+	}
+
+	eventQueryResult := <-s.ReservoirEventQuery.FindAllByID(reservoirUID)
+	if eventQueryResult.Error != nil {
+		log.Println(eventQueryResult.Error)
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.ReservoirEvent)
+	if !ok {
+		log.Println(errors.New("internal server error. error type assertion"))
+	}
+
+	reservoir := repository.NewReservoirFromHistory(events)
+
+	reservoirRead.Level = MapToReservoirLevel(*reservoir)
+	reservoirRead.WaterQuality = MapToReservoirWaterQuality(*reservoir)
+	reservoirRead.NutrientRecipe = MapToReservoirNutrientRecipe(*reservoir)
+	reservoirRead.Photo = storage.ReservoirPhoto(reservoir.Photo)
+	reservoirRead.Photos = MapToReservoirPhotos(*reservoir)
+
+	return &reservoirRead
+}
+
+// findAreaReadFromHistory gets the area read model with its photo gallery updated from the area history,
+// since removing the cover photo changes it.
+func (s *FarmServer) findAreaReadFromHistory(areaUID uuid.UUID) *storage.AreaRead {
+	queryResult := <-s.AreaReadQuery.FindByID(areaUID)
+	if queryResult.Error != nil {
+		log.Println(queryResult.Error)
+	}
+
+	areaRead, ok := queryResult.Result.(storage.AreaRead)
+	if !ok {
+		log.Println(errors.New("internal server error. error type assertion"))
+	}
+
+	eventQueryResult := <-s.AreaEventQuery.FindAllByID(areaUID)
+	if eventQueryResult.Error != nil {
+		log.Println(eventQueryResult.Error)
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.AreaEvent)
+	if !ok {
+		log.Println(errors.New("internal server error. error type assertion"))
+	}
+
+	area := repository.NewAreaFromHistory(events)
+
+	areaRead.Photo = storage.AreaPhoto(area.Photo)
+	areaRead.Photos = MapToAreaPhotos(*area)
+
+	return &areaRead
+}
+
 func (s *FarmServer) SaveToAreaReadModel(event interface{}) error {
 	areaRead := &storage.AreaRead{}
 
@@ -254,7 +512,7 @@ func (s *FarmServer) SaveToAreaReadModel(event interface{}) error {
 		areaRead.Name = e.Name
 		areaRead.Type = e.Type.Code
 		areaRead.Location = storage.AreaLocation(e.Location)
-		areaRead.Size = storage.AreaSize(e.Size)
+		areaRead.Size = storage.AreaSize(domain.ConvertAreaSize(e.Size, domain.SquareMeter))
 		areaRead.CreatedDate = e.CreatedDate
 		areaRead.Farm = storage.AreaFarm{
 			UID:  farm.UID,
@@ -293,7 +551,7 @@ func (s *FarmServer) SaveToAreaReadModel(event interface{}) error {
 
 		areaRead = &area
 
-		areaRead.Size = storage.AreaSize(e.Size)
+		areaRead.Size = storage.AreaSize(domain.ConvertAreaSize(e.Size, domain.SquareMeter))
 
 	case domain.AreaTypeChanged:
 		queryResult := <-s.AreaReadQuery.FindByID(e.AreaUID)
@@ -354,25 +612,19 @@ func (s *FarmServer) SaveToAreaReadModel(event interface{}) error {
 		}
 
 	case domain.AreaPhotoAdded:
-		queryResult := <-s.AreaReadQuery.FindByID(e.AreaUID)
-		if queryResult.Error != nil {
-			log.Println(queryResult.Error)
-		}
+		areaRead = s.findAreaReadFromHistory(e.AreaUID)
 
-		area, ok := queryResult.Result.(storage.AreaRead)
-		if !ok {
-			log.Println(errors.New("internal server error. error type assertion"))
-		}
+	case domain.AreaPhotoUpdated:
+		areaRead = s.findAreaReadFromHistory(e.AreaUID)
 
-		areaRead = &area
+	case domain.AreaPhotoRemoved:
+		areaRead = s.findAreaReadFromHistory(e.AreaUID)
 
-		areaRead.Photo = storage.AreaPhoto{
-			Filename: e.Filename,
-			MimeType: e.MimeType,
-			Size:     e.Size,
-			Width:    e.Width,
-			Height:   e.Height,
-		}
+	case domain.AreaPhotosReordered:
+		areaRead = s.findAreaReadFromHistory(e.AreaUID)
+
+	case domain.AreaCoverPhotoChanged:
+		areaRead = s.findAreaReadFromHistory(e.AreaUID)
 
 	case domain.AreaNoteAdded:
 		queryResult := <-s.AreaReadQuery.FindByID(e.AreaUID)
@@ -419,6 +671,57 @@ func (s *FarmServer) SaveToAreaReadModel(event interface{}) error {
 		}
 
 		areaRead.Notes = notes
+
+	case domain.AreaRetired:
+		queryResult := <-s.AreaReadQuery.FindByID(e.AreaUID)
+		if queryResult.Error != nil {
+			log.Println(queryResult.Error)
+		}
+
+		area, ok := queryResult.Result.(storage.AreaRead)
+		if !ok {
+			log.Println(errors.New("internal server error. error type assertion"))
+		}
+
+		areaRead = &area
+
+		areaRead.RetiredDate = &e.RetiredDate
+
+	case domain.AreaBoundaryChanged:
+		queryResult := <-s.AreaReadQuery.FindByID(e.AreaUID)
+		if queryResult.Error != nil {
+			log.Println(queryResult.Error)
+		}
+
+		area, ok := queryResult.Result.(storage.AreaRead)
+		if !ok {
+			log.Println(errors.New("internal server error. error type assertion"))
+		}
+
+		areaRead = &area
+
+		boundary := storage.AreaBoundary(e.Boundary)
+		areaRead.Boundary = &boundary
+		areaRead.Size = storage.AreaSize(domain.ConvertAreaSize(e.Size, domain.SquareMeter))
+
+	case domain.AreaCapacityChanged:
+		queryResult := <-s.AreaReadQuery.FindByID(e.AreaUID)
+		if queryResult.Error != nil {
+			log.Println(queryResult.Error)
+		}
+
+		area, ok := queryResult.Result.(storage.AreaRead)
+		if !ok {
+			log.Println(errors.New("internal server error. error type assertion"))
+		}
+
+		areaRead = &area
+		areaRead.Capacity = nil
+
+		if e.Capacity != nil {
+			capacity := storage.AreaCapacity(*e.Capacity)
+			areaRead.Capacity = &capacity
+		}
 	}
 
 	err := <-s.AreaReadRepo.Save(areaRead)
@@ -551,6 +854,66 @@ func (s *FarmServer) SaveToMaterialReadModel(event interface{}) error {
 		materialRead = &material
 
 		materialRead.ProducedBy = &e.ProducedBy
+
+	case domain.MaterialConsumed:
+		queryResult := <-s.MaterialReadQuery.FindByID(e.MaterialUID)
+		if queryResult.Error != nil {
+			log.Println(queryResult.Error)
+		}
+
+		material, ok := queryResult.Result.(storage.MaterialRead)
+		if !ok {
+			log.Println(errors.New("internal server error. error type assertion"))
+		}
+
+		materialRead = &material
+
+		materialRead.Quantity = storage.MaterialQuantity(domain.MaterialQuantity(materialRead.Quantity).Consume(e.Quantity))
+
+	case domain.MaterialReorderPointChanged:
+		queryResult := <-s.MaterialReadQuery.FindByID(e.MaterialUID)
+		if queryResult.Error != nil {
+			log.Println(queryResult.Error)
+		}
+
+		material, ok := queryResult.Result.(storage.MaterialRead)
+		if !ok {
+			log.Println(errors.New("internal server error. error type assertion"))
+		}
+
+		materialRead = &material
+
+		materialRead.ReorderPoint = e.ReorderPoint
+
+	case domain.MaterialStockReceived:
+		queryResult := <-s.MaterialReadQuery.FindByID(e.MaterialUID)
+		if queryResult.Error != nil {
+			log.Println(queryResult.Error)
+		}
+
+		material, ok := queryResult.Result.(storage.MaterialRead)
+		if !ok {
+			log.Println(errors.New("internal server error. error type assertion"))
+		}
+
+		materialRead = &material
+
+		materialRead.Quantity.Value += e.Quantity
+
+	case domain.MaterialStockIssued:
+		queryResult := <-s.MaterialReadQuery.FindByID(e.MaterialUID)
+		if queryResult.Error != nil {
+			log.Println(queryResult.Error)
+		}
+
+		material, ok := queryResult.Result.(storage.MaterialRead)
+		if !ok {
+			log.Println(errors.New("internal server error. error type assertion"))
+		}
+
+		materialRead = &material
+
+		materialRead.Quantity = storage.MaterialQuantity(domain.MaterialQuantity(materialRead.Quantity).Consume(e.Quantity))
 	}
 
 	err := <-s.MaterialReadRepo.Save(materialRead)
@@ -560,3 +923,56 @@ func (s *FarmServer) SaveToMaterialReadModel(event interface{}) error {
 
 	return nil
 }
+
+func (s *FarmServer) SaveToSupplierReadModel(event interface{}) error {
+	supplierRead := &storage.SupplierRead{}
+
+	switch e := event.(type) {
+	case domain.SupplierCreated:
+		supplierRead.UID = e.UID
+		supplierRead.Name = e.Name
+		supplierRead.Phone = e.Phone
+		supplierRead.Email = e.Email
+		supplierRead.Address = e.Address
+		supplierRead.CreatedDate = e.CreatedDate
+
+	case domain.SupplierNameChanged:
+		queryResult := <-s.SupplierReadQuery.FindByID(e.SupplierUID)
+		if queryResult.Error != nil {
+			log.Println(queryResult.Error)
+		}
+
+		supplier, ok := queryResult.Result.(storage.SupplierRead)
+		if !ok {
+			log.Println(errors.New("internal server error. error type assertion"))
+		}
+
+		supplierRead = &supplier
+
+		supplierRead.Name = e.Name
+
+	case domain.SupplierContactChanged:
+		queryResult := <-s.SupplierReadQuery.FindByID(e.SupplierUID)
+		if queryResult.Error != nil {
+			log.Println(queryResult.Error)
+		}
+
+		supplier, ok := queryResult.Result.(storage.SupplierRead)
+		if !ok {
+			log.Println(errors.New("internal server error. error type assertion"))
+		}
+
+		supplierRead = &supplier
+
+		supplierRead.Phone = e.Phone
+		supplierRead.Email = e.Email
+		supplierRead.Address = e.Address
+	}
+
+	err := <-s.SupplierReadRepo.Save(supplierRead)
+	if err != nil {
+		log.Println(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"log"
+	"mime/multipart"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/usetania/tania-core/src/helper/imagehelper"
+	"github.com/usetania/tania-core/src/helper/stringhelper"
+)
+
+// galleryPhoto is an uploaded photo of an area or reservoir gallery.
+// The file is stored under the photo UID, as photos of different galleries can have the same client filename.
+type galleryPhoto struct {
+	UID      uuid.UUID
+	Filename string
+	MimeType string
+	Size     int
+	Width    int
+	Height   int
+}
+
+// uploadGalleryPhoto stores the uploaded photo in the upload path with its renditions.
+func (s *FarmServer) uploadGalleryPhoto(photo *multipart.FileHeader, uploadPath string) (galleryPhoto, error) {
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return galleryPhoto{}, err
+	}
+
+	filename := uid.String() + strings.ToLower(path.Ext(photo.Filename))
+	destPath := stringhelper.Join(uploadPath, "/", filename)
+
+	err = s.File.Upload(photo, destPath)
+	if err != nil {
+		return galleryPhoto{}, err
+	}
+
+	width, height, err := imagehelper.SaveRenditions(s.File, destPath)
+	if err != nil {
+		return galleryPhoto{}, err
+	}
+
+	return galleryPhoto{
+		UID:      uid,
+		Filename: filename,
+		MimeType: photo.Header.Get("Content-Type"),
+		Size:     int(photo.Size),
+		Width:    width,
+		Height:   height,
+	}, nil
+}
+
+// serveGalleryPhoto serves the file of a gallery photo as a thumb, medium or original size.
+func (s *FarmServer) serveGalleryPhoto(c echo.Context, uploadPath, filename string) error {
+	size := c.QueryParam("size")
+	if size != "" && !imagehelper.IsPhotoSize(size) {
+		return Error(c, NewRequestValidationError(InvalidOption, "size"))
+	}
+
+	srcPath, err := imagehelper.GetRenditionPath(s.File, stringhelper.Join(uploadPath, "/", filename), size)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return serveFile(c, s.File, srcPath)
+}
+
+// removeGalleryPhotoFiles deletes the file of a removed gallery photo with its renditions.
+// The photo is already removed, so a file left behind is only logged.
+func (s *FarmServer) removeGalleryPhotoFiles(uploadPath, filename string) {
+	srcPath := stringhelper.Join(uploadPath, "/", filename)
+	sizes := []string{imagehelper.PhotoSizeOriginal, imagehelper.PhotoSizeThumb, imagehelper.PhotoSizeMedium}
+
+	for _, size := range sizes {
+		destPath := imagehelper.RenditionPath(srcPath, size)
+
+		err := s.File.RemoveFile(destPath)
+		if err != nil {
+			log.Print("Can't remove the photo file ", destPath, ": ", err)
+		}
+	}
+}
+
+// parsePhotoTakenDate reads the date a photo was taken, with its time or not. An empty date is unknown.
+func parsePhotoTakenDate(value string) (*time.Time, error) {
+	var takenDate *time.Time
+
+	if value != "" {
+		date, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			date, err = time.Parse("2006-01-02", value)
+			if err != nil {
+				return takenDate, NewRequestValidationError(ParseFailed, "taken_date")
+			}
+		}
+
+		takenDate = &date
+	}
+
+	return takenDate, nil
+}
+
+// parsePhotoOrder reads the photo_id values of a gallery order form.
+func parsePhotoOrder(c echo.Context) ([]uuid.UUID, error) {
+	params, err := c.FormParams()
+	if err != nil {
+		return nil, err
+	}
+
+	photoUIDs := []uuid.UUID{}
+
+	for _, v := range params["photo_id"] {
+		uid, err := uuid.FromString(v)
+		if err != nil {
+			return nil, NewRequestValidationError(ParseFailed, "photo_id")
+		}
+
+		photoUIDs = append(photoUIDs, uid)
+	}
+
+	return photoUIDs, nil
+}
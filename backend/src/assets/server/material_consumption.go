@@ -0,0 +1,194 @@
+package server
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/usetania/tania-core/src/assets/domain"
+	"github.com/usetania/tania-core/src/assets/repository"
+	"github.com/usetania/tania-core/src/assets/storage"
+	"github.com/usetania/tania-core/src/helper/eventhelper"
+)
+
+// MaterialConsumption is a quantity of a material used by a completed task, a crop batch, a reservoir dosing
+// or an animal treatment. Negative quantities were given back after a crop batch correction
+// or a dosing which could not be saved.
+type MaterialConsumption struct {
+	TaskUID      *uuid.UUID `json:"task_id"`
+	CropUID      *uuid.UUID `json:"crop_id"`
+	ReservoirUID *uuid.UUID `json:"reservoir_id"`
+	AnimalUID    *uuid.UUID `json:"animal_id"`
+	Quantity     float32    `json:"quantity"`
+	ConsumedDate time.Time  `json:"consumed_date"`
+}
+
+// ConsumeTaskMaterial is a subscriber which takes the material quantity used by a completed task out of the stock.
+func (s *FarmServer) ConsumeTaskMaterial(event interface{}) error {
+	e := taskCompleted{}
+
+	err := eventhelper.Decode(event, &e)
+	if err != nil {
+		log.Println(err)
+
+		return err
+	}
+
+	if e.MaterialID == nil || e.MaterialQuantity <= 0 {
+		return nil
+	}
+
+	return s.consumeMaterial(*e.MaterialID, func(material *domain.Material) error {
+		return material.Consume(e.UID, e.MaterialQuantity)
+	})
+}
+
+// ConsumeCropMaterial is a subscriber which takes the materials used by a crop batch out of the stock,
+// or gives them back when the crop batch is corrected.
+func (s *FarmServer) ConsumeCropMaterial(event interface{}) error {
+	e := cropBatchInventoryUsed{}
+
+	err := eventhelper.Decode(event, &e)
+	if err != nil {
+		log.Println(err)
+
+		return err
+	}
+
+	return s.consumeMaterial(e.InventoryUID, func(material *domain.Material) error {
+		return material.ConsumeForCrop(e.UID, e.Quantity)
+	})
+}
+
+// ConsumeAnimalMaterial is a subscriber which takes the veterinary material given by an animal treatment
+// out of the stock. The treatments done by completing a task are left to the task.
+func (s *FarmServer) ConsumeAnimalMaterial(event interface{}) error {
+	e := animalTreated{}
+
+	err := eventhelper.Decode(event, &e)
+	if err != nil {
+		log.Println(err)
+
+		return err
+	}
+
+	if e.MaterialUID == nil || e.TaskUID != nil {
+		return nil
+	}
+
+	return s.consumeMaterial(*e.MaterialUID, func(material *domain.Material) error {
+		return material.ConsumeForAnimal(e.AnimalUID, e.Quantity)
+	})
+}
+
+func (s *FarmServer) consumeMaterial(materialUID uuid.UUID, consume func(material *domain.Material) error) error {
+	eventQueryResult := <-s.MaterialEventQuery.FindAllByID(materialUID)
+	if eventQueryResult.Error != nil {
+		log.Println(eventQueryResult.Error)
+
+		return eventQueryResult.Error
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.MaterialEvent)
+	if !ok {
+		log.Println(errors.New("internal server error. error type assertion"))
+
+		return nil
+	}
+
+	material := repository.NewMaterialFromHistory(events)
+
+	if material.UID == (uuid.UUID{}) {
+		log.Println("consumed material not found:", materialUID)
+
+		return nil
+	}
+
+	err := consume(material)
+	if err != nil {
+		log.Println(err)
+
+		return err
+	}
+
+	if len(material.UncommittedChanges) == 0 {
+		return nil
+	}
+
+	err = eventhelper.SaveFromSubscriber(s.MaterialEventRepo, material.UID, material.Version,
+		material.UncommittedChanges, s.SaveToMaterialReadModel)
+	if err != nil {
+		log.Println(err)
+
+		return err
+	}
+
+	return nil
+}
+
+// GetMaterialConsumptions is a FarmServer's handler to list the quantities of a material
+// used by completed tasks, crop batches, reservoir dosings and animal treatments.
+func (s *FarmServer) GetMaterialConsumptions(c echo.Context) error {
+	materialUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	eventQueryResult := <-s.MaterialEventQuery.FindAllByID(materialUID)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.MaterialEvent)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if len(events) == 0 {
+		return Error(c, NewRequestValidationError(NotFound, "id"))
+	}
+
+	consumptions := []MaterialConsumption{}
+
+	for _, v := range events {
+		e, ok := v.Event.(domain.MaterialConsumed)
+		if !ok {
+			continue
+		}
+
+		consumption := MaterialConsumption{
+			Quantity:     e.Quantity,
+			ConsumedDate: e.ConsumedDate,
+		}
+
+		if e.TaskUID != (uuid.UUID{}) {
+			taskUID := e.TaskUID
+			consumption.TaskUID = &taskUID
+		}
+
+		if e.CropUID != (uuid.UUID{}) {
+			cropUID := e.CropUID
+			consumption.CropUID = &cropUID
+		}
+
+		if e.ReservoirUID != (uuid.UUID{}) {
+			reservoirUID := e.ReservoirUID
+			consumption.ReservoirUID = &reservoirUID
+		}
+
+		if e.AnimalUID != (uuid.UUID{}) {
+			animalUID := e.AnimalUID
+			consumption.AnimalUID = &animalUID
+		}
+
+		consumptions = append(consumptions, consumption)
+	}
+
+	data := make(map[string][]MaterialConsumption)
+	data["data"] = consumptions
+
+	return c.JSON(http.StatusOK, data)
+}
@@ -0,0 +1,444 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/usetania/tania-core/src/assets/domain"
+	"github.com/usetania/tania-core/src/assets/repository"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+const (
+	MaterialLedgerOpening     = "OPENING"
+	MaterialLedgerAdjustment  = "ADJUSTMENT"
+	MaterialLedgerReceipt     = "RECEIPT"
+	MaterialLedgerIssue       = "ISSUE"
+	MaterialLedgerConsumption = "CONSUMPTION"
+)
+
+// MaterialLedgerEntry is a movement of a material stock. The quantity is positive when it comes in
+// and negative when it goes out, and the balance is the stock left after the movement.
+type MaterialLedgerEntry struct {
+	Type         string                `json:"type"`
+	Quantity     float32               `json:"quantity"`
+	Balance      float32               `json:"balance"`
+	Date         time.Time             `json:"date"`
+	Lots         []MaterialLotMovement `json:"lots"`
+	SupplierUID  *uuid.UUID            `json:"supplier_id"`
+	UnitCost     *domain.PricePerUnit  `json:"unit_cost"`
+	TaskUID      *uuid.UUID            `json:"task_id"`
+	CropUID      *uuid.UUID            `json:"crop_id"`
+	ReservoirUID *uuid.UUID            `json:"reservoir_id"`
+	AnimalUID    *uuid.UUID            `json:"animal_id"`
+	Reason       string                `json:"reason"`
+}
+
+// MaterialLotMovement is the part of a ledger entry which came in or went out of a lot.
+type MaterialLotMovement struct {
+	LotUID   uuid.UUID `json:"lot_id"`
+	Quantity float32   `json:"quantity"`
+}
+
+// MaterialLotDetail is a received lot with the tasks, crop batches and issues which used it.
+type MaterialLotDetail struct {
+	domain.MaterialLot
+	Usages []MaterialLotUsage `json:"usages"`
+}
+
+// MaterialLotUsage is a quantity taken from a lot. Negative quantities were given back
+// after a crop batch correction.
+type MaterialLotUsage struct {
+	TaskUID      *uuid.UUID `json:"task_id"`
+	CropUID      *uuid.UUID `json:"crop_id"`
+	ReservoirUID *uuid.UUID `json:"reservoir_id"`
+	AnimalUID    *uuid.UUID `json:"animal_id"`
+	Reason       string     `json:"reason"`
+	Quantity     float32    `json:"quantity"`
+	Date         time.Time  `json:"date"`
+}
+
+// SaveMaterialReceipt is a FarmServer's handler to receive a lot of a material from a supplier.
+func (s *FarmServer) SaveMaterialReceipt(c echo.Context) error {
+	data := make(map[string]domain.MaterialLot)
+
+	materialUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	quantity := c.FormValue("quantity")
+	unitCost := c.FormValue("unit_cost")
+	currencyCode := c.FormValue("currency_code")
+	supplierID := c.FormValue("supplier_id")
+	receivedDate := c.FormValue("received_date")
+	expirationDate := c.FormValue("expiration_date")
+
+	// Validate //
+	if quantity == "" {
+		return Error(c, NewRequestValidationError(Required, "quantity"))
+	}
+
+	q, err := strconv.ParseFloat(quantity, 32)
+	if err != nil {
+		return Error(c, NewRequestValidationError(Float, "quantity"))
+	}
+
+	supplierUID := uuid.UUID{}
+
+	if supplierID != "" {
+		supplierUID, err = uuid.FromString(supplierID)
+		if err != nil {
+			return Error(c, NewRequestValidationError(ParseFailed, "supplier_id"))
+		}
+
+		queryResult := <-s.SupplierReadQuery.FindByID(supplierUID)
+		if queryResult.Error != nil {
+			return Error(c, queryResult.Error)
+		}
+
+		supplier, ok := queryResult.Result.(storage.SupplierRead)
+		if !ok {
+			return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+		}
+
+		if supplier.UID == (uuid.UUID{}) {
+			return Error(c, NewRequestValidationError(NotFound, "supplier_id"))
+		}
+	}
+
+	rDate := time.Now()
+
+	if receivedDate != "" {
+		rDate, err = time.Parse("2006-01-02", receivedDate)
+		if err != nil {
+			return Error(c, NewRequestValidationError(ParseFailed, "received_date"))
+		}
+	}
+
+	var expDate *time.Time
+
+	if expirationDate != "" {
+		tp, err := time.Parse("2006-01-02", expirationDate)
+		if err != nil {
+			return Error(c, NewRequestValidationError(ParseFailed, "expiration_date"))
+		}
+
+		expDate = &tp
+	}
+
+	material, err := s.findMaterialFromHistory(materialUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// The lot costs the material price unless told otherwise
+	cost := material.PricePerUnit
+
+	if unitCost != "" {
+		_, err = strconv.ParseFloat(unitCost, 64)
+		if err != nil {
+			return Error(c, NewRequestValidationError(Float, "unit_cost"))
+		}
+
+		if currencyCode == "" {
+			currencyCode = material.PricePerUnit.CurrencyCode
+		}
+
+		cost, err = domain.CreatePricePerUnit(unitCost, currencyCode)
+		if err != nil {
+			return Error(c, NewRequestValidationError(InvalidOption, "currency_code"))
+		}
+	}
+
+	// Process //
+	lot, err := material.ReceiveStock(c.FormValue("lot_number"), supplierUID, float32(q), cost, rDate, expDate)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persist //
+	err = <-s.MaterialEventRepo.Save(material.UID, material.Version, material.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Publish //
+	s.publishUncommittedEvents(material)
+
+	data["data"] = lot
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// SaveMaterialIssue is a FarmServer's handler to take a quantity of a material out of the stock,
+// from the given lot or from the oldest stock.
+func (s *FarmServer) SaveMaterialIssue(c echo.Context) error {
+	data := make(map[string]Material)
+
+	materialUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	quantity := c.FormValue("quantity")
+	lotID := c.FormValue("lot_id")
+
+	// Validate //
+	if quantity == "" {
+		return Error(c, NewRequestValidationError(Required, "quantity"))
+	}
+
+	q, err := strconv.ParseFloat(quantity, 32)
+	if err != nil {
+		return Error(c, NewRequestValidationError(Float, "quantity"))
+	}
+
+	var lotUID *uuid.UUID
+
+	if lotID != "" {
+		uid, err := uuid.FromString(lotID)
+		if err != nil {
+			return Error(c, NewRequestValidationError(ParseFailed, "lot_id"))
+		}
+
+		lotUID = &uid
+	}
+
+	material, err := s.findMaterialFromHistory(materialUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	err = material.IssueStock(float32(q), lotUID, c.FormValue("reason"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persist //
+	err = <-s.MaterialEventRepo.Save(material.UID, material.Version, material.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Publish //
+	s.publishUncommittedEvents(material)
+
+	data["data"] = MapToMaterial(*material)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// GetMaterialLedger is a FarmServer's handler to list the movements of a material stock,
+// from which its current quantity is derived.
+func (s *FarmServer) GetMaterialLedger(c echo.Context) error {
+	data := make(map[string][]MaterialLedgerEntry)
+
+	materialUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	events, err := s.findMaterialEvents(materialUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	entries := []MaterialLedgerEntry{}
+	material := &domain.Material{}
+
+	for _, v := range events {
+		before := material.Quantity.Value
+		material.Transition(v.Event)
+
+		entry := MaterialLedgerEntry{
+			Quantity: material.Quantity.Value - before,
+			Balance:  material.Quantity.Value,
+			Date:     v.CreatedDate,
+			Lots:     []MaterialLotMovement{},
+		}
+
+		switch e := v.Event.(type) {
+		case domain.MaterialCreated:
+			entry.Type = MaterialLedgerOpening
+			entry.Date = e.CreatedDate
+
+		case domain.MaterialQuantityChanged:
+			if entry.Quantity == 0 {
+				continue
+			}
+
+			entry.Type = MaterialLedgerAdjustment
+
+		case domain.MaterialStockReceived:
+			entry.Type = MaterialLedgerReceipt
+			entry.Date = e.ReceivedDate
+			entry.Lots = append(entry.Lots, MaterialLotMovement{LotUID: e.LotUID, Quantity: e.Quantity})
+			entry.UnitCost = &e.UnitCost
+
+			if e.SupplierUID != (uuid.UUID{}) {
+				entry.SupplierUID = &e.SupplierUID
+			}
+
+		case domain.MaterialStockIssued:
+			entry.Type = MaterialLedgerIssue
+			entry.Date = e.IssuedDate
+			entry.Lots = mapToLotMovements(e.Lots)
+			entry.Reason = e.Reason
+
+		case domain.MaterialConsumed:
+			entry.Type = MaterialLedgerConsumption
+			entry.Date = e.ConsumedDate
+			entry.Lots = mapToLotMovements(e.Lots)
+
+			if e.TaskUID != (uuid.UUID{}) {
+				entry.TaskUID = &e.TaskUID
+			}
+
+			if e.CropUID != (uuid.UUID{}) {
+				entry.CropUID = &e.CropUID
+			}
+
+			if e.ReservoirUID != (uuid.UUID{}) {
+				entry.ReservoirUID = &e.ReservoirUID
+			}
+
+			if e.AnimalUID != (uuid.UUID{}) {
+				entry.AnimalUID = &e.AnimalUID
+			}
+
+		default:
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+
+	data["data"] = entries
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// GetMaterialLots is a FarmServer's handler to list the received lots of a material
+// with the tasks and crop batches each of them went into.
+func (s *FarmServer) GetMaterialLots(c echo.Context) error {
+	data := make(map[string][]MaterialLotDetail)
+
+	materialUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	events, err := s.findMaterialEvents(materialUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	usages := map[uuid.UUID][]MaterialLotUsage{}
+
+	for _, v := range events {
+		switch e := v.Event.(type) {
+		case domain.MaterialStockIssued:
+			for _, l := range e.Lots {
+				usages[l.LotUID] = append(usages[l.LotUID], MaterialLotUsage{
+					Reason:   e.Reason,
+					Quantity: l.Quantity,
+					Date:     e.IssuedDate,
+				})
+			}
+
+		case domain.MaterialConsumed:
+			for _, l := range e.Lots {
+				usage := MaterialLotUsage{
+					Quantity: l.Quantity,
+					Date:     e.ConsumedDate,
+				}
+
+				if e.TaskUID != (uuid.UUID{}) {
+					taskUID := e.TaskUID
+					usage.TaskUID = &taskUID
+				}
+
+				if e.CropUID != (uuid.UUID{}) {
+					cropUID := e.CropUID
+					usage.CropUID = &cropUID
+				}
+
+				if e.ReservoirUID != (uuid.UUID{}) {
+					reservoirUID := e.ReservoirUID
+					usage.ReservoirUID = &reservoirUID
+				}
+
+				if e.AnimalUID != (uuid.UUID{}) {
+					animalUID := e.AnimalUID
+					usage.AnimalUID = &animalUID
+				}
+
+				usages[l.LotUID] = append(usages[l.LotUID], usage)
+			}
+		}
+	}
+
+	material := repository.NewMaterialFromHistory(events)
+
+	lots := []MaterialLotDetail{}
+
+	for _, v := range material.Lots {
+		lot := MaterialLotDetail{
+			MaterialLot: v,
+			Usages:      usages[v.UID],
+		}
+
+		if lot.Usages == nil {
+			lot.Usages = []MaterialLotUsage{}
+		}
+
+		lots = append(lots, lot)
+	}
+
+	data["data"] = lots
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// findMaterialEvents gets the events of a material, which is not found when it has none.
+func (s *FarmServer) findMaterialEvents(materialUID uuid.UUID) ([]storage.MaterialEvent, error) {
+	eventQueryResult := <-s.MaterialEventQuery.FindAllByID(materialUID)
+	if eventQueryResult.Error != nil {
+		return nil, eventQueryResult.Error
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.MaterialEvent)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	if len(events) == 0 {
+		return nil, NewRequestValidationError(NotFound, "id")
+	}
+
+	return events, nil
+}
+
+func (s *FarmServer) findMaterialFromHistory(materialUID uuid.UUID) (*domain.Material, error) {
+	events, err := s.findMaterialEvents(materialUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return repository.NewMaterialFromHistory(events), nil
+}
+
+func mapToLotMovements(lots []domain.MaterialLotQuantity) []MaterialLotMovement {
+	movements := []MaterialLotMovement{}
+
+	for _, v := range lots {
+		movements = append(movements, MaterialLotMovement{LotUID: v.LotUID, Quantity: -v.Quantity})
+	}
+
+	return movements
+}
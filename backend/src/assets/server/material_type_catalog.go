@@ -0,0 +1,196 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/usetania/tania-core/src/assets/domain"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+// materialTypeCatalogLock keeps the catalog changes one at a time, as each saves the whole catalog of the farm.
+var materialTypeCatalogLock sync.Mutex
+
+// materialTypeCatalogs are the catalogs by their route name.
+var materialTypeCatalogs = map[string]string{
+	"plant_types":     domain.CatalogPlantType,
+	"chemical_types":  domain.CatalogChemicalType,
+	"container_types": domain.CatalogContainerType,
+}
+
+// findMaterialTypeCatalog is the saved catalog of the farm, or the default types until the farm changes them.
+func (s *FarmServer) findMaterialTypeCatalog(farmUID uuid.UUID) (domain.MaterialTypeCatalog, error) {
+	result := <-s.MaterialTypeCatalogQuery.FindByFarmID(farmUID)
+	if result.Error != nil {
+		return domain.MaterialTypeCatalog{}, result.Error
+	}
+
+	catalog, ok := result.Result.(domain.MaterialTypeCatalog)
+	if !ok {
+		return domain.MaterialTypeCatalog{}, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	if !catalog.IsSaved() {
+		catalog = domain.DefaultMaterialTypeCatalog(farmUID)
+	}
+
+	return catalog, nil
+}
+
+// addMissingMaterialTypeDefaults adds the default types of this version the saved catalogs of the farms
+// were not seeded with, like the veterinary chemical type.
+func (s *FarmServer) addMissingMaterialTypeDefaults() error {
+	result := <-s.FarmReadQuery.FindAll()
+	if result.Error != nil {
+		return result.Error
+	}
+
+	farms, ok := result.Result.([]storage.FarmRead)
+	if !ok {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	for _, farm := range farms {
+		catalog, err := s.findMaterialTypeCatalog(farm.UID)
+		if err != nil {
+			return err
+		}
+
+		if !catalog.AddMissingDefaultTypes() {
+			continue
+		}
+
+		err = <-s.MaterialTypeCatalogRepo.Save(&catalog)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// materialTypeCatalogOfFarm is the catalog of the farm of the farm_id field.
+// Without it, the types are the default ones.
+func (s *FarmServer) materialTypeCatalogOfFarm(farmID string) (domain.MaterialTypeCatalog, error) {
+	if farmID == "" {
+		return domain.DefaultMaterialTypeCatalog(uuid.Nil), nil
+	}
+
+	farmUID, err := uuid.FromString(farmID)
+	if err != nil {
+		return domain.MaterialTypeCatalog{}, NewRequestValidationError(ParseFailed, "farm_id")
+	}
+
+	validation := RequestValidation{}
+
+	_, err = validation.ValidateFarm(*s, farmUID)
+	if err != nil {
+		return domain.MaterialTypeCatalog{}, err
+	}
+
+	return s.findMaterialTypeCatalog(farmUID)
+}
+
+// farmOfMaterialTypeCatalog is the farm of the id route parameter of the catalog handlers.
+func (s *FarmServer) farmOfMaterialTypeCatalog(c echo.Context) (uuid.UUID, error) {
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return uuid.Nil, NewRequestValidationError(ParseFailed, "id")
+	}
+
+	validation := RequestValidation{}
+
+	farm, err := validation.ValidateFarm(*s, farmUID)
+	if err != nil {
+		return uuid.Nil, NewRequestValidationError(NotFound, "id")
+	}
+
+	return farm.UID, nil
+}
+
+// GetMaterialCatalogTypes is a FarmServer's handler to list the types of a catalog of the farm,
+// which are plant_types, chemical_types or container_types.
+func (s *FarmServer) GetMaterialCatalogTypes(c echo.Context) error {
+	catalogName, ok := materialTypeCatalogs[c.Param("catalog")]
+	if !ok {
+		return Error(c, NewRequestValidationError(NotFound, "catalog"))
+	}
+
+	farmUID, err := s.farmOfMaterialTypeCatalog(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	catalog, err := s.findMaterialTypeCatalog(farmUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string][]domain.MaterialCatalogType)
+	data["data"] = catalog.Types(catalogName)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// SaveMaterialCatalogType is a FarmServer's handler to add a type to a catalog, like a MUSHROOM plant type.
+func (s *FarmServer) SaveMaterialCatalogType(c echo.Context) error {
+	return s.changeMaterialTypeCatalog(c, func(catalog *domain.MaterialTypeCatalog, catalogName string) error {
+		return catalog.AddType(catalogName, strings.ToUpper(c.FormValue("code")), c.FormValue("label"))
+	})
+}
+
+// UpdateMaterialCatalogType is a FarmServer's handler to change the label of a type of a catalog.
+func (s *FarmServer) UpdateMaterialCatalogType(c echo.Context) error {
+	return s.changeMaterialTypeCatalog(c, func(catalog *domain.MaterialTypeCatalog, catalogName string) error {
+		return catalog.ChangeTypeLabel(catalogName, strings.ToUpper(c.Param("code")), c.FormValue("label"))
+	})
+}
+
+// RemoveMaterialCatalogType is a FarmServer's handler to remove a type of a catalog. The materials of the type
+// keep it, but no new material can be of it.
+func (s *FarmServer) RemoveMaterialCatalogType(c echo.Context) error {
+	return s.changeMaterialTypeCatalog(c, func(catalog *domain.MaterialTypeCatalog, catalogName string) error {
+		return catalog.RemoveType(catalogName, strings.ToUpper(c.Param("code")))
+	})
+}
+
+func (s *FarmServer) changeMaterialTypeCatalog(
+	c echo.Context,
+	change func(catalog *domain.MaterialTypeCatalog, catalogName string) error,
+) error {
+	catalogName, ok := materialTypeCatalogs[c.Param("catalog")]
+	if !ok {
+		return Error(c, NewRequestValidationError(NotFound, "catalog"))
+	}
+
+	farmUID, err := s.farmOfMaterialTypeCatalog(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	materialTypeCatalogLock.Lock()
+	defer materialTypeCatalogLock.Unlock()
+
+	catalog, err := s.findMaterialTypeCatalog(farmUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = change(&catalog, catalogName)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = <-s.MaterialTypeCatalogRepo.Save(&catalog)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string][]domain.MaterialCatalogType)
+	data["data"] = catalog.Types(catalogName)
+
+	return c.JSON(http.StatusOK, data)
+}
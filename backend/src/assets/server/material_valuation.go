@@ -0,0 +1,306 @@
+package server
+
+import (
+	"bytes"
+	"encoding/csv"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/usetania/tania-core/src/assets/domain"
+	"github.com/usetania/tania-core/src/assets/repository"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+// MaterialValuationReport is the value of the materials in stock at a date, by material type,
+// in the farm base currency.
+type MaterialValuationReport struct {
+	FarmUID         uuid.UUID               `json:"farm_id"`
+	Currency        string                  `json:"currency"`
+	Date            time.Time               `json:"date"`
+	Method          string                  `json:"method"`
+	Total           string                  `json:"total"`
+	Types           []MaterialTypeValuation `json:"types"`
+	MissingRateFor  []string                `json:"missing_rate_for"`
+	InvalidPriceFor []uuid.UUID             `json:"invalid_price_for"`
+}
+
+// MaterialTypeValuation is the value of the materials of a type in stock.
+type MaterialTypeValuation struct {
+	Type      string              `json:"type"`
+	Total     string              `json:"total"`
+	Materials []MaterialValuation `json:"materials"`
+}
+
+// MaterialValuation is the value of a material in stock.
+type MaterialValuation struct {
+	MaterialUID  uuid.UUID `json:"material_id"`
+	Name         string    `json:"name"`
+	Quantity     float64   `json:"quantity"`
+	QuantityUnit string    `json:"quantity_unit"`
+	UnitCost     string    `json:"unit_cost"`
+	Value        string    `json:"value"`
+}
+
+// GetMaterialValuation is a FarmServer's handler to report the value of the materials the farm keeps
+// in stock at a date, costed with FIFO or the weighted average. The costs are converted into the farm base currency
+// with the exchange rates effective when the materials came in. It is exported as CSV with format=csv.
+func (s *FarmServer) GetMaterialValuation(c echo.Context) error {
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	date := time.Now()
+
+	if v := c.QueryParam("date"); v != "" {
+		date, err = time.Parse("2006-01-02", v)
+		if err != nil {
+			return Error(c, NewRequestValidationError(ParseFailed, "date"))
+		}
+	}
+
+	method := domain.ValuationMethodFIFO
+	if v := c.QueryParam("method"); v != "" {
+		method = strings.ToUpper(v)
+	}
+
+	if _, err := domain.CreateMaterialValuation(method); err != nil {
+		return Error(c, NewRequestValidationError(InvalidOption, "method"))
+	}
+
+	format := c.QueryParam("format")
+	if format != "" && format != "json" && format != "csv" {
+		return Error(c, NewRequestValidationError(InvalidOption, "format"))
+	}
+
+	queryResult := <-s.FarmReadQuery.FindByID(farmUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	farm, ok := queryResult.Result.(storage.FarmRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if farm.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NotFound, "id"))
+	}
+
+	queryResult = <-s.MaterialReadQuery.FindAll(c.QueryParam("type"), "", 0, 0)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	materials, ok := queryResult.Result.([]storage.MaterialRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	histories := [][]storage.MaterialEvent{}
+
+	for _, v := range materials {
+		events, err := s.findMaterialEvents(v.UID)
+		if err != nil {
+			return Error(c, err)
+		}
+
+		if repository.NewMaterialFromHistory(events).FarmUID != farm.UID {
+			continue
+		}
+
+		histories = append(histories, events)
+	}
+
+	report := MapToMaterialValuationReport(farm, histories, date, method)
+
+	if format == "csv" {
+		body, err := MapToMaterialValuationCSV(report)
+		if err != nil {
+			return Error(c, err)
+		}
+
+		c.Response().Header().Set(echo.HeaderContentDisposition,
+			`attachment; filename="material_valuation_`+date.Format("2006-01-02")+`.csv"`)
+
+		return c.Blob(http.StatusOK, "text/csv", body)
+	}
+
+	data := make(map[string]MaterialValuationReport)
+	data["data"] = report
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// MapToMaterialValuationReport replays the events of each material which happened until the end of the date.
+// The stock comes in at its price when the material is created or its quantity corrected upwards,
+// and at the lot unit cost when it is received.
+func MapToMaterialValuationReport(
+	farm storage.FarmRead,
+	histories [][]storage.MaterialEvent,
+	date time.Time,
+	method string,
+) MaterialValuationReport {
+	baseCurrency := domain.BaseCurrency(farm.Currency)
+	until := time.Date(date.Year(), date.Month(), date.Day()+1, 0, 0, 0, 0, date.Location())
+
+	rates := []domain.ExchangeRate{}
+	for _, v := range farm.ExchangeRates {
+		rates = append(rates, domain.ExchangeRate(v))
+	}
+
+	report := MaterialValuationReport{
+		FarmUID:         farm.UID,
+		Currency:        baseCurrency,
+		Date:            date,
+		Method:          method,
+		Types:           []MaterialTypeValuation{},
+		MissingRateFor:  []string{},
+		InvalidPriceFor: []uuid.UUID{},
+	}
+
+	totals := map[string]float64{}
+	total := 0.0
+	missingRates := make(map[string]bool)
+
+	for _, events := range histories {
+		material := &domain.Material{}
+		valuation, _ := domain.CreateMaterialValuation(method)
+		valued := true
+
+		for _, v := range events {
+			before := material.Quantity.Value
+			material.Transition(v.Event)
+			quantity := float64(material.Quantity.Value - before)
+
+			price := material.PricePerUnit
+			eventDate := v.CreatedDate
+
+			switch e := v.Event.(type) {
+			case domain.MaterialCreated:
+				eventDate = e.CreatedDate
+			case domain.MaterialStockReceived:
+				eventDate = e.ReceivedDate
+				price = e.UnitCost
+			case domain.MaterialStockIssued:
+				eventDate = e.IssuedDate
+			case domain.MaterialConsumed:
+				eventDate = e.ConsumedDate
+			}
+
+			if quantity == 0 || !eventDate.Before(until) {
+				continue
+			}
+
+			if quantity < 0 {
+				valuation.Out(-quantity)
+
+				continue
+			}
+
+			amount, err := strconv.ParseFloat(price.Amount, 64)
+			if err != nil {
+				valued = false
+
+				report.InvalidPriceFor = append(report.InvalidPriceFor, material.UID)
+
+				break
+			}
+
+			unitCost, err := domain.ConvertAmount(amount, price.CurrencyCode, baseCurrency, rates, eventDate)
+			if err != nil {
+				valued = false
+
+				if !missingRates[price.CurrencyCode] {
+					missingRates[price.CurrencyCode] = true
+					report.MissingRateFor = append(report.MissingRateFor, price.CurrencyCode)
+				}
+
+				break
+			}
+
+			valuation.In(quantity, unitCost)
+		}
+
+		if !valued || valuation.Quantity <= 0 {
+			continue
+		}
+
+		typeCode := material.Type.Code()
+
+		i := findMaterialTypeValuation(report.Types, typeCode)
+		if i < 0 {
+			report.Types = append(report.Types, MaterialTypeValuation{
+				Type:      typeCode,
+				Materials: []MaterialValuation{},
+			})
+			i = len(report.Types) - 1
+		}
+
+		report.Types[i].Materials = append(report.Types[i].Materials, MaterialValuation{
+			MaterialUID:  material.UID,
+			Name:         material.Name,
+			Quantity:     valuation.Quantity,
+			QuantityUnit: material.Quantity.Unit.Code,
+			UnitCost:     domain.FormatAmount(valuation.UnitCost(), baseCurrency),
+			Value:        domain.FormatAmount(valuation.Value, baseCurrency),
+		})
+
+		totals[typeCode] += valuation.Value
+		total += valuation.Value
+	}
+
+	for i, v := range report.Types {
+		report.Types[i].Total = domain.FormatAmount(totals[v.Type], baseCurrency)
+	}
+
+	report.Total = domain.FormatAmount(total, baseCurrency)
+
+	return report
+}
+
+// MapToMaterialValuationCSV writes a line for each material, followed by the total of each type and of the farm.
+func MapToMaterialValuationCSV(report MaterialValuationReport) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+
+	records := [][]string{
+		{"date", "method", "currency", "type", "material_id", "name", "quantity", "quantity_unit", "unit_cost", "value"},
+	}
+
+	date := report.Date.Format("2006-01-02")
+
+	for _, t := range report.Types {
+		for _, m := range t.Materials {
+			records = append(records, []string{
+				date, report.Method, report.Currency, t.Type, m.MaterialUID.String(), m.Name,
+				strconv.FormatFloat(m.Quantity, 'f', -1, 64), m.QuantityUnit, m.UnitCost, m.Value,
+			})
+		}
+
+		records = append(records, []string{date, report.Method, report.Currency, t.Type, "", "TOTAL", "", "", "", t.Total})
+	}
+
+	records = append(records, []string{date, report.Method, report.Currency, "", "", "TOTAL", "", "", "", report.Total})
+
+	err := w.WriteAll(records)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func findMaterialTypeValuation(types []MaterialTypeValuation, typeCode string) int {
+	for i, v := range types {
+		if v.Type == typeCode {
+			return i
+		}
+	}
+
+	return -1
+}
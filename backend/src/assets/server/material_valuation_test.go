@@ -0,0 +1,173 @@
+package server_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/usetania/tania-core/src/assets/domain"
+	. "github.com/usetania/tania-core/src/assets/server"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+func day(d int) time.Time {
+	return time.Date(2026, time.January, d, 9, 0, 0, 0, time.UTC)
+}
+
+func materialHistory(events ...interface{}) []storage.MaterialEvent {
+	history := []storage.MaterialEvent{}
+
+	for i, v := range events {
+		history = append(history, storage.MaterialEvent{Version: i + 1, CreatedDate: day(1), Event: v})
+	}
+
+	return history
+}
+
+func createdMaterial(uid uuid.UUID, name, amount, currencyCode string, materialType domain.MaterialType,
+	quantity float32, unit string, date time.Time,
+) domain.MaterialCreated {
+	return domain.MaterialCreated{
+		UID:          uid,
+		Name:         name,
+		PricePerUnit: domain.PricePerUnit{Amount: amount, CurrencyCode: currencyCode},
+		Type:         materialType,
+		Quantity:     domain.MaterialQuantity{Value: quantity, Unit: domain.MaterialQuantityUnit{Code: unit}},
+		CreatedDate:  date,
+	}
+}
+
+func TestMapToMaterialValuationReport(t *testing.T) {
+	t.Parallel()
+	// Given
+	farmUID, _ := uuid.NewV4()
+	farm := storage.FarmRead{
+		UID:      farmUID,
+		Currency: domain.MoneyEUR,
+		ExchangeRates: []storage.FarmExchangeRate{
+			{FromCurrency: "USD", ToCurrency: domain.MoneyEUR, Rate: 0.5, EffectiveDate: day(1)},
+		},
+	}
+
+	labelsUID, _ := uuid.NewV4()
+	lotUID, _ := uuid.NewV4()
+	labels := materialHistory(
+		createdMaterial(labelsUID, "Labels", "2", domain.MoneyEUR, domain.MaterialTypeOther{},
+			10, domain.MaterialUnitPieces, day(1)),
+		domain.MaterialStockReceived{
+			MaterialUID:  labelsUID,
+			LotUID:       lotUID,
+			Quantity:     10,
+			UnitCost:     domain.PricePerUnit{Amount: "3", CurrencyCode: domain.MoneyEUR},
+			ReceivedDate: day(10),
+		},
+		domain.MaterialConsumed{MaterialUID: labelsUID, Quantity: 4, ConsumedDate: day(11)},
+		domain.MaterialConsumed{MaterialUID: labelsUID, Quantity: 6, ConsumedDate: day(15)},
+	)
+
+	soilUID, _ := uuid.NewV4()
+	soil := materialHistory(
+		createdMaterial(soilUID, "Potting soil", "10", "USD", domain.MaterialTypeGrowingMedium{},
+			4, domain.MaterialUnitBags, day(2)),
+	)
+
+	poundsUID, _ := uuid.NewV4()
+	pounds := materialHistory(
+		createdMaterial(poundsUID, "Twine", "1", "GBP", domain.MaterialTypeOther{},
+			5, domain.MaterialUnitPieces, day(2)),
+	)
+
+	invalidUID, _ := uuid.NewV4()
+	invalid := materialHistory(
+		createdMaterial(invalidUID, "Stakes", "abc", domain.MoneyEUR, domain.MaterialTypeOther{},
+			5, domain.MaterialUnitPieces, day(2)),
+	)
+
+	laterUID, _ := uuid.NewV4()
+	later := materialHistory(
+		createdMaterial(laterUID, "Trays", "1", domain.MoneyEUR, domain.MaterialTypeOther{},
+			5, domain.MaterialUnitPieces, day(13)),
+	)
+
+	histories := [][]storage.MaterialEvent{labels, soil, pounds, invalid, later}
+
+	// When
+	report := MapToMaterialValuationReport(farm, histories, day(12), domain.ValuationMethodFIFO)
+
+	// Then
+	assert.Equal(t, farmUID, report.FarmUID)
+	assert.Equal(t, domain.MoneyEUR, report.Currency)
+	assert.Equal(t, "62.00", report.Total)
+	assert.Equal(t, []string{"GBP"}, report.MissingRateFor)
+	assert.Equal(t, []uuid.UUID{invalidUID}, report.InvalidPriceFor)
+	assert.Len(t, report.Types, 2)
+
+	assert.Equal(t, domain.MaterialTypeOtherCode, report.Types[0].Type)
+	assert.Equal(t, "42.00", report.Types[0].Total)
+	assert.Len(t, report.Types[0].Materials, 1)
+	assert.Equal(t, labelsUID, report.Types[0].Materials[0].MaterialUID)
+	assert.InDelta(t, 16, report.Types[0].Materials[0].Quantity, 0.0001)
+	assert.Equal(t, "42.00", report.Types[0].Materials[0].Value)
+
+	assert.Equal(t, domain.MaterialTypeGrowingMediumCode, report.Types[1].Type)
+	assert.Equal(t, "20.00", report.Types[1].Total)
+	assert.Equal(t, "5.00", report.Types[1].Materials[0].UnitCost)
+}
+
+func TestMapToMaterialValuationCSV(t *testing.T) {
+	t.Parallel()
+	// Given
+	farmUID, _ := uuid.NewV4()
+	labelsUID, _ := uuid.NewV4()
+	soilUID, _ := uuid.NewV4()
+	report := MaterialValuationReport{
+		FarmUID:  farmUID,
+		Currency: domain.MoneyEUR,
+		Date:     day(12),
+		Method:   domain.ValuationMethodWeightedAverage,
+		Total:    "62.00",
+		Types: []MaterialTypeValuation{
+			{
+				Type:  domain.MaterialTypeOtherCode,
+				Total: "42.00",
+				Materials: []MaterialValuation{
+					{
+						MaterialUID:  labelsUID,
+						Name:         "Labels, white",
+						Quantity:     16,
+						QuantityUnit: domain.MaterialUnitPieces,
+						UnitCost:     "2.63",
+						Value:        "42.00",
+					},
+				},
+			},
+			{
+				Type:  domain.MaterialTypeGrowingMediumCode,
+				Total: "20.00",
+				Materials: []MaterialValuation{
+					{
+						MaterialUID:  soilUID,
+						Name:         "Potting soil",
+						Quantity:     4,
+						QuantityUnit: domain.MaterialUnitBags,
+						UnitCost:     "5.00",
+						Value:        "20.00",
+					},
+				},
+			},
+		},
+	}
+
+	// When
+	body, err := MapToMaterialValuationCSV(report)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "date,method,currency,type,material_id,name,quantity,quantity_unit,unit_cost,value\n"+
+		"2026-01-12,WEIGHTED_AVERAGE,EUR,OTHER,"+labelsUID.String()+",\"Labels, white\",16,PIECES,2.63,42.00\n"+
+		"2026-01-12,WEIGHTED_AVERAGE,EUR,OTHER,,TOTAL,,,,42.00\n"+
+		"2026-01-12,WEIGHTED_AVERAGE,EUR,GROWING_MEDIUM,"+soilUID.String()+",Potting soil,4,BAGS,5.00,20.00\n"+
+		"2026-01-12,WEIGHTED_AVERAGE,EUR,GROWING_MEDIUM,,TOTAL,,,,20.00\n"+
+		"2026-01-12,WEIGHTED_AVERAGE,EUR,,,TOTAL,,,,62.00\n", string(body))
+}
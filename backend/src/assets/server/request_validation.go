@@ -123,6 +123,27 @@ func Error(c echo.Context, err error) error {
 		return c.JSON(http.StatusBadRequest, errorResponse)
 	}
 
+	var me domain.MaterialError
+	if errors.As(err, &me) {
+		errorResponse["error_code"] = strconv.Itoa(me.Code)
+
+		return c.JSON(http.StatusBadRequest, errorResponse)
+	}
+
+	var se domain.SupplierError
+	if errors.As(err, &se) {
+		errorResponse["error_code"] = strconv.Itoa(se.Code)
+
+		return c.JSON(http.StatusBadRequest, errorResponse)
+	}
+
+	var eqe domain.EquipmentError
+	if errors.As(err, &eqe) {
+		errorResponse["error_code"] = strconv.Itoa(eqe.Code)
+
+		return c.JSON(http.StatusBadRequest, errorResponse)
+	}
+
 	var rve RequestValidationError
 	if errors.As(err, &rve) {
 		errorResponse["field_name"] = rve.FieldName
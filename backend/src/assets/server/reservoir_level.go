@@ -0,0 +1,267 @@
+package server
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/usetania/tania-core/src/assets/domain"
+	"github.com/usetania/tania-core/src/assets/repository"
+	"github.com/usetania/tania-core/src/assets/storage"
+	"github.com/usetania/tania-core/src/helper/eventhelper"
+)
+
+// ConsumeReservoirWater is a subscriber which estimates the water drawn from the reservoir of the area
+// where a crop batch is watered.
+func (s *FarmServer) ConsumeReservoirWater(event interface{}) error {
+	e := cropBatchWatered{}
+
+	err := eventhelper.Decode(event, &e)
+	if err != nil {
+		log.Println(err)
+
+		return err
+	}
+
+	queryResult := <-s.AreaReadQuery.FindByID(e.AreaUID)
+	if queryResult.Error != nil {
+		log.Println(queryResult.Error)
+
+		return queryResult.Error
+	}
+
+	area, ok := queryResult.Result.(storage.AreaRead)
+	if !ok {
+		log.Println(errors.New("internal server error. error type assertion"))
+
+		return nil
+	}
+
+	if area.Reservoir.UID == (uuid.UUID{}) {
+		return nil
+	}
+
+	reservoir, err := s.findReservoirFromHistory(area.Reservoir.UID)
+	if err != nil {
+		log.Println(err)
+
+		return err
+	}
+
+	reservoir.ConsumeWater(area.UID, e.UID, e.WateringDate)
+
+	if len(reservoir.UncommittedChanges) == 0 {
+		return nil
+	}
+
+	err = eventhelper.SaveFromSubscriber(s.ReservoirEventRepo, reservoir.UID, reservoir.Version,
+		reservoir.UncommittedChanges, s.SaveToReservoirReadModel)
+	if err != nil {
+		log.Println(err)
+
+		return err
+	}
+
+	return nil
+}
+
+// SaveReservoirLevel is a FarmServer's handler to record a reading of a bucket water level.
+// Readings sent by a sensor have the AUTOMATIC source.
+func (s *FarmServer) SaveReservoirLevel(c echo.Context) error {
+	reservoirUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	level := c.FormValue("level")
+	if level == "" {
+		return Error(c, NewRequestValidationError(Required, "level"))
+	}
+
+	levelFloat, err := strconv.ParseFloat(level, 32)
+	if err != nil {
+		return Error(c, NewRequestValidationError(Float, "level"))
+	}
+
+	source := domain.ReservoirLevelSourceManual
+	if v := c.FormValue("source"); v != "" {
+		source = strings.ToUpper(v)
+	}
+
+	readingDate, err := parseReservoirLevelDate(c.FormValue("reading_date"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(ParseFailed, "reading_date"))
+	}
+
+	reservoir, err := s.findReservoirFromHistory(reservoirUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = reservoir.RecordLevel(float32(levelFloat), source, readingDate)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveReservoirChanges(c, reservoir)
+}
+
+// SaveReservoirRefill is a FarmServer's handler to record water added to a bucket.
+// Without a volume, the bucket is filled up.
+func (s *FarmServer) SaveReservoirRefill(c echo.Context) error {
+	reservoirUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	volume := float64(0)
+
+	if v := c.FormValue("volume"); v != "" {
+		volume, err = strconv.ParseFloat(v, 32)
+		if err != nil {
+			return Error(c, NewRequestValidationError(Float, "volume"))
+		}
+	}
+
+	refillDate, err := parseReservoirLevelDate(c.FormValue("refill_date"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(ParseFailed, "refill_date"))
+	}
+
+	reservoir, err := s.findReservoirFromHistory(reservoirUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = reservoir.Refill(float32(volume), refillDate)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveReservoirChanges(c, reservoir)
+}
+
+// GetReservoirLevels is a FarmServer's handler to list the level readings, refills
+// and estimated consumptions of a bucket.
+func (s *FarmServer) GetReservoirLevels(c echo.Context) error {
+	data := make(map[string][]storage.ReservoirLevelEntry)
+
+	reservoirUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	queryResult := <-s.ReservoirReadQuery.FindByID(reservoirUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	reservoirRead, ok := queryResult.Result.(storage.ReservoirRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if reservoirRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NotFound, "id"))
+	}
+
+	queryResult = <-s.ReservoirReadQuery.FindLevelsByReservoirID(reservoirUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	levels, ok := queryResult.Result.([]storage.ReservoirLevelEntry)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	data["data"] = levels
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *FarmServer) saveReservoirChanges(c echo.Context, reservoir *domain.Reservoir) error {
+	err := s.commitReservoirChanges(reservoir)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.respondReservoirRead(c, reservoir)
+}
+
+// commitReservoirChanges persists and publishes the changes of the reservoir.
+func (s *FarmServer) commitReservoirChanges(reservoir *domain.Reservoir) error {
+	err := <-s.ReservoirEventRepo.Save(reservoir.UID, reservoir.Version, reservoir.UncommittedChanges)
+	if err != nil {
+		return err
+	}
+
+	s.publishUncommittedEvents(reservoir)
+
+	return nil
+}
+
+func (s *FarmServer) respondReservoirRead(c echo.Context, reservoir *domain.Reservoir) error {
+	resRead, err := MapToReservoirRead(s, *reservoir)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string]storage.ReservoirRead)
+	data["data"] = resRead
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *FarmServer) findReservoirFromHistory(reservoirUID uuid.UUID) (*domain.Reservoir, error) {
+	eventQueryResult := <-s.ReservoirEventQuery.FindAllByID(reservoirUID)
+	if eventQueryResult.Error != nil {
+		return nil, eventQueryResult.Error
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.ReservoirEvent)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	if len(events) == 0 {
+		return nil, NewRequestValidationError(NotFound, "id")
+	}
+
+	return repository.NewReservoirFromHistory(events), nil
+}
+
+// MapToReservoirLevel is the latest level of the reservoir with its prediction, or nil when it is unknown.
+func MapToReservoirLevel(reservoir domain.Reservoir) *storage.ReservoirLevel {
+	current := reservoir.CurrentLevel()
+	if current == nil {
+		return nil
+	}
+
+	return &storage.ReservoirLevel{
+		Level:            current.Level,
+		Date:             current.Date,
+		DailyConsumption: reservoir.DailyConsumption(),
+		PredictedDryDate: reservoir.PredictDryDate(),
+	}
+}
+
+// parseReservoirLevelDate reads a date, or a date and time for the readings sent by sensors.
+// It is now when empty.
+func parseReservoirLevelDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Now(), nil
+	}
+
+	date, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return date, nil
+	}
+
+	return time.Parse("2006-01-02", value)
+}
@@ -0,0 +1,249 @@
+package server
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/usetania/tania-core/src/assets/domain"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+// UpdateReservoirNutrientRecipe is a FarmServer's handler to set the fertilizers mixed into a reservoir.
+// Each material_id is sent with its amount_per_liter, in the same order. Without any, the recipe is removed.
+func (s *FarmServer) UpdateReservoirNutrientRecipe(c echo.Context) error {
+	reservoirUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	params, err := c.FormParams()
+	if err != nil {
+		return Error(c, err)
+	}
+
+	materialIDs := params["material_id"]
+	amounts := params["amount_per_liter"]
+
+	if len(amounts) != len(materialIDs) {
+		return Error(c, NewRequestValidationError(Required, "amount_per_liter"))
+	}
+
+	nutrients := []domain.ReservoirNutrient{}
+
+	for i, v := range materialIDs {
+		materialUID, err := uuid.FromString(v)
+		if err != nil {
+			return Error(c, NewRequestValidationError(ParseFailed, "material_id"))
+		}
+
+		amount, err := strconv.ParseFloat(amounts[i], 32)
+		if err != nil {
+			return Error(c, NewRequestValidationError(Float, "amount_per_liter"))
+		}
+
+		nutrients = append(nutrients, domain.ReservoirNutrient{
+			MaterialUID:    materialUID,
+			AmountPerLiter: float32(amount),
+		})
+	}
+
+	reservoir, err := s.findReservoirFromHistory(reservoirUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = reservoir.ChangeNutrientRecipe(s.ReservoirService, nutrients)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveReservoirChanges(c, reservoir)
+}
+
+// SaveReservoirDosing is a FarmServer's handler to mix the nutrients of the recipe into a reservoir.
+// The quantities are computed from the volume, or the bucket capacity, and taken out of the stock.
+// A quantity more than the material stock is refused unless force is true.
+func (s *FarmServer) SaveReservoirDosing(c echo.Context) error {
+	data := make(map[string]domain.ReservoirDosing)
+
+	reservoirUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	volume := float64(0)
+
+	if v := c.FormValue("volume"); v != "" {
+		volume, err = strconv.ParseFloat(v, 32)
+		if err != nil {
+			return Error(c, NewRequestValidationError(Float, "volume"))
+		}
+	}
+
+	dosedDate, err := parseReservoirLevelDate(c.FormValue("dosed_date"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(ParseFailed, "dosed_date"))
+	}
+
+	force := c.FormValue("force") == "true"
+
+	reservoir, err := s.findReservoirFromHistory(reservoirUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	queryResult := <-s.AreaReadQuery.FindAreasByReservoirID(reservoir.UID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	areas, ok := queryResult.Result.([]storage.AreaRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	areaUIDs := []uuid.UUID{}
+	for _, v := range areas {
+		areaUIDs = append(areaUIDs, v.UID)
+	}
+
+	dosing, err := reservoir.Dose(float32(volume), areaUIDs, dosedDate)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	materials := []*domain.Material{}
+	debits := []reservoirDebit{}
+
+	for _, v := range dosing.Nutrients {
+		material, err := s.findMaterialFromHistory(v.MaterialUID)
+		if err != nil {
+			return Error(c, err)
+		}
+
+		if !force && v.Quantity > material.Quantity.Value {
+			return Error(c, domain.MaterialError{Code: domain.MaterialErrorInsufficientStock})
+		}
+
+		// A forced dosing takes the stock down to zero at most.
+		taken := v.Quantity
+		if taken > material.Quantity.Value {
+			taken = material.Quantity.Value
+		}
+
+		err = material.ConsumeForReservoir(reservoir.UID, v.Quantity)
+		if err != nil {
+			return Error(c, err)
+		}
+
+		consumed, ok := material.UncommittedChanges[len(material.UncommittedChanges)-1].(domain.MaterialConsumed)
+		if !ok {
+			return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+		}
+
+		materials = append(materials, material)
+		debits = append(debits, reservoirDebit{MaterialUID: material.UID, Quantity: taken, Lots: consumed.Lots})
+	}
+
+	// The debits are saved before the dosing, and given back when a later save fails,
+	// so a dosing is never saved without its materials taken out of the stock.
+	for i, v := range materials {
+		err = <-s.MaterialEventRepo.Save(v.UID, v.Version, v.UncommittedChanges)
+		if err != nil {
+			s.returnReservoirDebits(reservoir.UID, debits[:i])
+
+			return Error(c, err)
+		}
+	}
+
+	err = <-s.ReservoirEventRepo.Save(reservoir.UID, reservoir.Version, reservoir.UncommittedChanges)
+	if err != nil {
+		s.returnReservoirDebits(reservoir.UID, debits)
+
+		return Error(c, err)
+	}
+
+	s.publishUncommittedEvents(reservoir)
+
+	for _, v := range materials {
+		s.publishUncommittedEvents(v)
+	}
+
+	data["data"] = dosing
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// reservoirDebit is the quantity of a material a dosing took out of the stock, and the lots it was taken from.
+type reservoirDebit struct {
+	MaterialUID uuid.UUID
+	Quantity    float32
+	Lots        []domain.MaterialLotQuantity
+}
+
+// returnReservoirDebits gives back the materials of a dosing which could not be saved. The debits were not
+// published yet, so neither are the give-backs.
+func (s *FarmServer) returnReservoirDebits(reservoirUID uuid.UUID, debits []reservoirDebit) {
+	for _, v := range debits {
+		if v.Quantity <= 0 {
+			continue
+		}
+
+		material, err := s.findMaterialFromHistory(v.MaterialUID)
+		if err != nil {
+			log.Println(err)
+
+			continue
+		}
+
+		err = material.ReturnFromReservoir(reservoirUID, v.Quantity, v.Lots)
+		if err != nil {
+			log.Println(err)
+
+			continue
+		}
+
+		err = <-s.MaterialEventRepo.Save(material.UID, material.Version, material.UncommittedChanges)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+}
+
+// GetReservoirDosings is a FarmServer's handler to list the nutrient dosings of a reservoir.
+func (s *FarmServer) GetReservoirDosings(c echo.Context) error {
+	data := make(map[string][]domain.ReservoirDosing)
+
+	reservoirUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	reservoir, err := s.findReservoirFromHistory(reservoirUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	dosings := reservoir.Dosings
+	if dosings == nil {
+		dosings = []domain.ReservoirDosing{}
+	}
+
+	data["data"] = dosings
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// MapToReservoirNutrientRecipe is the nutrient recipe of the reservoir read model.
+func MapToReservoirNutrientRecipe(reservoir domain.Reservoir) []storage.ReservoirNutrient {
+	recipe := []storage.ReservoirNutrient{}
+
+	for _, v := range reservoir.NutrientRecipe {
+		recipe = append(recipe, storage.ReservoirNutrient(v))
+	}
+
+	return recipe
+}
@@ -0,0 +1,232 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/usetania/tania-core/config"
+	"github.com/usetania/tania-core/src/assets/domain"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+// SaveReservoirPhoto is a FarmServer's handler to add a photo to the reservoir gallery,
+// with its caption and the date it was taken.
+func (s *FarmServer) SaveReservoirPhoto(c echo.Context) error {
+	reservoirUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	photo, err := c.FormFile("photo")
+	if err != nil {
+		return Error(c, NewRequestValidationError(Required, "photo"))
+	}
+
+	takenDate, err := parsePhotoTakenDate(c.FormValue("taken_date"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	reservoir, err := s.findReservoirFromHistory(reservoirUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	uploaded, err := s.uploadGalleryPhoto(photo, *config.Config.UploadPathReservoir)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = reservoir.AddPhoto(domain.ReservoirPhoto{
+		UID:       uploaded.UID,
+		Filename:  uploaded.Filename,
+		MimeType:  uploaded.MimeType,
+		Size:      uploaded.Size,
+		Width:     uploaded.Width,
+		Height:    uploaded.Height,
+		Caption:   c.FormValue("caption"),
+		TakenDate: takenDate,
+	})
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveReservoirChanges(c, reservoir)
+}
+
+// GetReservoirGallery is a FarmServer's handler to list the photos of the reservoir gallery in their order.
+func (s *FarmServer) GetReservoirGallery(c echo.Context) error {
+	data := make(map[string][]storage.ReservoirPhoto)
+
+	reservoirUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	reservoir, err := s.findReservoirFromHistory(reservoirUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data["data"] = MapToReservoirPhotos(*reservoir)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// GetReservoirPhotoByID is a FarmServer's handler to serve the file of a photo of the reservoir gallery,
+// as a thumb, medium or original size.
+func (s *FarmServer) GetReservoirPhotoByID(c echo.Context) error {
+	reservoirUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	photoUID, err := uuid.FromString(c.Param("photo_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	reservoir, err := s.findReservoirFromHistory(reservoirUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	photo := reservoir.FindPhotoByID(photoUID)
+	if photo == nil {
+		return Error(c, NewRequestValidationError(NotFound, "photo_id"))
+	}
+
+	return s.serveGalleryPhoto(c, *config.Config.UploadPathReservoir, photo.Filename)
+}
+
+// UpdateReservoirPhoto is a FarmServer's handler to change the caption and the taken date of a photo.
+func (s *FarmServer) UpdateReservoirPhoto(c echo.Context) error {
+	reservoirUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	photoUID, err := uuid.FromString(c.Param("photo_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	takenDate, err := parsePhotoTakenDate(c.FormValue("taken_date"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	reservoir, err := s.findReservoirFromHistory(reservoirUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = reservoir.UpdatePhoto(photoUID, c.FormValue("caption"), takenDate)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveReservoirChanges(c, reservoir)
+}
+
+// RemoveReservoirPhoto is a FarmServer's handler to remove a photo from the reservoir gallery.
+func (s *FarmServer) RemoveReservoirPhoto(c echo.Context) error {
+	reservoirUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	photoUID, err := uuid.FromString(c.Param("photo_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	reservoir, err := s.findReservoirFromHistory(reservoirUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	photo := reservoir.FindPhotoByID(photoUID)
+	if photo == nil {
+		return Error(c, NewRequestValidationError(NotFound, "photo_id"))
+	}
+
+	filename := photo.Filename
+
+	err = reservoir.RemovePhoto(photoUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = s.commitReservoirChanges(reservoir)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	s.removeGalleryPhotoFiles(*config.Config.UploadPathReservoir, filename)
+
+	return s.respondReservoirRead(c, reservoir)
+}
+
+// ChangeReservoirCoverPhoto is a FarmServer's handler to make a photo of the gallery the reservoir photo.
+func (s *FarmServer) ChangeReservoirCoverPhoto(c echo.Context) error {
+	reservoirUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	photoUID, err := uuid.FromString(c.Param("photo_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	reservoir, err := s.findReservoirFromHistory(reservoirUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = reservoir.ChangeCoverPhoto(photoUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveReservoirChanges(c, reservoir)
+}
+
+// ReorderReservoirPhotos is a FarmServer's handler to sort the reservoir gallery.
+// Every photo_id of the gallery is sent once, in the new order.
+func (s *FarmServer) ReorderReservoirPhotos(c echo.Context) error {
+	reservoirUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	photoUIDs, err := parsePhotoOrder(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	reservoir, err := s.findReservoirFromHistory(reservoirUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = reservoir.ReorderPhotos(photoUIDs)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveReservoirChanges(c, reservoir)
+}
+
+// MapToReservoirPhotos is the photo gallery of the reservoir read model.
+func MapToReservoirPhotos(reservoir domain.Reservoir) []storage.ReservoirPhoto {
+	photos := []storage.ReservoirPhoto{}
+
+	for _, v := range reservoir.Photos {
+		photos = append(photos, storage.ReservoirPhoto(v))
+	}
+
+	return photos
+}
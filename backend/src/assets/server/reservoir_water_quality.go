@@ -0,0 +1,247 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/usetania/tania-core/src/assets/domain"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+// GetWaterQualityParameters is a FarmServer's handler to list the water quality parameters with their units.
+func (*FarmServer) GetWaterQualityParameters(c echo.Context) error {
+	data := make(map[string][]domain.WaterQualityParameter)
+	data["data"] = domain.FindAllWaterQualityParameters()
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// SaveReservoirWaterQuality is a FarmServer's handler to record a measurement of a water quality parameter,
+// like the pH or EC of a nutrient solution. Measurements sent by a sensor have the AUTOMATIC source.
+func (s *FarmServer) SaveReservoirWaterQuality(c echo.Context) error {
+	reservoirUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	parameter := strings.ToUpper(c.FormValue("parameter"))
+	if parameter == "" {
+		return Error(c, NewRequestValidationError(Required, "parameter"))
+	}
+
+	value := c.FormValue("value")
+	if value == "" {
+		return Error(c, NewRequestValidationError(Required, "value"))
+	}
+
+	valueFloat, err := strconv.ParseFloat(value, 32)
+	if err != nil {
+		return Error(c, NewRequestValidationError(Float, "value"))
+	}
+
+	source := domain.ReservoirLevelSourceManual
+	if v := c.FormValue("source"); v != "" {
+		source = strings.ToUpper(v)
+	}
+
+	measuredDate, err := parseReservoirLevelDate(c.FormValue("measured_date"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(ParseFailed, "measured_date"))
+	}
+
+	reservoir, err := s.findReservoirFromHistory(reservoirUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = reservoir.MeasureWaterQuality(
+		parameter,
+		float32(valueFloat),
+		strings.ToUpper(c.FormValue("unit")),
+		source,
+		measuredDate,
+	)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveReservoirChanges(c, reservoir)
+}
+
+// UpdateReservoirWaterQualityRange is a FarmServer's handler to set the acceptable range of a water quality
+// parameter, in the parameter unit. A bound left empty is not checked.
+func (s *FarmServer) UpdateReservoirWaterQualityRange(c echo.Context) error {
+	reservoirUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	parameter := strings.ToUpper(c.FormValue("parameter"))
+	if parameter == "" {
+		return Error(c, NewRequestValidationError(Required, "parameter"))
+	}
+
+	minValue, err := parseWaterQualityBound(c.FormValue("min"), "min")
+	if err != nil {
+		return Error(c, err)
+	}
+
+	maxValue, err := parseWaterQualityBound(c.FormValue("max"), "max")
+	if err != nil {
+		return Error(c, err)
+	}
+
+	reservoir, err := s.findReservoirFromHistory(reservoirUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = reservoir.ChangeWaterQualityRange(parameter, minValue, maxValue)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveReservoirChanges(c, reservoir)
+}
+
+// GetReservoirWaterQuality is a FarmServer's handler to list the water quality measurements of a reservoir
+// between two dates, or their daily minimum, maximum and average of a parameter with aggregate=daily.
+func (s *FarmServer) GetReservoirWaterQuality(c echo.Context) error {
+	data := make(map[string]interface{})
+
+	reservoirUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	parameter := strings.ToUpper(c.QueryParam("parameter"))
+	if parameter != "" {
+		if _, err := domain.FindWaterQualityParameterByCode(parameter); err != nil {
+			return Error(c, NewRequestValidationError(InvalidOption, "parameter"))
+		}
+	}
+
+	aggregate := c.QueryParam("aggregate")
+	if aggregate != "" && aggregate != "daily" {
+		return Error(c, NewRequestValidationError(InvalidOption, "aggregate"))
+	}
+
+	if aggregate != "" && parameter == "" {
+		return Error(c, NewRequestValidationError(Required, "parameter"))
+	}
+
+	from := time.Time{}
+	if v := c.QueryParam("from"); v != "" {
+		from, err = time.Parse("2006-01-02", v)
+		if err != nil {
+			return Error(c, NewRequestValidationError(ParseFailed, "from"))
+		}
+	}
+
+	to := time.Time{}
+	if v := c.QueryParam("to"); v != "" {
+		to, err = time.Parse("2006-01-02", v)
+		if err != nil {
+			return Error(c, NewRequestValidationError(ParseFailed, "to"))
+		}
+
+		to = to.AddDate(0, 0, 1)
+	}
+
+	queryResult := <-s.ReservoirReadQuery.FindByID(reservoirUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	reservoirRead, ok := queryResult.Result.(storage.ReservoirRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if reservoirRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NotFound, "id"))
+	}
+
+	queryResult = <-s.ReservoirReadQuery.FindWaterQualityByReservoirID(reservoirUID, parameter, from, to)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	measurements, ok := queryResult.Result.([]storage.ReservoirWaterQualityMeasurement)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if aggregate == "daily" {
+		data["data"] = domain.AggregateWaterQualityDaily(mapToWaterQualityMeasurements(measurements))
+	} else {
+		data["data"] = measurements
+	}
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// MapToReservoirWaterQuality is the latest measurement of each parameter measured or given a range.
+func MapToReservoirWaterQuality(reservoir domain.Reservoir) []storage.ReservoirWaterQuality {
+	waterQuality := []storage.ReservoirWaterQuality{}
+
+	for _, p := range domain.FindAllWaterQualityParameters() {
+		latest := reservoir.LatestWaterQuality(p.Code)
+		wr, hasRange := reservoir.WaterQualityRanges[p.Code]
+
+		if latest == nil && !hasRange {
+			continue
+		}
+
+		item := storage.ReservoirWaterQuality{
+			Parameter:  p.Code,
+			Unit:       p.Unit,
+			Min:        wr.Min,
+			Max:        wr.Max,
+			OutOfRange: reservoir.IsWaterQualityOutOfRange(p.Code),
+		}
+
+		if latest != nil {
+			item.Value = &latest.Value
+			item.MeasuredDate = &latest.MeasuredDate
+		}
+
+		waterQuality = append(waterQuality, item)
+	}
+
+	return waterQuality
+}
+
+// mapToWaterQualityMeasurements converts the measurements read from the history to aggregate them.
+func mapToWaterQualityMeasurements(
+	measurements []storage.ReservoirWaterQualityMeasurement,
+) []domain.WaterQualityMeasurement {
+	waterQuality := []domain.WaterQualityMeasurement{}
+
+	for _, v := range measurements {
+		waterQuality = append(waterQuality, domain.WaterQualityMeasurement(v))
+	}
+
+	return waterQuality
+}
+
+// parseWaterQualityBound reads a bound of a water quality range form. An empty bound is nil.
+func parseWaterQualityBound(value, field string) (*float32, error) {
+	var bound *float32
+
+	if value != "" {
+		v, err := strconv.ParseFloat(value, 32)
+		if err != nil {
+			return bound, NewRequestValidationError(Float, field)
+		}
+
+		f := float32(v)
+		bound = &f
+	}
+
+	return bound, nil
+}
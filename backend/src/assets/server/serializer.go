@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"sort"
+	"strconv"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -11,6 +12,7 @@ import (
 	"github.com/usetania/tania-core/src/assets/domain"
 	"github.com/usetania/tania-core/src/assets/query"
 	"github.com/usetania/tania-core/src/assets/storage"
+	"github.com/usetania/tania-core/src/helper/datehelper"
 )
 
 type (
@@ -88,8 +90,11 @@ type Material struct {
 	Type           MaterialType     `json:"type"`
 	Quantity       MaterialQuantity `json:"quantity"`
 	ExpirationDate *time.Time       `json:"expiration_date,omitempty"`
+	Expired        bool             `json:"expired"`
 	Notes          *string          `json:"notes"`
 	ProducedBy     *string          `json:"produced_by"`
+	ReorderPoint   *float32         `json:"reorder_point"`
+	LowStock       bool             `json:"low_stock"`
 	CreatedDate    time.Time        `json:"created_date"`
 }
 
@@ -130,6 +135,25 @@ type AvailableMaterialPlantType struct {
 	Names     []string `json:"names"`
 }
 
+// GeoJSONFeatureCollection is the farm map format read by GIS tools.
+// Features which have no location have a null geometry.
+type GeoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []GeoJSONFeature `json:"features"`
+}
+
+type GeoJSONFeature struct {
+	Type       string                 `json:"type"`
+	ID         uuid.UUID              `json:"id"`
+	Geometry   interface{}            `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type GeoJSONPoint struct {
+	Type        string    `json:"type"`
+	Coordinates []float64 `json:"coordinates"`
+}
+
 type SortedAreaNotes []domain.AreaNote
 
 // Len is part of sort.Interface.
@@ -152,6 +176,13 @@ func MapToFarmRead(farm *domain.Farm) *storage.FarmRead {
 	farmRead.City = farm.City
 	farmRead.CreatedDate = farm.CreatedDate
 	farmRead.IsActive = farm.IsActive
+	farmRead.UnitSystem = farm.UnitSystem
+	farmRead.Currency = domain.BaseCurrency(farm.Currency)
+
+	farmRead.ExchangeRates = []storage.FarmExchangeRate{}
+	for _, v := range farm.ExchangeRates {
+		farmRead.ExchangeRates = append(farmRead.ExchangeRates, storage.FarmExchangeRate(v))
+	}
 
 	return farmRead
 }
@@ -182,8 +213,21 @@ func MapToSimpleArea(areas []domain.Area) []SimpleArea {
 
 func MapToAreaList(s *FarmServer, areas []storage.AreaRead) ([]AreaList, error) {
 	areaList := make([]AreaList, len(areas))
+	unitSystems := make(map[uuid.UUID]string)
 
 	for i, area := range areas {
+		unitSystem, ok := unitSystems[area.Farm.UID]
+		if !ok {
+			var err error
+
+			unitSystem, err = findFarmUnitSystem(s, area.Farm.UID)
+			if err != nil {
+				return []AreaList{}, err
+			}
+
+			unitSystems[area.Farm.UID] = unitSystem
+		}
+
 		queryResult := <-s.CropReadQuery.CountCropsByArea(area.UID)
 		if queryResult.Error != nil {
 			return []AreaList{}, queryResult.Error
@@ -198,7 +242,7 @@ func MapToAreaList(s *FarmServer, areas []storage.AreaRead) ([]AreaList, error)
 			UID:            area.UID,
 			Name:           area.Name,
 			Type:           area.Type,
-			Size:           area.Size,
+			Size:           displayAreaSize(area.Size, unitSystem),
 			TotalCropBatch: cropCount.TotalCropBatch,
 			PlantQuantity:  cropCount.PlantQuantity,
 		}
@@ -207,12 +251,37 @@ func MapToAreaList(s *FarmServer, areas []storage.AreaRead) ([]AreaList, error)
 	return areaList, nil
 }
 
+// findFarmUnitSystem is the unit system sizes of the farm areas are shown in.
+func findFarmUnitSystem(s *FarmServer, farmUID uuid.UUID) (string, error) {
+	queryResult := <-s.FarmReadQuery.FindByID(farmUID)
+	if queryResult.Error != nil {
+		return "", queryResult.Error
+	}
+
+	farm, ok := queryResult.Result.(storage.FarmRead)
+	if !ok {
+		return "", echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	return farm.UnitSystem, nil
+}
+
+func displayAreaSize(size storage.AreaSize, unitSystem string) storage.AreaSize {
+	return storage.AreaSize(domain.DisplayAreaSize(domain.AreaSize(size), unitSystem))
+}
+
 func MapToReservoirRead(s *FarmServer, reservoir domain.Reservoir) (storage.ReservoirRead, error) {
 	resRead := storage.ReservoirRead{}
 
 	resRead.UID = reservoir.UID
 	resRead.Name = reservoir.Name
 	resRead.CreatedDate = reservoir.CreatedDate
+	resRead.WaterPerWatering = reservoir.WaterPerWatering
+	resRead.Level = MapToReservoirLevel(reservoir)
+	resRead.WaterQuality = MapToReservoirWaterQuality(reservoir)
+	resRead.NutrientRecipe = MapToReservoirNutrientRecipe(reservoir)
+	resRead.Photo = storage.ReservoirPhoto(reservoir.Photo)
+	resRead.Photos = MapToReservoirPhotos(reservoir)
 
 	switch v := reservoir.WaterSource.(type) {
 	case domain.Bucket:
@@ -306,10 +375,19 @@ func MapToDetailAreaFromStorage(s *FarmServer, areaRead storage.AreaRead) (Detai
 	detailArea.Type = areaRead.Type
 	detailArea.Location = areaRead.Location
 	detailArea.Photo = areaRead.Photo
-	detailArea.Size = areaRead.Size
+	detailArea.Photos = areaRead.Photos
 	detailArea.CreatedDate = areaRead.CreatedDate
 	detailArea.Reservoir = areaRead.Reservoir
 	detailArea.Farm = areaRead.Farm
+	detailArea.Boundary = areaRead.Boundary
+	detailArea.Capacity = areaRead.Capacity
+
+	unitSystem, err := findFarmUnitSystem(s, areaRead.Farm.UID)
+	if err != nil {
+		return DetailArea{}, err
+	}
+
+	detailArea.Size = displayAreaSize(areaRead.Size, unitSystem)
 
 	queryResult := <-s.CropReadQuery.CountCropsByArea(areaRead.UID)
 	if queryResult.Error != nil {
@@ -359,9 +437,19 @@ func MapToDetailArea(s *FarmServer, area domain.Area) (DetailArea, error) {
 	areaRead.Type = area.Type.Code
 	areaRead.Location = storage.AreaLocation(area.Location)
 	areaRead.Photo = storage.AreaPhoto(area.Photo)
-	areaRead.Size = storage.AreaSize(area.Size)
+	areaRead.Photos = MapToAreaPhotos(area)
 	areaRead.CreatedDate = area.CreatedDate
 
+	if area.Boundary != nil {
+		boundary := storage.AreaBoundary(*area.Boundary)
+		areaRead.Boundary = &boundary
+	}
+
+	if area.Capacity != nil {
+		capacity := storage.AreaCapacity(*area.Capacity)
+		areaRead.Capacity = &capacity
+	}
+
 	queryResult := <-s.ReservoirReadQuery.FindByID(area.ReservoirUID)
 	if queryResult.Error != nil {
 		return DetailArea{}, echo.NewHTTPError(http.StatusBadRequest, "Internal server error")
@@ -391,6 +479,7 @@ func MapToDetailArea(s *FarmServer, area domain.Area) (DetailArea, error) {
 		UID:  farm.UID,
 		Name: farm.Name,
 	}
+	areaRead.Size = storage.AreaSize(domain.DisplayAreaSize(area.Size, farm.UnitSystem))
 
 	queryResult = <-s.CropReadQuery.CountCropsByArea(area.UID)
 	if queryResult.Error != nil {
@@ -516,6 +605,10 @@ func MapToMaterial(material domain.Material) Material {
 		m.ProducedBy = material.ProducedBy
 	}
 
+	m.Expired = datehelper.IsExpired(m.ExpirationDate, time.Now())
+	m.ReorderPoint = material.ReorderPoint
+	m.LowStock = material.IsLowStock()
+
 	m.CreatedDate = material.CreatedDate
 
 	return m
@@ -594,6 +687,10 @@ func MapToMaterialFromRead(material storage.MaterialRead) Material {
 		m.ProducedBy = material.ProducedBy
 	}
 
+	m.Expired = datehelper.IsExpired(m.ExpirationDate, time.Now())
+	m.ReorderPoint = material.ReorderPoint
+	m.LowStock = domain.MaterialQuantity(material.Quantity).IsBelow(material.ReorderPoint)
+
 	m.CreatedDate = material.CreatedDate
 
 	return m
@@ -726,3 +823,138 @@ func (rt ReservoirTap) MarshalJSON() ([]byte, error) {
 		Type: rt.Type(),
 	})
 }
+
+func MapToFarmMap(
+	farm storage.FarmRead,
+	areas []storage.AreaRead,
+	reservoirs []storage.ReservoirRead,
+) GeoJSONFeatureCollection {
+	collection := GeoJSONFeatureCollection{
+		Type:     "FeatureCollection",
+		Features: []GeoJSONFeature{},
+	}
+
+	var farmPoint interface{}
+
+	longitude, errLongitude := strconv.ParseFloat(farm.Longitude, 64)
+	latitude, errLatitude := strconv.ParseFloat(farm.Latitude, 64)
+
+	if errLongitude == nil && errLatitude == nil {
+		farmPoint = GeoJSONPoint{
+			Type:        "Point",
+			Coordinates: []float64{longitude, latitude},
+		}
+	}
+
+	collection.Features = append(collection.Features, GeoJSONFeature{
+		Type:     domain.GeoJSONFeature,
+		ID:       farm.UID,
+		Geometry: farmPoint,
+		Properties: map[string]interface{}{
+			"kind":    "farm",
+			"name":    farm.Name,
+			"type":    farm.Type,
+			"country": farm.Country,
+			"city":    farm.City,
+		},
+	})
+
+	for _, v := range areas {
+		size := displayAreaSize(v.Size, farm.UnitSystem)
+
+		collection.Features = append(collection.Features, GeoJSONFeature{
+			Type:     domain.GeoJSONFeature,
+			ID:       v.UID,
+			Geometry: v.Boundary,
+			Properties: map[string]interface{}{
+				"kind":          "area",
+				"name":          v.Name,
+				"type":          v.Type,
+				"location":      v.Location.Code,
+				"size":          size.Value,
+				"size_unit":     size.Unit.Symbol,
+				"reservoir_uid": v.Reservoir.UID,
+			},
+		})
+	}
+
+	for _, v := range reservoirs {
+		collection.Features = append(collection.Features, GeoJSONFeature{
+			Type:     domain.GeoJSONFeature,
+			ID:       v.UID,
+			Geometry: nil,
+			Properties: map[string]interface{}{
+				"kind":         "reservoir",
+				"name":         v.Name,
+				"water_source": v.WaterSource.Type,
+				"capacity":     v.WaterSource.Capacity,
+			},
+		})
+	}
+
+	return collection
+}
+
+func MapToMaterialCostReport(
+	farm storage.FarmRead,
+	materials []storage.MaterialRead,
+	date time.Time,
+) MaterialCostReport {
+	baseCurrency := domain.BaseCurrency(farm.Currency)
+
+	rates := []domain.ExchangeRate{}
+	for _, v := range farm.ExchangeRates {
+		rates = append(rates, domain.ExchangeRate(v))
+	}
+
+	report := MaterialCostReport{
+		FarmUID:         farm.UID,
+		Currency:        baseCurrency,
+		Date:            date,
+		Materials:       []MaterialCost{},
+		MissingRateFor:  []string{},
+		InvalidPriceFor: []uuid.UUID{},
+	}
+
+	total := 0.0
+	missingRates := make(map[string]bool)
+
+	for _, v := range materials {
+		price, err := strconv.ParseFloat(v.PricePerUnit.Amount, 64)
+		if err != nil {
+			report.InvalidPriceFor = append(report.InvalidPriceFor, v.UID)
+
+			continue
+		}
+
+		amount := price * float64(v.Quantity.Value)
+
+		cost := MaterialCost{
+			MaterialUID:  v.UID,
+			Name:         v.Name,
+			Quantity:     v.Quantity.Value,
+			QuantityUnit: v.Quantity.Unit.Code,
+			PricePerUnit: v.PricePerUnit.Amount,
+			Currency:     v.PricePerUnit.CurrencyCode,
+			Amount:       domain.FormatAmount(amount, v.PricePerUnit.CurrencyCode),
+		}
+
+		converted, err := domain.ConvertAmount(amount, v.PricePerUnit.CurrencyCode, baseCurrency, rates, date)
+		if err != nil {
+			if !missingRates[v.PricePerUnit.CurrencyCode] {
+				missingRates[v.PricePerUnit.CurrencyCode] = true
+				report.MissingRateFor = append(report.MissingRateFor, v.PricePerUnit.CurrencyCode)
+			}
+		} else {
+			formatted := domain.FormatAmount(converted, baseCurrency)
+			cost.ConvertedAmount = &formatted
+			total += converted
+		}
+
+		report.Materials = append(report.Materials, cost)
+	}
+
+	report.Total = domain.FormatAmount(total, baseCurrency)
+
+	return report
+}
@@ -0,0 +1,43 @@
+package server
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// The events of the other modules the FarmServer subscribes to are mirrored here with the fields it uses,
+// and decoded with eventhelper.Decode.
+
+// taskCompleted mirrors the TaskCompleted event of the tasks module.
+type taskCompleted struct {
+	UID              uuid.UUID  `json:"uid"`
+	CompletedDate    *time.Time `json:"completed_date"`
+	MaterialID       *uuid.UUID `json:"material_id"`
+	MaterialQuantity float32    `json:"material_quantity"`
+
+	EquipmentID           *uuid.UUID `json:"equipment_id"`
+	MaintenanceScheduleID *uuid.UUID `json:"maintenance_schedule_id"`
+}
+
+// cropBatchInventoryUsed mirrors the CropBatchInventoryUsed event of the growth module.
+type cropBatchInventoryUsed struct {
+	UID          uuid.UUID
+	InventoryUID uuid.UUID
+	Quantity     float32
+}
+
+// cropBatchWatered mirrors the CropBatchWatered event of the growth module.
+type cropBatchWatered struct {
+	UID          uuid.UUID
+	AreaUID      uuid.UUID
+	WateringDate time.Time
+}
+
+// animalTreated mirrors the AnimalTreated event of the livestock module.
+type animalTreated struct {
+	AnimalUID   uuid.UUID
+	MaterialUID *uuid.UUID
+	Quantity    float32
+	TaskUID     *uuid.UUID
+}
@@ -0,0 +1,167 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/usetania/tania-core/src/assets/domain"
+	"github.com/usetania/tania-core/src/assets/repository"
+	"github.com/usetania/tania-core/src/assets/storage"
+)
+
+// GetSuppliers is a FarmServer's handler to list the suppliers.
+func (s *FarmServer) GetSuppliers(c echo.Context) error {
+	data := make(map[string][]storage.SupplierRead)
+
+	queryResult := <-s.SupplierReadQuery.FindAll()
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	suppliers, ok := queryResult.Result.([]storage.SupplierRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	data["data"] = suppliers
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// GetSupplierByID is a FarmServer's handler to get a supplier.
+func (s *FarmServer) GetSupplierByID(c echo.Context) error {
+	data := make(map[string]storage.SupplierRead)
+
+	supplierUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	queryResult := <-s.SupplierReadQuery.FindByID(supplierUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	supplier, ok := queryResult.Result.(storage.SupplierRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if supplier.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NotFound, "id"))
+	}
+
+	data["data"] = supplier
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// SaveSupplier is a FarmServer's handler to register a supplier.
+func (s *FarmServer) SaveSupplier(c echo.Context) error {
+	data := make(map[string]storage.SupplierRead)
+
+	supplier, err := domain.CreateSupplier(
+		c.FormValue("name"),
+		c.FormValue("phone"),
+		c.FormValue("email"),
+		c.FormValue("address"),
+	)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = <-s.SupplierEventRepo.Save(supplier.UID, supplier.Version, supplier.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	s.publishUncommittedEvents(supplier)
+
+	data["data"] = MapToSupplierRead(*supplier)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// UpdateSupplier is a FarmServer's handler to change the name or the contact of a supplier.
+// Phone, email and address are only changed when sent, so they can be cleared with an empty value.
+func (s *FarmServer) UpdateSupplier(c echo.Context) error {
+	data := make(map[string]storage.SupplierRead)
+
+	supplierUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	eventQueryResult := <-s.SupplierEventQuery.FindAllByID(supplierUID)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.SupplierEvent)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	supplier := repository.NewSupplierFromHistory(events)
+
+	if supplier.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NotFound, "id"))
+	}
+
+	name := c.FormValue("name")
+	if name != "" && name != supplier.Name {
+		err = supplier.ChangeName(name)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	params, err := c.FormParams()
+	if err != nil {
+		return Error(c, err)
+	}
+
+	phone, email, address := supplier.Phone, supplier.Email, supplier.Address
+
+	if _, ok := params["phone"]; ok {
+		phone = c.FormValue("phone")
+	}
+
+	if _, ok := params["email"]; ok {
+		email = c.FormValue("email")
+	}
+
+	if _, ok := params["address"]; ok {
+		address = c.FormValue("address")
+	}
+
+	if phone != supplier.Phone || email != supplier.Email || address != supplier.Address {
+		err = supplier.ChangeContact(phone, email, address)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	err = <-s.SupplierEventRepo.Save(supplier.UID, supplier.Version, supplier.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	s.publishUncommittedEvents(supplier)
+
+	data["data"] = MapToSupplierRead(*supplier)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func MapToSupplierRead(supplier domain.Supplier) storage.SupplierRead {
+	return storage.SupplierRead{
+		UID:         supplier.UID,
+		Name:        supplier.Name,
+		Phone:       supplier.Phone,
+		Email:       supplier.Email,
+		Address:     supplier.Address,
+		CreatedDate: supplier.CreatedDate,
+	}
+}
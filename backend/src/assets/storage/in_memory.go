@@ -6,6 +6,7 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/sasha-s/go-deadlock"
+	"github.com/usetania/tania-core/src/assets/domain"
 )
 
 type FarmEventStorage struct {
@@ -56,6 +57,8 @@ func CreateReservoirEventStorage() *ReservoirEventStorage {
 type ReservoirReadStorage struct {
 	Lock             *deadlock.RWMutex
 	ReservoirReadMap map[uuid.UUID]ReservoirRead
+	LevelMap         map[uuid.UUID][]ReservoirLevelEntry
+	WaterQualityMap  map[uuid.UUID][]ReservoirWaterQualityMeasurement
 }
 
 func CreateReservoirReadStorage() *ReservoirReadStorage {
@@ -65,7 +68,12 @@ func CreateReservoirReadStorage() *ReservoirReadStorage {
 		log.Println("RESERVOIR READ STORAGE DEADLOCK!")
 	}
 
-	return &ReservoirReadStorage{ReservoirReadMap: make(map[uuid.UUID]ReservoirRead), Lock: &rwMutex}
+	return &ReservoirReadStorage{
+		ReservoirReadMap: make(map[uuid.UUID]ReservoirRead),
+		LevelMap:         make(map[uuid.UUID][]ReservoirLevelEntry),
+		WaterQualityMap:  make(map[uuid.UUID][]ReservoirWaterQualityMeasurement),
+		Lock:             &rwMutex,
+	}
 }
 
 type AreaEventStorage struct {
@@ -127,3 +135,78 @@ func CreateMaterialReadStorage() *MaterialReadStorage {
 
 	return &MaterialReadStorage{MaterialReadMap: make(map[uuid.UUID]MaterialRead), Lock: &rwMutex}
 }
+
+type SupplierEventStorage struct {
+	Lock           *deadlock.RWMutex
+	SupplierEvents []SupplierEvent
+}
+
+func CreateSupplierEventStorage() *SupplierEventStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		log.Println("SUPPLIER EVENT STORAGE DEADLOCK!")
+	}
+
+	return &SupplierEventStorage{Lock: &rwMutex}
+}
+
+type SupplierReadStorage struct {
+	Lock            *deadlock.RWMutex
+	SupplierReadMap map[uuid.UUID]SupplierRead
+}
+
+func CreateSupplierReadStorage() *SupplierReadStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		log.Println("SUPPLIER READ STORAGE DEADLOCK!")
+	}
+
+	return &SupplierReadStorage{SupplierReadMap: make(map[uuid.UUID]SupplierRead), Lock: &rwMutex}
+}
+
+type MaterialTypeCatalogStorage struct {
+	Lock              *deadlock.RWMutex
+	CatalogsByFarmUID map[uuid.UUID]domain.MaterialTypeCatalog
+}
+
+func CreateMaterialTypeCatalogStorage() *MaterialTypeCatalogStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		log.Println("MATERIAL TYPE CATALOG STORAGE DEADLOCK!")
+	}
+
+	return &MaterialTypeCatalogStorage{CatalogsByFarmUID: make(map[uuid.UUID]domain.MaterialTypeCatalog), Lock: &rwMutex}
+}
+
+type EquipmentEventStorage struct {
+	Lock            *deadlock.RWMutex
+	EquipmentEvents []EquipmentEvent
+}
+
+func CreateEquipmentEventStorage() *EquipmentEventStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		log.Println("EQUIPMENT EVENT STORAGE DEADLOCK!")
+	}
+
+	return &EquipmentEventStorage{Lock: &rwMutex}
+}
+
+type EquipmentReadStorage struct {
+	Lock             *deadlock.RWMutex
+	EquipmentReadMap map[uuid.UUID]EquipmentRead
+}
+
+func CreateEquipmentReadStorage() *EquipmentReadStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		log.Println("EQUIPMENT READ STORAGE DEADLOCK!")
+	}
+
+	return &EquipmentReadStorage{EquipmentReadMap: make(map[uuid.UUID]EquipmentRead), Lock: &rwMutex}
+}
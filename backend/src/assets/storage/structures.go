@@ -23,9 +23,15 @@ type FarmRead struct {
 	Country     string    `json:"country"`
 	City        string    `json:"city"`
 	IsActive    bool      `json:"is_active"`
+	UnitSystem  string    `json:"unit_system"`
+	Currency    string    `json:"currency"`
 	CreatedDate time.Time `json:"created_date"`
+
+	ExchangeRates []FarmExchangeRate `json:"exchange_rates"`
 }
 
+type FarmExchangeRate domain.ExchangeRate
+
 type ReservoirEvent struct {
 	ReservoirUID uuid.UUID
 	Version      int
@@ -41,8 +47,50 @@ type ReservoirRead struct {
 	Notes           []ReservoirNote `json:"notes"`
 	CreatedDate     time.Time       `json:"created_date"`
 	InstalledToArea []AreaInstalled `json:"installed_to_area"`
+
+	WaterPerWatering float32         `json:"water_per_watering"`
+	Level            *ReservoirLevel `json:"level"`
+
+	WaterQuality []ReservoirWaterQuality `json:"water_quality"`
+
+	NutrientRecipe []ReservoirNutrient `json:"nutrient_recipe"`
+
+	Photo  ReservoirPhoto   `json:"photo"`
+	Photos []ReservoirPhoto `json:"photos"`
+}
+
+type (
+	ReservoirNutrient domain.ReservoirNutrient
+	ReservoirPhoto    domain.ReservoirPhoto
+)
+
+// ReservoirLevel is the latest known water level of a bucket and when it is predicted to run dry.
+type ReservoirLevel struct {
+	Level            float32    `json:"level"`
+	Date             time.Time  `json:"date"`
+	DailyConsumption float32    `json:"daily_consumption"`
+	PredictedDryDate *time.Time `json:"predicted_dry_date"`
 }
 
+// ReservoirLevelEntry is a reading, a refill or an estimated consumption in the level history of a bucket.
+type ReservoirLevelEntry domain.ReservoirLevel
+
+// ReservoirWaterQuality is the latest measurement of a water quality parameter of a reservoir
+// and its acceptable range.
+type ReservoirWaterQuality struct {
+	Parameter    string     `json:"parameter"`
+	Unit         string     `json:"unit"`
+	Value        *float32   `json:"value"`
+	MeasuredDate *time.Time `json:"measured_date"`
+	Min          *float32   `json:"min"`
+	Max          *float32   `json:"max"`
+	OutOfRange   bool       `json:"out_of_range"`
+}
+
+// ReservoirWaterQualityMeasurement is a measurement of a water quality parameter of a reservoir,
+// kept to list them between two dates.
+type ReservoirWaterQualityMeasurement domain.WaterQualityMeasurement
+
 type WaterSource struct {
 	Type     string  `json:"type"`
 	Capacity float32 `json:"capacity"`
@@ -74,10 +122,14 @@ type AreaRead struct {
 	Location    AreaLocation  `json:"location"`
 	Type        string        `json:"type"`
 	Photo       AreaPhoto     `json:"photo"`
+	Photos      []AreaPhoto   `json:"photos"`
 	CreatedDate time.Time     `json:"created_date"`
 	Notes       []AreaNote    `json:"notes"`
 	Farm        AreaFarm      `json:"farm"`
 	Reservoir   AreaReservoir `json:"reservoir"`
+	Boundary    *AreaBoundary `json:"boundary,omitempty"`
+	Capacity    *AreaCapacity `json:"capacity,omitempty"`
+	RetiredDate *time.Time    `json:"retired_date,omitempty"`
 }
 
 type AreaFarm struct {
@@ -96,6 +148,8 @@ type (
 	AreaType     domain.AreaType
 	AreaPhoto    domain.AreaPhoto
 	AreaNote     domain.AreaNote
+	AreaBoundary domain.AreaBoundary
+	AreaCapacity domain.AreaCapacity
 )
 
 type MaterialEvent struct {
@@ -115,6 +169,7 @@ type MaterialRead struct {
 	Notes          *string          `json:"notes"`
 	IsExpense      *bool            `json:"is_expense"`
 	ProducedBy     *string          `json:"produced_by"`
+	ReorderPoint   *float32         `json:"reorder_point"`
 	CreatedDate    time.Time        `json:"created_date"`
 }
 
@@ -182,3 +237,57 @@ type Inventory struct {
 	PlantType string    `json:"plant_type"`
 	Name      string    `json:"name"`
 }
+
+type SupplierEvent struct {
+	SupplierUID uuid.UUID
+	Version     int
+	CreatedDate time.Time
+	Event       interface{}
+}
+
+type SupplierRead struct {
+	UID         uuid.UUID `json:"uid"`
+	Name        string    `json:"name"`
+	Phone       string    `json:"phone"`
+	Email       string    `json:"email"`
+	Address     string    `json:"address"`
+	CreatedDate time.Time `json:"created_date"`
+}
+
+type EquipmentEvent struct {
+	EquipmentUID uuid.UUID
+	Version      int
+	CreatedDate  time.Time
+	Event        interface{}
+}
+
+type EquipmentRead struct {
+	UID          uuid.UUID          `json:"uid"`
+	Name         string             `json:"name"`
+	Type         string             `json:"type"`
+	SerialNumber string             `json:"serial_number"`
+	Farm         EquipmentFarm      `json:"farm"`
+	Location     *EquipmentLocation `json:"location"`
+	PurchaseDate *time.Time         `json:"purchase_date"`
+	Cost         *EquipmentCost     `json:"cost"`
+	CreatedDate  time.Time          `json:"created_date"`
+
+	MaintenanceSchedules []EquipmentMaintenanceSchedule `json:"maintenance_schedules"`
+}
+
+type EquipmentFarm struct {
+	UID  uuid.UUID `json:"uid"`
+	Name string    `json:"name"`
+}
+
+// EquipmentLocation is the area or the reservoir where the equipment is, with its name.
+type EquipmentLocation struct {
+	Type string    `json:"type"`
+	UID  uuid.UUID `json:"uid"`
+	Name string    `json:"name"`
+}
+
+type (
+	EquipmentCost                domain.EquipmentCost
+	EquipmentMaintenanceSchedule domain.EquipmentMaintenanceSchedule
+)
@@ -139,6 +139,16 @@ func (w *CropActivityTypeWrapper) UnmarshalJSON(b []byte) error {
 			return err
 		}
 
+		w.Data = a
+
+	case storage.NutrientDosingActivityCode:
+		a := storage.NutrientDosingActivity{}
+
+		_, err := Decode(f, &mapped, &a)
+		if err != nil {
+			return err
+		}
+
 		w.Data = a
 	}
 
@@ -72,6 +72,26 @@ func (w *CropEventWrapper) UnmarshalJSON(b []byte) error {
 
 		w.Data = e
 
+	case "CropBatchInventoryUsageChanged":
+		e := domain.CropBatchInventoryUsageChanged{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case "CropBatchInventoryUsed":
+		e := domain.CropBatchInventoryUsed{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
 	case "CropBatchMoved":
 		e := domain.CropBatchMoved{}
 
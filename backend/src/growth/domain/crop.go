@@ -1,12 +1,14 @@
 package domain
 
 import (
+	"math"
 	"strings"
 	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/usetania/tania-core/src/growth/query"
 	"github.com/usetania/tania-core/src/helper/stringhelper"
+	"github.com/usetania/tania-core/src/helper/unithelper"
 )
 
 type Crop struct {
@@ -19,6 +21,9 @@ type Crop struct {
 	FarmUID      uuid.UUID
 	Photos       []CropPhoto
 
+	// How much the crop batch takes out of the inventory
+	InventoryUsage CropInventoryUsage
+
 	// Fields to track crop's movement
 	InitialArea      InitialArea
 	MovedArea        []MovedArea
@@ -43,6 +48,8 @@ type CropService interface {
 	FindMaterialByID(uid uuid.UUID) ServiceResult
 	FindByBatchID(batchID string) ServiceResult
 	FindAreaByID(uid uuid.UUID) ServiceResult
+	FindAreaOccupancy(uid uuid.UUID) ServiceResult
+	FindFarmByID(uid uuid.UUID) ServiceResult
 }
 
 // ServiceResult is the container for service result.
@@ -201,8 +208,10 @@ func GetHarvestType(code string) HarvestType {
 }
 
 const (
-	Kg = "Kg"
-	Gr = "Gr"
+	Kg = unithelper.Kilogram
+	Gr = unithelper.Gram
+	Lb = unithelper.Pound
+	Oz = unithelper.Ounce
 )
 
 type ProducedUnit struct {
@@ -214,6 +223,8 @@ func ProducedUnits() []ProducedUnit {
 	return []ProducedUnit{
 		{Code: Kg, Label: "kg"},
 		{Code: Gr, Label: "gr"},
+		{Code: Lb, Label: "lb"},
+		{Code: Oz, Label: "oz"},
 	}
 }
 
@@ -233,14 +244,19 @@ type CropNote struct {
 	CreatedDate time.Time `json:"created_date"`
 }
 
+// CropPhoto is a photo of the crop batch. TakenDate is when the photo was taken, or uploaded
+// when the photo does not tell. Latitude and Longitude are where it was taken, nil when unknown.
 type CropPhoto struct {
-	UID         uuid.UUID `json:"uid"`
-	Filename    string    `json:"filename"`
-	MimeType    string    `json:"mime_type"`
-	Size        int       `json:"size"`
-	Width       int       `json:"width"`
-	Height      int       `json:"height"`
-	Description string    `json:"description"`
+	UID         uuid.UUID  `json:"uid"`
+	Filename    string     `json:"filename"`
+	MimeType    string     `json:"mime_type"`
+	Size        int        `json:"size"`
+	Width       int        `json:"width"`
+	Height      int        `json:"height"`
+	Description string     `json:"description"`
+	TakenDate   *time.Time `json:"taken_date"`
+	Latitude    *float64   `json:"latitude"`
+	Longitude   *float64   `json:"longitude"`
 }
 
 func (c *Crop) TrackChange(event interface{}) {
@@ -278,6 +294,9 @@ func (c *Crop) Transition(event interface{}) {
 		c.InitialArea.CurrentQuantity = e.Container.Quantity
 		c.InitialArea.InitialQuantity = e.Container.Quantity
 
+	case CropBatchInventoryUsageChanged:
+		c.InventoryUsage = e.InventoryUsage
+
 	case CropBatchMoved:
 		if c.InitialArea.AreaUID == e.SrcAreaUID {
 			ia, ok := e.UpdatedSrcArea.(InitialArea)
@@ -414,6 +433,9 @@ func (c *Crop) Transition(event interface{}) {
 			Width:       e.Width,
 			Height:      e.Height,
 			Description: e.Description,
+			TakenDate:   e.TakenDate,
+			Latitude:    e.Latitude,
+			Longitude:   e.Longitude,
 		})
 	}
 }
@@ -423,7 +445,8 @@ func CreateCropBatch(
 	areaUID uuid.UUID,
 	cropType string,
 	inventoryUID uuid.UUID,
-	quantity int, containerType CropContainerType) (*Crop, error,
+	quantity int, containerType CropContainerType,
+	overrideCapacity bool) (*Crop, error,
 ) {
 	serviceResult := cropService.FindAreaByID(areaUID)
 	if serviceResult.Error != nil {
@@ -432,6 +455,21 @@ func CreateCropBatch(
 
 	area := serviceResult.Result.(query.CropAreaQueryResult)
 
+	if area.IsRetired {
+		return nil, CropError{Code: CropErrorAreaRetiredCode}
+	}
+
+	serviceResult = cropService.FindFarmByID(area.FarmUID)
+	if serviceResult.Error != nil {
+		return nil, serviceResult.Error
+	}
+
+	farm := serviceResult.Result.(query.CropFarmQueryResult)
+
+	if farm.UID != (uuid.UUID{}) && !farm.IsActive {
+		return nil, CropError{Code: CropErrorFarmArchivedCode}
+	}
+
 	ct := GetCropType(cropType)
 	if ct == (CropType{}) {
 		return nil, CropError{Code: CropErrorInvalidCropType}
@@ -449,6 +487,13 @@ func CreateCropBatch(
 		return nil, err
 	}
 
+	if !overrideCapacity {
+		err = checkAreaCapacity(cropService, area, inv.PlantTypeCode, containerType, 0, quantity)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	cropContainer := CropContainer{
 		Quantity: quantity,
 		Type:     containerType,
@@ -484,7 +529,14 @@ func CreateCropBatch(
 	return initial, nil
 }
 
-func (c *Crop) MoveToArea(cropService CropService, sourceAreaUID, destinationAreaUID uuid.UUID, quantity int) error {
+// MoveToArea moves plants of the crop batch to another area. The move is refused
+// when the destination area cannot hold them, unless the capacity is overridden.
+func (c *Crop) MoveToArea(
+	cropService CropService,
+	sourceAreaUID, destinationAreaUID uuid.UUID,
+	quantity int,
+	overrideCapacity bool,
+) error {
 	// Validate //
 	// Check if source area is exist in DB
 	serviceResult := cropService.FindAreaByID(sourceAreaUID)
@@ -516,6 +568,10 @@ func (c *Crop) MoveToArea(cropService CropService, sourceAreaUID, destinationAre
 		return CropError{Code: CropMoveToAreaErrorDestinationAreaNotFound}
 	}
 
+	if dstArea.IsRetired {
+		return CropError{Code: CropErrorAreaRetiredCode}
+	}
+
 	// Check if movement rules for area type is valid
 	isValidMoveRules := false
 	if (srcArea.Type == "SEEDING" && dstArea.Type == "GROWING") ||
@@ -562,6 +618,22 @@ func (c *Crop) MoveToArea(cropService CropService, sourceAreaUID, destinationAre
 		return CropError{Code: CropMoveToAreaErrorInvalidQuantity}
 	}
 
+	if !overrideCapacity && dstArea.Capacity != nil {
+		serviceResult = cropService.FindMaterialByID(c.InventoryUID)
+		if serviceResult.Error != nil {
+			return serviceResult.Error
+		}
+
+		inv := serviceResult.Result.(query.CropMaterialQueryResult)
+
+		currentQuantity := c.quantityInArea(dstArea.UID)
+
+		err := checkAreaCapacity(cropService, dstArea, inv.PlantTypeCode, c.Container.Type, currentQuantity, quantity)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Process //
 	movedDate := time.Now()
 
@@ -696,6 +768,12 @@ func (c *Crop) Harvest(
 		return CropError{Code: CropHarvestErrorInvalidHarvestType}
 	}
 
+	// Produced Quantity always converted to gram
+	producedGrams, err := unithelper.ToGrams(float64(producedQuantity), producedUnit.Code)
+	if err != nil {
+		return CropError{Code: CropHarvestErrorInvalidProducedUnit}
+	}
+
 	// Process //
 	harvestDate := time.Now()
 
@@ -761,12 +839,7 @@ func (c *Crop) Harvest(
 	}
 
 	// Calculate the produced harvest
-	// Produced Quantity always converted to gram
-	totalProduced := producedQuantity
-	if producedUnit.Code == Kg {
-		totalProduced = producedQuantity * 1000
-	}
-
+	totalProduced := float32(producedGrams)
 	harvestedStorage.ProducedGramQuantity += totalProduced
 
 	// Check all the quantity in InitialArea and MovedArea,
@@ -1056,6 +1129,8 @@ func (c *Crop) ChangeContainer(quantity int, containerType CropContainerType) er
 		return CropError{Code: CropContainerErrorInvalidType}
 	}
 
+	used := c.inventoryUsed()
+
 	c.TrackChange(CropBatchContainerChanged{
 		UID: c.UID,
 		Container: CropContainer{
@@ -1064,6 +1139,8 @@ func (c *Crop) ChangeContainer(quantity int, containerType CropContainerType) er
 		},
 	})
 
+	c.trackInventoryUsed(used)
+
 	return nil
 }
 
@@ -1081,12 +1158,16 @@ func (c *Crop) ChangeInventory(cropService CropService, inventoryUID uuid.UUID)
 		return err
 	}
 
+	used := c.inventoryUsed()
+
 	c.TrackChange(CropBatchInventoryChanged{
 		UID:          c.UID,
 		InventoryUID: inventory.UID,
 		BatchID:      batchID,
 	})
 
+	c.trackInventoryUsed(used)
+
 	return nil
 }
 
@@ -1137,7 +1218,15 @@ func (c *Crop) RemoveNote(uid uuid.UUID) error {
 	return nil
 }
 
-func (c *Crop) AddPhoto(filename, mimeType string, size, width, height int, description string) error {
+// AddPhoto adds a photo taken at the date and place. The photo is dated by its upload without a date,
+// and the place is optional.
+func (c *Crop) AddPhoto(
+	filename, mimeType string,
+	size, width, height int,
+	description string,
+	takenDate *time.Time,
+	latitude, longitude *float64,
+) error {
 	if filename == "" {
 		return CropError{CropErrorPhotoInvalidFilename}
 	}
@@ -1154,6 +1243,19 @@ func (c *Crop) AddPhoto(filename, mimeType string, size, width, height int, desc
 		return CropError{CropErrorPhotoInvalidDescription}
 	}
 
+	if (latitude == nil) != (longitude == nil) {
+		return CropError{CropErrorPhotoInvalidLocation}
+	}
+
+	if latitude != nil && (math.Abs(*latitude) > 90 || math.Abs(*longitude) > 180) {
+		return CropError{CropErrorPhotoInvalidLocation}
+	}
+
+	if takenDate == nil {
+		now := time.Now()
+		takenDate = &now
+	}
+
 	uid, err := uuid.NewV4()
 	if err != nil {
 		return err
@@ -1168,6 +1270,9 @@ func (c *Crop) AddPhoto(filename, mimeType string, size, width, height int, desc
 		Width:       width,
 		Height:      height,
 		Description: description,
+		TakenDate:   takenDate,
+		Latitude:    latitude,
+		Longitude:   longitude,
 	})
 
 	return nil
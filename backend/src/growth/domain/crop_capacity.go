@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/growth/query"
+	"github.com/usetania/tania-core/src/helper/unithelper"
+)
+
+// checkAreaCapacity refuses to add plants to an area that cannot hold them.
+// Areas without a declared capacity are not limited. An area with a tray capacity only holds trays.
+// The plants already in the area from the same crop batch share its trays, so they are given to count the new trays.
+func checkAreaCapacity(
+	cropService CropService,
+	area query.CropAreaQueryResult,
+	plantType string,
+	container CropContainerType,
+	currentQuantity, quantity int,
+) error {
+	if area.Capacity == nil {
+		return nil
+	}
+
+	serviceResult := cropService.FindAreaOccupancy(area.UID)
+	if serviceResult.Error != nil {
+		return serviceResult.Error
+	}
+
+	occupancy := serviceResult.Result.(query.CropAreaOccupancyQueryResult)
+	capacity := *area.Capacity
+
+	switch capacity.Type {
+	case query.AreaCapacityPlant:
+		if occupancy.Plants+quantity > capacity.Value {
+			return CropError{Code: CropErrorAreaPlantCapacityExceededCode}
+		}
+
+	case query.AreaCapacityTray:
+		tray, ok := container.(Tray)
+		if !ok || tray.Cell <= 0 {
+			return CropError{Code: CropErrorAreaTrayCapacityContainerCode}
+		}
+
+		trays := countTrays(currentQuantity+quantity, tray.Cell) - countTrays(currentQuantity, tray.Cell)
+		if occupancy.Trays+trays > capacity.Value {
+			return CropError{Code: CropErrorAreaTrayCapacityExceededCode}
+		}
+
+	case query.AreaCapacitySpacing:
+		surface, err := unithelper.ToSquareMeters(float64(area.Size.Value), area.Size.Symbol)
+		if err != nil {
+			surface = float64(area.Size.Value)
+		}
+
+		used := float64(quantity) * capacity.PlantSurface(plantType)
+		for k, v := range occupancy.PlantsByType {
+			used += float64(v) * capacity.PlantSurface(k)
+		}
+
+		if used > surface {
+			return CropError{Code: CropErrorAreaSpaceExceededCode}
+		}
+	}
+
+	return nil
+}
+
+// quantityInArea is the current number of plants of the crop batch in an area.
+func (c Crop) quantityInArea(areaUID uuid.UUID) int {
+	if c.InitialArea.AreaUID == areaUID {
+		return c.InitialArea.CurrentQuantity
+	}
+
+	for _, v := range c.MovedArea {
+		if v.AreaUID == areaUID {
+			return v.CurrentQuantity
+		}
+	}
+
+	return 0
+}
+
+func countTrays(quantity, cell int) int {
+	return (quantity + cell - 1) / cell
+}
@@ -54,6 +54,22 @@ const (
 
 	CropNoteErrorInvalidContent
 	CropNoteErrorNotFound
+
+	CropErrorFarmArchivedCode
+	CropErrorAreaRetiredCode
+
+	CropErrorAreaPlantCapacityExceededCode
+	CropErrorAreaTrayCapacityExceededCode
+	CropErrorAreaSpaceExceededCode
+
+	CropHarvestErrorInvalidProducedUnit
+
+	CropErrorInvalidInventoryUsageCode
+	CropErrorInvalidContainerInventoryCode
+
+	CropErrorPhotoInvalidLocation
+
+	CropErrorAreaTrayCapacityContainerCode
 )
 
 // CropError is a custom error from Go built-in error.
@@ -147,6 +163,27 @@ func (e CropError) Error() string {
 		return "Invalid crop note content"
 	case CropNoteErrorNotFound:
 		return "Crop note not found"
+
+	case CropErrorFarmArchivedCode:
+		return "Cannot add crop batch to an archived farm"
+	case CropErrorAreaRetiredCode:
+		return "Cannot put crops in a retired area"
+	case CropErrorAreaPlantCapacityExceededCode:
+		return "Area cannot hold that many plants. Use override capacity to put them anyway"
+	case CropErrorAreaTrayCapacityExceededCode:
+		return "Area cannot hold that many trays. Use override capacity to put them anyway"
+	case CropErrorAreaSpaceExceededCode:
+		return "Area has not enough space for that many plants. Use override capacity to put them anyway"
+	case CropHarvestErrorInvalidProducedUnit:
+		return "Invalid produced unit"
+	case CropErrorInvalidInventoryUsageCode:
+		return "Invalid inventory usage. Quantities cannot be negative and need a container material"
+	case CropErrorInvalidContainerInventoryCode:
+		return "Container material should be a seeding container"
+	case CropErrorPhotoInvalidLocation:
+		return "Invalid photo location. Latitude and longitude are both needed and in range"
+	case CropErrorAreaTrayCapacityContainerCode:
+		return "Area with a tray capacity only holds trays. Use override capacity to put them anyway"
 	default:
 		return "Unrecognized Crop Error Code"
 	}
@@ -35,6 +35,20 @@ type CropBatchContainerChanged struct {
 	Container CropContainer
 }
 
+type CropBatchInventoryUsageChanged struct {
+	UID            uuid.UUID
+	InventoryUsage CropInventoryUsage
+}
+
+// CropBatchInventoryUsed is a quantity of a material taken out of the inventory by the crop batch.
+// A negative quantity is given back to the inventory after a correction of the crop batch.
+type CropBatchInventoryUsed struct {
+	UID          uuid.UUID
+	InventoryUID uuid.UUID
+	Quantity     float32
+	UsedDate     time.Time
+}
+
 type CropBatchMoved struct {
 	UID                uuid.UUID
 	Quantity           int
@@ -103,4 +117,7 @@ type CropBatchPhotoCreated struct {
 	Width       int
 	Height      int
 	Description string
+	TakenDate   *time.Time
+	Latitude    *float64
+	Longitude   *float64
 }
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/growth/query"
+)
+
+// CropInventoryUsage is how much a crop batch takes out of the inventory. InventoryPerCell is
+// the quantity of the seed or plant material for each tray cell or pot, and ContainerInventoryPerContainer
+// the quantity of the seeding container material for each tray or pot.
+type CropInventoryUsage struct {
+	InventoryPerCell               float32   `json:"inventory_per_cell"`
+	ContainerInventoryUID          uuid.UUID `json:"container_inventory_id"`
+	ContainerInventoryPerContainer float32   `json:"container_inventory_per_container"`
+}
+
+// ChangeInventoryUsage sets how much the crop batch takes out of the inventory.
+// The materials are debited, or credited back, by the difference with the previous usage.
+func (c *Crop) ChangeInventoryUsage(cropService CropService, usage CropInventoryUsage) error {
+	if usage.InventoryPerCell < 0 || usage.ContainerInventoryPerContainer < 0 {
+		return CropError{Code: CropErrorInvalidInventoryUsageCode}
+	}
+
+	if usage.ContainerInventoryPerContainer > 0 && usage.ContainerInventoryUID == (uuid.UUID{}) {
+		return CropError{Code: CropErrorInvalidInventoryUsageCode}
+	}
+
+	if usage.ContainerInventoryUID != (uuid.UUID{}) {
+		serviceResult := cropService.FindMaterialByID(usage.ContainerInventoryUID)
+		if serviceResult.Error != nil {
+			return serviceResult.Error
+		}
+
+		material, ok := serviceResult.Result.(query.CropMaterialQueryResult)
+		if !ok || material.TypeCode != query.MaterialTypeSeedingContainerCode {
+			return CropError{Code: CropErrorInvalidContainerInventoryCode}
+		}
+	}
+
+	used := c.inventoryUsed()
+
+	c.TrackChange(CropBatchInventoryUsageChanged{
+		UID:            c.UID,
+		InventoryUsage: usage,
+	})
+
+	c.trackInventoryUsed(used)
+
+	return nil
+}
+
+// inventoryUsed is the quantity of each material taken by the crop batch as it is now.
+func (c *Crop) inventoryUsed() map[uuid.UUID]float32 {
+	used := map[uuid.UUID]float32{}
+
+	if c.InventoryUsage.InventoryPerCell > 0 && c.InventoryUID != (uuid.UUID{}) {
+		used[c.InventoryUID] += c.InventoryUsage.InventoryPerCell * float32(c.Container.Quantity)
+	}
+
+	if c.InventoryUsage.ContainerInventoryPerContainer > 0 {
+		containers := c.Container.Quantity
+
+		if tray, ok := c.Container.Type.(Tray); ok && tray.Cell > 0 {
+			containers = countTrays(c.Container.Quantity, tray.Cell)
+		}
+
+		used[c.InventoryUsage.ContainerInventoryUID] += c.InventoryUsage.ContainerInventoryPerContainer * float32(containers)
+	}
+
+	return used
+}
+
+// trackInventoryUsed debits the materials the crop batch takes more of than before a change,
+// and credits back the ones it takes less of.
+func (c *Crop) trackInventoryUsed(before map[uuid.UUID]float32) {
+	after := c.inventoryUsed()
+
+	uids := []uuid.UUID{}
+
+	for uid := range before {
+		uids = append(uids, uid)
+	}
+
+	for uid := range after {
+		if _, ok := before[uid]; !ok {
+			uids = append(uids, uid)
+		}
+	}
+
+	sort.Slice(uids, func(i, j int) bool {
+		return uids[i].String() < uids[j].String()
+	})
+
+	usedDate := time.Now()
+
+	for _, uid := range uids {
+		quantity := after[uid] - before[uid]
+		if math.Abs(float64(quantity)) < 1e-6 {
+			continue
+		}
+
+		c.TrackChange(CropBatchInventoryUsed{
+			UID:          c.UID,
+			InventoryUID: uid,
+			Quantity:     quantity,
+			UsedDate:     usedDate,
+		})
+	}
+}
@@ -35,6 +35,18 @@ func (m *CropServiceMock) FindAreaByID(uid uuid.UUID) ServiceResult {
 	return args.Get(0).(ServiceResult)
 }
 
+func (m *CropServiceMock) FindAreaOccupancy(uid uuid.UUID) ServiceResult {
+	args := m.Called(uid)
+
+	return args.Get(0).(ServiceResult)
+}
+
+func (m *CropServiceMock) FindFarmByID(uid uuid.UUID) ServiceResult {
+	args := m.Called(uid)
+
+	return args.Get(0).(ServiceResult)
+}
+
 func TestCreateCropBatch(t *testing.T) {
 	t.Parallel()
 	// Given
@@ -51,6 +63,7 @@ func TestCreateCropBatch(t *testing.T) {
 
 	cropServiceMock.On("FindAreaByID", areaAUID).Return(areaAServiceResult)
 	cropServiceMock.On("FindAreaByID", areaBUID).Return(areaBServiceResult)
+	cropServiceMock.On("FindFarmByID", uuid.UUID{}).Return(ServiceResult{Result: query.CropFarmQueryResult{}})
 
 	inventoryUID, _ := uuid.NewV4()
 	inventoryServiceResult := ServiceResult{
@@ -68,8 +81,8 @@ func TestCreateCropBatch(t *testing.T) {
 	containerType := Tray{Cell: 15}
 
 	// When
-	crop, _ := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, containerType)
-	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 15)
+	crop, _ := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, containerType, false)
+	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 15, false)
 	crop.Dump(cropServiceMock, areaBUID, 5, "Notes")
 	crop.Fertilize()
 	crop.Pesticide()
@@ -103,6 +116,7 @@ func TestHarvestCropBatch(t *testing.T) {
 
 	cropServiceMock.On("FindAreaByID", areaAUID).Return(areaAServiceResult)
 	cropServiceMock.On("FindAreaByID", areaBUID).Return(areaBServiceResult)
+	cropServiceMock.On("FindFarmByID", uuid.UUID{}).Return(ServiceResult{Result: query.CropFarmQueryResult{}})
 
 	inventoryUID, _ := uuid.NewV4()
 	inventoryServiceResult := ServiceResult{
@@ -120,8 +134,8 @@ func TestHarvestCropBatch(t *testing.T) {
 	containerType := Tray{Cell: 15}
 
 	// When
-	crop, _ := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, containerType)
-	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 15)
+	crop, _ := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, containerType, false)
+	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 15, false)
 	err1 := crop.Harvest(cropServiceMock, areaBUID, HarvestTypePartial, 10, GetProducedUnit(Kg), "Notes")
 	err2 := crop.Harvest(cropServiceMock, areaAUID, HarvestTypePartial, 10, GetProducedUnit(Kg), "Notes")
 
@@ -135,13 +149,22 @@ func TestHarvestCropBatch(t *testing.T) {
 
 	assert.NotNil(t, err2)
 
+	// When
+	errPound := crop.Harvest(cropServiceMock, areaBUID, HarvestTypePartial, 2, GetProducedUnit(Lb), "Notes")
+	errUnit := crop.Harvest(cropServiceMock, areaBUID, HarvestTypePartial, 2, ProducedUnit{Code: "ton"}, "Notes")
+
+	// Then
+	assert.Nil(t, errPound)
+	assert.Equal(t, CropError{Code: CropHarvestErrorInvalidProducedUnit}, errUnit)
+	assert.InDelta(t, 10907.185, crop.HarvestedStorage[0].ProducedGramQuantity, 0.01)
+
 	// When
 	crop.Harvest(cropServiceMock, areaBUID, HarvestTypeAll, 2000, GetProducedUnit(Gr), "Notes")
 
 	// Then
 	assert.Equal(t, 0, crop.MovedArea[0].CurrentQuantity)
 	assert.Equal(t, 15, crop.HarvestedStorage[0].Quantity)
-	assert.Equal(t, float32(12000), crop.HarvestedStorage[0].ProducedGramQuantity)
+	assert.InDelta(t, 12907.185, crop.HarvestedStorage[0].ProducedGramQuantity, 0.01)
 }
 
 func TestWaterCrop(t *testing.T) {
@@ -160,6 +183,7 @@ func TestWaterCrop(t *testing.T) {
 
 	cropServiceMock.On("FindAreaByID", areaAUID).Return(areaAServiceResult)
 	cropServiceMock.On("FindAreaByID", areaBUID).Return(areaBServiceResult)
+	cropServiceMock.On("FindFarmByID", uuid.UUID{}).Return(ServiceResult{Result: query.CropFarmQueryResult{}})
 
 	inventoryUID, _ := uuid.NewV4()
 	inventoryServiceResult := ServiceResult{
@@ -180,8 +204,8 @@ func TestWaterCrop(t *testing.T) {
 	wDate, _ := time.Parse("2006-Jan-02", wateringDate)
 
 	// When
-	crop, errCrop := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, containerType)
-	errMove := crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 15)
+	crop, errCrop := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, containerType, false)
+	errMove := crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 15, false)
 	errWater1 := crop.Water(cropServiceMock, areaAUID, wDate)
 	errWater2 := crop.Water(cropServiceMock, areaBUID, wDate)
 
@@ -212,6 +236,7 @@ func TestCropHarvestArchiveStatus(t *testing.T) {
 
 	cropServiceMock.On("FindAreaByID", areaAUID).Return(areaAServiceResult)
 	cropServiceMock.On("FindAreaByID", areaBUID).Return(areaBServiceResult)
+	cropServiceMock.On("FindFarmByID", uuid.UUID{}).Return(ServiceResult{Result: query.CropFarmQueryResult{}})
 
 	inventoryUID, _ := uuid.NewV4()
 	inventoryServiceResult := ServiceResult{
@@ -229,15 +254,15 @@ func TestCropHarvestArchiveStatus(t *testing.T) {
 	containerType := Tray{Cell: 15}
 
 	// When
-	crop, _ := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, containerType)
-	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 15)
+	crop, _ := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, containerType, false)
+	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 15, false)
 	crop.Harvest(cropServiceMock, areaBUID, HarvestTypeAll, 2000, GetProducedUnit(Gr), "Notes")
 
 	// Then
 	assert.Equal(t, crop.Status.Code, CropActive)
 
 	// When
-	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 5)
+	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 5, false)
 	crop.Harvest(cropServiceMock, areaBUID, HarvestTypeAll, 3000, GetProducedUnit(Gr), "Notes")
 
 	// Then
@@ -260,6 +285,7 @@ func TestCropDumpArchiveStatus(t *testing.T) {
 
 	cropServiceMock.On("FindAreaByID", areaAUID).Return(areaAServiceResult)
 	cropServiceMock.On("FindAreaByID", areaBUID).Return(areaBServiceResult)
+	cropServiceMock.On("FindFarmByID", uuid.UUID{}).Return(ServiceResult{Result: query.CropFarmQueryResult{}})
 
 	inventoryUID, _ := uuid.NewV4()
 	inventoryServiceResult := ServiceResult{
@@ -277,8 +303,8 @@ func TestCropDumpArchiveStatus(t *testing.T) {
 	containerType := Tray{Cell: 15}
 
 	// When
-	crop, _ := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, containerType)
-	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 15)
+	crop, _ := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, containerType, false)
+	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 15, false)
 	crop.Dump(cropServiceMock, areaBUID, 15, "Notes")
 
 	// Then
@@ -290,3 +316,216 @@ func TestCropDumpArchiveStatus(t *testing.T) {
 	// Then
 	assert.Equal(t, crop.Status.Code, CropArchived)
 }
+
+func TestCreateCropBatchInArchivedFarm(t *testing.T) {
+	t.Parallel()
+	// Given
+	cropServiceMock := new(CropServiceMock)
+
+	farmUID, _ := uuid.NewV4()
+	areaUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindAreaByID", areaUID).Return(ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaUID, FarmUID: farmUID, Type: "SEEDING"},
+	})
+	cropServiceMock.On("FindFarmByID", farmUID).Return(ServiceResult{
+		Result: query.CropFarmQueryResult{UID: farmUID, IsActive: false},
+	})
+
+	inventoryUID, _ := uuid.NewV4()
+
+	// When
+	crop, err := CreateCropBatch(cropServiceMock, areaUID, CropTypeSeeding, inventoryUID, 20, Pot{}, false)
+
+	// Then
+	cropServiceMock.AssertExpectations(t)
+
+	assert.Nil(t, crop)
+	assert.Equal(t, CropError{Code: CropErrorFarmArchivedCode}, err)
+}
+
+func TestCropAreaCapacity(t *testing.T) {
+	t.Parallel()
+	// Given
+	cropServiceMock := new(CropServiceMock)
+
+	areaAUID, _ := uuid.NewV4()
+	areaBUID, _ := uuid.NewV4()
+	areaAServiceResult := ServiceResult{
+		Result: query.CropAreaQueryResult{
+			UID:      areaAUID,
+			Type:     "SEEDING",
+			Capacity: &query.CropAreaCapacity{Type: "PLANT", Value: 50},
+		},
+	}
+	areaBServiceResult := ServiceResult{
+		Result: query.CropAreaQueryResult{
+			UID:      areaBUID,
+			Type:     "GROWING",
+			Capacity: &query.CropAreaCapacity{Type: "TRAY", Value: 3},
+		},
+	}
+
+	cropServiceMock.On("FindAreaByID", areaAUID).Return(areaAServiceResult)
+	cropServiceMock.On("FindAreaByID", areaBUID).Return(areaBServiceResult)
+	cropServiceMock.On("FindFarmByID", uuid.UUID{}).Return(ServiceResult{Result: query.CropFarmQueryResult{}})
+	cropServiceMock.On("FindAreaOccupancy", areaAUID).Return(ServiceResult{
+		Result: query.CropAreaOccupancyQueryResult{Plants: 30},
+	})
+	cropServiceMock.On("FindAreaOccupancy", areaBUID).Return(ServiceResult{
+		Result: query.CropAreaOccupancyQueryResult{Plants: 20, Trays: 2},
+	})
+
+	inventoryUID, _ := uuid.NewV4()
+	inventoryServiceResult := ServiceResult{
+		Result: query.CropMaterialQueryResult{
+			UID:           inventoryUID,
+			Name:          "Tomato Super One",
+			PlantTypeCode: "VEGETABLE",
+		},
+	}
+	cropServiceMock.On("FindMaterialByID", inventoryUID).Return(inventoryServiceResult)
+
+	date := strings.ToLower(time.Now().Format("2Jan"))
+	batchID := fmt.Sprintf("%s%s", "tom-sup-one-", date)
+	cropServiceMock.On("FindByBatchID", batchID).Return(ServiceResult{})
+
+	containerType := Tray{Cell: 10}
+
+	// When
+	_, errExceeded := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 25, containerType, false)
+	crop, errOverride := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 25, containerType, true)
+
+	// Then
+	assert.Equal(t, CropError{Code: CropErrorAreaPlantCapacityExceededCode}, errExceeded)
+	assert.Nil(t, errOverride)
+
+	// When
+	// The first move fills one more tray, the second would fill two more.
+	errMove := crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 10, false)
+	errMoveExceeded := crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 11, false)
+
+	// Then
+	cropServiceMock.AssertExpectations(t)
+
+	assert.Nil(t, errMove)
+	assert.Equal(t, CropError{Code: CropErrorAreaTrayCapacityExceededCode}, errMoveExceeded)
+	assert.Equal(t, 10, crop.MovedArea[0].CurrentQuantity)
+
+	// When
+	_, errPots := CreateCropBatch(cropServiceMock, areaBUID, CropTypeSeeding, inventoryUID, 5, Pot{}, false)
+	_, errPotsOverride := CreateCropBatch(cropServiceMock, areaBUID, CropTypeSeeding, inventoryUID, 5, Pot{}, true)
+
+	// Then
+	assert.Equal(t, CropError{Code: CropErrorAreaTrayCapacityContainerCode}, errPots)
+	assert.Nil(t, errPotsOverride)
+}
+
+func TestCropInventoryUsage(t *testing.T) {
+	t.Parallel()
+	// Given
+	cropServiceMock := new(CropServiceMock)
+
+	areaUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindAreaByID", areaUID).Return(ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaUID, Type: "SEEDING"},
+	})
+	cropServiceMock.On("FindFarmByID", uuid.UUID{}).Return(ServiceResult{Result: query.CropFarmQueryResult{}})
+
+	seedUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindMaterialByID", seedUID).Return(ServiceResult{
+		Result: query.CropMaterialQueryResult{UID: seedUID, TypeCode: "SEED", Name: "Tomato Super One"},
+	})
+
+	otherSeedUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindMaterialByID", otherSeedUID).Return(ServiceResult{
+		Result: query.CropMaterialQueryResult{UID: otherSeedUID, TypeCode: "SEED", Name: "Tomato Super Two"},
+	})
+
+	trayUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindMaterialByID", trayUID).Return(ServiceResult{
+		Result: query.CropMaterialQueryResult{UID: trayUID, TypeCode: "SEEDING_CONTAINER", Name: "Soft Tray"},
+	})
+
+	cropServiceMock.On("FindByBatchID", mock.Anything).Return(ServiceResult{})
+
+	inventoryUsed := func(crop *Crop) map[uuid.UUID]float32 {
+		used := map[uuid.UUID]float32{}
+
+		for _, v := range crop.UncommittedChanges {
+			if e, ok := v.(CropBatchInventoryUsed); ok {
+				used[e.InventoryUID] += e.Quantity
+			}
+		}
+
+		crop.UncommittedChanges = nil
+
+		return used
+	}
+
+	crop, err := CreateCropBatch(cropServiceMock, areaUID, CropTypeSeeding, seedUID, 20, Tray{Cell: 15}, false)
+
+	// When
+	errNegative := crop.ChangeInventoryUsage(cropServiceMock, CropInventoryUsage{InventoryPerCell: -1})
+	errNoContainer := crop.ChangeInventoryUsage(cropServiceMock, CropInventoryUsage{ContainerInventoryPerContainer: 1})
+	errNotContainer := crop.ChangeInventoryUsage(cropServiceMock, CropInventoryUsage{
+		ContainerInventoryUID:          seedUID,
+		ContainerInventoryPerContainer: 1,
+	})
+	errUsage := crop.ChangeInventoryUsage(cropServiceMock, CropInventoryUsage{
+		InventoryPerCell:               2,
+		ContainerInventoryUID:          trayUID,
+		ContainerInventoryPerContainer: 1,
+	})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, CropError{Code: CropErrorInvalidInventoryUsageCode}, errNegative)
+	assert.Equal(t, CropError{Code: CropErrorInvalidInventoryUsageCode}, errNoContainer)
+	assert.Equal(t, CropError{Code: CropErrorInvalidContainerInventoryCode}, errNotContainer)
+	assert.Nil(t, errUsage)
+	assert.Equal(t, map[uuid.UUID]float32{seedUID: 40, trayUID: 2}, inventoryUsed(crop))
+
+	// When
+	errContainer := crop.ChangeContainer(10, Tray{Cell: 15})
+
+	// Then
+	assert.Nil(t, errContainer)
+	assert.Equal(t, map[uuid.UUID]float32{seedUID: -20, trayUID: -1}, inventoryUsed(crop))
+
+	// When
+	errInventory := crop.ChangeInventory(cropServiceMock, otherSeedUID)
+
+	// Then
+	assert.Nil(t, errInventory)
+	assert.Equal(t, map[uuid.UUID]float32{seedUID: -20, otherSeedUID: 20}, inventoryUsed(crop))
+}
+
+func TestAddCropPhoto(t *testing.T) {
+	t.Parallel()
+	// Given
+	crop := &Crop{}
+
+	takenDate := time.Date(2019, time.March, 2, 1, 30, 15, 0, time.UTC)
+	latitude := -7.8
+	longitude := 110.36
+	outOfRange := 91.0
+
+	// When
+	errTaken := crop.AddPhoto("leaf.jpg", "image/jpeg", 1000, 40, 20, "Leaf", &takenDate, &latitude, &longitude)
+	errUploaded := crop.AddPhoto("stem.jpg", "image/jpeg", 1000, 40, 20, "Stem", nil, nil, nil)
+	errHalf := crop.AddPhoto("root.jpg", "image/jpeg", 1000, 40, 20, "Root", nil, &latitude, nil)
+	errRange := crop.AddPhoto("root.jpg", "image/jpeg", 1000, 40, 20, "Root", nil, &outOfRange, &longitude)
+
+	// Then
+	assert.Nil(t, errTaken)
+	assert.Nil(t, errUploaded)
+	assert.Equal(t, CropError{CropErrorPhotoInvalidLocation}, errHalf)
+	assert.Equal(t, CropError{CropErrorPhotoInvalidLocation}, errRange)
+
+	assert.Len(t, crop.Photos, 2)
+	assert.Equal(t, takenDate, *crop.Photos[0].TakenDate)
+	assert.Equal(t, latitude, *crop.Photos[0].Latitude)
+	assert.Equal(t, longitude, *crop.Photos[0].Longitude)
+	assert.NotNil(t, crop.Photos[1].TakenDate)
+	assert.Nil(t, crop.Photos[1].Latitude)
+}
@@ -11,6 +11,7 @@ type CropServiceInMemory struct {
 	MaterialReadQuery query.MaterialReadQuery
 	CropReadQuery     query.CropReadQuery
 	AreaReadQuery     query.AreaReadQuery
+	FarmReadQuery     query.FarmReadQuery
 }
 
 func (s CropServiceInMemory) FindMaterialByID(uid uuid.UUID) domain.ServiceResult {
@@ -94,3 +95,60 @@ func (s CropServiceInMemory) FindAreaByID(uid uuid.UUID) domain.ServiceResult {
 		Result: area,
 	}
 }
+
+// FindAreaOccupancy counts the plants and the trays currently in an area.
+func (s CropServiceInMemory) FindAreaOccupancy(uid uuid.UUID) domain.ServiceResult {
+	result := <-s.CropReadQuery.FindAllCropsByArea(uid)
+
+	if result.Error != nil {
+		return domain.ServiceResult{
+			Error: result.Error,
+		}
+	}
+
+	crops, ok := result.Result.([]query.CropAreaByAreaQueryResult)
+	if !ok {
+		return domain.ServiceResult{
+			Error: domain.CropError{Code: domain.CropErrorInvalidArea},
+		}
+	}
+
+	occupancy := query.CropAreaOccupancyQueryResult{
+		PlantsByType: make(map[string]int),
+	}
+
+	for _, v := range crops {
+		trayCells := 0
+		if v.Container.Type == (domain.Tray{}).Code() {
+			trayCells = v.Container.Cell
+		}
+
+		occupancy.AddPlants(v.Inventory.PlantType, v.Area.CurrentQuantity, trayCells)
+	}
+
+	return domain.ServiceResult{
+		Result: occupancy,
+	}
+}
+
+// FindFarmByID finds the farm of an area. A farm missing from the read model is an empty result.
+func (s CropServiceInMemory) FindFarmByID(uid uuid.UUID) domain.ServiceResult {
+	result := <-s.FarmReadQuery.FindByID(uid)
+
+	if result.Error != nil {
+		return domain.ServiceResult{
+			Error: result.Error,
+		}
+	}
+
+	farm, ok := result.Result.(query.CropFarmQueryResult)
+	if !ok {
+		return domain.ServiceResult{
+			Error: domain.CropError{Code: domain.CropErrorInvalidArea},
+		}
+	}
+
+	return domain.ServiceResult{
+		Result: farm,
+	}
+}
@@ -32,6 +32,16 @@ func (s AreaReadQueryInMemory) FindByID(uid uuid.UUID) <-chan query.Result {
 				area.Type = val.Type
 				area.Location = val.Location.Code
 				area.FarmUID = val.Farm.UID
+				area.IsRetired = val.RetiredDate != nil
+
+				if val.Capacity != nil {
+					area.Capacity = &query.CropAreaCapacity{
+						Type:              val.Capacity.Type,
+						Value:             val.Capacity.Value,
+						Spacing:           val.Capacity.Spacing,
+						PlantTypeSpacings: val.Capacity.PlantTypeSpacings,
+					}
+				}
 			}
 		}
 
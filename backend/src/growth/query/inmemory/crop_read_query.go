@@ -1,6 +1,9 @@
 package inmemory
 
 import (
+	"sort"
+	"time"
+
 	"github.com/gofrs/uuid"
 	"github.com/usetania/tania-core/src/growth/query"
 	"github.com/usetania/tania-core/src/growth/storage"
@@ -375,3 +378,64 @@ func (s CropReadQueryInMemory) CountTotalBatch(farmUID uuid.UUID) <-chan query.R
 
 	return result
 }
+
+func (s CropReadQueryInMemory) FindAllPhotosByFarm(farmUID uuid.UUID, from, to time.Time) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		photos := []query.CropPhotoQueryResult{}
+
+		for _, val := range s.Storage.CropReadMap {
+			if val.FarmUID != farmUID {
+				continue
+			}
+
+			for _, v := range val.Photos {
+				if (!from.IsZero() || !to.IsZero()) && v.TakenDate == nil {
+					continue
+				}
+
+				if !from.IsZero() && v.TakenDate.Before(from) {
+					continue
+				}
+
+				if !to.IsZero() && !v.TakenDate.Before(to) {
+					continue
+				}
+
+				photos = append(photos, query.CropPhotoQueryResult{
+					CropUID:     val.UID,
+					BatchID:     val.BatchID,
+					UID:         v.UID,
+					Filename:    v.Filename,
+					MimeType:    v.MimeType,
+					Size:        v.Size,
+					Width:       v.Width,
+					Height:      v.Height,
+					Description: v.Description,
+					TakenDate:   v.TakenDate,
+					Latitude:    v.Latitude,
+					Longitude:   v.Longitude,
+				})
+			}
+		}
+
+		// Photos without a date come first, like a NULL date in the database.
+		sort.SliceStable(photos, func(i, j int) bool {
+			if photos[i].TakenDate == nil || photos[j].TakenDate == nil {
+				return photos[i].TakenDate == nil && photos[j].TakenDate != nil
+			}
+
+			return photos[i].TakenDate.Before(*photos[j].TakenDate)
+		})
+
+		result <- query.Result{Result: photos}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -27,6 +27,8 @@ func (s FarmReadQueryInMemory) FindByID(uid uuid.UUID) <-chan query.Result {
 			if val.UID == uid {
 				farm.UID = uid
 				farm.Name = val.Name
+				farm.IsActive = val.IsActive
+				farm.UnitSystem = val.UnitSystem
 			}
 		}
 
@@ -57,6 +57,9 @@ type cropReadPhotoResult struct {
 	Width       int
 	Height      int
 	Description string
+	TakenDate   sql.NullTime
+	Latitude    sql.NullFloat64
+	Longitude   sql.NullFloat64
 }
 
 type cropReadMovedAreaResult struct {
@@ -787,6 +790,9 @@ func (s CropReadQueryMysql) populateCropPhotos(uid uuid.UUID, cropRead *storage.
 			&photoRowsData.Width,
 			&photoRowsData.Height,
 			&photoRowsData.Description,
+			&photoRowsData.TakenDate,
+			&photoRowsData.Latitude,
+			&photoRowsData.Longitude,
 		)
 
 		if err != nil {
@@ -798,7 +804,7 @@ func (s CropReadQueryMysql) populateCropPhotos(uid uuid.UUID, cropRead *storage.
 			return err
 		}
 
-		photos = append(photos, storage.CropPhoto{
+		photo := storage.CropPhoto{
 			UID:         photoUID,
 			Filename:    photoRowsData.Filename,
 			MimeType:    photoRowsData.Mimetype,
@@ -806,7 +812,21 @@ func (s CropReadQueryMysql) populateCropPhotos(uid uuid.UUID, cropRead *storage.
 			Width:       photoRowsData.Width,
 			Height:      photoRowsData.Height,
 			Description: photoRowsData.Description,
-		})
+		}
+
+		if photoRowsData.TakenDate.Valid {
+			takenDate := photoRowsData.TakenDate.Time
+			photo.TakenDate = &takenDate
+		}
+
+		if photoRowsData.Latitude.Valid && photoRowsData.Longitude.Valid {
+			latitude := photoRowsData.Latitude.Float64
+			longitude := photoRowsData.Longitude.Float64
+			photo.Latitude = &latitude
+			photo.Longitude = &longitude
+		}
+
+		photos = append(photos, photo)
 	}
 
 	cropRead.Photos = photos
@@ -1032,3 +1052,120 @@ func (s CropReadQueryMysql) populateCropNotes(uid uuid.UUID, cropRead *storage.C
 
 	return nil
 }
+
+func (s CropReadQueryMysql) FindAllPhotosByFarm(farmUID uuid.UUID, from, to time.Time) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		photos := []query.CropPhotoQueryResult{}
+
+		sqlQuery := `SELECT P.UID, P.CROP_UID, C.BATCH_ID, P.FILENAME, P.MIMETYPE, P.SIZE, P.WIDTH, P.HEIGHT,
+			P.DESCRIPTION, P.TAKEN_DATE, P.LATITUDE, P.LONGITUDE
+			FROM CROP_READ_PHOTO P
+			INNER JOIN CROP_READ C ON C.UID = P.CROP_UID
+			WHERE C.FARM_UID = ?`
+
+		params := []interface{}{farmUID.Bytes()}
+
+		if !from.IsZero() {
+			sqlQuery += ` AND P.TAKEN_DATE >= ?`
+
+			params = append(params, from)
+		}
+
+		if !to.IsZero() {
+			sqlQuery += ` AND P.TAKEN_DATE < ?`
+
+			params = append(params, to)
+		}
+
+		sqlQuery += ` ORDER BY P.TAKEN_DATE`
+
+		rows, err := s.DB.Query(sqlQuery, params...)
+		if err != nil {
+			result <- query.Result{Error: err}
+			close(result)
+
+			return
+		}
+
+		defer rows.Close()
+
+		for rows.Next() {
+			rowsData := struct {
+				UID         []byte
+				CropUID     []byte
+				BatchID     string
+				Filename    string
+				MimeType    string
+				Size        int
+				Width       int
+				Height      int
+				Description string
+				TakenDate   sql.NullTime
+				Latitude    sql.NullFloat64
+				Longitude   sql.NullFloat64
+			}{}
+
+			err = rows.Scan(
+				&rowsData.UID,
+				&rowsData.CropUID,
+				&rowsData.BatchID,
+				&rowsData.Filename,
+				&rowsData.MimeType,
+				&rowsData.Size,
+				&rowsData.Width,
+				&rowsData.Height,
+				&rowsData.Description,
+				&rowsData.TakenDate,
+				&rowsData.Latitude,
+				&rowsData.Longitude,
+			)
+			if err != nil {
+				result <- query.Result{Error: err}
+				close(result)
+
+				return
+			}
+
+			photo := query.CropPhotoQueryResult{
+				BatchID:     rowsData.BatchID,
+				Filename:    rowsData.Filename,
+				MimeType:    rowsData.MimeType,
+				Size:        rowsData.Size,
+				Width:       rowsData.Width,
+				Height:      rowsData.Height,
+				Description: rowsData.Description,
+			}
+
+			photo.UID, err = uuid.FromBytes(rowsData.UID)
+			if err == nil {
+				photo.CropUID, err = uuid.FromBytes(rowsData.CropUID)
+			}
+
+			if err != nil {
+				result <- query.Result{Error: err}
+				close(result)
+
+				return
+			}
+
+			if rowsData.TakenDate.Valid {
+				takenDate := rowsData.TakenDate.Time
+				photo.TakenDate = &takenDate
+			}
+
+			if rowsData.Latitude.Valid && rowsData.Longitude.Valid {
+				photo.Latitude = &rowsData.Latitude.Float64
+				photo.Longitude = &rowsData.Longitude.Float64
+			}
+
+			photos = append(photos, photo)
+		}
+
+		result <- query.Result{Result: photos}
+		close(result)
+	}()
+
+	return result
+}
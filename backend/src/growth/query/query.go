@@ -4,8 +4,12 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	assetsdomain "github.com/usetania/tania-core/src/assets/domain"
 )
 
+// MaterialTypeSeedingContainerCode is the type of the trays and pots materials of the inventory.
+const MaterialTypeSeedingContainerCode = assetsdomain.MaterialTypeSeedingContainerCode
+
 type AreaReadQuery interface {
 	FindByID(areaUID uuid.UUID) <-chan Result
 }
@@ -30,6 +34,10 @@ type CropReadQuery interface {
 	CountAllArchivedCropsByFarm(farmUID uuid.UUID) <-chan Result
 	FindCropsInformation(farmUID uuid.UUID) <-chan Result
 	CountTotalBatch(farmUID uuid.UUID) <-chan Result
+
+	// FindAllPhotosByFarm finds the crop photos of the farm taken from the date until before the to date,
+	// by the date they were taken. A zero date is not bound.
+	FindAllPhotosByFarm(farmUID uuid.UUID, from, to time.Time) <-chan Result
 }
 
 type CropActivityQuery interface {
@@ -69,10 +77,25 @@ type CropAreaQueryResult struct {
 		Value  float32 `json:"value"`
 		Symbol string  `json:"symbol"`
 	} `json:"size"`
-	Type     string    `json:"type"`
-	Location string    `json:"location"`
-	FarmUID  uuid.UUID `json:"farm_uid"`
-}
+	Type      string            `json:"type"`
+	Location  string            `json:"location"`
+	FarmUID   uuid.UUID         `json:"farm_uid"`
+	IsRetired bool              `json:"-"`
+	Capacity  *CropAreaCapacity `json:"capacity,omitempty"`
+}
+
+// The area capacity types, as declared in the area.
+const (
+	AreaCapacityPlant   = assetsdomain.AreaCapacityPlant
+	AreaCapacityTray    = assetsdomain.AreaCapacityTray
+	AreaCapacitySpacing = assetsdomain.AreaCapacitySpacing
+)
+
+// CropAreaCapacity is how much an area can hold, as declared in the area.
+type CropAreaCapacity = assetsdomain.AreaCapacity
+
+// CropAreaOccupancyQueryResult is what an area currently holds.
+type CropAreaOccupancyQueryResult = assetsdomain.AreaOccupancy
 
 type CropAreaByAreaQueryResult struct {
 	UID         uuid.UUID `json:"uid"`
@@ -117,8 +140,10 @@ type CropInformationQueryResult struct {
 }
 
 type CropFarmQueryResult struct {
-	UID  uuid.UUID
-	Name string
+	UID        uuid.UUID
+	Name       string
+	IsActive   bool
+	UnitSystem string
 }
 
 type CountTotalBatchQueryResult struct {
@@ -126,6 +151,22 @@ type CountTotalBatchQueryResult struct {
 	TotalBatch  int    `json:"total_batch"`
 }
 
+// CropPhotoQueryResult is a photo of a crop batch, with when and where it was taken when known.
+type CropPhotoQueryResult struct {
+	CropUID     uuid.UUID  `json:"crop_uid"`
+	BatchID     string     `json:"batch_id"`
+	UID         uuid.UUID  `json:"uid"`
+	Filename    string     `json:"filename"`
+	MimeType    string     `json:"mime_type"`
+	Size        int        `json:"size"`
+	Width       int        `json:"width"`
+	Height      int        `json:"height"`
+	Description string     `json:"description"`
+	TakenDate   *time.Time `json:"taken_date"`
+	Latitude    *float64   `json:"latitude"`
+	Longitude   *float64   `json:"longitude"`
+}
+
 type CropTaskQueryResult struct {
 	UID         uuid.UUID
 	Title       string
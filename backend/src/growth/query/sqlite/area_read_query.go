@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
 
 	"github.com/gofrs/uuid"
@@ -24,6 +25,8 @@ type areaReadResult struct {
 	Type     string
 	Location string
 	FarmUID  string
+	Retired  int
+	Capacity sql.NullString
 }
 
 func (s AreaReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.Result {
@@ -33,7 +36,9 @@ func (s AreaReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.Result {
 		areaQueryResult := query.CropAreaQueryResult{}
 		rowsData := areaReadResult{}
 
-		err := s.DB.QueryRow(`SELECT UID, NAME, SIZE, SIZE_UNIT, TYPE, LOCATION, FARM_UID
+		err := s.DB.QueryRow(`SELECT UID, NAME, SIZE, SIZE_UNIT, TYPE, LOCATION, FARM_UID,
+			(SELECT COUNT(*) FROM AREA_READ_RETIRED WHERE AREA_UID = AREA_READ.UID),
+			(SELECT CAPACITY FROM AREA_READ_CAPACITY WHERE AREA_UID = AREA_READ.UID)
 			FROM AREA_READ WHERE UID = ?`, uid).Scan(
 			&rowsData.UID,
 			&rowsData.Name,
@@ -42,6 +47,8 @@ func (s AreaReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.Result {
 			&rowsData.Type,
 			&rowsData.Location,
 			&rowsData.FarmUID,
+			&rowsData.Retired,
+			&rowsData.Capacity,
 		)
 
 		if err != nil && !errors.Is(err, sql.ErrNoRows) {
@@ -69,6 +76,16 @@ func (s AreaReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.Result {
 		areaQueryResult.Type = rowsData.Type
 		areaQueryResult.Location = rowsData.Location
 		areaQueryResult.FarmUID = farmUID
+		areaQueryResult.IsRetired = rowsData.Retired > 0
+
+		if rowsData.Capacity.Valid {
+			areaQueryResult.Capacity = &query.CropAreaCapacity{}
+
+			err = json.Unmarshal([]byte(rowsData.Capacity.String), areaQueryResult.Capacity)
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+		}
 
 		result <- query.Result{Result: areaQueryResult}
 		close(result)
@@ -57,6 +57,9 @@ type cropReadPhotoResult struct {
 	Width       int
 	Height      int
 	Description string
+	TakenDate   sql.NullString
+	Latitude    sql.NullFloat64
+	Longitude   sql.NullFloat64
 }
 
 type cropReadMovedAreaResult struct {
@@ -797,6 +800,9 @@ func (s CropReadQuerySqlite) populateCropPhotos(uid uuid.UUID, cropRead *storage
 			&photoRowsData.Width,
 			&photoRowsData.Height,
 			&photoRowsData.Description,
+			&photoRowsData.TakenDate,
+			&photoRowsData.Latitude,
+			&photoRowsData.Longitude,
 		)
 
 		if err != nil {
@@ -808,7 +814,7 @@ func (s CropReadQuerySqlite) populateCropPhotos(uid uuid.UUID, cropRead *storage
 			return err
 		}
 
-		photos = append(photos, storage.CropPhoto{
+		photo := storage.CropPhoto{
 			UID:         photoUID,
 			Filename:    photoRowsData.Filename,
 			MimeType:    photoRowsData.Mimetype,
@@ -816,7 +822,25 @@ func (s CropReadQuerySqlite) populateCropPhotos(uid uuid.UUID, cropRead *storage
 			Width:       photoRowsData.Width,
 			Height:      photoRowsData.Height,
 			Description: photoRowsData.Description,
-		})
+		}
+
+		if photoRowsData.TakenDate.Valid {
+			takenDate, err := time.Parse(time.RFC3339, photoRowsData.TakenDate.String)
+			if err != nil {
+				return err
+			}
+
+			photo.TakenDate = &takenDate
+		}
+
+		if photoRowsData.Latitude.Valid && photoRowsData.Longitude.Valid {
+			latitude := photoRowsData.Latitude.Float64
+			longitude := photoRowsData.Longitude.Float64
+			photo.Latitude = &latitude
+			photo.Longitude = &longitude
+		}
+
+		photos = append(photos, photo)
 	}
 
 	cropRead.Photos = photos
@@ -1077,3 +1101,127 @@ func (s CropReadQuerySqlite) populateCropNotes(uid uuid.UUID, cropRead *storage.
 
 	return nil
 }
+
+func (s CropReadQuerySqlite) FindAllPhotosByFarm(farmUID uuid.UUID, from, to time.Time) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		photos := []query.CropPhotoQueryResult{}
+
+		sqlQuery := `SELECT P.UID, P.CROP_UID, C.BATCH_ID, P.FILENAME, P.MIMETYPE, P.SIZE, P.WIDTH, P.HEIGHT,
+			P.DESCRIPTION, P.TAKEN_DATE, P.LATITUDE, P.LONGITUDE
+			FROM CROP_READ_PHOTO P
+			INNER JOIN CROP_READ C ON C.UID = P.CROP_UID
+			WHERE C.FARM_UID = ?`
+
+		params := []interface{}{farmUID}
+
+		if !from.IsZero() {
+			sqlQuery += ` AND P.TAKEN_DATE >= ?`
+
+			params = append(params, from.UTC().Format(time.RFC3339))
+		}
+
+		if !to.IsZero() {
+			sqlQuery += ` AND P.TAKEN_DATE < ?`
+
+			params = append(params, to.UTC().Format(time.RFC3339))
+		}
+
+		sqlQuery += ` ORDER BY P.TAKEN_DATE`
+
+		rows, err := s.DB.Query(sqlQuery, params...)
+		if err != nil {
+			result <- query.Result{Error: err}
+			close(result)
+
+			return
+		}
+
+		defer rows.Close()
+
+		for rows.Next() {
+			rowsData := struct {
+				UID         string
+				CropUID     string
+				BatchID     string
+				Filename    string
+				MimeType    string
+				Size        int
+				Width       int
+				Height      int
+				Description string
+				TakenDate   sql.NullString
+				Latitude    sql.NullFloat64
+				Longitude   sql.NullFloat64
+			}{}
+
+			err = rows.Scan(
+				&rowsData.UID,
+				&rowsData.CropUID,
+				&rowsData.BatchID,
+				&rowsData.Filename,
+				&rowsData.MimeType,
+				&rowsData.Size,
+				&rowsData.Width,
+				&rowsData.Height,
+				&rowsData.Description,
+				&rowsData.TakenDate,
+				&rowsData.Latitude,
+				&rowsData.Longitude,
+			)
+			if err != nil {
+				result <- query.Result{Error: err}
+				close(result)
+
+				return
+			}
+
+			photo := query.CropPhotoQueryResult{
+				BatchID:     rowsData.BatchID,
+				Filename:    rowsData.Filename,
+				MimeType:    rowsData.MimeType,
+				Size:        rowsData.Size,
+				Width:       rowsData.Width,
+				Height:      rowsData.Height,
+				Description: rowsData.Description,
+			}
+
+			photo.UID, err = uuid.FromString(rowsData.UID)
+			if err == nil {
+				photo.CropUID, err = uuid.FromString(rowsData.CropUID)
+			}
+
+			if err != nil {
+				result <- query.Result{Error: err}
+				close(result)
+
+				return
+			}
+
+			if rowsData.TakenDate.Valid {
+				takenDate, err := time.Parse(time.RFC3339, rowsData.TakenDate.String)
+				if err != nil {
+					result <- query.Result{Error: err}
+					close(result)
+
+					return
+				}
+
+				photo.TakenDate = &takenDate
+			}
+
+			if rowsData.Latitude.Valid && rowsData.Longitude.Valid {
+				photo.Latitude = &rowsData.Latitude.Float64
+				photo.Longitude = &rowsData.Longitude.Float64
+			}
+
+			photos = append(photos, photo)
+		}
+
+		result <- query.Result{Result: photos}
+		close(result)
+	}()
+
+	return result
+}
@@ -17,8 +17,10 @@ func NewFarmReadQuerySqlite(db *sql.DB) query.FarmReadQuery {
 }
 
 type farmReadResult struct {
-	UID  string
-	Name string
+	UID        string
+	Name       string
+	IsActive   bool
+	UnitSystem sql.NullString
 }
 
 func (s FarmReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.Result {
@@ -28,9 +30,13 @@ func (s FarmReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.Result {
 		farmRead := query.CropFarmQueryResult{}
 		rowsData := farmReadResult{}
 
-		err := s.DB.QueryRow("SELECT UID, NAME FROM FARM_READ WHERE UID = ?", uid).Scan(
+		err := s.DB.QueryRow(`SELECT UID, NAME, IS_ACTIVE,
+			(SELECT UNIT_SYSTEM FROM FARM_READ_UNIT_SYSTEM WHERE FARM_UID = FARM_READ.UID)
+			FROM FARM_READ WHERE UID = ?`, uid).Scan(
 			&rowsData.UID,
 			&rowsData.Name,
+			&rowsData.IsActive,
+			&rowsData.UnitSystem,
 		)
 
 		if err != nil && !errors.Is(err, sql.ErrNoRows) {
@@ -48,6 +54,8 @@ func (s FarmReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.Result {
 
 		farmRead.UID = farmUID
 		farmRead.Name = rowsData.Name
+		farmRead.IsActive = rowsData.IsActive
+		farmRead.UnitSystem = rowsData.UnitSystem.String
 
 		result <- query.Result{Result: farmRead}
 		close(result)
@@ -71,11 +71,19 @@ func (f *CropReadRepositoryMysql) Save(cropRead *storage.CropRead) <-chan error
 
 			if len(cropRead.Photos) > 0 {
 				for _, v := range cropRead.Photos {
+					var takenDate interface{}
+
+					if v.TakenDate != nil {
+						takenDate = *v.TakenDate
+					}
+
 					res, err := f.DB.Exec(`UPDATE CROP_READ_PHOTO
 						SET FILENAME = ?, MIMETYPE = ?, SIZE = ?,
-						WIDTH = ?, HEIGHT = ?, DESCRIPTION = ?
+						WIDTH = ?, HEIGHT = ?, DESCRIPTION = ?,
+						TAKEN_DATE = ?, LATITUDE = ?, LONGITUDE = ?
 						WHERE UID = ?`,
-						v.Filename, v.MimeType, v.Size, v.Width, v.Height, v.Description, v.UID.Bytes())
+						v.Filename, v.MimeType, v.Size, v.Width, v.Height, v.Description,
+						takenDate, v.Latitude, v.Longitude, v.UID.Bytes())
 					if err != nil {
 						result <- err
 					}
@@ -87,9 +95,11 @@ func (f *CropReadRepositoryMysql) Save(cropRead *storage.CropRead) <-chan error
 
 					if rowsAffected == 0 {
 						f.DB.Exec(`INSERT INTO CROP_READ_PHOTO (
-							UID, CROP_UID, FILENAME, MIMETYPE, SIZE, WIDTH, HEIGHT, DESCRIPTION)
-							VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
-							v.UID.Bytes(), cropRead.UID.Bytes(), v.Filename, v.MimeType, v.Size, v.Width, v.Height, v.Description)
+							UID, CROP_UID, FILENAME, MIMETYPE, SIZE, WIDTH, HEIGHT, DESCRIPTION,
+							TAKEN_DATE, LATITUDE, LONGITUDE)
+							VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+							v.UID.Bytes(), cropRead.UID.Bytes(), v.Filename, v.MimeType, v.Size, v.Width, v.Height, v.Description,
+							takenDate, v.Latitude, v.Longitude)
 
 						if err != nil {
 							result <- err
@@ -92,11 +92,19 @@ func (f *CropReadRepositorySqlite) Save(cropRead *storage.CropRead) <-chan error
 
 			if len(cropRead.Photos) > 0 {
 				for _, v := range cropRead.Photos {
+					var takenDate interface{}
+
+					if v.TakenDate != nil {
+						takenDate = v.TakenDate.UTC().Format(time.RFC3339)
+					}
+
 					res, err := f.DB.Exec(`UPDATE CROP_READ_PHOTO
 						SET FILENAME = ?, MIMETYPE = ?, SIZE = ?,
-						WIDTH = ?, HEIGHT = ?, DESCRIPTION = ?
+						WIDTH = ?, HEIGHT = ?, DESCRIPTION = ?,
+						TAKEN_DATE = ?, LATITUDE = ?, LONGITUDE = ?
 						WHERE UID = ?`,
-						v.Filename, v.MimeType, v.Size, v.Width, v.Height, v.Description, v.UID)
+						v.Filename, v.MimeType, v.Size, v.Width, v.Height, v.Description,
+						takenDate, v.Latitude, v.Longitude, v.UID)
 					if err != nil {
 						result <- err
 					}
@@ -108,9 +116,11 @@ func (f *CropReadRepositorySqlite) Save(cropRead *storage.CropRead) <-chan error
 
 					if rowsAffected == 0 {
 						f.DB.Exec(`INSERT INTO CROP_READ_PHOTO (
-							UID, CROP_UID, FILENAME, MIMETYPE, SIZE, WIDTH, HEIGHT, DESCRIPTION)
-							VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
-							v.UID, cropRead.UID, v.Filename, v.MimeType, v.Size, v.Width, v.Height, v.Description)
+							UID, CROP_UID, FILENAME, MIMETYPE, SIZE, WIDTH, HEIGHT, DESCRIPTION,
+							TAKEN_DATE, LATITUDE, LONGITUDE)
+							VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+							v.UID, cropRead.UID, v.Filename, v.MimeType, v.Size, v.Width, v.Height, v.Description,
+							takenDate, v.Latitude, v.Longitude)
 
 						if err != nil {
 							result <- err
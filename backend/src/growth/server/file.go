@@ -2,57 +2,34 @@ package server
 
 import (
 	"io"
-	"log"
 	"mime/multipart"
-	"os"
-	"strings"
-)
+	"net/http"
 
-// File used to handle file path and file operation.
-// We use interface so we can swap it to other file storage easily.
-type File interface {
-	GetFile(src string) ([]byte, error)
-	Upload(file *multipart.FileHeader, destPath string) error
-}
+	"github.com/labstack/echo/v4"
+	"github.com/usetania/tania-core/src/helper/filehelper"
+)
 
-type LocalFile struct{}
+// serveFile responds with the file, or redirects to its download URL when the storage has one.
+func serveFile(c echo.Context, file filehelper.File, srcPath string) error {
+	downloadURL, err := file.DownloadURL(srcPath)
+	if err != nil {
+		return Error(c, err)
+	}
 
-func (LocalFile) GetFile(srcPath string) ([]byte, error) {
-	file, err := os.ReadFile(srcPath)
+	if downloadURL != "" {
+		return c.Redirect(http.StatusFound, downloadURL)
+	}
 
-	return file, err
+	return c.File(srcPath)
 }
 
-// Upload saves uploaded file to the destined path.
-func (LocalFile) Upload(file *multipart.FileHeader, destPath string) error {
+// readFormFile reads the content of an uploaded file, like the EXIF of an uploaded photo.
+func readFormFile(file *multipart.FileHeader) ([]byte, error) {
 	src, err := file.Open()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer src.Close()
 
-	// Create all directory if not exists
-	s := strings.Split(destPath, "/")
-	s = s[:len(s)-1]
-	sJoin := strings.Join(s, "/")
-
-	if _, err := os.Stat(sJoin); os.IsNotExist(err) {
-		log.Print("Upload folder is missing. Creating folder...")
-		os.MkdirAll(sJoin, os.ModePerm)
-		log.Print("Folder created in ", sJoin)
-	}
-
-	// Destination
-	dst, err := os.Create(destPath)
-	if err != nil {
-		return err
-	}
-	defer dst.Close()
-
-	// Copy
-	if _, err = io.Copy(dst, src); err != nil {
-		return err
-	}
-
-	return nil
+	return io.ReadAll(src)
 }
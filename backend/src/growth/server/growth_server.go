@@ -3,6 +3,7 @@ package server
 import (
 	"database/sql"
 	"net/http"
+	"sort"
 	"strconv"
 	"time"
 
@@ -22,6 +23,7 @@ import (
 	repoMysql "github.com/usetania/tania-core/src/growth/repository/mysql"
 	repoSqlite "github.com/usetania/tania-core/src/growth/repository/sqlite"
 	"github.com/usetania/tania-core/src/growth/storage"
+	"github.com/usetania/tania-core/src/helper/filehelper"
 	"github.com/usetania/tania-core/src/helper/imagehelper"
 	"github.com/usetania/tania-core/src/helper/paginationhelper"
 	"github.com/usetania/tania-core/src/helper/stringhelper"
@@ -43,7 +45,7 @@ type GrowthServer struct {
 	FarmReadQuery     query.FarmReadQuery
 	TaskReadQuery     query.TaskReadQuery
 	EventBus          eventbus.TaniaEventBus
-	File              File
+	File              filehelper.File
 }
 
 // NewGrowthServer initializes GrowthServer's dependencies and create new GrowthServer struct.
@@ -59,7 +61,7 @@ func NewGrowthServer(
 	taskReadStorage *taskstorage.TaskReadStorage,
 ) (*GrowthServer, error) {
 	growthServer := &GrowthServer{
-		File:     LocalFile{},
+		File:     filehelper.NewFile(),
 		EventBus: bus,
 	}
 
@@ -82,6 +84,7 @@ func NewGrowthServer(
 			MaterialReadQuery: growthServer.MaterialReadQuery,
 			CropReadQuery:     growthServer.CropReadQuery,
 			AreaReadQuery:     growthServer.AreaReadQuery,
+			FarmReadQuery:     growthServer.FarmReadQuery,
 		}
 	case config.DBSqlite:
 		growthServer.CropEventRepo = repoSqlite.NewCropEventRepositorySqlite(db)
@@ -101,6 +104,7 @@ func NewGrowthServer(
 			MaterialReadQuery: growthServer.MaterialReadQuery,
 			CropReadQuery:     growthServer.CropReadQuery,
 			AreaReadQuery:     growthServer.AreaReadQuery,
+			FarmReadQuery:     growthServer.FarmReadQuery,
 		}
 
 	case config.DBMysql:
@@ -121,6 +125,7 @@ func NewGrowthServer(
 			MaterialReadQuery: growthServer.MaterialReadQuery,
 			CropReadQuery:     growthServer.CropReadQuery,
 			AreaReadQuery:     growthServer.AreaReadQuery,
+			FarmReadQuery:     growthServer.FarmReadQuery,
 		}
 	}
 
@@ -152,6 +157,7 @@ func (s *GrowthServer) InitSubscriber() {
 	s.EventBus.Subscribe("CropBatchPhotoCreated", s.SaveToCropActivityReadModel)
 
 	s.EventBus.Subscribe("TaskCompleted", s.SaveToCropActivityReadModel)
+	s.EventBus.Subscribe("ReservoirDosed", s.SaveReservoirDosingToCropActivityReadModel)
 }
 
 // Mount defines the GrowthServer's endpoints with its handlers.
@@ -159,6 +165,7 @@ func (s *GrowthServer) Mount(g *echo.Group) {
 	g.GET("/:id/crops", s.FindAllCrops)
 	g.GET("/:id/crops/archives", s.FindAllCropArchives)
 	g.GET("/:id/crops/total_batch", s.GetBatchQuantity)
+	g.GET("/:id/crops/photos", s.FindAllCropPhotosByFarm)
 	g.GET("/areas/:id/crops", s.FindAllCropsByArea)
 	g.POST("/areas/:id/crops", s.SaveAreaCropBatch)
 	g.PUT("/crops/:id", s.UpdateCropBatch)
@@ -170,6 +177,7 @@ func (s *GrowthServer) Mount(g *echo.Group) {
 	g.POST("/crops/:id/notes", s.SaveCropNotes)
 	g.DELETE("/crops/:crop_id/notes/:note_id", s.RemoveCropNotes)
 	g.POST("/crops/:id/photos", s.UploadCropPhotos)
+	g.GET("/crops/:id/photos", s.FindAllCropPhotos)
 	g.GET("/crops/:crop_id/photos/:photo_id", s.GetCropPhotos)
 	g.GET("/crops/:id/activities", s.GetCropActivities)
 	g.GET("/:id/crops/information", s.GetCropsInformation)
@@ -239,11 +247,24 @@ func (s *GrowthServer) SaveAreaCropBatch(c echo.Context) error {
 		material.UID,
 		containerQuantity,
 		containerT,
+		c.FormValue("override_capacity") == "true",
 	)
 	if err != nil {
 		return Error(c, err)
 	}
 
+	usage, ok, err := parseInventoryUsage(c, cropBatch.InventoryUsage)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	if ok {
+		err = cropBatch.ChangeInventoryUsage(s.CropService, usage)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
 	// Persists //
 	err = <-s.CropEventRepo.Save(cropBatch.UID, 0, cropBatch.UncommittedChanges)
 	if err != nil {
@@ -253,7 +274,7 @@ func (s *GrowthServer) SaveAreaCropBatch(c echo.Context) error {
 	// Trigger Events
 	s.publishUncommittedEvents(cropBatch)
 
-	data := make(map[string]storage.CropRead)
+	data := make(map[string]CropRead)
 
 	cr, err := MapToCropRead(s, *cropBatch)
 	if err != nil {
@@ -374,6 +395,18 @@ func (s *GrowthServer) UpdateCropBatch(c echo.Context) error {
 		}
 	}
 
+	usage, ok, err := parseInventoryUsage(c, crop.InventoryUsage)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	if ok {
+		err = crop.ChangeInventoryUsage(s.CropService, usage)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
 	// Persist //
 	err = <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
 	if err != nil {
@@ -383,7 +416,7 @@ func (s *GrowthServer) UpdateCropBatch(c echo.Context) error {
 	// Trigger Events //
 	s.publishUncommittedEvents(crop)
 
-	data := make(map[string]storage.CropRead)
+	data := make(map[string]CropRead)
 
 	cr, err := MapToCropRead(s, *crop)
 	if err != nil {
@@ -395,6 +428,44 @@ func (s *GrowthServer) UpdateCropBatch(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
+// parseInventoryUsage reads the inventory usage fields of the form over the current usage of the crop batch.
+// It returns false when the form has none of them.
+func parseInventoryUsage(c echo.Context, usage domain.CropInventoryUsage) (domain.CropInventoryUsage, bool, error) {
+	found := false
+
+	if v := c.FormValue("inventory_per_cell"); v != "" {
+		f, err := strconv.ParseFloat(v, 32)
+		if err != nil {
+			return usage, false, NewRequestValidationError(Float, "inventory_per_cell")
+		}
+
+		usage.InventoryPerCell = float32(f)
+		found = true
+	}
+
+	if v := c.FormValue("container_inventory_id"); v != "" {
+		uid, err := uuid.FromString(v)
+		if err != nil {
+			return usage, false, NewRequestValidationError(NotFound, "container_inventory_id")
+		}
+
+		usage.ContainerInventoryUID = uid
+		found = true
+	}
+
+	if v := c.FormValue("container_inventory_per_container"); v != "" {
+		f, err := strconv.ParseFloat(v, 32)
+		if err != nil {
+			return usage, false, NewRequestValidationError(Float, "container_inventory_per_container")
+		}
+
+		usage.ContainerInventoryPerContainer = float32(f)
+		found = true
+	}
+
+	return usage, found, nil
+}
+
 func (s *GrowthServer) FindCropByID(c echo.Context) error {
 	cropUID, err := uuid.FromString(c.Param("id"))
 	if err != nil {
@@ -416,8 +487,13 @@ func (s *GrowthServer) FindCropByID(c echo.Context) error {
 		return Error(c, NewRequestValidationError(NotFound, "id"))
 	}
 
-	data := make(map[string]storage.CropRead)
-	data["data"] = crop
+	cr, err := MapToCropReadOutput(s, crop)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string]CropRead)
+	data["data"] = cr
 
 	return c.JSON(http.StatusOK, data)
 }
@@ -472,7 +548,7 @@ func (s *GrowthServer) MoveCrop(c echo.Context) error {
 
 	crop := repository.NewCropBatchFromHistory(events)
 
-	err = crop.MoveToArea(s.CropService, srcAreaUID, dstAreaUID, qty)
+	err = crop.MoveToArea(s.CropService, srcAreaUID, dstAreaUID, qty, c.FormValue("override_capacity") == "true")
 	if err != nil {
 		return Error(c, err)
 	}
@@ -486,7 +562,7 @@ func (s *GrowthServer) MoveCrop(c echo.Context) error {
 	// TRIGGER EVENTS
 	s.publishUncommittedEvents(crop)
 
-	data := make(map[string]storage.CropRead)
+	data := make(map[string]CropRead)
 
 	cr, err := MapToCropRead(s, *crop)
 	if err != nil {
@@ -573,7 +649,7 @@ func (s *GrowthServer) HarvestCrop(c echo.Context) error {
 	// TRIGGER EVENTS
 	s.publishUncommittedEvents(crop)
 
-	data := make(map[string]storage.CropRead)
+	data := make(map[string]CropRead)
 
 	cr, err := MapToCropRead(s, *crop)
 	if err != nil {
@@ -644,7 +720,7 @@ func (s *GrowthServer) DumpCrop(c echo.Context) error {
 	// TRIGGER EVENTS
 	s.publishUncommittedEvents(crop)
 
-	data := make(map[string]storage.CropRead)
+	data := make(map[string]CropRead)
 
 	cr, err := MapToCropRead(s, *crop)
 	if err != nil {
@@ -714,7 +790,7 @@ func (s *GrowthServer) WaterCrop(c echo.Context) error {
 	// TRIGGER EVENTS //
 	s.publishUncommittedEvents(crop)
 
-	data := make(map[string]storage.CropRead)
+	data := make(map[string]CropRead)
 
 	cr, err := MapToCropRead(s, *crop)
 	if err != nil {
@@ -777,7 +853,7 @@ func (s *GrowthServer) SaveCropNotes(c echo.Context) error {
 	// TRIGGER EVENTS //
 	s.publishUncommittedEvents(crop)
 
-	data := make(map[string]storage.CropRead)
+	data := make(map[string]CropRead)
 
 	cr, err := MapToCropRead(s, *crop)
 	if err != nil {
@@ -839,7 +915,7 @@ func (s *GrowthServer) RemoveCropNotes(c echo.Context) error {
 	// TRIGGER EVENTS //
 	s.publishUncommittedEvents(crop)
 
-	data := make(map[string]storage.CropRead)
+	data := make(map[string]CropRead)
 
 	cr, err := MapToCropRead(s, *crop)
 	if err != nil {
@@ -903,10 +979,7 @@ func (s *GrowthServer) FindAllCrops(c echo.Context) error {
 		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
 	}
 
-	temp := []storage.CropRead{}
-	temp = append(temp, crops...)
-
-	data["data"] = temp
+	data["data"] = MapToCropReadListOutput(farm.UnitSystem, crops)
 	data["total_rows"] = total
 	data["page"] = pageInt
 
@@ -963,10 +1036,7 @@ func (s *GrowthServer) FindAllCropArchives(c echo.Context) error {
 
 	data := make(map[string]interface{})
 
-	temp := []storage.CropRead{}
-	temp = append(temp, crops...)
-
-	data["data"] = temp
+	data["data"] = MapToCropReadListOutput(farm.UnitSystem, crops)
 	data["total"] = total
 	data["page"] = pageInt
 
@@ -1107,11 +1177,19 @@ func (s *GrowthServer) UploadCropPhotos(c echo.Context) error {
 		return Error(c, err)
 	}
 
-	width, height, err := imagehelper.GetImageDimension(destPath)
+	width, height, err := imagehelper.SaveRenditions(s.File, destPath)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	content, err := readFormFile(photo)
 	if err != nil {
 		return Error(c, err)
 	}
 
+	// Camera clocks have no time zone, so the capture time without one is taken as the server local time.
+	exif := imagehelper.GetExif(content, time.Local)
+
 	err = crop.AddPhoto(
 		photo.Filename,
 		photo.Header["Content-Type"][0],
@@ -1119,6 +1197,9 @@ func (s *GrowthServer) UploadCropPhotos(c echo.Context) error {
 		width,
 		height,
 		description,
+		exif.TakenDate,
+		exif.Latitude,
+		exif.Longitude,
 	)
 	if err != nil {
 		return Error(c, err)
@@ -1133,7 +1214,7 @@ func (s *GrowthServer) UploadCropPhotos(c echo.Context) error {
 	// TRIGGER EVENTS //
 	s.publishUncommittedEvents(crop)
 
-	data := make(map[string]storage.CropRead)
+	data := make(map[string]CropRead)
 
 	cr, err := MapToCropRead(s, *crop)
 	if err != nil {
@@ -1145,6 +1226,137 @@ func (s *GrowthServer) UploadCropPhotos(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
+// FindAllCropPhotos is a GrowthServer's handler to list the photos of a crop batch
+// taken between two dates, by the date they were taken.
+func (s *GrowthServer) FindAllCropPhotos(c echo.Context) error {
+	data := make(map[string]interface{})
+
+	cropUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	from, to, err := parsePhotoDateRange(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	result := <-s.CropReadQuery.FindByID(cropUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	cropRead, ok := result.Result.(storage.CropRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if cropRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NotFound, "id"))
+	}
+
+	photos := []storage.CropPhoto{}
+
+	for _, v := range cropRead.Photos {
+		if from.IsZero() && to.IsZero() {
+			photos = append(photos, v)
+
+			continue
+		}
+
+		if v.TakenDate == nil || (!from.IsZero() && v.TakenDate.Before(from)) ||
+			(!to.IsZero() && !v.TakenDate.Before(to)) {
+			continue
+		}
+
+		photos = append(photos, v)
+	}
+
+	sort.SliceStable(photos, func(i, j int) bool {
+		if photos[i].TakenDate == nil || photos[j].TakenDate == nil {
+			return photos[i].TakenDate == nil && photos[j].TakenDate != nil
+		}
+
+		return photos[i].TakenDate.Before(*photos[j].TakenDate)
+	})
+
+	data["data"] = photos
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// FindAllCropPhotosByFarm is a GrowthServer's handler to list the photos of every crop batch of a farm
+// taken between two dates, by the date they were taken.
+func (s *GrowthServer) FindAllCropPhotosByFarm(c echo.Context) error {
+	data := make(map[string]interface{})
+
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	from, to, err := parsePhotoDateRange(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	result := <-s.FarmReadQuery.FindByID(farmUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	farm, ok := result.Result.(query.CropFarmQueryResult)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if farm.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NotFound, "id"))
+	}
+
+	result = <-s.CropReadQuery.FindAllPhotosByFarm(farm.UID, from, to)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	photos, ok := result.Result.([]query.CropPhotoQueryResult)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	data["data"] = photos
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// parsePhotoDateRange reads the from and to days of a photo listing, both included, as the start of the from day
+// and the start of the day after the to day. Days are in the local time of the server, like the capture time
+// of the photos without a time zone. An empty day is not bound.
+func parsePhotoDateRange(c echo.Context) (time.Time, time.Time, error) {
+	from := time.Time{}
+	to := time.Time{}
+
+	if v := c.QueryParam("from"); v != "" {
+		date, err := time.ParseInLocation("2006-01-02", v, time.Local)
+		if err != nil {
+			return from, to, NewRequestValidationError(ParseFailed, "from")
+		}
+
+		from = date
+	}
+
+	if v := c.QueryParam("to"); v != "" {
+		date, err := time.ParseInLocation("2006-01-02", v, time.Local)
+		if err != nil {
+			return from, to, NewRequestValidationError(ParseFailed, "to")
+		}
+
+		to = date.AddDate(0, 0, 1)
+	}
+
+	return from, to, nil
+}
+
 func (s *GrowthServer) GetCropPhotos(c echo.Context) error {
 	cropUID, err := uuid.FromString(c.Param("crop_id"))
 	if err != nil {
@@ -1184,9 +1396,21 @@ func (s *GrowthServer) GetCropPhotos(c echo.Context) error {
 	}
 
 	// Process //
-	srcPath := stringhelper.Join(*config.Config.UploadPathCrop, "/", found.Filename)
+	size := c.QueryParam("size")
+	if size != "" && !imagehelper.IsPhotoSize(size) {
+		return Error(c, NewRequestValidationError(InvalidOption, "size"))
+	}
+
+	srcPath, err := imagehelper.GetRenditionPath(
+		s.File,
+		stringhelper.Join(*config.Config.UploadPathCrop, "/", found.Filename),
+		size,
+	)
+	if err != nil {
+		return Error(c, err)
+	}
 
-	return c.File(srcPath)
+	return serveFile(c, s.File, srcPath)
 }
 
 func (s *GrowthServer) GetCropActivities(c echo.Context) error {
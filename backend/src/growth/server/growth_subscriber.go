@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	assetsevents "github.com/usetania/tania-core/src/assets/domain"
 	"github.com/usetania/tania-core/src/growth/domain"
 	"github.com/usetania/tania-core/src/growth/query"
 	"github.com/usetania/tania-core/src/growth/storage"
@@ -514,6 +515,9 @@ func (s *GrowthServer) SaveToCropReadModel(event interface{}) error {
 			Width:       e.Width,
 			Height:      e.Height,
 			Description: e.Description,
+			TakenDate:   e.TakenDate,
+			Latitude:    e.Latitude,
+			Longitude:   e.Longitude,
 		})
 	}
 
@@ -738,6 +742,12 @@ func (s *GrowthServer) SaveToCropActivityReadModel(event interface{}) error {
 		cropActivity.BatchID = cr.BatchID
 		cropActivity.ContainerType = cr.Container.Type
 		cropActivity.CreatedDate = time.Now()
+
+		// The activity happened when the photo was taken, which can be long before its upload.
+		if e.TakenDate != nil {
+			cropActivity.CreatedDate = e.TakenDate.In(time.Local)
+		}
+
 		cropActivity.ActivityType = storage.PhotoActivity{
 			UID:         e.UID,
 			Filename:    e.Filename,
@@ -746,6 +756,9 @@ func (s *GrowthServer) SaveToCropActivityReadModel(event interface{}) error {
 			Width:       e.Width,
 			Height:      e.Height,
 			Description: e.Description,
+			TakenDate:   e.TakenDate,
+			Latitude:    e.Latitude,
+			Longitude:   e.Longitude,
 		}
 
 	// TODO:
@@ -857,3 +870,68 @@ func (s *GrowthServer) SaveToCropActivityReadModel(event interface{}) error {
 
 	return nil
 }
+
+// SaveReservoirDosingToCropActivityReadModel adds the dosing of a reservoir to the activities
+// of every crop in the areas it waters.
+func (s *GrowthServer) SaveReservoirDosingToCropActivityReadModel(event interface{}) error {
+	e, ok := event.(assetsevents.ReservoirDosed)
+	if !ok {
+		return nil
+	}
+
+	nutrients := []storage.NutrientDosingMaterial{}
+	for _, v := range e.Nutrients {
+		nutrients = append(nutrients, storage.NutrientDosingMaterial{
+			MaterialUID:  v.MaterialUID,
+			MaterialName: v.MaterialName,
+			Quantity:     v.Quantity,
+			QuantityUnit: v.QuantityUnit,
+		})
+	}
+
+	for _, areaUID := range e.AreaUIDs {
+		queryResult := <-s.AreaReadQuery.FindByID(areaUID)
+		if queryResult.Error != nil {
+			log.Println(queryResult.Error)
+		}
+
+		area, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Println(errors.New("internal server error. error type assertion"))
+		}
+
+		queryResult = <-s.CropReadQuery.FindAllCropsByArea(areaUID)
+		if queryResult.Error != nil {
+			log.Println(queryResult.Error)
+		}
+
+		crops, ok := queryResult.Result.([]query.CropAreaByAreaQueryResult)
+		if !ok {
+			log.Println(errors.New("internal server error. error type assertion"))
+		}
+
+		for _, crop := range crops {
+			cropActivity := &storage.CropActivity{
+				UID:           crop.UID,
+				BatchID:       crop.BatchID,
+				ContainerType: crop.Container.Type,
+				CreatedDate:   time.Now(),
+				ActivityType: storage.NutrientDosingActivity{
+					ReservoirUID: e.ReservoirUID,
+					AreaUID:      areaUID,
+					AreaName:     area.Name,
+					Volume:       e.Volume,
+					Nutrients:    nutrients,
+					DosingDate:   e.DosedDate,
+				},
+			}
+
+			err := <-s.CropActivityRepo.Save(cropActivity, false)
+			if err != nil {
+				log.Println(err)
+			}
+		}
+	}
+
+	return nil
+}
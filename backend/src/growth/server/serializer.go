@@ -11,6 +11,7 @@ import (
 	"github.com/usetania/tania-core/src/growth/domain"
 	"github.com/usetania/tania-core/src/growth/query"
 	"github.com/usetania/tania-core/src/growth/storage"
+	"github.com/usetania/tania-core/src/helper/unithelper"
 )
 
 type CropListInArea struct {
@@ -32,6 +33,22 @@ type InitialArea struct {
 	Name    string    `json:"name"`
 }
 
+// CropRead is a crop batch with its harvests weighed in the unit system of its farm.
+type CropRead struct {
+	storage.CropRead
+	HarvestedStorage []HarvestedStorage `json:"harvested_storage"`
+}
+
+type HarvestedStorage struct {
+	storage.HarvestedStorage
+	Produced ProducedWeight `json:"produced"`
+}
+
+type ProducedWeight struct {
+	Value float32 `json:"value"`
+	Unit  string  `json:"unit"`
+}
+
 type SortedCropNotes []domain.CropNote
 
 // Len is part of sort.Interface.
@@ -63,6 +80,9 @@ type (
 	TaskSanitationActivity struct {
 		*storage.TaskSanitationActivity
 	}
+	NutrientDosingActivity struct {
+		*storage.NutrientDosingActivity
+	}
 )
 
 func MapToCropActivity(activity storage.CropActivity) CropActivity {
@@ -91,25 +111,27 @@ func MapToCropActivity(activity storage.CropActivity) CropActivity {
 		ca.ActivityType = TaskSanitationActivity{&v}
 	case storage.TaskSafetyActivity:
 		ca.ActivityType = TaskSafetyActivity{&v}
+	case storage.NutrientDosingActivity:
+		ca.ActivityType = NutrientDosingActivity{&v}
 	}
 
 	return ca
 }
 
-func MapToCropRead(s *GrowthServer, crop domain.Crop) (storage.CropRead, error) {
+func MapToCropRead(s *GrowthServer, crop domain.Crop) (CropRead, error) {
 	queryResult := <-s.MaterialReadQuery.FindByID(crop.InventoryUID)
 	if queryResult.Error != nil {
-		return storage.CropRead{}, queryResult.Error
+		return CropRead{}, queryResult.Error
 	}
 
 	inv, ok := queryResult.Result.(query.CropMaterialQueryResult)
 	if !ok {
-		return storage.CropRead{}, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+		return CropRead{}, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
 	}
 
 	queryResult = <-s.AreaReadQuery.FindByID(crop.InitialArea.AreaUID)
 	if queryResult.Error != nil {
-		return storage.CropRead{}, queryResult.Error
+		return CropRead{}, queryResult.Error
 	}
 
 	totalSeeding := 0
@@ -118,7 +140,7 @@ func MapToCropRead(s *GrowthServer, crop domain.Crop) (storage.CropRead, error)
 
 	initialArea, ok := queryResult.Result.(query.CropAreaQueryResult)
 	if !ok {
-		return storage.CropRead{}, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+		return CropRead{}, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
 	}
 
 	if initialArea.Type == "SEEDING" {
@@ -132,12 +154,12 @@ func MapToCropRead(s *GrowthServer, crop domain.Crop) (storage.CropRead, error)
 	for _, v := range crop.MovedArea {
 		queryResult = <-s.AreaReadQuery.FindByID(v.AreaUID)
 		if queryResult.Error != nil {
-			return storage.CropRead{}, queryResult.Error
+			return CropRead{}, queryResult.Error
 		}
 
 		area, ok := queryResult.Result.(query.CropAreaQueryResult)
 		if !ok {
-			return storage.CropRead{}, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+			return CropRead{}, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
 		}
 
 		if area.Type == "SEEDING" {
@@ -185,12 +207,12 @@ func MapToCropRead(s *GrowthServer, crop domain.Crop) (storage.CropRead, error)
 	for _, v := range crop.HarvestedStorage {
 		queryResult = <-s.AreaReadQuery.FindByID(v.SourceAreaUID)
 		if queryResult.Error != nil {
-			return storage.CropRead{}, queryResult.Error
+			return CropRead{}, queryResult.Error
 		}
 
 		area, ok := queryResult.Result.(query.CropAreaQueryResult)
 		if !ok {
-			return storage.CropRead{}, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+			return CropRead{}, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
 		}
 
 		harvestedStorage = append(harvestedStorage, storage.HarvestedStorage{
@@ -208,12 +230,12 @@ func MapToCropRead(s *GrowthServer, crop domain.Crop) (storage.CropRead, error)
 	for _, v := range crop.Trash {
 		queryResult = <-s.AreaReadQuery.FindByID(v.SourceAreaUID)
 		if queryResult.Error != nil {
-			return storage.CropRead{}, queryResult.Error
+			return CropRead{}, queryResult.Error
 		}
 
 		area, ok := queryResult.Result.(query.CropAreaQueryResult)
 		if !ok {
-			return storage.CropRead{}, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+			return CropRead{}, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
 		}
 
 		totalDumped += v.Quantity
@@ -267,6 +289,9 @@ func MapToCropRead(s *GrowthServer, crop domain.Crop) (storage.CropRead, error)
 			Width:       v.Width,
 			Height:      v.Height,
 			Description: v.Description,
+			TakenDate:   v.TakenDate,
+			Latitude:    v.Latitude,
+			Longitude:   v.Longitude,
 		})
 	}
 
@@ -317,7 +342,65 @@ func MapToCropRead(s *GrowthServer, crop domain.Crop) (storage.CropRead, error)
 		return cropRead.Notes[i].CreatedDate.After(cropRead.Notes[j].CreatedDate)
 	})
 
-	return cropRead, nil
+	return MapToCropReadOutput(s, cropRead)
+}
+
+// MapToCropReadOutput converts the produced harvests of a crop batch, stored in grams,
+// to the unit system of its farm.
+func MapToCropReadOutput(s *GrowthServer, cropRead storage.CropRead) (CropRead, error) {
+	unitSystem, err := findFarmUnitSystem(s, cropRead.FarmUID)
+	if err != nil {
+		return CropRead{}, err
+	}
+
+	return mapToCropReadInUnitSystem(cropRead, unitSystem), nil
+}
+
+// MapToCropReadListOutput converts the produced harvests of crop batches from the same farm.
+func MapToCropReadListOutput(unitSystem string, cropReads []storage.CropRead) []CropRead {
+	crops := []CropRead{}
+
+	for _, v := range cropReads {
+		crops = append(crops, mapToCropReadInUnitSystem(v, unitSystem))
+	}
+
+	return crops
+}
+
+func mapToCropReadInUnitSystem(cropRead storage.CropRead, unitSystem string) CropRead {
+	crop := CropRead{
+		CropRead:         cropRead,
+		HarvestedStorage: []HarvestedStorage{},
+	}
+
+	for _, v := range cropRead.HarvestedStorage {
+		grams := float64(v.ProducedGramQuantity)
+		unit := unithelper.WeightUnit(unitSystem, grams)
+
+		// The unit always comes from unithelper, so it is known.
+		value, _ := unithelper.FromGrams(grams, unit)
+
+		crop.HarvestedStorage = append(crop.HarvestedStorage, HarvestedStorage{
+			HarvestedStorage: v,
+			Produced:         ProducedWeight{Value: float32(value), Unit: unit},
+		})
+	}
+
+	return crop
+}
+
+func findFarmUnitSystem(s *GrowthServer, farmUID uuid.UUID) (string, error) {
+	queryResult := <-s.FarmReadQuery.FindByID(farmUID)
+	if queryResult.Error != nil {
+		return "", queryResult.Error
+	}
+
+	farm, ok := queryResult.Result.(query.CropFarmQueryResult)
+	if !ok {
+		return "", echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	return farm.UnitSystem, nil
 }
 
 func MapToCropListInArea(crop query.CropAreaByAreaQueryResult) (CropListInArea, error) {
@@ -480,3 +563,15 @@ func (a TaskSanitationActivity) MarshalJSON() ([]byte, error) {
 		Code:  a.Code(),
 	})
 }
+
+func (a NutrientDosingActivity) MarshalJSON() ([]byte, error) {
+	type Alias NutrientDosingActivity
+
+	return json.Marshal(struct {
+		*Alias
+		Code string `json:"code"`
+	}{
+		Alias: (*Alias)(&a),
+		Code:  a.Code(),
+	})
+}
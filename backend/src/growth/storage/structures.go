@@ -110,13 +110,16 @@ type Inventory struct {
 }
 
 type CropPhoto struct {
-	UID         uuid.UUID `json:"uid"`
-	Filename    string    `json:"filename"`
-	MimeType    string    `json:"mime_type"`
-	Size        int       `json:"size"`
-	Width       int       `json:"width"`
-	Height      int       `json:"height"`
-	Description string    `json:"description"`
+	UID         uuid.UUID  `json:"uid"`
+	Filename    string     `json:"filename"`
+	MimeType    string     `json:"mime_type"`
+	Size        int        `json:"size"`
+	Width       int        `json:"width"`
+	Height      int        `json:"height"`
+	Description string     `json:"description"`
+	TakenDate   *time.Time `json:"taken_date"`
+	Latitude    *float64   `json:"latitude"`
+	Longitude   *float64   `json:"longitude"`
 }
 
 const (
@@ -131,6 +134,7 @@ const (
 	TaskPestControlActivityCode = "TASK_PEST_CONTROL"
 	TaskSafetyActivityCode      = "TASK_SAFETY"
 	TaskSanitationActivityCode  = "TASK_SANITATION"
+	NutrientDosingActivityCode  = "NUTRIENT_DOSING"
 )
 
 type CropActivity struct {
@@ -205,13 +209,16 @@ func (WaterActivity) Code() string {
 }
 
 type PhotoActivity struct {
-	UID         uuid.UUID `json:"uid"`
-	Filename    string    `json:"filename"`
-	MimeType    string    `json:"mime_type"`
-	Size        int       `json:"size"`
-	Width       int       `json:"width"`
-	Height      int       `json:"height"`
-	Description string    `json:"description"`
+	UID         uuid.UUID  `json:"uid"`
+	Filename    string     `json:"filename"`
+	MimeType    string     `json:"mime_type"`
+	Size        int        `json:"size"`
+	Width       int        `json:"width"`
+	Height      int        `json:"height"`
+	Description string     `json:"description"`
+	TakenDate   *time.Time `json:"taken_date"`
+	Latitude    *float64   `json:"latitude"`
+	Longitude   *float64   `json:"longitude"`
 }
 
 func (PhotoActivity) Code() string {
@@ -272,3 +279,24 @@ type TaskSanitationActivity struct {
 func (TaskSanitationActivity) Code() string {
 	return TaskSanitationActivityCode
 }
+
+// NutrientDosingActivity is a dosing of the reservoir that waters the crop area.
+type NutrientDosingActivity struct {
+	ReservoirUID uuid.UUID                `json:"reservoir_id"`
+	AreaUID      uuid.UUID                `json:"area_id"`
+	AreaName     string                   `json:"area_name"`
+	Volume       float32                  `json:"volume"`
+	Nutrients    []NutrientDosingMaterial `json:"nutrients"`
+	DosingDate   time.Time                `json:"dosing_date"`
+}
+
+type NutrientDosingMaterial struct {
+	MaterialUID  uuid.UUID `json:"material_id"`
+	MaterialName string    `json:"material_name"`
+	Quantity     float32   `json:"quantity"`
+	QuantityUnit string    `json:"quantity_unit"`
+}
+
+func (NutrientDosingActivity) Code() string {
+	return NutrientDosingActivityCode
+}
@@ -0,0 +1,17 @@
+// Package datehelper compares the dates of the farm records by day.
+package datehelper
+
+import "time"
+
+// IsExpired tells if an expiration date is before the day of the given date,
+// the day being in the location of the expiration date.
+// Things can still be used on their expiration day, and have no expiration when the date is nil.
+func IsExpired(expirationDate *time.Time, date time.Time) bool {
+	if expirationDate == nil {
+		return false
+	}
+
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, expirationDate.Location())
+
+	return expirationDate.Before(day)
+}
@@ -0,0 +1,23 @@
+package datehelper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/usetania/tania-core/src/helper/datehelper"
+)
+
+func TestIsExpired(t *testing.T) {
+	t.Parallel()
+	// Given
+	date := time.Date(2026, time.March, 10, 15, 0, 0, 0, time.UTC)
+	expired := time.Date(2026, time.March, 9, 0, 0, 0, 0, time.UTC)
+	today := time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	// When
+	// Then
+	assert.True(t, datehelper.IsExpired(&expired, date))
+	assert.False(t, datehelper.IsExpired(&today, date))
+	assert.False(t, datehelper.IsExpired(nil, date))
+}
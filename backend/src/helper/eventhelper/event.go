@@ -0,0 +1,47 @@
+// Package eventhelper helps the subscribers of a module handle the events of another module.
+package eventhelper
+
+import (
+	"encoding/json"
+
+	"github.com/gofrs/uuid"
+)
+
+// EventRepository saves the uncommitted events of an aggregate.
+type EventRepository interface {
+	Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error
+}
+
+// Decode copies the fields of an event into the local type mirroring it, matching the fields by their JSON name.
+// It lets a module subscribe to the events of another module without importing its domain.
+// The fields the mirror does not have are left out.
+func Decode(event interface{}, mirror interface{}) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, mirror)
+}
+
+// SaveFromSubscriber saves the events of an aggregate changed by a subscriber, then passes them to saveToReadModel.
+// A subscriber runs while the event bus is still locked by the publishing of the event it handles,
+// so publishing the new events from there would never return. The read model is updated directly instead.
+func SaveFromSubscriber(
+	repo EventRepository,
+	uid uuid.UUID,
+	latestVersion int,
+	events []interface{},
+	saveToReadModel func(event interface{}) error,
+) error {
+	err := <-repo.Save(uid, latestVersion, events)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range events {
+		saveToReadModel(v)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package eventhelper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/usetania/tania-core/src/helper/eventhelper"
+)
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+	// Given
+	uid, _ := uuid.NewV4()
+	date := time.Date(2026, time.March, 10, 15, 0, 0, 0, time.UTC)
+
+	event := struct {
+		UID          uuid.UUID  `json:"uid"`
+		MaterialID   *uuid.UUID `json:"material_id,omitempty"`
+		Quantity     float32
+		ConsumedDate time.Time
+		Notes        string
+	}{UID: uid, MaterialID: &uid, Quantity: 2.5, ConsumedDate: date, Notes: "Left out"}
+
+	mirror := struct {
+		UID          uuid.UUID  `json:"uid"`
+		MaterialID   *uuid.UUID `json:"material_id"`
+		Quantity     float32
+		ConsumedDate time.Time
+	}{}
+
+	// When
+	err := eventhelper.Decode(event, &mirror)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, uid, mirror.UID)
+	assert.Equal(t, &uid, mirror.MaterialID)
+	assert.Equal(t, float32(2.5), mirror.Quantity)
+	assert.True(t, date.Equal(mirror.ConsumedDate))
+}
+
+type eventRepositoryMock struct {
+	saved []interface{}
+	err   error
+}
+
+func (r *eventRepositoryMock) Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error {
+	result := make(chan error, 1)
+
+	if r.err == nil {
+		r.saved = append(r.saved, events...)
+	}
+
+	result <- r.err
+	close(result)
+
+	return result
+}
+
+func TestSaveFromSubscriber(t *testing.T) {
+	t.Parallel()
+	// Given
+	uid, _ := uuid.NewV4()
+	events := []interface{}{"first", "second"}
+
+	repo := &eventRepositoryMock{}
+	failingRepo := &eventRepositoryMock{err: errors.New("save failed")}
+
+	readModel := []interface{}{}
+	saveToReadModel := func(event interface{}) error {
+		readModel = append(readModel, event)
+
+		return nil
+	}
+
+	// When
+	err := eventhelper.SaveFromSubscriber(repo, uid, 1, events, saveToReadModel)
+	errFailing := eventhelper.SaveFromSubscriber(failingRepo, uid, 1, events, saveToReadModel)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, events, repo.saved)
+	assert.Equal(t, events, readModel)
+
+	assert.NotNil(t, errFailing)
+	assert.Empty(t, failingRepo.saved)
+}
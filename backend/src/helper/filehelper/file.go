@@ -0,0 +1,185 @@
+// Package filehelper keeps the uploaded files, on the local disk or in an S3 compatible object storage.
+package filehelper
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/usetania/tania-core/config"
+	"github.com/usetania/tania-core/src/helper/s3helper"
+)
+
+// File used to handle file path and file operation.
+// We use interface so we can swap it to other file storage easily.
+type File interface {
+	GetFile(src string) ([]byte, error)
+	Upload(file *multipart.FileHeader, destPath string) error
+	WriteFile(destPath string, content []byte) error
+
+	// FileExists checks the file is stored without reading it.
+	FileExists(srcPath string) (bool, error)
+
+	// RemoveFile deletes the file. Removing a missing file is not an error.
+	RemoveFile(srcPath string) error
+
+	// DownloadURL is where the client downloads the file from,
+	// or empty when the file is served by Tania.
+	DownloadURL(srcPath string) (string, error)
+}
+
+// NewFile returns the file storage of the upload_storage config.
+func NewFile() File {
+	if *config.Config.UploadStorage == config.StorageS3 {
+		return S3File{
+			Client: s3helper.Client{
+				Endpoint:  *config.Config.S3Endpoint,
+				Region:    *config.Config.S3Region,
+				Bucket:    *config.Config.S3Bucket,
+				AccessKey: *config.Config.S3AccessKey,
+				SecretKey: *config.Config.S3SecretKey,
+				PathStyle: *config.Config.S3PathStyle,
+			},
+			PresignExpiry: time.Duration(*config.Config.S3PresignExpiry) * time.Minute,
+		}
+	}
+
+	return LocalFile{}
+}
+
+// LocalFile keeps the files on the local disk.
+type LocalFile struct{}
+
+func (LocalFile) GetFile(srcPath string) ([]byte, error) {
+	file, err := os.ReadFile(srcPath)
+
+	return file, err
+}
+
+// Upload saves uploaded file to the destined path.
+func (LocalFile) Upload(file *multipart.FileHeader, destPath string) error {
+	src, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	// Create all directory if not exists
+	s := strings.Split(destPath, "/")
+	s = s[:len(s)-1]
+	sJoin := strings.Join(s, "/")
+
+	if _, err := os.Stat(sJoin); os.IsNotExist(err) {
+		log.Print("Upload folder is missing. Creating folder...")
+
+		if err := os.MkdirAll(sJoin, os.ModePerm); err != nil {
+			log.Println("Can't create folder")
+
+			return err
+		}
+
+		log.Print("Folder created in ", sJoin)
+	}
+
+	// Destination
+	dst, err := os.Create(destPath)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	// Copy
+	if _, err = io.Copy(dst, src); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WriteFile saves the content to the destined path, like the renditions of an uploaded photo.
+func (LocalFile) WriteFile(destPath string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(destPath), os.ModePerm); err != nil {
+		return err
+	}
+
+	return os.WriteFile(destPath, content, 0o600)
+}
+
+func (LocalFile) FileExists(srcPath string) (bool, error) {
+	_, err := os.Stat(srcPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func (LocalFile) RemoveFile(srcPath string) error {
+	err := os.Remove(srcPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+// DownloadURL is empty, as the local files are served by Tania.
+func (LocalFile) DownloadURL(srcPath string) (string, error) {
+	return "", nil
+}
+
+// S3File keeps the files in a bucket of an S3 compatible object storage, the file path being the object key.
+// Clients download the files from pre-signed URLs.
+type S3File struct {
+	Client        s3helper.Client
+	PresignExpiry time.Duration
+}
+
+func (f S3File) GetFile(srcPath string) ([]byte, error) {
+	return f.Client.GetObject(context.Background(), srcPath)
+}
+
+// Upload saves uploaded file to the object key of the destined path.
+func (f S3File) Upload(file *multipart.FileHeader, destPath string) error {
+	src, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	content, err := io.ReadAll(src)
+	if err != nil {
+		return err
+	}
+
+	return f.Client.PutObject(context.Background(), destPath, content, file.Header.Get("Content-Type"))
+}
+
+func (f S3File) WriteFile(destPath string, content []byte) error {
+	return f.Client.PutObject(context.Background(), destPath, content, http.DetectContentType(content))
+}
+
+func (f S3File) FileExists(srcPath string) (bool, error) {
+	err := f.Client.HeadObject(context.Background(), srcPath)
+	if errors.Is(err, s3helper.ErrNotFound) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func (f S3File) RemoveFile(srcPath string) error {
+	return f.Client.DeleteObject(context.Background(), srcPath)
+}
+
+func (f S3File) DownloadURL(srcPath string) (string, error) {
+	return f.Client.PresignGetObject(srcPath, time.Now(), f.PresignExpiry)
+}
@@ -0,0 +1,39 @@
+package filehelper_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/usetania/tania-core/src/helper/filehelper"
+)
+
+func TestLocalFile(t *testing.T) {
+	t.Parallel()
+	// Given
+	file := filehelper.LocalFile{}
+	destPath := filepath.Join(t.TempDir(), "uploads", "areas", "thumb", "front.jpg")
+
+	// When
+	errWrite := file.WriteFile(destPath, []byte("photo"))
+	content, errGet := file.GetFile(destPath)
+	exists, errExists := file.FileExists(destPath)
+
+	// Then
+	assert.Nil(t, errWrite)
+	assert.Nil(t, errGet)
+	assert.Equal(t, "photo", string(content))
+	assert.Nil(t, errExists)
+	assert.True(t, exists)
+
+	// When
+	errRemove := file.RemoveFile(destPath)
+	errRemoveMissing := file.RemoveFile(destPath)
+	existsRemoved, errExistsRemoved := file.FileExists(destPath)
+
+	// Then
+	assert.Nil(t, errRemove)
+	assert.Nil(t, errRemoveMissing)
+	assert.Nil(t, errExistsRemoved)
+	assert.False(t, existsRemoved)
+}
@@ -0,0 +1,116 @@
+// Package geohelper provides geometry calculations on WGS 84 coordinates.
+// Positions follow the GeoJSON order, longitude first then latitude.
+package geohelper
+
+import "math"
+
+// WGS 84 ellipsoid constants.
+const (
+	eccentricity   = 0.08181919084262149
+	eccentricitySq = 0.0066943799901413165
+
+	// qPole is the authalic q at the pole, the normalizer of the authalic latitude.
+	qPole = 1.9955310875028376
+
+	// authalicRadius is the radius of the sphere having the same surface as the ellipsoid.
+	authalicRadius = 6371007.180918476
+)
+
+// PolygonArea returns the area in square meters of a polygon.
+// The first ring is the exterior and the next rings are holes.
+func PolygonArea(rings [][][]float64) float64 {
+	if len(rings) == 0 {
+		return 0
+	}
+
+	area := math.Abs(RingArea(rings[0]))
+
+	for _, v := range rings[1:] {
+		area -= math.Abs(RingArea(v))
+	}
+
+	return math.Max(area, 0)
+}
+
+// RingArea returns the signed area in square meters of a closed ring,
+// positive when the ring is counterclockwise.
+// Latitudes are mapped to authalic latitudes, so the result accounts for the ellipsoid.
+func RingArea(ring [][]float64) float64 {
+	n := len(ring)
+	if n < 4 {
+		return 0
+	}
+
+	// The ring is closed, so the last position is the same as the first one.
+	n--
+
+	total := 0.0
+
+	for i := 0; i < n; i++ {
+		prev := ring[(i+n-1)%n]
+		next := ring[(i+1)%n]
+
+		total += (toRadians(prev[0]) - toRadians(next[0])) * authalicSin(ring[i][1])
+	}
+
+	return total * authalicRadius * authalicRadius / 2
+}
+
+// IsSimpleRing checks that no edges of a closed ring cross each other.
+func IsSimpleRing(ring [][]float64) bool {
+	n := len(ring) - 1
+
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			// Adjacent edges share a position.
+			if j == i+1 || (i == 0 && j == n-1) {
+				continue
+			}
+
+			if segmentsIntersect(ring[i], ring[i+1], ring[j], ring[j+1]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func segmentsIntersect(p1, p2, p3, p4 []float64) bool {
+	d1 := orientation(p3, p4, p1)
+	d2 := orientation(p3, p4, p2)
+	d3 := orientation(p1, p2, p3)
+	d4 := orientation(p1, p2, p4)
+
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		return true
+	}
+
+	return (d1 == 0 && onSegment(p3, p4, p1)) ||
+		(d2 == 0 && onSegment(p3, p4, p2)) ||
+		(d3 == 0 && onSegment(p1, p2, p3)) ||
+		(d4 == 0 && onSegment(p1, p2, p4))
+}
+
+func orientation(a, b, c []float64) float64 {
+	return (b[0]-a[0])*(c[1]-a[1]) - (b[1]-a[1])*(c[0]-a[0])
+}
+
+func onSegment(a, b, p []float64) bool {
+	return math.Min(a[0], b[0]) <= p[0] && p[0] <= math.Max(a[0], b[0]) &&
+		math.Min(a[1], b[1]) <= p[1] && p[1] <= math.Max(a[1], b[1])
+}
+
+func authalicSin(latitude float64) float64 {
+	return authalicQ(math.Sin(toRadians(latitude))) / qPole
+}
+
+func authalicQ(sinLat float64) float64 {
+	esin := eccentricity * sinLat
+
+	return (1 - eccentricitySq) * (sinLat/(1-esin*esin) - math.Log((1-esin)/(1+esin))/(2*eccentricity))
+}
+
+func toRadians(degree float64) float64 {
+	return degree * math.Pi / 180
+}
@@ -0,0 +1,76 @@
+package geohelper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/usetania/tania-core/src/helper/geohelper"
+)
+
+func TestPolygonArea(t *testing.T) {
+	t.Parallel()
+	// Given
+	// A square of about 100 by 100 meters near Yogyakarta.
+	field := [][][]float64{{
+		{110.3600, -7.8000},
+		{110.3609066, -7.8000},
+		{110.3609066, -7.7990958},
+		{110.3600, -7.7990958},
+		{110.3600, -7.8000},
+	}}
+
+	// The same square with a 10 by 10 meters hole, in clockwise order.
+	fieldWithPond := [][][]float64{
+		field[0],
+		{
+			{110.3604, -7.7996},
+			{110.3604, -7.79950958},
+			{110.36049066, -7.79950958},
+			{110.36049066, -7.7996},
+			{110.3604, -7.7996},
+		},
+	}
+
+	// One degree quadrangle at the equator.
+	quadrangle := [][][]float64{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}}
+
+	// When
+	fieldArea := geohelper.PolygonArea(field)
+	fieldWithPondArea := geohelper.PolygonArea(fieldWithPond)
+	quadrangleArea := geohelper.PolygonArea(quadrangle)
+
+	// Then
+	assert.InDelta(t, 10000, fieldArea, 1)
+	assert.InDelta(t, 9900, fieldWithPondArea, 1)
+	assert.InDelta(t, 12308463894, quadrangleArea, 1e3)
+}
+
+func TestRingAreaOrientation(t *testing.T) {
+	t.Parallel()
+	// Given
+	counterclockwise := [][]float64{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}
+	clockwise := [][]float64{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}
+
+	// When
+	ccwArea := geohelper.RingArea(counterclockwise)
+	cwArea := geohelper.RingArea(clockwise)
+
+	// Then
+	assert.Greater(t, ccwArea, 0.0)
+	assert.InDelta(t, -ccwArea, cwArea, 1e-3)
+}
+
+func TestIsSimpleRing(t *testing.T) {
+	t.Parallel()
+	// Given
+	square := [][]float64{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}
+	bowtie := [][]float64{{0, 0}, {1, 1}, {1, 0}, {0, 1}, {0, 0}}
+
+	// When
+	squareSimple := geohelper.IsSimpleRing(square)
+	bowtieSimple := geohelper.IsSimpleRing(bowtie)
+
+	// Then
+	assert.True(t, squareSimple)
+	assert.False(t, bowtieSimple)
+}
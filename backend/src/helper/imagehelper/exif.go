@@ -0,0 +1,325 @@
+package imagehelper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"time"
+)
+
+// EXIF orientations, how the rows and columns of the photo are stored compared to the scene.
+const (
+	OrientationTopLeft     = 1
+	OrientationTopRight    = 2
+	OrientationBottomRight = 3
+	OrientationBottomLeft  = 4
+	OrientationLeftTop     = 5
+	OrientationRightTop    = 6
+	OrientationRightBottom = 7
+	OrientationLeftBottom  = 8
+)
+
+const (
+	exifTagOrientation = 0x0112
+	exifTagDateTime    = 0x0132
+	exifTagExifIFD     = 0x8769
+	exifTagGPSIFD      = 0x8825
+
+	exifTagDateTimeOriginal   = 0x9003
+	exifTagOffsetTimeOriginal = 0x9011
+
+	gpsTagLatitudeRef  = 0x0001
+	gpsTagLatitude     = 0x0002
+	gpsTagLongitudeRef = 0x0003
+	gpsTagLongitude    = 0x0004
+	gpsTagTimeStamp    = 0x0007
+	gpsTagDateStamp    = 0x001D
+
+	exifTypeASCII    = 2
+	exifTypeShort    = 3
+	exifTypeLong     = 4
+	exifTypeRational = 5
+
+	exifDateFormat = "2006:01:02 15:04:05"
+)
+
+// Exif is the capture time and place of a photo, read from its EXIF. They are nil when unknown.
+type Exif struct {
+	TakenDate *time.Time
+	Latitude  *float64
+	Longitude *float64
+}
+
+// exifReader reads the TIFF structure of the EXIF segment of a JPEG photo.
+type exifReader struct {
+	data  []byte
+	order binary.ByteOrder
+}
+
+// exifEntry is a tag of an image file directory. The value holds the data
+// when it fits in four bytes, else its offset.
+type exifEntry struct {
+	kind  uint16
+	count uint32
+	value []byte
+}
+
+// GetExif returns the capture time and place of the photo content.
+// The capture time is taken with its offset, else from the GPS clock in UTC,
+// else it is read in the location given, as cameras mostly use the local time.
+func GetExif(content []byte, loc *time.Location) Exif {
+	result := Exif{}
+
+	r, ok := newExifReader(content)
+	if !ok {
+		return result
+	}
+
+	gps, hasGPS := r.subIFD(r.firstIFD(), exifTagGPSIFD)
+	if hasGPS {
+		result.Latitude = r.gpsCoordinate(gps, gpsTagLatitude, gpsTagLatitudeRef, "S", 90)
+		result.Longitude = r.gpsCoordinate(gps, gpsTagLongitude, gpsTagLongitudeRef, "W", 180)
+
+		// The coordinates are only known together.
+		if result.Latitude == nil || result.Longitude == nil {
+			result.Latitude, result.Longitude = nil, nil
+		}
+	}
+
+	dateTime := ""
+	offset := ""
+
+	if exif, ok := r.subIFD(r.firstIFD(), exifTagExifIFD); ok {
+		dateTime = r.ascii(exif, exifTagDateTimeOriginal)
+		offset = r.ascii(exif, exifTagOffsetTimeOriginal)
+	}
+
+	if dateTime == "" {
+		dateTime = r.ascii(r.firstIFD(), exifTagDateTime)
+	}
+
+	if dateTime != "" && offset != "" {
+		if date, err := time.Parse(exifDateFormat+"-07:00", dateTime+offset); err == nil {
+			result.TakenDate = &date
+
+			return result
+		}
+	}
+
+	if hasGPS {
+		if date, ok := r.gpsDate(gps); ok {
+			result.TakenDate = &date
+
+			return result
+		}
+	}
+
+	if date, err := time.ParseInLocation(exifDateFormat, dateTime, loc); err == nil {
+		result.TakenDate = &date
+	}
+
+	return result
+}
+
+// GetOrientation returns the EXIF orientation of the photo content,
+// or OrientationTopLeft when the photo does not have one.
+func GetOrientation(content []byte) int {
+	r, ok := newExifReader(content)
+	if !ok {
+		return OrientationTopLeft
+	}
+
+	entry, ok := r.findTag(r.firstIFD(), exifTagOrientation)
+	if !ok || entry.kind != exifTypeShort {
+		return OrientationTopLeft
+	}
+
+	orientation := int(r.order.Uint16(entry.value))
+	if orientation < OrientationTopLeft || orientation > OrientationLeftBottom {
+		return OrientationTopLeft
+	}
+
+	return orientation
+}
+
+// newExifReader finds the EXIF segment in the markers of a JPEG photo, before its image data.
+func newExifReader(content []byte) (exifReader, bool) {
+	if len(content) < 4 || content[0] != 0xFF || content[1] != 0xD8 {
+		return exifReader{}, false
+	}
+
+	i := 2
+
+	for i+4 <= len(content) && content[i] == 0xFF {
+		marker := content[i+1]
+		if marker == 0xFF {
+			// Fill byte before the marker.
+			i++
+
+			continue
+		}
+
+		// The start of scan is followed by the image data, there is no more metadata.
+		if marker == 0xDA {
+			break
+		}
+
+		length := int(binary.BigEndian.Uint16(content[i+2:]))
+		if length < 2 || i+2+length > len(content) {
+			break
+		}
+
+		segment := content[i+4 : i+2+length]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return newTIFFReader(segment[6:])
+		}
+
+		i += 2 + length
+	}
+
+	return exifReader{}, false
+}
+
+func newTIFFReader(data []byte) (exifReader, bool) {
+	if len(data) < 8 {
+		return exifReader{}, false
+	}
+
+	switch string(data[:2]) {
+	case "II":
+		return exifReader{data: data, order: binary.LittleEndian}, true
+	case "MM":
+		return exifReader{data: data, order: binary.BigEndian}, true
+	}
+
+	return exifReader{}, false
+}
+
+// firstIFD is the offset of the image file directory of the main image.
+func (r exifReader) firstIFD() uint32 {
+	return r.order.Uint32(r.data[4:])
+}
+
+// findTag looks for the tag in the image file directory at the offset.
+func (r exifReader) findTag(offset uint32, tag uint16) (exifEntry, bool) {
+	if int64(offset)+2 > int64(len(r.data)) {
+		return exifEntry{}, false
+	}
+
+	count := int(r.order.Uint16(r.data[offset:]))
+
+	for i := 0; i < count; i++ {
+		start := int(offset) + 2 + i*12
+		if start+12 > len(r.data) {
+			break
+		}
+
+		if r.order.Uint16(r.data[start:]) == tag {
+			return exifEntry{
+				kind:  r.order.Uint16(r.data[start+2:]),
+				count: r.order.Uint32(r.data[start+4:]),
+				value: r.data[start+8 : start+12],
+			}, true
+		}
+	}
+
+	return exifEntry{}, false
+}
+
+// subIFD is the offset of the image file directory the tag points to.
+func (r exifReader) subIFD(offset uint32, tag uint16) (uint32, bool) {
+	entry, ok := r.findTag(offset, tag)
+	if !ok || entry.kind != exifTypeLong {
+		return 0, false
+	}
+
+	return r.order.Uint32(entry.value), true
+}
+
+// entryData is the data of the entry, or nil when it is out of the segment.
+func (r exifReader) entryData(entry exifEntry) []byte {
+	size := map[uint16]int{exifTypeASCII: 1, exifTypeShort: 2, exifTypeLong: 4, exifTypeRational: 8}[entry.kind]
+	if size == 0 || entry.count > uint32(len(r.data)) {
+		return nil
+	}
+
+	length := size * int(entry.count)
+	if length <= 4 {
+		return entry.value[:length]
+	}
+
+	offset := int(r.order.Uint32(entry.value))
+	if offset+length > len(r.data) {
+		return nil
+	}
+
+	return r.data[offset : offset+length]
+}
+
+// ascii is the text of the tag, empty when the directory does not have it.
+func (r exifReader) ascii(offset uint32, tag uint16) string {
+	entry, ok := r.findTag(offset, tag)
+	if !ok || entry.kind != exifTypeASCII {
+		return ""
+	}
+
+	return strings.TrimSpace(strings.TrimRight(string(r.entryData(entry)), "\x00"))
+}
+
+// rationals are the values of the tag, nil when the directory does not have it.
+func (r exifReader) rationals(offset uint32, tag uint16) []float64 {
+	entry, ok := r.findTag(offset, tag)
+	if !ok || entry.kind != exifTypeRational {
+		return nil
+	}
+
+	data := r.entryData(entry)
+	values := []float64{}
+
+	for i := 0; i+8 <= len(data); i += 8 {
+		denominator := r.order.Uint32(data[i+4:])
+		if denominator == 0 {
+			return nil
+		}
+
+		values = append(values, float64(r.order.Uint32(data[i:]))/float64(denominator))
+	}
+
+	return values
+}
+
+// gpsCoordinate reads the degrees, minutes and seconds of a coordinate,
+// negative when its reference is the negative one. It is nil when out of range, like a broken GPS fix.
+func (r exifReader) gpsCoordinate(gps uint32, tag, refTag uint16, negativeRef string, maxDegrees float64) *float64 {
+	dms := r.rationals(gps, tag)
+	if len(dms) != 3 || dms[1] >= 60 || dms[2] >= 60 {
+		return nil
+	}
+
+	coordinate := dms[0] + dms[1]/60 + dms[2]/3600
+	if coordinate > maxDegrees {
+		return nil
+	}
+	if r.ascii(gps, refTag) == negativeRef {
+		coordinate = -coordinate
+	}
+
+	return &coordinate
+}
+
+// gpsDate is the UTC date and time of the GPS fix.
+func (r exifReader) gpsDate(gps uint32) (time.Time, bool) {
+	hms := r.rationals(gps, gpsTagTimeStamp)
+	if len(hms) != 3 {
+		return time.Time{}, false
+	}
+
+	date, err := time.Parse("2006:01:02", r.ascii(gps, gpsTagDateStamp))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	seconds := hms[0]*3600 + hms[1]*60 + hms[2]
+
+	return date.Add(time.Duration(seconds * float64(time.Second))), true
+}
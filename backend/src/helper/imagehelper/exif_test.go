@@ -0,0 +1,174 @@
+package imagehelper_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/usetania/tania-core/src/helper/imagehelper"
+)
+
+type tiffEntry struct {
+	tag   uint16
+	kind  uint16
+	count uint32
+	data  []byte
+}
+
+func shortEntry(tag, value uint16) tiffEntry {
+	return tiffEntry{tag: tag, kind: 3, count: 1, data: binary.LittleEndian.AppendUint16(nil, value)}
+}
+
+func asciiEntry(tag uint16, value string) tiffEntry {
+	return tiffEntry{tag: tag, kind: 2, count: uint32(len(value) + 1), data: append([]byte(value), 0)}
+}
+
+func rationalEntry(tag uint16, values ...uint32) tiffEntry {
+	data := []byte{}
+	for i := 0; i+1 < len(values); i += 2 {
+		data = binary.LittleEndian.AppendUint32(data, values[i])
+		data = binary.LittleEndian.AppendUint32(data, values[i+1])
+	}
+
+	return tiffEntry{tag: tag, kind: 5, count: uint32(len(values) / 2), data: data}
+}
+
+// jpegWithExif encodes the photo as a JPEG with an EXIF segment of the main, EXIF and GPS directories.
+func jpegWithExif(t *testing.T, img image.Image, ifd0, exif, gps []tiffEntry) []byte {
+	t.Helper()
+
+	buf := bytes.Buffer{}
+	err := jpeg.Encode(&buf, img, nil)
+	assert.Nil(t, err)
+
+	ifdSize := func(entries []tiffEntry) int {
+		if entries == nil {
+			return 0
+		}
+
+		return 2 + 12*len(entries) + 4
+	}
+
+	pointers := 0
+	if exif != nil {
+		pointers++
+	}
+
+	if gps != nil {
+		pointers++
+	}
+
+	exifOffset := 8 + 2 + 12*(len(ifd0)+pointers) + 4
+	gpsOffset := exifOffset + ifdSize(exif)
+	dataOffset := gpsOffset + ifdSize(gps)
+
+	if exif != nil {
+		pointer := binary.LittleEndian.AppendUint32(nil, uint32(exifOffset))
+		ifd0 = append(ifd0, tiffEntry{tag: 0x8769, kind: 4, count: 1, data: pointer})
+	}
+
+	if gps != nil {
+		pointer := binary.LittleEndian.AppendUint32(nil, uint32(gpsOffset))
+		ifd0 = append(ifd0, tiffEntry{tag: 0x8825, kind: 4, count: 1, data: pointer})
+	}
+
+	tiff := []byte("II*\x00\x08\x00\x00\x00")
+	data := []byte{}
+
+	for _, ifd := range [][]tiffEntry{ifd0, exif, gps} {
+		if ifd == nil {
+			continue
+		}
+
+		tiff = binary.LittleEndian.AppendUint16(tiff, uint16(len(ifd)))
+
+		for _, v := range ifd {
+			tiff = binary.LittleEndian.AppendUint16(tiff, v.tag)
+			tiff = binary.LittleEndian.AppendUint16(tiff, v.kind)
+			tiff = binary.LittleEndian.AppendUint32(tiff, v.count)
+
+			if len(v.data) <= 4 {
+				tiff = append(tiff, append(v.data, make([]byte, 4-len(v.data))...)...)
+			} else {
+				tiff = binary.LittleEndian.AppendUint32(tiff, uint32(dataOffset+len(data)))
+				data = append(data, v.data...)
+			}
+		}
+
+		tiff = append(tiff, 0, 0, 0, 0)
+	}
+
+	segment := append([]byte("Exif\x00\x00"), append(tiff, data...)...)
+	app1 := binary.BigEndian.AppendUint16([]byte{0xFF, 0xE1}, uint16(len(segment)+2))
+
+	content := append([]byte{}, buf.Bytes()[:2]...)
+	content = append(content, app1...)
+	content = append(content, segment...)
+
+	return append(content, buf.Bytes()[2:]...)
+}
+
+func TestGetExif(t *testing.T) {
+	t.Parallel()
+	// Given
+	img := photoWithMarker(40, 20)
+	jakarta := time.FixedZone("WIB", 7*60*60)
+
+	// Yogyakarta, 7°48'0" S 110°21'36" E
+	gps := []tiffEntry{
+		asciiEntry(0x0001, "S"),
+		rationalEntry(0x0002, 7, 1, 48, 1, 0, 1),
+		asciiEntry(0x0003, "E"),
+		rationalEntry(0x0004, 110, 1, 21, 1, 3600, 100),
+		rationalEntry(0x0007, 1, 1, 30, 1, 15, 1),
+		asciiEntry(0x001D, "2019:03:02"),
+	}
+
+	withOffset := jpegWithExif(t, img, nil, []tiffEntry{
+		asciiEntry(0x9003, "2019:03:02 08:30:15"),
+		asciiEntry(0x9011, "+07:00"),
+	}, gps)
+
+	withGPSClock := jpegWithExif(t, img, nil, []tiffEntry{asciiEntry(0x9003, "2019:03:02 08:30:15")}, gps)
+
+	localTime := jpegWithExif(t, img, []tiffEntry{asciiEntry(0x0132, "2019:03:02 08:30:15")}, nil, nil)
+
+	outOfRange := jpegWithExif(t, img, nil, nil, []tiffEntry{
+		asciiEntry(0x0001, "N"),
+		rationalEntry(0x0002, 97, 1, 48, 1, 0, 1),
+		asciiEntry(0x0003, "E"),
+		rationalEntry(0x0004, 110, 1, 21, 1, 3600, 100),
+	})
+
+	withoutExif := bytes.Buffer{}
+	jpeg.Encode(&withoutExif, img, nil)
+
+	// When
+	offsetExif := imagehelper.GetExif(withOffset, time.UTC)
+	gpsClockExif := imagehelper.GetExif(withGPSClock, time.UTC)
+	localExif := imagehelper.GetExif(localTime, jakarta)
+	emptyExif := imagehelper.GetExif(withoutExif.Bytes(), time.UTC)
+	outOfRangeExif := imagehelper.GetExif(outOfRange, time.UTC)
+
+	// Then
+	takenDate := time.Date(2019, time.March, 2, 1, 30, 15, 0, time.UTC)
+
+	assert.True(t, takenDate.Equal(*offsetExif.TakenDate))
+	assert.InDelta(t, -7.8, *offsetExif.Latitude, 0.000001)
+	assert.InDelta(t, 110.36, *offsetExif.Longitude, 0.000001)
+
+	assert.True(t, takenDate.Equal(*gpsClockExif.TakenDate))
+
+	assert.True(t, takenDate.Equal(*localExif.TakenDate))
+	assert.Nil(t, localExif.Latitude)
+	assert.Nil(t, localExif.Longitude)
+
+	assert.Nil(t, outOfRangeExif.Latitude)
+	assert.Nil(t, outOfRangeExif.Longitude)
+
+	assert.Equal(t, imagehelper.Exif{}, emptyExif)
+}
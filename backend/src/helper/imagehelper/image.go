@@ -1,21 +1,16 @@
 package imagehelper
 
 import (
+	"bytes"
 	"image"
-	"os"
 )
 
-func GetImageDimension(srcPath string) (width, height int, err error) {
-	src, err := os.Open(srcPath)
+// GetImageDimension reads the dimension of the image from its header, without decoding its pixels.
+func GetImageDimension(content []byte) (width, height int, err error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(content))
 	if err != nil {
 		return 0, 0, err
 	}
-	defer src.Close()
 
-	image, _, err := image.DecodeConfig(src)
-	if err != nil {
-		return 0, 0, err
-	}
-
-	return image.Width, image.Height, nil
+	return config.Width, config.Height, nil
 }
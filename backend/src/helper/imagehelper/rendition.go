@@ -0,0 +1,292 @@
+// Package imagehelper processes the uploaded photos.
+package imagehelper
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"path"
+)
+
+// Sizes an uploaded photo is served in.
+const (
+	PhotoSizeThumb    = "thumb"
+	PhotoSizeMedium   = "medium"
+	PhotoSizeOriginal = "original"
+)
+
+// Longest side in pixels of the photo renditions.
+const (
+	ThumbMaxSize  = 320
+	MediumMaxSize = 1280
+)
+
+const jpegQuality = 85
+
+// MaxPixels is the largest photo which is decoded to create its renditions, in pixels.
+// Decoding takes around 8 bytes per pixel.
+const MaxPixels = 50_000_000
+
+var ErrImageTooLarge = errors.New("image is larger than 50 megapixels")
+
+// Storage keeps the uploaded photos and their renditions.
+type Storage interface {
+	GetFile(srcPath string) ([]byte, error)
+	WriteFile(destPath string, content []byte) error
+	FileExists(srcPath string) (bool, error)
+}
+
+// Rendition is a smaller copy of a photo, turned upright.
+type Rendition struct {
+	Size    string
+	Width   int
+	Height  int
+	Content []byte
+}
+
+// IsPhotoSize checks the size is one a photo is served in.
+func IsPhotoSize(size string) bool {
+	return size == PhotoSizeThumb || size == PhotoSizeMedium || size == PhotoSizeOriginal
+}
+
+// RenditionPath is the path of a photo rendition, in the folder of its size next to the photo.
+// The original photo is kept as it was uploaded.
+func RenditionPath(srcPath, size string) string {
+	if size == "" || size == PhotoSizeOriginal {
+		return srcPath
+	}
+
+	dir, filename := path.Split(srcPath)
+
+	return path.Join(dir, size, filename)
+}
+
+// SaveRenditions creates the renditions of a stored photo,
+// and returns the dimension of the photo once turned upright.
+func SaveRenditions(storage Storage, srcPath string) (width, height int, err error) {
+	content, err := storage.GetFile(srcPath)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	width, height, renditions, err := CreateRenditions(content)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, v := range renditions {
+		err = storage.WriteFile(RenditionPath(srcPath, v.Size), v.Content)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return width, height, nil
+}
+
+// GetRenditionPath returns the path of the photo in the size.
+// The renditions of a photo uploaded before they existed are created on the first request.
+func GetRenditionPath(storage Storage, srcPath, size string) (string, error) {
+	destPath := RenditionPath(srcPath, size)
+	if destPath == srcPath {
+		return destPath, nil
+	}
+
+	exists, err := storage.FileExists(destPath)
+	if err != nil {
+		return "", err
+	}
+
+	if exists {
+		return destPath, nil
+	}
+
+	if _, _, err := SaveRenditions(storage, srcPath); err != nil {
+		return "", err
+	}
+
+	return destPath, nil
+}
+
+// CreateRenditions turns the photo content upright following its EXIF orientation and resizes it
+// to the thumb and medium renditions, keeping its format. Photos are never enlarged.
+// It returns the dimension of the photo once turned upright.
+// Photos of more than MaxPixels are rejected before decoding their pixels.
+func CreateRenditions(content []byte) (width, height int, renditions []Rendition, err error) {
+	width, height, err = GetImageDimension(content)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	if width*height > MaxPixels {
+		return 0, 0, nil, ErrImageTooLarge
+	}
+
+	src, format, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	orientation := GetOrientation(content)
+
+	bounds := src.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), src, bounds.Min, draw.Src)
+
+	width, height = bounds.Dx(), bounds.Dy()
+	if orientation >= OrientationLeftTop {
+		width, height = height, width
+	}
+
+	// The medium rendition is resized before turning it, as there are less pixels to move.
+	mediumWidth, mediumHeight := fitSize(width, height, MediumMaxSize)
+	if orientation >= OrientationLeftTop {
+		mediumWidth, mediumHeight = mediumHeight, mediumWidth
+	}
+
+	medium := orient(resize(rgba, mediumWidth, mediumHeight), orientation)
+
+	thumbWidth, thumbHeight := fitSize(width, height, ThumbMaxSize)
+	thumb := resize(medium, thumbWidth, thumbHeight)
+
+	renditions = []Rendition{
+		{Size: PhotoSizeThumb, Width: thumb.Rect.Dx(), Height: thumb.Rect.Dy()},
+		{Size: PhotoSizeMedium, Width: medium.Rect.Dx(), Height: medium.Rect.Dy()},
+	}
+
+	for i, img := range []*image.RGBA{thumb, medium} {
+		renditions[i].Content, err = encode(img, format)
+		if err != nil {
+			return 0, 0, nil, err
+		}
+	}
+
+	return width, height, renditions, nil
+}
+
+// fitSize is the dimension which fits in a square of the max size, keeping the ratio.
+func fitSize(width, height, maxSize int) (int, int) {
+	if width <= maxSize && height <= maxSize {
+		return width, height
+	}
+
+	if width >= height {
+		return maxSize, atLeastOne(height * maxSize / width)
+	}
+
+	return atLeastOne(width * maxSize / height), maxSize
+}
+
+func atLeastOne(value int) int {
+	if value < 1 {
+		return 1
+	}
+
+	return value
+}
+
+// resize shrinks the image by averaging the pixels covered by each new pixel.
+func resize(src *image.RGBA, width, height int) *image.RGBA {
+	srcWidth, srcHeight := src.Rect.Dx(), src.Rect.Dy()
+	if width == srcWidth && height == srcHeight {
+		return src
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		// Each new pixel covers at least one pixel of the source.
+		y0 := y * srcHeight / height
+		y1 := y0 + atLeastOne((y+1)*srcHeight/height-y0)
+
+		for x := 0; x < width; x++ {
+			x0 := x * srcWidth / width
+			x1 := x0 + atLeastOne((x+1)*srcWidth/width-x0)
+
+			var sum [4]int
+
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+
+				for sx := x0; sx < x1; sx++ {
+					for c := 0; c < 4; c++ {
+						sum[c] += int(row[sx*4+c])
+					}
+				}
+			}
+
+			n := (y1 - y0) * (x1 - x0)
+			i := y*dst.Stride + x*4
+
+			for c := 0; c < 4; c++ {
+				dst.Pix[i+c] = uint8(sum[c] / n)
+			}
+		}
+	}
+
+	return dst
+}
+
+// orient moves the pixels of the image so it is upright for the EXIF orientation.
+func orient(src *image.RGBA, orientation int) *image.RGBA {
+	if orientation == OrientationTopLeft {
+		return src
+	}
+
+	w, h := src.Rect.Dx(), src.Rect.Dy()
+
+	dstWidth, dstHeight := w, h
+	if orientation >= OrientationLeftTop {
+		dstWidth, dstHeight = h, w
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+
+	for y := 0; y < dstHeight; y++ {
+		for x := 0; x < dstWidth; x++ {
+			var sx, sy int
+
+			switch orientation {
+			case OrientationTopRight:
+				sx, sy = w-1-x, y
+			case OrientationBottomRight:
+				sx, sy = w-1-x, h-1-y
+			case OrientationBottomLeft:
+				sx, sy = x, h-1-y
+			case OrientationLeftTop:
+				sx, sy = y, x
+			case OrientationRightTop:
+				sx, sy = y, h-1-x
+			case OrientationRightBottom:
+				sx, sy = w-1-y, h-1-x
+			case OrientationLeftBottom:
+				sx, sy = w-1-y, x
+			}
+
+			copy(dst.Pix[y*dst.Stride+x*4:y*dst.Stride+x*4+4], src.Pix[sy*src.Stride+sx*4:])
+		}
+	}
+
+	return dst
+}
+
+func encode(img image.Image, format string) ([]byte, error) {
+	buf := bytes.Buffer{}
+
+	var err error
+
+	switch format {
+	case "png":
+		err = png.Encode(&buf, img)
+	case "gif":
+		err = gif.Encode(&buf, img, nil)
+	default:
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+	}
+
+	return buf.Bytes(), err
+}
@@ -0,0 +1,141 @@
+package imagehelper_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/usetania/tania-core/src/helper/imagehelper"
+)
+
+// photoWithMarker is a white photo with a red square in its top left corner.
+func photoWithMarker(width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.White)
+
+			if x < width/10 && y < height/10 {
+				img.Set(x, y, color.RGBA{R: 255, A: 255})
+			}
+		}
+	}
+
+	return img
+}
+
+// jpegWithOrientation encodes the photo as a JPEG whose EXIF segment holds the orientation.
+func jpegWithOrientation(t *testing.T, img image.Image, orientation uint16) []byte {
+	t.Helper()
+
+	return jpegWithExif(t, img, []tiffEntry{shortEntry(0x0112, orientation)}, nil, nil)
+}
+
+func TestGetOrientation(t *testing.T) {
+	t.Parallel()
+	// Given
+	img := photoWithMarker(40, 20)
+
+	rotated := jpegWithOrientation(t, img, imagehelper.OrientationRightTop)
+	invalid := jpegWithOrientation(t, img, 9)
+
+	withoutExif := bytes.Buffer{}
+	jpeg.Encode(&withoutExif, img, nil)
+
+	// When
+	rotatedOrientation := imagehelper.GetOrientation(rotated)
+	invalidOrientation := imagehelper.GetOrientation(invalid)
+	withoutExifOrientation := imagehelper.GetOrientation(withoutExif.Bytes())
+	notJPEGOrientation := imagehelper.GetOrientation([]byte("GIF89a"))
+
+	// Then
+	assert.Equal(t, imagehelper.OrientationRightTop, rotatedOrientation)
+	assert.Equal(t, imagehelper.OrientationTopLeft, invalidOrientation)
+	assert.Equal(t, imagehelper.OrientationTopLeft, withoutExifOrientation)
+	assert.Equal(t, imagehelper.OrientationTopLeft, notJPEGOrientation)
+}
+
+func TestCreateRenditions(t *testing.T) {
+	t.Parallel()
+	// Given
+	large := bytes.Buffer{}
+	png.Encode(&large, photoWithMarker(2000, 1000))
+
+	small := bytes.Buffer{}
+	png.Encode(&small, photoWithMarker(200, 100))
+
+	rotated := jpegWithOrientation(t, photoWithMarker(2000, 1000), imagehelper.OrientationRightTop)
+
+	// When
+	largeWidth, largeHeight, largeRenditions, errLarge := imagehelper.CreateRenditions(large.Bytes())
+	_, _, smallRenditions, errSmall := imagehelper.CreateRenditions(small.Bytes())
+	rotatedWidth, rotatedHeight, rotatedRenditions, errRotated := imagehelper.CreateRenditions(rotated)
+	_, _, _, errInvalid := imagehelper.CreateRenditions([]byte("not a photo"))
+
+	// Then
+	assert.Nil(t, errLarge)
+	assert.Equal(t, []int{2000, 1000}, []int{largeWidth, largeHeight})
+	assert.Equal(t, imagehelper.PhotoSizeThumb, largeRenditions[0].Size)
+	assert.Equal(t, []int{320, 160}, []int{largeRenditions[0].Width, largeRenditions[0].Height})
+	assert.Equal(t, imagehelper.PhotoSizeMedium, largeRenditions[1].Size)
+	assert.Equal(t, []int{1280, 640}, []int{largeRenditions[1].Width, largeRenditions[1].Height})
+
+	thumb, format, err := image.Decode(bytes.NewReader(largeRenditions[0].Content))
+	assert.Nil(t, err)
+	assert.Equal(t, "png", format)
+	assert.Equal(t, 320, thumb.Bounds().Dx())
+
+	assert.Nil(t, errSmall)
+	assert.Equal(t, []int{200, 100}, []int{smallRenditions[1].Width, smallRenditions[1].Height})
+
+	// The photo was taken with the camera turned, so the marker is in the top right corner once upright.
+	assert.Nil(t, errRotated)
+	assert.Equal(t, []int{1000, 2000}, []int{rotatedWidth, rotatedHeight})
+	assert.Equal(t, []int{160, 320}, []int{rotatedRenditions[0].Width, rotatedRenditions[0].Height})
+
+	upright, _, err := image.Decode(bytes.NewReader(rotatedRenditions[1].Content))
+	assert.Nil(t, err)
+
+	r, g, _, _ := upright.At(630, 10).RGBA()
+	assert.True(t, r > 0xC000 && g < 0x4000)
+
+	r, g, _, _ = upright.At(10, 10).RGBA()
+	assert.True(t, r > 0xC000 && g > 0xC000)
+
+	assert.NotNil(t, errInvalid)
+}
+
+func TestCreateRenditionsOfLargeImage(t *testing.T) {
+	t.Parallel()
+	// Given
+	// The header of a 50000 x 50000 pixels gif, without any pixel.
+	header := []byte("GIF89a\x50\xc3\x50\xc3\x00\x00\x00")
+
+	// When
+	width, height, errDimension := imagehelper.GetImageDimension(header)
+	_, _, _, err := imagehelper.CreateRenditions(header)
+
+	// Then
+	assert.Nil(t, errDimension)
+	assert.Equal(t, 50000, width)
+	assert.Equal(t, 50000, height)
+	assert.ErrorIs(t, err, imagehelper.ErrImageTooLarge)
+}
+
+func TestRenditionPath(t *testing.T) {
+	t.Parallel()
+	// When
+	thumbPath := imagehelper.RenditionPath("uploads/crops/tomato.jpg", imagehelper.PhotoSizeThumb)
+	originalPath := imagehelper.RenditionPath("uploads/crops/tomato.jpg", imagehelper.PhotoSizeOriginal)
+
+	// Then
+	assert.Equal(t, "uploads/crops/thumb/tomato.jpg", thumbPath)
+	assert.Equal(t, "uploads/crops/tomato.jpg", originalPath)
+	assert.True(t, imagehelper.IsPhotoSize(imagehelper.PhotoSizeMedium))
+	assert.False(t, imagehelper.IsPhotoSize("large"))
+}
@@ -0,0 +1,315 @@
+// Package s3helper is a minimal client of the S3 API, used to keep the uploaded files
+// in Amazon S3 or in a compatible object storage such as MinIO.
+// Requests are signed with AWS Signature Version 4.
+package s3helper
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	algorithm   = "AWS4-HMAC-SHA256"
+	service     = "s3"
+	dateFormat  = "20060102T150405Z"
+	scopeFormat = "20060102"
+
+	// unsignedPayload is used for pre-signed URLs, as their payload is not known when signing.
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+
+	// MaxPresignExpiry is the longest validity of a pre-signed URL accepted by S3.
+	MaxPresignExpiry = 7 * 24 * time.Hour
+)
+
+var ErrNotFound = errors.New("object not found")
+
+// Client reads and writes the objects of a bucket.
+type Client struct {
+	// Endpoint is the scheme and host of the S3 API, like http://localhost:9000 for a local MinIO.
+	// Amazon S3 of the region is used when it is empty.
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+
+	// PathStyle puts the bucket in the path of the URL instead of its host, as MinIO expects.
+	PathStyle bool
+
+	HTTP *http.Client
+}
+
+// Key is the object key of a file path. Paths are cleaned and relative, so the key of
+// uploads/crops/tomato.jpg and ./uploads/crops//tomato.jpg is the same.
+func Key(filePath string) string {
+	return strings.TrimPrefix(path.Clean("/"+filePath), "/")
+}
+
+// PutObject writes the content to the object key.
+func (c Client) PutObject(ctx context.Context, key string, content []byte, contentType string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.objectURL(key).String(), bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	sum := sha256.Sum256(content)
+
+	_, err = c.do(req, hex.EncodeToString(sum[:]))
+
+	return err
+}
+
+// GetObject reads the content of the object key. It returns ErrNotFound when there is no such object.
+func (c Client) GetObject(ctx context.Context, key string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.objectURL(key).String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req, hashHex(""))
+}
+
+// HeadObject checks the object key exists without reading its content.
+// It returns ErrNotFound when there is no such object.
+func (c Client) HeadObject(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, c.objectURL(key).String(), http.NoBody)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(req, hashHex(""))
+
+	return err
+}
+
+// DeleteObject removes the object key. Removing a missing object is not an error.
+func (c Client) DeleteObject(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.objectURL(key).String(), http.NoBody)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(req, hashHex(""))
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+
+	return err
+}
+
+// PresignGetObject returns a URL to download the object key without credentials,
+// valid from the date for the expiry duration.
+func (c Client) PresignGetObject(key string, date time.Time, expiry time.Duration) (string, error) {
+	if expiry <= 0 || expiry > MaxPresignExpiry {
+		return "", fmt.Errorf("pre-signed url expiry should be between 1 second and %v", MaxPresignExpiry)
+	}
+
+	date = date.UTC()
+	u := c.objectURL(key)
+
+	query := u.Query()
+	query.Set("X-Amz-Algorithm", algorithm)
+	query.Set("X-Amz-Credential", c.AccessKey+"/"+c.scope(date))
+	query.Set("X-Amz-Date", date.Format(dateFormat))
+	query.Set("X-Amz-Expires", strconv.Itoa(int(expiry.Seconds())))
+	query.Set("X-Amz-SignedHeaders", "host")
+	u.RawQuery = encodeQuery(query)
+
+	canonicalRequest := strings.Join([]string{
+		http.MethodGet,
+		u.EscapedPath(),
+		u.RawQuery,
+		"host:" + u.Host + "\n",
+		"host",
+		unsignedPayload,
+	}, "\n")
+
+	u.RawQuery += "&X-Amz-Signature=" + c.signature(date, canonicalRequest)
+
+	return u.String(), nil
+}
+
+// Sign adds the authorization of the request at the date, with the hex SHA-256 of its payload.
+// Every header of the request is signed, with its host.
+func (c Client) Sign(req *http.Request, payloadHash string, date time.Time) {
+	date = date.UTC()
+
+	req.Header.Set("X-Amz-Date", date.Format(dateFormat))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+
+	sort.Strings(names)
+
+	canonicalHeaders := ""
+	for _, v := range names {
+		canonicalHeaders += v + ":" + headers[v] + "\n"
+	}
+
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		encodeQuery(req.URL.Query()),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		algorithm, c.AccessKey, c.scope(date), signedHeaders, c.signature(date, canonicalRequest),
+	))
+}
+
+func (c Client) do(req *http.Request, payloadHash string) ([]byte, error) {
+	c.Sign(req, payloadHash, time.Now())
+
+	client := c.HTTP
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("s3 %s %s failed with status %d: %s", req.Method, req.URL.Path, resp.StatusCode, body)
+	}
+
+	return body, nil
+}
+
+func (c Client) objectURL(key string) *url.URL {
+	endpoint := c.Endpoint
+	if endpoint == "" {
+		endpoint = "https://s3." + c.Region + ".amazonaws.com"
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		u = &url.URL{}
+	}
+
+	if c.PathStyle {
+		u.Path = "/" + c.Bucket + "/" + Key(key)
+	} else {
+		u.Host = c.Bucket + "." + u.Host
+		u.Path = "/" + Key(key)
+	}
+
+	// Keys are escaped like S3 does, with the slashes kept.
+	u.RawPath = strings.ReplaceAll(escape(u.Path), "%2F", "/")
+
+	return u
+}
+
+func (c Client) scope(date time.Time) string {
+	return date.Format(scopeFormat) + "/" + c.Region + "/" + service + "/aws4_request"
+}
+
+func (c Client) signature(date time.Time, canonicalRequest string) string {
+	stringToSign := strings.Join([]string{
+		algorithm,
+		date.Format(dateFormat),
+		c.scope(date),
+		hashHex(canonicalRequest),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+c.SecretKey), date.Format(scopeFormat))
+	key = hmacSHA256(key, c.Region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+// encodeQuery sorts the query by key and escapes it the way AWS Signature Version 4 expects.
+func encodeQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	parts := []string{}
+
+	for _, k := range keys {
+		values := query[k]
+		sort.Strings(values)
+
+		for _, v := range values {
+			parts = append(parts, escape(k)+"="+escape(v))
+		}
+	}
+
+	return strings.Join(parts, "&")
+}
+
+// escape encodes every byte except the unreserved characters of RFC 3986.
+func escape(value string) string {
+	buf := strings.Builder{}
+
+	for _, b := range []byte(value) {
+		if ('A' <= b && b <= 'Z') || ('a' <= b && b <= 'z') || ('0' <= b && b <= '9') ||
+			b == '-' || b == '_' || b == '.' || b == '~' {
+			buf.WriteByte(b)
+		} else {
+			fmt.Fprintf(&buf, "%%%02X", b)
+		}
+	}
+
+	return buf.String()
+}
+
+func hashHex(value string) string {
+	sum := sha256.Sum256([]byte(value))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, value string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(value))
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,117 @@
+package totphelper
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Period is the TOTP time step in seconds, as defined in RFC 6238.
+	Period = 30
+
+	// Digits is the length of the generated one time password.
+	Digits = 6
+
+	// Skew is how many time steps before and after the current one are still accepted,
+	// to tolerate the clock drift between the server and the authenticator app.
+	Skew = 1
+
+	secretLength = 20
+)
+
+// GenerateSecret creates a random base32 encoded secret to be shared with the authenticator app.
+func GenerateSecret() (string, error) {
+	b := make([]byte, secretLength)
+
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b), nil
+}
+
+// TimeStep returns the RFC 6238 time counter for the given time.
+func TimeStep(t time.Time) int64 {
+	return t.Unix() / Period
+}
+
+// GenerateCode computes the one time password of the secret for a given time step.
+func GenerateCode(secret string, step int64) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation, https://tools.ietf.org/html/rfc4226#section-5.3
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < Digits; i++ {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", Digits, value%mod), nil
+}
+
+// Validate checks the code against the secret at the given time.
+// It returns the matched time step, so the caller can reject a code that has been used before.
+func Validate(secret, code string, t time.Time) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != Digits {
+		return 0, false
+	}
+
+	current := TimeStep(t)
+
+	for i := -Skew; i <= Skew; i++ {
+		step := current + int64(i)
+
+		expected, err := GenerateCode(secret, step)
+		if err != nil {
+			return 0, false
+		}
+
+		if hmac.Equal([]byte(expected), []byte(code)) {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// KeyURI builds the otpauth URI which is usually rendered as QR code for the authenticator app.
+// https://github.com/google/google-authenticator/wiki/Key-Uri-Format
+func KeyURI(issuer, accountName, secret string) string {
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(accountName)
+
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(Digits))
+	v.Set("period", fmt.Sprint(Period))
+
+	return "otpauth://totp/" + label + "?" + v.Encode()
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	s := strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+
+	return base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.TrimRight(s, "="))
+}
@@ -0,0 +1,71 @@
+package totphelper_test
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/usetania/tania-core/src/helper/totphelper"
+)
+
+func TestGenerateCode(t *testing.T) {
+	t.Parallel()
+	// Given
+	// The SHA1 seed from https://tools.ietf.org/html/rfc6238#appendix-B
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+	tests := []struct {
+		unix     int64
+		expected string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	for _, test := range tests {
+		// When
+		code, err := totphelper.GenerateCode(secret, totphelper.TimeStep(time.Unix(test.unix, 0)))
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, code)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	// Given
+	secret, err := totphelper.GenerateSecret()
+	now := time.Now()
+
+	previous, _ := totphelper.GenerateCode(secret, totphelper.TimeStep(now)-1)
+	tooOld, _ := totphelper.GenerateCode(secret, totphelper.TimeStep(now)-5)
+
+	// When
+	step, valid := totphelper.Validate(secret, previous, now)
+	_, validOld := totphelper.Validate(secret, tooOld, now)
+	_, validGarbage := totphelper.Validate(secret, "12ab", now)
+
+	// Then
+	assert.Nil(t, err)
+	assert.True(t, valid)
+	assert.Equal(t, totphelper.TimeStep(now)-1, step)
+	assert.False(t, validOld)
+	assert.False(t, validGarbage)
+}
+
+func TestKeyURI(t *testing.T) {
+	t.Parallel()
+	// When
+	uri := totphelper.KeyURI("Tania", "tania", "JBSWY3DPEHPK3PXP")
+
+	// Then
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/Tania:tania?"))
+	assert.Contains(t, uri, "secret=JBSWY3DPEHPK3PXP")
+	assert.Contains(t, uri, "issuer=Tania")
+}
@@ -0,0 +1,175 @@
+// Package unithelper converts the area and weight units used by farms.
+// Values are stored in square meters and grams, and converted to the unit system of a farm on output.
+package unithelper
+
+import "errors"
+
+const (
+	SystemMetric   = "METRIC"
+	SystemImperial = "IMPERIAL"
+
+	SquareMeter = "m2"
+	Hectare     = "Ha"
+	Acre        = "ac"
+	SquareFoot  = "ft2"
+
+	Gram     = "Gr"
+	Kilogram = "Kg"
+	Pound    = "lb"
+	Ounce    = "oz"
+)
+
+const (
+	squareMetersPerHectare    = 10000
+	squareMetersPerAcre       = 4046.8564224
+	squareMetersPerSquareFoot = 0.09290304
+
+	gramsPerKilogram = 1000
+	gramsPerPound    = 453.59237
+	gramsPerOunce    = 28.349523125
+)
+
+var ErrUnknownUnit = errors.New("unknown unit")
+
+// UnitSystems returns the unit systems a farm can pick.
+func UnitSystems() []string {
+	return []string{SystemMetric, SystemImperial}
+}
+
+func IsUnitSystem(system string) bool {
+	for _, v := range UnitSystems() {
+		if v == system {
+			return true
+		}
+	}
+
+	return false
+}
+
+func squareMetersPer(unit string) (float64, error) {
+	switch unit {
+	case SquareMeter:
+		return 1, nil
+	case Hectare:
+		return squareMetersPerHectare, nil
+	case Acre:
+		return squareMetersPerAcre, nil
+	case SquareFoot:
+		return squareMetersPerSquareFoot, nil
+	default:
+		return 0, ErrUnknownUnit
+	}
+}
+
+func gramsPer(unit string) (float64, error) {
+	switch unit {
+	case Gram:
+		return 1, nil
+	case Kilogram:
+		return gramsPerKilogram, nil
+	case Pound:
+		return gramsPerPound, nil
+	case Ounce:
+		return gramsPerOunce, nil
+	default:
+		return 0, ErrUnknownUnit
+	}
+}
+
+// ToSquareMeters converts an area value from its unit to square meters.
+func ToSquareMeters(value float64, unit string) (float64, error) {
+	factor, err := squareMetersPer(unit)
+	if err != nil {
+		return 0, err
+	}
+
+	return value * factor, nil
+}
+
+// FromSquareMeters converts an area value in square meters to the unit.
+func FromSquareMeters(value float64, unit string) (float64, error) {
+	factor, err := squareMetersPer(unit)
+	if err != nil {
+		return 0, err
+	}
+
+	return value / factor, nil
+}
+
+// ToGrams converts a weight value from its unit to grams.
+func ToGrams(value float64, unit string) (float64, error) {
+	factor, err := gramsPer(unit)
+	if err != nil {
+		return 0, err
+	}
+
+	return value * factor, nil
+}
+
+// FromGrams converts a weight value in grams to the unit.
+func FromGrams(value float64, unit string) (float64, error) {
+	factor, err := gramsPer(unit)
+	if err != nil {
+		return 0, err
+	}
+
+	return value / factor, nil
+}
+
+// AreaUnit is the unit to show an area of the unit system in. An area entered in a unit
+// of the unit system keeps it. Other areas from one hectare or one acre are shown in them,
+// smaller areas in square meters or square feet. An empty unit system is metric.
+func AreaUnit(system, unit string, squareMeters float64) string {
+	if system != SystemImperial {
+		system = SystemMetric
+	}
+
+	if areaUnitSystem(unit) == system {
+		return unit
+	}
+
+	if system == SystemImperial {
+		if squareMeters >= squareMetersPerAcre {
+			return Acre
+		}
+
+		return SquareFoot
+	}
+
+	if squareMeters >= squareMetersPerHectare {
+		return Hectare
+	}
+
+	return SquareMeter
+}
+
+// areaUnitSystem is the unit system of an area unit, empty for an unknown unit.
+func areaUnitSystem(unit string) string {
+	switch unit {
+	case SquareMeter, Hectare:
+		return SystemMetric
+	case Acre, SquareFoot:
+		return SystemImperial
+	default:
+		return ""
+	}
+}
+
+// WeightUnit is the unit to show a weight of the unit system in. Weights from one kilogram
+// or one pound are shown in them, smaller weights in grams or ounces.
+// An empty unit system is metric.
+func WeightUnit(system string, grams float64) string {
+	if system == SystemImperial {
+		if grams >= gramsPerPound {
+			return Pound
+		}
+
+		return Ounce
+	}
+
+	if grams >= gramsPerKilogram {
+		return Kilogram
+	}
+
+	return Gram
+}
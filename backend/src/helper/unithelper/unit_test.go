@@ -0,0 +1,68 @@
+package unithelper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/usetania/tania-core/src/helper/unithelper"
+)
+
+func TestAreaConversion(t *testing.T) {
+	t.Parallel()
+	// Given
+	// When
+	hectare, errHectare := unithelper.ToSquareMeters(2, unithelper.Hectare)
+	acre, errAcre := unithelper.ToSquareMeters(1, unithelper.Acre)
+	squareFeet, errSquareFeet := unithelper.FromSquareMeters(1, unithelper.SquareFoot)
+	_, errUnknown := unithelper.ToSquareMeters(1, "km2")
+
+	// Then
+	assert.Nil(t, errHectare)
+	assert.Nil(t, errAcre)
+	assert.Nil(t, errSquareFeet)
+	assert.Equal(t, unithelper.ErrUnknownUnit, errUnknown)
+	assert.InDelta(t, 20000, hectare, 0.0001)
+	assert.InDelta(t, 4046.8564, acre, 0.0001)
+	assert.InDelta(t, 10.7639, squareFeet, 0.0001)
+}
+
+func TestWeightConversion(t *testing.T) {
+	t.Parallel()
+	// Given
+	// When
+	kilogram, errKilogram := unithelper.ToGrams(1.5, unithelper.Kilogram)
+	pound, errPound := unithelper.FromGrams(1000, unithelper.Pound)
+	ounce, errOunce := unithelper.ToGrams(16, unithelper.Ounce)
+	_, errUnknown := unithelper.FromGrams(1, "ton")
+
+	// Then
+	assert.Nil(t, errKilogram)
+	assert.Nil(t, errPound)
+	assert.Nil(t, errOunce)
+	assert.Equal(t, unithelper.ErrUnknownUnit, errUnknown)
+	assert.InDelta(t, 1500, kilogram, 0.0001)
+	assert.InDelta(t, 2.2046, pound, 0.0001)
+	assert.InDelta(t, 453.5924, ounce, 0.0001)
+}
+
+func TestDisplayUnit(t *testing.T) {
+	t.Parallel()
+	// Given
+	// When
+	// Then
+	assert.Equal(t, unithelper.SquareMeter, unithelper.AreaUnit("", "", 500))
+	assert.Equal(t, unithelper.Hectare, unithelper.AreaUnit(unithelper.SystemMetric, "", 10000))
+	assert.Equal(t, unithelper.SquareFoot, unithelper.AreaUnit(unithelper.SystemImperial, "", 500))
+	assert.Equal(t, unithelper.Acre, unithelper.AreaUnit(unithelper.SystemImperial, "", 5000))
+	assert.Equal(t, unithelper.SquareMeter, unithelper.AreaUnit("", unithelper.SquareMeter, 20000))
+	assert.Equal(t, unithelper.Hectare, unithelper.AreaUnit(unithelper.SystemMetric, unithelper.Hectare, 500))
+	assert.Equal(t, unithelper.SquareFoot, unithelper.AreaUnit(unithelper.SystemImperial, unithelper.SquareFoot, 5000))
+	assert.Equal(t, unithelper.Acre, unithelper.AreaUnit(unithelper.SystemImperial, unithelper.Hectare, 5000))
+	assert.Equal(t, unithelper.SquareMeter, unithelper.AreaUnit(unithelper.SystemMetric, unithelper.Acre, 500))
+	assert.Equal(t, unithelper.Gram, unithelper.WeightUnit("", 500))
+	assert.Equal(t, unithelper.Kilogram, unithelper.WeightUnit(unithelper.SystemMetric, 1000))
+	assert.Equal(t, unithelper.Ounce, unithelper.WeightUnit(unithelper.SystemImperial, 400))
+	assert.Equal(t, unithelper.Pound, unithelper.WeightUnit(unithelper.SystemImperial, 500))
+	assert.True(t, unithelper.IsUnitSystem(unithelper.SystemImperial))
+	assert.False(t, unithelper.IsUnitSystem("NAUTICAL"))
+}
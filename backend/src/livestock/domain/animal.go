@@ -0,0 +1,379 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// Animal is a head of livestock of the farm, identified by its tag and kept in a group and a pen area.
+type Animal struct {
+	UID         uuid.UUID
+	FarmUID     uuid.UUID
+	Species     string
+	TagID       string
+	Sex         string
+	BirthDate   *time.Time
+	Group       string
+	PenAreaUID  *uuid.UUID
+	MotherUID   *uuid.UUID
+	Status      string
+	CreatedDate time.Time
+
+	Weights       []AnimalWeight
+	Treatments    []AnimalTreatment
+	Feedings      []AnimalFeeding
+	OffspringUIDs []uuid.UUID
+	DeathDate     *time.Time
+	DeathCause    string
+
+	// Events
+	Version            int
+	UncommittedChanges []interface{}
+}
+
+type AnimalService interface {
+	FindFarmByID(farmUID uuid.UUID) (AnimalFarmServiceResult, error)
+	FindAreaByID(areaUID uuid.UUID) (AnimalAreaServiceResult, error)
+	FindMaterialByID(materialUID uuid.UUID) (AnimalMaterialServiceResult, error)
+
+	// FindAnimalByTagID finds the animal of the farm wearing the tag. The UID is empty when there is none.
+	FindAnimalByTagID(farmUID uuid.UUID, tagID string) (uuid.UUID, error)
+}
+
+type AnimalFarmServiceResult struct {
+	UID  uuid.UUID
+	Name string
+	Type string
+}
+
+type AnimalAreaServiceResult struct {
+	UID     uuid.UUID
+	Name    string
+	FarmUID uuid.UUID
+}
+
+type AnimalMaterialServiceResult struct {
+	UID              uuid.UUID
+	Name             string
+	TypeCode         string
+	ChemicalTypeCode string
+	Quantity         float32
+	QuantityUnit     string
+	ExpirationDate   *time.Time
+}
+
+// FarmTypeLivestock is the only type of farm which keeps animals.
+const FarmTypeLivestock = "livestock"
+
+const (
+	AnimalSpeciesCattle  = "CATTLE"
+	AnimalSpeciesBuffalo = "BUFFALO"
+	AnimalSpeciesGoat    = "GOAT"
+	AnimalSpeciesSheep   = "SHEEP"
+	AnimalSpeciesPig     = "PIG"
+	AnimalSpeciesHorse   = "HORSE"
+	AnimalSpeciesRabbit  = "RABBIT"
+	AnimalSpeciesChicken = "CHICKEN"
+	AnimalSpeciesDuck    = "DUCK"
+	AnimalSpeciesOther   = "OTHER"
+)
+
+type AnimalSpecies struct {
+	Code  string `json:"code"`
+	Label string `json:"label"`
+}
+
+func AnimalSpeciesList() []AnimalSpecies {
+	return []AnimalSpecies{
+		{Code: AnimalSpeciesCattle, Label: "Cattle"},
+		{Code: AnimalSpeciesBuffalo, Label: "Buffalo"},
+		{Code: AnimalSpeciesGoat, Label: "Goat"},
+		{Code: AnimalSpeciesSheep, Label: "Sheep"},
+		{Code: AnimalSpeciesPig, Label: "Pig"},
+		{Code: AnimalSpeciesHorse, Label: "Horse"},
+		{Code: AnimalSpeciesRabbit, Label: "Rabbit"},
+		{Code: AnimalSpeciesChicken, Label: "Chicken"},
+		{Code: AnimalSpeciesDuck, Label: "Duck"},
+		{Code: AnimalSpeciesOther, Label: "Other"},
+	}
+}
+
+func GetAnimalSpecies(code string) AnimalSpecies {
+	for _, v := range AnimalSpeciesList() {
+		if v.Code == code {
+			return v
+		}
+	}
+
+	return AnimalSpecies{}
+}
+
+const (
+	AnimalSexFemale  = "FEMALE"
+	AnimalSexMale    = "MALE"
+	AnimalSexUnknown = "UNKNOWN"
+)
+
+const (
+	AnimalStatusAlive = "ALIVE"
+	AnimalStatusDead  = "DEAD"
+)
+
+func (a *Animal) TrackChange(event interface{}) {
+	a.UncommittedChanges = append(a.UncommittedChanges, event)
+	a.Transition(event)
+}
+
+func (a *Animal) Transition(event interface{}) {
+	switch e := event.(type) {
+	case AnimalCreated:
+		a.UID = e.UID
+		a.FarmUID = e.FarmUID
+		a.Species = e.Species
+		a.TagID = e.TagID
+		a.Sex = e.Sex
+		a.BirthDate = e.BirthDate
+		a.Group = e.Group
+		a.PenAreaUID = e.PenAreaUID
+		a.MotherUID = e.MotherUID
+		a.Status = AnimalStatusAlive
+		a.CreatedDate = e.CreatedDate
+
+	case AnimalDetailsChanged:
+		a.TagID = e.TagID
+		a.Sex = e.Sex
+		a.BirthDate = e.BirthDate
+
+	case AnimalGroupChanged:
+		a.Group = e.Group
+
+	case AnimalMoved:
+		a.PenAreaUID = e.PenAreaUID
+
+	default:
+		a.transitionRecord(event)
+	}
+}
+
+// CreateAnimal registers a new Animal of a livestock farm.
+// The sex is unknown when empty, and the birth date, the group and the pen area are optional.
+func CreateAnimal(
+	animalService AnimalService,
+	farmUID uuid.UUID,
+	species, tagID, sex string,
+	birthDate *time.Time,
+	group string,
+	penAreaUID *uuid.UUID,
+) (*Animal, error) {
+	return createAnimal(animalService, farmUID, species, tagID, sex, birthDate, group, penAreaUID, nil)
+}
+
+func createAnimal(
+	animalService AnimalService,
+	farmUID uuid.UUID,
+	species, tagID, sex string,
+	birthDate *time.Time,
+	group string,
+	penAreaUID, motherUID *uuid.UUID,
+) (*Animal, error) {
+	tagID, group = strings.TrimSpace(tagID), strings.TrimSpace(group)
+
+	if sex == "" {
+		sex = AnimalSexUnknown
+	}
+
+	if GetAnimalSpecies(species) == (AnimalSpecies{}) {
+		return nil, AnimalError{AnimalErrorInvalidSpeciesCode}
+	}
+
+	err := validateAnimalDetails(sex, birthDate)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateAnimalGroup(group)
+	if err != nil {
+		return nil, err
+	}
+
+	farm, err := animalService.FindFarmByID(farmUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if farm.Type != FarmTypeLivestock {
+		return nil, AnimalError{AnimalErrorFarmNotLivestockCode}
+	}
+
+	err = validateAnimalTagID(animalService, farm.UID, uuid.UUID{}, tagID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateAnimalPenArea(animalService, farm.UID, penAreaUID)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	initial := &Animal{}
+
+	initial.TrackChange(AnimalCreated{
+		UID:         uid,
+		FarmUID:     farm.UID,
+		Species:     species,
+		TagID:       tagID,
+		Sex:         sex,
+		BirthDate:   birthDate,
+		Group:       group,
+		PenAreaUID:  penAreaUID,
+		MotherUID:   motherUID,
+		CreatedDate: time.Now(),
+	})
+
+	return initial, nil
+}
+
+// ChangeDetails replaces the tag, the sex and the birth date of the Animal.
+func (a *Animal) ChangeDetails(animalService AnimalService, tagID, sex string, birthDate *time.Time) error {
+	tagID = strings.TrimSpace(tagID)
+
+	err := a.validateAlive()
+	if err != nil {
+		return err
+	}
+
+	err = validateAnimalDetails(sex, birthDate)
+	if err != nil {
+		return err
+	}
+
+	err = validateAnimalTagID(animalService, a.FarmUID, a.UID, tagID)
+	if err != nil {
+		return err
+	}
+
+	a.TrackChange(AnimalDetailsChanged{
+		AnimalUID: a.UID,
+		TagID:     tagID,
+		Sex:       sex,
+		BirthDate: birthDate,
+	})
+
+	return nil
+}
+
+// ChangeGroup puts the Animal in another group of the farm, or in none when empty.
+func (a *Animal) ChangeGroup(group string) error {
+	group = strings.TrimSpace(group)
+
+	err := a.validateAlive()
+	if err != nil {
+		return err
+	}
+
+	err = validateAnimalGroup(group)
+	if err != nil {
+		return err
+	}
+
+	a.TrackChange(AnimalGroupChanged{
+		AnimalUID: a.UID,
+		Group:     group,
+	})
+
+	return nil
+}
+
+// MoveToPen moves the Animal to another pen area of its farm, or out of any pen when nil.
+func (a *Animal) MoveToPen(animalService AnimalService, penAreaUID *uuid.UUID) error {
+	err := a.validateAlive()
+	if err != nil {
+		return err
+	}
+
+	err = validateAnimalPenArea(animalService, a.FarmUID, penAreaUID)
+	if err != nil {
+		return err
+	}
+
+	a.TrackChange(AnimalMoved{
+		AnimalUID:  a.UID,
+		PenAreaUID: penAreaUID,
+	})
+
+	return nil
+}
+
+func (a *Animal) validateAlive() error {
+	if a.Status == AnimalStatusDead {
+		return AnimalError{AnimalErrorDeadCode}
+	}
+
+	return nil
+}
+
+func validateAnimalDetails(sex string, birthDate *time.Time) error {
+	if sex != AnimalSexFemale && sex != AnimalSexMale && sex != AnimalSexUnknown {
+		return AnimalError{AnimalErrorInvalidSexCode}
+	}
+
+	if birthDate != nil && birthDate.After(time.Now()) {
+		return AnimalError{AnimalErrorInvalidBirthDateCode}
+	}
+
+	return nil
+}
+
+func validateAnimalGroup(group string) error {
+	if len(group) > 100 {
+		return AnimalError{AnimalErrorGroupExceedMaximunCharacterCode}
+	}
+
+	return nil
+}
+
+// validateAnimalTagID checks the tag is not worn by another animal of the farm than the given one.
+func validateAnimalTagID(animalService AnimalService, farmUID, animalUID uuid.UUID, tagID string) error {
+	if tagID == "" {
+		return AnimalError{AnimalErrorTagIDEmptyCode}
+	}
+
+	if len(tagID) > 50 {
+		return AnimalError{AnimalErrorTagIDExceedMaximunCharacterCode}
+	}
+
+	foundUID, err := animalService.FindAnimalByTagID(farmUID, tagID)
+	if err != nil {
+		return err
+	}
+
+	if foundUID != (uuid.UUID{}) && foundUID != animalUID {
+		return AnimalError{AnimalErrorTagIDAlreadyExistsCode}
+	}
+
+	return nil
+}
+
+func validateAnimalPenArea(animalService AnimalService, farmUID uuid.UUID, penAreaUID *uuid.UUID) error {
+	if penAreaUID == nil {
+		return nil
+	}
+
+	area, err := animalService.FindAreaByID(*penAreaUID)
+	if err != nil {
+		return err
+	}
+
+	if area.FarmUID != farmUID {
+		return AnimalError{AnimalErrorPenAreaNotFoundCode}
+	}
+
+	return nil
+}
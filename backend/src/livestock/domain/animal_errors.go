@@ -0,0 +1,83 @@
+package domain
+
+const (
+	AnimalErrorFarmNotFoundCode = iota
+	AnimalErrorFarmNotLivestockCode
+	AnimalErrorInvalidSpeciesCode
+	AnimalErrorTagIDEmptyCode
+	AnimalErrorTagIDExceedMaximunCharacterCode
+	AnimalErrorTagIDAlreadyExistsCode
+	AnimalErrorInvalidSexCode
+	AnimalErrorInvalidBirthDateCode
+	AnimalErrorGroupExceedMaximunCharacterCode
+	AnimalErrorPenAreaNotFoundCode
+	AnimalErrorDeadCode
+
+	AnimalErrorInvalidRecordDateCode
+	AnimalErrorInvalidWeightCode
+	AnimalErrorInvalidUnitCode
+	AnimalErrorTreatmentEmptyCode
+	AnimalErrorMaterialNotFoundCode
+	AnimalErrorInvalidMaterialTypeCode
+	AnimalErrorMaterialExpiredCode
+	AnimalErrorInvalidQuantityCode
+	AnimalErrorFeedEmptyCode
+	AnimalErrorNotFemaleCode
+	AnimalErrorInsufficientStockCode
+)
+
+// AnimalError is a custom error from Go built-in error.
+type AnimalError struct {
+	Code int
+}
+
+func (e AnimalError) Error() string {
+	switch e.Code {
+	case AnimalErrorFarmNotFoundCode:
+		return "Animal farm is not found."
+	case AnimalErrorFarmNotLivestockCode:
+		return "Animals can only be kept in a livestock farm."
+	case AnimalErrorInvalidSpeciesCode:
+		return "Animal species is invalid."
+	case AnimalErrorTagIDEmptyCode:
+		return "Animal tag ID is required."
+	case AnimalErrorTagIDExceedMaximunCharacterCode:
+		return "Animal tag ID cannot more than 50 characters"
+	case AnimalErrorTagIDAlreadyExistsCode:
+		return "Animal tag ID is already used in the farm."
+	case AnimalErrorInvalidSexCode:
+		return "Animal sex should be female, male or unknown."
+	case AnimalErrorInvalidBirthDateCode:
+		return "Animal birth date cannot be in the future."
+	case AnimalErrorGroupExceedMaximunCharacterCode:
+		return "Animal group cannot more than 100 characters"
+	case AnimalErrorPenAreaNotFoundCode:
+		return "Animal pen area is not found in the farm."
+	case AnimalErrorDeadCode:
+		return "Animal is dead."
+	case AnimalErrorInvalidRecordDateCode:
+		return "Animal record date should be between its birth and today."
+	case AnimalErrorInvalidWeightCode:
+		return "Animal weight should be more than zero."
+	case AnimalErrorInvalidUnitCode:
+		return "Unit should be Gr, Kg, lb or oz."
+	case AnimalErrorTreatmentEmptyCode:
+		return "Animal treatment is required."
+	case AnimalErrorMaterialNotFoundCode:
+		return "Treatment material is not found."
+	case AnimalErrorInvalidMaterialTypeCode:
+		return "Treatment material should be a veterinary agrochemical."
+	case AnimalErrorMaterialExpiredCode:
+		return "Treatment material is expired."
+	case AnimalErrorInvalidQuantityCode:
+		return "Quantity should be more than zero."
+	case AnimalErrorFeedEmptyCode:
+		return "Animal feed is required."
+	case AnimalErrorNotFemaleCode:
+		return "Only a female animal can give birth."
+	case AnimalErrorInsufficientStockCode:
+		return "Treatment quantity is more than the material stock left."
+	default:
+		return "Unrecognized Animal Error Code"
+	}
+}
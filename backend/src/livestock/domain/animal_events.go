@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+type AnimalCreated struct {
+	UID         uuid.UUID
+	FarmUID     uuid.UUID
+	Species     string
+	TagID       string
+	Sex         string
+	BirthDate   *time.Time
+	Group       string
+	PenAreaUID  *uuid.UUID
+	MotherUID   *uuid.UUID
+	CreatedDate time.Time
+}
+
+type AnimalDetailsChanged struct {
+	AnimalUID uuid.UUID
+	TagID     string
+	Sex       string
+	BirthDate *time.Time
+}
+
+type AnimalGroupChanged struct {
+	AnimalUID uuid.UUID
+	Group     string
+}
+
+type AnimalMoved struct {
+	AnimalUID  uuid.UUID
+	PenAreaUID *uuid.UUID
+}
+
+type AnimalWeighed struct {
+	AnimalUID   uuid.UUID
+	WeightUID   uuid.UUID
+	Weight      float32
+	Unit        string
+	WeighedDate time.Time
+	Notes       string
+}
+
+// AnimalTreated is a health treatment of the animal. The material quantity is taken out of the stock
+// by the assets module, unless the treatment comes from a completed task.
+type AnimalTreated struct {
+	AnimalUID    uuid.UUID
+	TreatmentUID uuid.UUID
+	Treatment    string
+	MaterialUID  *uuid.UUID
+	Quantity     float32
+	QuantityUnit string
+	TaskUID      *uuid.UUID
+	TreatedDate  time.Time
+	Notes        string
+}
+
+type AnimalFed struct {
+	AnimalUID  uuid.UUID
+	FeedingUID uuid.UUID
+	Feed       string
+	Quantity   float32
+	Unit       string
+	FedDate    time.Time
+	Notes      string
+}
+
+// AnimalGaveBirth is recorded on the mother, the offspring being created with its own AnimalCreated.
+type AnimalGaveBirth struct {
+	AnimalUID    uuid.UUID
+	OffspringUID uuid.UUID
+	BirthDate    time.Time
+}
+
+type AnimalDied struct {
+	AnimalUID uuid.UUID
+	DeathDate time.Time
+	Cause     string
+}
@@ -0,0 +1,388 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/helper/datehelper"
+	"github.com/usetania/tania-core/src/helper/unithelper"
+)
+
+// AnimalWeight is a weighing of the animal.
+type AnimalWeight struct {
+	UID         uuid.UUID `json:"uid"`
+	Weight      float32   `json:"weight"`
+	Unit        string    `json:"unit"`
+	WeighedDate time.Time `json:"weighed_date"`
+	Notes       string    `json:"notes"`
+}
+
+// AnimalTreatment is a health treatment given to the animal, by hand or by completing a task,
+// with the quantity of the veterinary material it used if any.
+type AnimalTreatment struct {
+	UID          uuid.UUID  `json:"uid"`
+	Treatment    string     `json:"treatment"`
+	MaterialUID  *uuid.UUID `json:"material_id"`
+	Quantity     float32    `json:"quantity"`
+	QuantityUnit string     `json:"quantity_unit"`
+	TaskUID      *uuid.UUID `json:"task_id"`
+	TreatedDate  time.Time  `json:"treated_date"`
+	Notes        string     `json:"notes"`
+}
+
+// AnimalFeeding is a ration of feed given to the animal.
+type AnimalFeeding struct {
+	UID      uuid.UUID `json:"uid"`
+	Feed     string    `json:"feed"`
+	Quantity float32   `json:"quantity"`
+	Unit     string    `json:"unit"`
+	FedDate  time.Time `json:"fed_date"`
+	Notes    string    `json:"notes"`
+}
+
+// The veterinary drugs are the agrochemicals of the veterinary chemical type.
+const (
+	materialTypeAgrochemical = "AGROCHEMICAL"
+	chemicalTypeVeterinary   = "VETERINARY"
+)
+
+func (a *Animal) transitionRecord(event interface{}) {
+	switch e := event.(type) {
+	case AnimalWeighed:
+		a.Weights = append(a.Weights, AnimalWeight{
+			UID:         e.WeightUID,
+			Weight:      e.Weight,
+			Unit:        e.Unit,
+			WeighedDate: e.WeighedDate,
+			Notes:       e.Notes,
+		})
+
+		sort.SliceStable(a.Weights, func(i, j int) bool {
+			return a.Weights[i].WeighedDate.Before(a.Weights[j].WeighedDate)
+		})
+
+	case AnimalTreated:
+		a.Treatments = append(a.Treatments, AnimalTreatment{
+			UID:          e.TreatmentUID,
+			Treatment:    e.Treatment,
+			MaterialUID:  e.MaterialUID,
+			Quantity:     e.Quantity,
+			QuantityUnit: e.QuantityUnit,
+			TaskUID:      e.TaskUID,
+			TreatedDate:  e.TreatedDate,
+			Notes:        e.Notes,
+		})
+
+		sort.SliceStable(a.Treatments, func(i, j int) bool {
+			return a.Treatments[i].TreatedDate.Before(a.Treatments[j].TreatedDate)
+		})
+
+	case AnimalFed:
+		a.Feedings = append(a.Feedings, AnimalFeeding{
+			UID:      e.FeedingUID,
+			Feed:     e.Feed,
+			Quantity: e.Quantity,
+			Unit:     e.Unit,
+			FedDate:  e.FedDate,
+			Notes:    e.Notes,
+		})
+
+		sort.SliceStable(a.Feedings, func(i, j int) bool {
+			return a.Feedings[i].FedDate.Before(a.Feedings[j].FedDate)
+		})
+
+	case AnimalGaveBirth:
+		a.OffspringUIDs = append(a.OffspringUIDs, e.OffspringUID)
+
+	case AnimalDied:
+		deathDate := e.DeathDate
+
+		a.Status = AnimalStatusDead
+		a.DeathDate = &deathDate
+		a.DeathCause = e.Cause
+	}
+}
+
+// LatestWeight is the last weighing of the Animal, or nil when it was never weighed.
+func (a *Animal) LatestWeight() *AnimalWeight {
+	if len(a.Weights) == 0 {
+		return nil
+	}
+
+	weight := a.Weights[len(a.Weights)-1]
+
+	return &weight
+}
+
+// RecordWeight records the weight of the Animal on the given date, in grams, kilograms, pounds or ounces.
+func (a *Animal) RecordWeight(weight float32, unit string, weighedDate time.Time, notes string) (AnimalWeight, error) {
+	err := a.validateRecordDate(weighedDate)
+	if err != nil {
+		return AnimalWeight{}, err
+	}
+
+	if weight <= 0 {
+		return AnimalWeight{}, AnimalError{AnimalErrorInvalidWeightCode}
+	}
+
+	err = validateWeightUnit(unit)
+	if err != nil {
+		return AnimalWeight{}, err
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return AnimalWeight{}, err
+	}
+
+	event := AnimalWeighed{
+		AnimalUID:   a.UID,
+		WeightUID:   uid,
+		Weight:      weight,
+		Unit:        unit,
+		WeighedDate: weighedDate,
+		Notes:       strings.TrimSpace(notes),
+	}
+
+	a.TrackChange(event)
+
+	return AnimalWeight{
+		UID:         event.WeightUID,
+		Weight:      event.Weight,
+		Unit:        event.Unit,
+		WeighedDate: event.WeighedDate,
+		Notes:       event.Notes,
+	}, nil
+}
+
+// RecordTreatment records a health treatment given to the Animal on the given date.
+// A veterinary material can be given with the quantity used, which is then taken out of its stock
+// unless the treatment was done by completing the task, which already used it. Otherwise the stock
+// left should cover the quantity.
+func (a *Animal) RecordTreatment(
+	animalService AnimalService,
+	treatment string,
+	materialUID *uuid.UUID,
+	quantity float32,
+	treatedDate time.Time,
+	taskUID *uuid.UUID,
+	notes string,
+) (AnimalTreatment, error) {
+	treatment = strings.TrimSpace(treatment)
+
+	err := a.validateRecordDate(treatedDate)
+	if err != nil {
+		return AnimalTreatment{}, err
+	}
+
+	if treatment == "" {
+		return AnimalTreatment{}, AnimalError{AnimalErrorTreatmentEmptyCode}
+	}
+
+	quantityUnit := ""
+
+	if materialUID == nil {
+		quantity = 0
+	} else {
+		material, err := animalService.FindMaterialByID(*materialUID)
+		if err != nil {
+			return AnimalTreatment{}, err
+		}
+
+		err = validateVeterinaryMaterial(material, treatedDate)
+		if err != nil {
+			return AnimalTreatment{}, err
+		}
+
+		if quantity <= 0 {
+			return AnimalTreatment{}, AnimalError{AnimalErrorInvalidQuantityCode}
+		}
+
+		if taskUID == nil && quantity > material.Quantity {
+			return AnimalTreatment{}, AnimalError{AnimalErrorInsufficientStockCode}
+		}
+
+		quantityUnit = material.QuantityUnit
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return AnimalTreatment{}, err
+	}
+
+	event := AnimalTreated{
+		AnimalUID:    a.UID,
+		TreatmentUID: uid,
+		Treatment:    treatment,
+		MaterialUID:  materialUID,
+		Quantity:     quantity,
+		QuantityUnit: quantityUnit,
+		TaskUID:      taskUID,
+		TreatedDate:  treatedDate,
+		Notes:        strings.TrimSpace(notes),
+	}
+
+	a.TrackChange(event)
+
+	return AnimalTreatment{
+		UID:          event.TreatmentUID,
+		Treatment:    event.Treatment,
+		MaterialUID:  event.MaterialUID,
+		Quantity:     event.Quantity,
+		QuantityUnit: event.QuantityUnit,
+		TaskUID:      event.TaskUID,
+		TreatedDate:  event.TreatedDate,
+		Notes:        event.Notes,
+	}, nil
+}
+
+// RecordFeeding records a ration of feed given to the Animal on the given date,
+// in grams, kilograms, pounds or ounces.
+func (a *Animal) RecordFeeding(
+	feed string,
+	quantity float32,
+	unit string,
+	fedDate time.Time,
+	notes string,
+) (AnimalFeeding, error) {
+	feed = strings.TrimSpace(feed)
+
+	err := a.validateRecordDate(fedDate)
+	if err != nil {
+		return AnimalFeeding{}, err
+	}
+
+	if feed == "" {
+		return AnimalFeeding{}, AnimalError{AnimalErrorFeedEmptyCode}
+	}
+
+	if quantity <= 0 {
+		return AnimalFeeding{}, AnimalError{AnimalErrorInvalidQuantityCode}
+	}
+
+	err = validateWeightUnit(unit)
+	if err != nil {
+		return AnimalFeeding{}, err
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return AnimalFeeding{}, err
+	}
+
+	event := AnimalFed{
+		AnimalUID:  a.UID,
+		FeedingUID: uid,
+		Feed:       feed,
+		Quantity:   quantity,
+		Unit:       unit,
+		FedDate:    fedDate,
+		Notes:      strings.TrimSpace(notes),
+	}
+
+	a.TrackChange(event)
+
+	return AnimalFeeding{
+		UID:      event.FeedingUID,
+		Feed:     event.Feed,
+		Quantity: event.Quantity,
+		Unit:     event.Unit,
+		FedDate:  event.FedDate,
+		Notes:    event.Notes,
+	}, nil
+}
+
+// RecordBirth registers the offspring born to the Animal on the given date, of the species of its mother.
+// The offspring joins the group and the pen area of its mother unless others are given.
+func (a *Animal) RecordBirth(
+	animalService AnimalService,
+	tagID, sex string,
+	birthDate time.Time,
+	group string,
+	penAreaUID *uuid.UUID,
+) (*Animal, error) {
+	err := a.validateRecordDate(birthDate)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.Sex != AnimalSexFemale {
+		return nil, AnimalError{AnimalErrorNotFemaleCode}
+	}
+
+	if strings.TrimSpace(group) == "" {
+		group = a.Group
+	}
+
+	if penAreaUID == nil {
+		penAreaUID = a.PenAreaUID
+	}
+
+	offspring, err := createAnimal(animalService, a.FarmUID, a.Species, tagID, sex, &birthDate, group, penAreaUID, &a.UID)
+	if err != nil {
+		return nil, err
+	}
+
+	a.TrackChange(AnimalGaveBirth{
+		AnimalUID:    a.UID,
+		OffspringUID: offspring.UID,
+		BirthDate:    birthDate,
+	})
+
+	return offspring, nil
+}
+
+// RecordDeath records the death of the Animal, which cannot be changed anymore.
+func (a *Animal) RecordDeath(deathDate time.Time, cause string) error {
+	err := a.validateRecordDate(deathDate)
+	if err != nil {
+		return err
+	}
+
+	a.TrackChange(AnimalDied{
+		AnimalUID: a.UID,
+		DeathDate: deathDate,
+		Cause:     strings.TrimSpace(cause),
+	})
+
+	return nil
+}
+
+// validateRecordDate checks a record of the Animal is between its birth and today, while it is alive.
+func (a *Animal) validateRecordDate(date time.Time) error {
+	err := a.validateAlive()
+	if err != nil {
+		return err
+	}
+
+	if date.After(time.Now()) || (a.BirthDate != nil && date.Before(*a.BirthDate)) {
+		return AnimalError{AnimalErrorInvalidRecordDateCode}
+	}
+
+	return nil
+}
+
+func validateWeightUnit(unit string) error {
+	_, err := unithelper.ToGrams(1, unit)
+	if err != nil {
+		return AnimalError{AnimalErrorInvalidUnitCode}
+	}
+
+	return nil
+}
+
+// validateVeterinaryMaterial checks the material is a veterinary agrochemical which has not expired before
+// the given day. It can still be used on its expiration day.
+func validateVeterinaryMaterial(material AnimalMaterialServiceResult, date time.Time) error {
+	if material.TypeCode != materialTypeAgrochemical || material.ChemicalTypeCode != chemicalTypeVeterinary {
+		return AnimalError{AnimalErrorInvalidMaterialTypeCode}
+	}
+
+	if datehelper.IsExpired(material.ExpirationDate, date) {
+		return AnimalError{AnimalErrorMaterialExpiredCode}
+	}
+
+	return nil
+}
@@ -0,0 +1,204 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	. "github.com/usetania/tania-core/src/livestock/domain"
+)
+
+type AnimalServiceMock struct {
+	mock.Mock
+}
+
+func (m *AnimalServiceMock) FindFarmByID(uid uuid.UUID) (AnimalFarmServiceResult, error) {
+	args := m.Called(uid)
+
+	return args.Get(0).(AnimalFarmServiceResult), nil
+}
+
+func (m *AnimalServiceMock) FindAreaByID(uid uuid.UUID) (AnimalAreaServiceResult, error) {
+	args := m.Called(uid)
+
+	return args.Get(0).(AnimalAreaServiceResult), nil
+}
+
+func (m *AnimalServiceMock) FindMaterialByID(uid uuid.UUID) (AnimalMaterialServiceResult, error) {
+	args := m.Called(uid)
+
+	return args.Get(0).(AnimalMaterialServiceResult), nil
+}
+
+func (m *AnimalServiceMock) FindAnimalByTagID(farmUID uuid.UUID, tagID string) (uuid.UUID, error) {
+	args := m.Called(farmUID, tagID)
+
+	return args.Get(0).(uuid.UUID), nil
+}
+
+func TestCreateAnimal(t *testing.T) {
+	t.Parallel()
+	// Given
+	farmUID, _ := uuid.NewV4()
+	plantFarmUID, _ := uuid.NewV4()
+	penUID, _ := uuid.NewV4()
+	otherPenUID, _ := uuid.NewV4()
+	taggedUID, _ := uuid.NewV4()
+
+	serviceMock := new(AnimalServiceMock)
+	serviceMock.On("FindFarmByID", farmUID).Return(AnimalFarmServiceResult{UID: farmUID, Type: FarmTypeLivestock})
+	serviceMock.On("FindFarmByID", plantFarmUID).Return(AnimalFarmServiceResult{UID: plantFarmUID, Type: "organic"})
+	serviceMock.On("FindAreaByID", penUID).Return(AnimalAreaServiceResult{UID: penUID, FarmUID: farmUID})
+	serviceMock.On("FindAreaByID", otherPenUID).Return(AnimalAreaServiceResult{UID: otherPenUID, FarmUID: plantFarmUID})
+	serviceMock.On("FindAnimalByTagID", farmUID, "TAG-1").Return(uuid.UUID{})
+	serviceMock.On("FindAnimalByTagID", farmUID, "TAG-2").Return(taggedUID)
+
+	birthDate := time.Date(2019, time.January, 10, 0, 0, 0, 0, time.UTC)
+	tomorrow := time.Now().AddDate(0, 0, 1)
+
+	// When
+	animal, err := CreateAnimal(serviceMock, farmUID, AnimalSpeciesCattle, " TAG-1 ", "", &birthDate, "Dairy", &penUID)
+	_, errSpecies := CreateAnimal(serviceMock, farmUID, "DRAGON", "TAG-1", "", nil, "", nil)
+	_, errTagEmpty := CreateAnimal(serviceMock, farmUID, AnimalSpeciesCattle, "", "", nil, "", nil)
+	_, errTagUsed := CreateAnimal(serviceMock, farmUID, AnimalSpeciesCattle, "TAG-2", "", nil, "", nil)
+	_, errSex := CreateAnimal(serviceMock, farmUID, AnimalSpeciesCattle, "TAG-1", "BOTH", nil, "", nil)
+	_, errBirthDate := CreateAnimal(serviceMock, farmUID, AnimalSpeciesCattle, "TAG-1", "", &tomorrow, "", nil)
+	_, errFarmType := CreateAnimal(serviceMock, plantFarmUID, AnimalSpeciesCattle, "TAG-1", "", nil, "", nil)
+	_, errPen := CreateAnimal(serviceMock, farmUID, AnimalSpeciesCattle, "TAG-1", "", nil, "", &otherPenUID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "TAG-1", animal.TagID)
+	assert.Equal(t, AnimalSexUnknown, animal.Sex)
+	assert.Equal(t, AnimalStatusAlive, animal.Status)
+	assert.Equal(t, &penUID, animal.PenAreaUID)
+
+	event, ok := animal.UncommittedChanges[0].(AnimalCreated)
+	assert.True(t, ok)
+	assert.Equal(t, animal.UID, event.UID)
+
+	assert.Equal(t, AnimalError{AnimalErrorInvalidSpeciesCode}, errSpecies)
+	assert.Equal(t, AnimalError{AnimalErrorTagIDEmptyCode}, errTagEmpty)
+	assert.Equal(t, AnimalError{AnimalErrorTagIDAlreadyExistsCode}, errTagUsed)
+	assert.Equal(t, AnimalError{AnimalErrorInvalidSexCode}, errSex)
+	assert.Equal(t, AnimalError{AnimalErrorInvalidBirthDateCode}, errBirthDate)
+	assert.Equal(t, AnimalError{AnimalErrorFarmNotLivestockCode}, errFarmType)
+	assert.Equal(t, AnimalError{AnimalErrorPenAreaNotFoundCode}, errPen)
+
+	// When
+	errDetails := animal.ChangeDetails(serviceMock, "TAG-1", AnimalSexFemale, &birthDate)
+	errGroup := animal.ChangeGroup("Heifers")
+	errMove := animal.MoveToPen(serviceMock, nil)
+
+	// Then
+	assert.Nil(t, errDetails)
+	assert.Nil(t, errGroup)
+	assert.Nil(t, errMove)
+	assert.Equal(t, AnimalSexFemale, animal.Sex)
+	assert.Equal(t, "Heifers", animal.Group)
+	assert.Nil(t, animal.PenAreaUID)
+	assert.Len(t, animal.UncommittedChanges, 4)
+}
+
+func TestAnimalRecords(t *testing.T) {
+	t.Parallel()
+	// Given
+	farmUID, _ := uuid.NewV4()
+	drugUID, _ := uuid.NewV4()
+	seedUID, _ := uuid.NewV4()
+	pesticideUID, _ := uuid.NewV4()
+	taskUID, _ := uuid.NewV4()
+	expiredUID, _ := uuid.NewV4()
+
+	expirationDate := time.Date(2019, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	serviceMock := new(AnimalServiceMock)
+	serviceMock.On("FindFarmByID", farmUID).Return(AnimalFarmServiceResult{UID: farmUID, Type: FarmTypeLivestock})
+	serviceMock.On("FindAnimalByTagID", farmUID, "CALF-1").Return(uuid.UUID{})
+	serviceMock.On("FindMaterialByID", drugUID).
+		Return(AnimalMaterialServiceResult{
+			UID: drugUID, TypeCode: "AGROCHEMICAL", ChemicalTypeCode: "VETERINARY", Quantity: 20, QuantityUnit: "MILLILITER",
+		})
+	serviceMock.On("FindMaterialByID", seedUID).Return(AnimalMaterialServiceResult{UID: seedUID, TypeCode: "SEED"})
+	serviceMock.On("FindMaterialByID", pesticideUID).Return(AnimalMaterialServiceResult{
+		UID: pesticideUID, TypeCode: "AGROCHEMICAL", ChemicalTypeCode: "PESTICIDE",
+	})
+	serviceMock.On("FindMaterialByID", expiredUID).Return(AnimalMaterialServiceResult{
+		UID: expiredUID, TypeCode: "AGROCHEMICAL", ChemicalTypeCode: "VETERINARY", ExpirationDate: &expirationDate,
+	})
+
+	birthDate := time.Date(2019, time.January, 10, 0, 0, 0, 0, time.UTC)
+
+	animal := &Animal{}
+	animal.Transition(AnimalCreated{
+		FarmUID:   farmUID,
+		Species:   AnimalSpeciesGoat,
+		Sex:       AnimalSexFemale,
+		BirthDate: &birthDate,
+		Group:     "Milking",
+	})
+
+	// When
+	_, err := animal.RecordWeight(42.5, "Kg", time.Date(2019, time.June, 1, 0, 0, 0, 0, time.UTC), "")
+	_, errEarlier := animal.RecordWeight(30, "Kg", time.Date(2019, time.April, 1, 0, 0, 0, 0, time.UTC), "")
+	_, errUnit := animal.RecordWeight(30, "stone", time.Now(), "")
+	_, errBeforeBirth := animal.RecordWeight(3, "Kg", time.Date(2018, time.December, 1, 0, 0, 0, 0, time.UTC), "")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Nil(t, errEarlier)
+	assert.Equal(t, float32(42.5), animal.LatestWeight().Weight)
+	assert.Equal(t, AnimalError{AnimalErrorInvalidUnitCode}, errUnit)
+	assert.Equal(t, AnimalError{AnimalErrorInvalidRecordDateCode}, errBeforeBirth)
+
+	// When
+	treatedDate := time.Date(2019, time.March, 2, 0, 0, 0, 0, time.UTC)
+
+	treatment, err := animal.RecordTreatment(serviceMock, "Deworming", &drugUID, 5, treatedDate, nil, "")
+	_, errNoMaterial := animal.RecordTreatment(serviceMock, "Hoof trimming", nil, 5, treatedDate, nil, "")
+	_, errMaterialType := animal.RecordTreatment(serviceMock, "Deworming", &seedUID, 5, treatedDate, nil, "")
+	_, errChemicalType := animal.RecordTreatment(serviceMock, "Deworming", &pesticideUID, 5, treatedDate, nil, "")
+	_, errExpired := animal.RecordTreatment(serviceMock, "Deworming", &expiredUID, 5, treatedDate, nil, "")
+	_, errQuantity := animal.RecordTreatment(serviceMock, "Deworming", &drugUID, 0, treatedDate, nil, "")
+	_, errStock := animal.RecordTreatment(serviceMock, "Deworming", &drugUID, 25, treatedDate, nil, "")
+	taskTreatment, errTask := animal.RecordTreatment(serviceMock, "Deworming", &drugUID, 25, treatedDate, &taskUID, "")
+	_, errFeeding := animal.RecordFeeding("Hay", 2, "Kg", treatedDate, "")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "MILLILITER", treatment.QuantityUnit)
+	assert.Nil(t, errNoMaterial)
+	assert.Len(t, animal.Treatments, 3)
+	assert.Equal(t, float32(0), animal.Treatments[1].Quantity)
+	assert.Equal(t, AnimalError{AnimalErrorInvalidMaterialTypeCode}, errMaterialType)
+	assert.Equal(t, AnimalError{AnimalErrorInvalidMaterialTypeCode}, errChemicalType)
+	assert.Equal(t, AnimalError{AnimalErrorMaterialExpiredCode}, errExpired)
+	assert.Equal(t, AnimalError{AnimalErrorInvalidQuantityCode}, errQuantity)
+	assert.Equal(t, AnimalError{AnimalErrorInsufficientStockCode}, errStock)
+	assert.Nil(t, errTask)
+	assert.Equal(t, float32(25), taskTreatment.Quantity)
+	assert.Nil(t, errFeeding)
+
+	// When
+	offspring, err := animal.RecordBirth(serviceMock, "CALF-1", AnimalSexMale, time.Now(), "", nil)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, AnimalSpeciesGoat, offspring.Species)
+	assert.Equal(t, "Milking", offspring.Group)
+	assert.Equal(t, &animal.UID, offspring.MotherUID)
+	assert.Equal(t, []uuid.UUID{offspring.UID}, animal.OffspringUIDs)
+
+	// When
+	_, errNotFemale := offspring.RecordBirth(serviceMock, "CALF-2", "", time.Now(), "", nil)
+	errDeath := animal.RecordDeath(time.Now(), "Old age")
+	errAfterDeath := animal.ChangeGroup("Culled")
+
+	// Then
+	assert.Equal(t, AnimalError{AnimalErrorNotFemaleCode}, errNotFemale)
+	assert.Nil(t, errDeath)
+	assert.Equal(t, AnimalStatusDead, animal.Status)
+	assert.Equal(t, AnimalError{AnimalErrorDeadCode}, errAfterDeath)
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/livestock/domain"
+	"github.com/usetania/tania-core/src/livestock/query"
+	"github.com/usetania/tania-core/src/livestock/storage"
+)
+
+type AnimalServiceInMemory struct {
+	FarmReadQuery     query.FarmReadQuery
+	AreaReadQuery     query.AreaReadQuery
+	MaterialReadQuery query.MaterialReadQuery
+	AnimalReadQuery   query.AnimalReadQuery
+}
+
+func (s AnimalServiceInMemory) FindFarmByID(uid uuid.UUID) (domain.AnimalFarmServiceResult, error) {
+	result := <-s.FarmReadQuery.FindByID(uid)
+
+	if result.Error != nil {
+		return domain.AnimalFarmServiceResult{}, result.Error
+	}
+
+	farm, ok := result.Result.(query.AnimalFarmQueryResult)
+
+	if !ok || farm.UID == (uuid.UUID{}) {
+		return domain.AnimalFarmServiceResult{}, domain.AnimalError{Code: domain.AnimalErrorFarmNotFoundCode}
+	}
+
+	return domain.AnimalFarmServiceResult{
+		UID:  farm.UID,
+		Name: farm.Name,
+		Type: farm.Type,
+	}, nil
+}
+
+func (s AnimalServiceInMemory) FindAreaByID(uid uuid.UUID) (domain.AnimalAreaServiceResult, error) {
+	result := <-s.AreaReadQuery.FindByID(uid)
+
+	if result.Error != nil {
+		return domain.AnimalAreaServiceResult{}, result.Error
+	}
+
+	area, ok := result.Result.(query.AnimalAreaQueryResult)
+
+	if !ok || area.UID == (uuid.UUID{}) {
+		return domain.AnimalAreaServiceResult{}, domain.AnimalError{Code: domain.AnimalErrorPenAreaNotFoundCode}
+	}
+
+	return domain.AnimalAreaServiceResult{
+		UID:     area.UID,
+		Name:    area.Name,
+		FarmUID: area.FarmUID,
+	}, nil
+}
+
+func (s AnimalServiceInMemory) FindMaterialByID(uid uuid.UUID) (domain.AnimalMaterialServiceResult, error) {
+	result := <-s.MaterialReadQuery.FindByID(uid)
+
+	if result.Error != nil {
+		return domain.AnimalMaterialServiceResult{}, result.Error
+	}
+
+	material, ok := result.Result.(query.AnimalMaterialQueryResult)
+
+	if !ok || material.UID == (uuid.UUID{}) {
+		return domain.AnimalMaterialServiceResult{}, domain.AnimalError{Code: domain.AnimalErrorMaterialNotFoundCode}
+	}
+
+	return domain.AnimalMaterialServiceResult{
+		UID:              material.UID,
+		Name:             material.Name,
+		TypeCode:         material.TypeCode,
+		ChemicalTypeCode: material.ChemicalTypeCode,
+		Quantity:         material.Quantity,
+		QuantityUnit:     material.QuantityUnit,
+		ExpirationDate:   material.ExpirationDate,
+	}, nil
+}
+
+func (s AnimalServiceInMemory) FindAnimalByTagID(farmUID uuid.UUID, tagID string) (uuid.UUID, error) {
+	result := <-s.AnimalReadQuery.FindByTagID(farmUID, tagID)
+
+	if result.Error != nil {
+		return uuid.UUID{}, result.Error
+	}
+
+	animal, ok := result.Result.(storage.AnimalRead)
+	if !ok {
+		return uuid.UUID{}, nil
+	}
+
+	return animal.UID, nil
+}
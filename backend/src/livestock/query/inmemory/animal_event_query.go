@@ -0,0 +1,44 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/livestock/query"
+	"github.com/usetania/tania-core/src/livestock/storage"
+)
+
+type AnimalEventQueryInMemory struct {
+	Storage *storage.AnimalEventStorage
+}
+
+func NewAnimalEventQueryInMemory(s *storage.AnimalEventStorage) query.AnimalEventQuery {
+	return &AnimalEventQueryInMemory{Storage: s}
+}
+
+func (f *AnimalEventQueryInMemory) FindAllByID(uid uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		events := []storage.AnimalEvent{}
+
+		for _, v := range f.Storage.AnimalEvents {
+			if v.AnimalUID == uid {
+				events = append(events, v)
+			}
+		}
+
+		sort.Slice(events, func(i, j int) bool {
+			return events[i].Version < events[j].Version
+		})
+
+		result <- query.Result{Result: events}
+
+		close(result)
+	}()
+
+	return result
+}
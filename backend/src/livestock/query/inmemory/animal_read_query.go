@@ -0,0 +1,92 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/livestock/query"
+	"github.com/usetania/tania-core/src/livestock/storage"
+)
+
+type AnimalReadQueryInMemory struct {
+	Storage *storage.AnimalReadStorage
+}
+
+func NewAnimalReadQueryInMemory(s *storage.AnimalReadStorage) query.AnimalReadQuery {
+	return AnimalReadQueryInMemory{Storage: s}
+}
+
+func (q AnimalReadQueryInMemory) FindByID(uid uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		q.Storage.Lock.RLock()
+		defer q.Storage.Lock.RUnlock()
+
+		result <- query.Result{Result: q.Storage.AnimalReadMap[uid]}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (q AnimalReadQueryInMemory) FindByTagID(farmUID uuid.UUID, tagID string) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		q.Storage.Lock.RLock()
+		defer q.Storage.Lock.RUnlock()
+
+		animal := storage.AnimalRead{}
+
+		for _, val := range q.Storage.AnimalReadMap {
+			if val.Farm.UID == farmUID && val.TagID == tagID {
+				animal = val
+
+				break
+			}
+		}
+
+		result <- query.Result{Result: animal}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (q AnimalReadQueryInMemory) FindAllByFarm(farmUID uuid.UUID, status, group, species string) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		q.Storage.Lock.RLock()
+		defer q.Storage.Lock.RUnlock()
+
+		animals := []storage.AnimalRead{}
+
+		for _, val := range q.Storage.AnimalReadMap {
+			if val.Farm.UID != farmUID {
+				continue
+			}
+
+			if (status != "" && val.Status != status) ||
+				(group != "" && val.Group != group) ||
+				(species != "" && val.Species != species) {
+				continue
+			}
+
+			animals = append(animals, val)
+		}
+
+		sort.Slice(animals, func(i, j int) bool {
+			return animals[i].TagID < animals[j].TagID
+		})
+
+		result <- query.Result{Result: animals}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,38 @@
+package inmemory
+
+import (
+	"github.com/gofrs/uuid"
+	assetsstorage "github.com/usetania/tania-core/src/assets/storage"
+	"github.com/usetania/tania-core/src/livestock/query"
+)
+
+type AreaReadQueryInMemory struct {
+	Storage *assetsstorage.AreaReadStorage
+}
+
+func NewAreaReadQueryInMemory(s *assetsstorage.AreaReadStorage) query.AreaReadQuery {
+	return AreaReadQueryInMemory{Storage: s}
+}
+
+func (s AreaReadQueryInMemory) FindByID(uid uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		area := query.AnimalAreaQueryResult{}
+
+		if val, ok := s.Storage.AreaReadMap[uid]; ok {
+			area.UID = val.UID
+			area.Name = val.Name
+			area.FarmUID = val.Farm.UID
+		}
+
+		result <- query.Result{Result: area}
+
+		close(result)
+	}()
+
+	return result
+}
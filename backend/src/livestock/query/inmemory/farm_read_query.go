@@ -0,0 +1,38 @@
+package inmemory
+
+import (
+	"github.com/gofrs/uuid"
+	assetsstorage "github.com/usetania/tania-core/src/assets/storage"
+	"github.com/usetania/tania-core/src/livestock/query"
+)
+
+type FarmReadQueryInMemory struct {
+	Storage *assetsstorage.FarmReadStorage
+}
+
+func NewFarmReadQueryInMemory(s *assetsstorage.FarmReadStorage) query.FarmReadQuery {
+	return FarmReadQueryInMemory{Storage: s}
+}
+
+func (s FarmReadQueryInMemory) FindByID(uid uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		farm := query.AnimalFarmQueryResult{}
+
+		if val, ok := s.Storage.FarmReadMap[uid]; ok {
+			farm.UID = val.UID
+			farm.Name = val.Name
+			farm.Type = val.Type
+		}
+
+		result <- query.Result{Result: farm}
+
+		close(result)
+	}()
+
+	return result
+}
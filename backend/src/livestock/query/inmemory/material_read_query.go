@@ -0,0 +1,46 @@
+package inmemory
+
+import (
+	"github.com/gofrs/uuid"
+	assetsdomain "github.com/usetania/tania-core/src/assets/domain"
+	assetsstorage "github.com/usetania/tania-core/src/assets/storage"
+	"github.com/usetania/tania-core/src/livestock/query"
+)
+
+type MaterialReadQueryInMemory struct {
+	Storage *assetsstorage.MaterialReadStorage
+}
+
+func NewMaterialReadQueryInMemory(s *assetsstorage.MaterialReadStorage) query.MaterialReadQuery {
+	return MaterialReadQueryInMemory{Storage: s}
+}
+
+func (s MaterialReadQueryInMemory) FindByID(uid uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		material := query.AnimalMaterialQueryResult{}
+
+		if val, ok := s.Storage.MaterialReadMap[uid]; ok {
+			material.UID = val.UID
+			material.Name = val.Name
+			material.TypeCode = val.Type.Code()
+			material.Quantity = val.Quantity.Value
+			material.QuantityUnit = val.Quantity.Unit.Code
+			material.ExpirationDate = val.ExpirationDate
+
+			if agrochemical, ok := val.Type.(assetsdomain.MaterialTypeAgrochemical); ok {
+				material.ChemicalTypeCode = agrochemical.ChemicalType.Code
+			}
+		}
+
+		result <- query.Result{Result: material}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,74 @@
+package mysql
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/livestock/decoder"
+	"github.com/usetania/tania-core/src/livestock/query"
+	"github.com/usetania/tania-core/src/livestock/storage"
+)
+
+type AnimalEventQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewAnimalEventQueryMysql(db *sql.DB) query.AnimalEventQuery {
+	return &AnimalEventQueryMysql{DB: db}
+}
+
+func (f *AnimalEventQueryMysql) FindAllByID(uid uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		events := []storage.AnimalEvent{}
+
+		rows, err := f.DB.Query("SELECT * FROM ANIMAL_EVENT WHERE ANIMAL_UID = ? ORDER BY VERSION ASC", uid.Bytes())
+		if err != nil {
+			result <- query.Result{Error: err}
+		}
+
+		rowsData := struct {
+			ID          int
+			AnimalUID   []byte
+			Version     int
+			CreatedDate time.Time
+			Event       []byte
+		}{}
+
+		for rows.Next() {
+			err := rows.Scan(&rowsData.ID, &rowsData.AnimalUID, &rowsData.Version, &rowsData.CreatedDate, &rowsData.Event)
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			wrapper := decoder.AnimalEventWrapper{}
+
+			err = json.Unmarshal(rowsData.Event, &wrapper)
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			animalUID, err := uuid.FromBytes(rowsData.AnimalUID)
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			createdDate := rowsData.CreatedDate
+
+			events = append(events, storage.AnimalEvent{
+				AnimalUID:   animalUID,
+				Version:     rowsData.Version,
+				CreatedDate: createdDate,
+				Event:       wrapper.Data,
+			})
+		}
+
+		result <- query.Result{Result: events}
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,70 @@
+package mysql
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/livestock/query"
+)
+
+type AreaReadQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewAreaReadQueryMysql(db *sql.DB) query.AreaReadQuery {
+	return AreaReadQueryMysql{DB: db}
+}
+
+func (s AreaReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		defer close(result)
+
+		rowsData := struct {
+			UID     []byte
+			Name    string
+			FarmUID []byte
+		}{}
+
+		err := s.DB.QueryRow(`SELECT UID, NAME, FARM_UID FROM AREA_READ WHERE UID = ?`, uid.Bytes()).Scan(
+			&rowsData.UID,
+			&rowsData.Name,
+			&rowsData.FarmUID,
+		)
+		if errors.Is(err, sql.ErrNoRows) {
+			result <- query.Result{Result: query.AnimalAreaQueryResult{}}
+
+			return
+		}
+
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		areaUID, err := uuid.FromBytes(rowsData.UID)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		farmUID, err := uuid.FromBytes(rowsData.FarmUID)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		result <- query.Result{Result: query.AnimalAreaQueryResult{
+			UID:     areaUID,
+			Name:    rowsData.Name,
+			FarmUID: farmUID,
+		}}
+	}()
+
+	return result
+}
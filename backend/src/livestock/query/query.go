@@ -0,0 +1,58 @@
+package query
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+type AnimalEventQuery interface {
+	FindAllByID(animalUID uuid.UUID) <-chan Result
+}
+
+type AnimalReadQuery interface {
+	FindByID(animalUID uuid.UUID) <-chan Result
+	FindByTagID(farmUID uuid.UUID, tagID string) <-chan Result
+
+	// FindAllByFarm finds the animals of the farm by their tag. An empty status, group or species is not filtered.
+	FindAllByFarm(farmUID uuid.UUID, status, group, species string) <-chan Result
+}
+
+type FarmReadQuery interface {
+	FindByID(farmUID uuid.UUID) <-chan Result
+}
+
+type AreaReadQuery interface {
+	FindByID(areaUID uuid.UUID) <-chan Result
+}
+
+type MaterialReadQuery interface {
+	FindByID(materialUID uuid.UUID) <-chan Result
+}
+
+type Result struct {
+	Result interface{}
+	Error  error
+}
+
+type AnimalFarmQueryResult struct {
+	UID  uuid.UUID
+	Name string
+	Type string
+}
+
+type AnimalAreaQueryResult struct {
+	UID     uuid.UUID
+	Name    string
+	FarmUID uuid.UUID
+}
+
+type AnimalMaterialQueryResult struct {
+	UID              uuid.UUID
+	Name             string
+	TypeCode         string
+	ChemicalTypeCode string
+	Quantity         float32
+	QuantityUnit     string
+	ExpirationDate   *time.Time
+}
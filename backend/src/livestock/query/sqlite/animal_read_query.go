@@ -0,0 +1,283 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/livestock/query"
+	"github.com/usetania/tania-core/src/livestock/storage"
+)
+
+type AnimalReadQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewAnimalReadQuerySqlite(db *sql.DB) query.AnimalReadQuery {
+	return AnimalReadQuerySqlite{DB: db}
+}
+
+type animalReadResult struct {
+	UID            string
+	Species        string
+	TagID          string
+	Sex            string
+	BirthDate      sql.NullString
+	Group          string
+	FarmUID        string
+	FarmName       string
+	PenAreaUID     sql.NullString
+	PenAreaName    sql.NullString
+	MotherUID      sql.NullString
+	Status         string
+	Weight         sql.NullFloat64
+	WeightUnit     sql.NullString
+	WeighedDate    sql.NullString
+	OffspringCount int
+	DeathDate      sql.NullString
+	DeathCause     string
+	CreatedDate    string
+}
+
+const animalReadColumns = `UID, SPECIES, TAG_ID, SEX, BIRTH_DATE, ANIMAL_GROUP, FARM_UID, FARM_NAME,
+	PEN_AREA_UID, PEN_AREA_NAME, MOTHER_UID, STATUS, WEIGHT, WEIGHT_UNIT, WEIGHED_DATE, OFFSPRING_COUNT,
+	DEATH_DATE, DEATH_CAUSE, CREATED_DATE`
+
+func (s AnimalReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		defer close(result)
+
+		rows, err := s.DB.Query("SELECT "+animalReadColumns+" FROM ANIMAL_READ WHERE UID = ?", uid)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		animals, err := scanAnimals(rows)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		if len(animals) == 0 {
+			result <- query.Result{Result: storage.AnimalRead{}}
+
+			return
+		}
+
+		result <- query.Result{Result: animals[0]}
+	}()
+
+	return result
+}
+
+func (s AnimalReadQuerySqlite) FindByTagID(farmUID uuid.UUID, tagID string) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		defer close(result)
+
+		rows, err := s.DB.Query("SELECT "+animalReadColumns+" FROM ANIMAL_READ WHERE FARM_UID = ? AND TAG_ID = ?",
+			farmUID, tagID)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		animals, err := scanAnimals(rows)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		if len(animals) == 0 {
+			result <- query.Result{Result: storage.AnimalRead{}}
+
+			return
+		}
+
+		result <- query.Result{Result: animals[0]}
+	}()
+
+	return result
+}
+
+func (s AnimalReadQuerySqlite) FindAllByFarm(farmUID uuid.UUID, status, group, species string) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		defer close(result)
+
+		sql := "SELECT " + animalReadColumns + " FROM ANIMAL_READ WHERE FARM_UID = ?"
+		params := []interface{}{farmUID}
+
+		if status != "" {
+			sql += " AND STATUS = ?"
+
+			params = append(params, status)
+		}
+
+		if group != "" {
+			sql += " AND ANIMAL_GROUP = ?"
+
+			params = append(params, group)
+		}
+
+		if species != "" {
+			sql += " AND SPECIES = ?"
+
+			params = append(params, species)
+		}
+
+		sql += " ORDER BY TAG_ID"
+
+		rows, err := s.DB.Query(sql, params...)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		animals, err := scanAnimals(rows)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		result <- query.Result{Result: animals}
+	}()
+
+	return result
+}
+
+func scanAnimals(rows *sql.Rows) ([]storage.AnimalRead, error) {
+	animals := []storage.AnimalRead{}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		rowsData := animalReadResult{}
+
+		err := rows.Scan(
+			&rowsData.UID,
+			&rowsData.Species,
+			&rowsData.TagID,
+			&rowsData.Sex,
+			&rowsData.BirthDate,
+			&rowsData.Group,
+			&rowsData.FarmUID,
+			&rowsData.FarmName,
+			&rowsData.PenAreaUID,
+			&rowsData.PenAreaName,
+			&rowsData.MotherUID,
+			&rowsData.Status,
+			&rowsData.Weight,
+			&rowsData.WeightUnit,
+			&rowsData.WeighedDate,
+			&rowsData.OffspringCount,
+			&rowsData.DeathDate,
+			&rowsData.DeathCause,
+			&rowsData.CreatedDate,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		animalRead, err := rowsData.animalRead()
+		if err != nil {
+			return nil, err
+		}
+
+		animals = append(animals, animalRead)
+	}
+
+	return animals, rows.Err()
+}
+
+func (rowsData animalReadResult) animalRead() (storage.AnimalRead, error) {
+	animalUID, err := uuid.FromString(rowsData.UID)
+	if err != nil {
+		return storage.AnimalRead{}, err
+	}
+
+	farmUID, err := uuid.FromString(rowsData.FarmUID)
+	if err != nil {
+		return storage.AnimalRead{}, err
+	}
+
+	createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+	if err != nil {
+		return storage.AnimalRead{}, err
+	}
+
+	animalRead := storage.AnimalRead{
+		UID:            animalUID,
+		Species:        rowsData.Species,
+		TagID:          rowsData.TagID,
+		Sex:            rowsData.Sex,
+		Group:          rowsData.Group,
+		Farm:           storage.AnimalFarm{UID: farmUID, Name: rowsData.FarmName},
+		Status:         rowsData.Status,
+		OffspringCount: rowsData.OffspringCount,
+		DeathCause:     rowsData.DeathCause,
+		CreatedDate:    createdDate,
+	}
+
+	if rowsData.BirthDate.Valid {
+		birthDate, err := time.Parse(time.RFC3339, rowsData.BirthDate.String)
+		if err != nil {
+			return storage.AnimalRead{}, err
+		}
+
+		animalRead.BirthDate = &birthDate
+	}
+
+	if rowsData.PenAreaUID.Valid {
+		penAreaUID, err := uuid.FromString(rowsData.PenAreaUID.String)
+		if err != nil {
+			return storage.AnimalRead{}, err
+		}
+
+		animalRead.PenArea = &storage.AnimalPenArea{UID: penAreaUID, Name: rowsData.PenAreaName.String}
+	}
+
+	if rowsData.MotherUID.Valid {
+		motherUID, err := uuid.FromString(rowsData.MotherUID.String)
+		if err != nil {
+			return storage.AnimalRead{}, err
+		}
+
+		animalRead.MotherUID = &motherUID
+	}
+
+	if rowsData.WeighedDate.Valid {
+		weighedDate, err := time.Parse(time.RFC3339, rowsData.WeighedDate.String)
+		if err != nil {
+			return storage.AnimalRead{}, err
+		}
+
+		animalRead.LatestWeight = &storage.AnimalWeight{
+			Weight:      float32(rowsData.Weight.Float64),
+			Unit:        rowsData.WeightUnit.String,
+			WeighedDate: weighedDate,
+		}
+	}
+
+	if rowsData.DeathDate.Valid {
+		deathDate, err := time.Parse(time.RFC3339, rowsData.DeathDate.String)
+		if err != nil {
+			return storage.AnimalRead{}, err
+		}
+
+		animalRead.DeathDate = &deathDate
+	}
+
+	return animalRead, nil
+}
@@ -0,0 +1,70 @@
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/livestock/query"
+)
+
+type AreaReadQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewAreaReadQuerySqlite(db *sql.DB) query.AreaReadQuery {
+	return AreaReadQuerySqlite{DB: db}
+}
+
+func (s AreaReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		defer close(result)
+
+		rowsData := struct {
+			UID     string
+			Name    string
+			FarmUID string
+		}{}
+
+		err := s.DB.QueryRow(`SELECT UID, NAME, FARM_UID FROM AREA_READ WHERE UID = ?`, uid).Scan(
+			&rowsData.UID,
+			&rowsData.Name,
+			&rowsData.FarmUID,
+		)
+		if errors.Is(err, sql.ErrNoRows) {
+			result <- query.Result{Result: query.AnimalAreaQueryResult{}}
+
+			return
+		}
+
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		areaUID, err := uuid.FromString(rowsData.UID)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		farmUID, err := uuid.FromString(rowsData.FarmUID)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		result <- query.Result{Result: query.AnimalAreaQueryResult{
+			UID:     areaUID,
+			Name:    rowsData.Name,
+			FarmUID: farmUID,
+		}}
+	}()
+
+	return result
+}
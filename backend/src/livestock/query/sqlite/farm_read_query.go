@@ -0,0 +1,63 @@
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/livestock/query"
+)
+
+type FarmReadQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewFarmReadQuerySqlite(db *sql.DB) query.FarmReadQuery {
+	return FarmReadQuerySqlite{DB: db}
+}
+
+func (s FarmReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		defer close(result)
+
+		rowsData := struct {
+			UID  string
+			Name string
+			Type string
+		}{}
+
+		err := s.DB.QueryRow(`SELECT UID, NAME, TYPE FROM FARM_READ WHERE UID = ?`, uid).Scan(
+			&rowsData.UID,
+			&rowsData.Name,
+			&rowsData.Type,
+		)
+		if errors.Is(err, sql.ErrNoRows) {
+			result <- query.Result{Result: query.AnimalFarmQueryResult{}}
+
+			return
+		}
+
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		farmUID, err := uuid.FromString(rowsData.UID)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		result <- query.Result{Result: query.AnimalFarmQueryResult{
+			UID:  farmUID,
+			Name: rowsData.Name,
+			Type: rowsData.Type,
+		}}
+	}()
+
+	return result
+}
@@ -0,0 +1,89 @@
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/livestock/query"
+)
+
+type MaterialReadQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewMaterialReadQuerySqlite(db *sql.DB) query.MaterialReadQuery {
+	return MaterialReadQuerySqlite{DB: db}
+}
+
+func (s MaterialReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		defer close(result)
+
+		rowsData := struct {
+			UID            string
+			Name           string
+			Type           string
+			TypeData       sql.NullString
+			Quantity       float32
+			QuantityUnit   sql.NullString
+			ExpirationDate sql.NullString
+		}{}
+
+		err := s.DB.QueryRow(`SELECT UID, NAME, TYPE, TYPE_DATA, QUANTITY, QUANTITY_UNIT, EXPIRATION_DATE
+			FROM MATERIAL_READ WHERE UID = ?`, uid).Scan(
+			&rowsData.UID,
+			&rowsData.Name,
+			&rowsData.Type,
+			&rowsData.TypeData,
+			&rowsData.Quantity,
+			&rowsData.QuantityUnit,
+			&rowsData.ExpirationDate,
+		)
+		if errors.Is(err, sql.ErrNoRows) {
+			result <- query.Result{Result: query.AnimalMaterialQueryResult{}}
+
+			return
+		}
+
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		materialUID, err := uuid.FromString(rowsData.UID)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		material := query.AnimalMaterialQueryResult{
+			UID:              materialUID,
+			Name:             rowsData.Name,
+			TypeCode:         rowsData.Type,
+			ChemicalTypeCode: rowsData.TypeData.String,
+			Quantity:         rowsData.Quantity,
+			QuantityUnit:     rowsData.QuantityUnit.String,
+		}
+
+		if rowsData.ExpirationDate.Valid && rowsData.ExpirationDate.String != "" {
+			date, err := time.Parse(time.RFC3339, rowsData.ExpirationDate.String)
+			if err != nil {
+				result <- query.Result{Error: err}
+
+				return
+			}
+
+			material.ExpirationDate = &date
+		}
+
+		result <- query.Result{Result: material}
+	}()
+
+	return result
+}
@@ -0,0 +1,40 @@
+package inmemory
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/livestock/repository"
+	"github.com/usetania/tania-core/src/livestock/storage"
+)
+
+type AnimalEventRepositoryInMemory struct {
+	Storage *storage.AnimalEventStorage
+}
+
+func NewAnimalEventRepositoryInMemory(s *storage.AnimalEventStorage) repository.AnimalEvent {
+	return &AnimalEventRepositoryInMemory{Storage: s}
+}
+
+func (f *AnimalEventRepositoryInMemory) Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		for _, v := range events {
+			latestVersion++
+
+			f.Storage.AnimalEvents = append(f.Storage.AnimalEvents, storage.AnimalEvent{
+				AnimalUID: uid,
+				Version:   latestVersion,
+				Event:     v,
+			})
+		}
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,31 @@
+package inmemory
+
+import (
+	"github.com/usetania/tania-core/src/livestock/repository"
+	"github.com/usetania/tania-core/src/livestock/storage"
+)
+
+type AnimalReadRepositoryInMemory struct {
+	Storage *storage.AnimalReadStorage
+}
+
+func NewAnimalReadRepositoryInMemory(s *storage.AnimalReadStorage) repository.AnimalRead {
+	return &AnimalReadRepositoryInMemory{Storage: s}
+}
+
+func (f *AnimalReadRepositoryInMemory) Save(animalRead *storage.AnimalRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.AnimalReadMap[animalRead.UID] = *animalRead
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
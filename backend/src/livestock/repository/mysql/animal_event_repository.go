@@ -0,0 +1,50 @@
+package mysql
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/helper/structhelper"
+	"github.com/usetania/tania-core/src/livestock/decoder"
+	"github.com/usetania/tania-core/src/livestock/repository"
+)
+
+type AnimalEventRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewAnimalEventRepositoryMysql(db *sql.DB) repository.AnimalEvent {
+	return &AnimalEventRepositoryMysql{DB: db}
+}
+
+func (f *AnimalEventRepositoryMysql) Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		for _, v := range events {
+			latestVersion++
+
+			e, err := json.Marshal(decoder.InterfaceWrapper{
+				Name: structhelper.GetName(v),
+				Data: v,
+			})
+			if err != nil {
+				result <- err
+			}
+
+			_, err = f.DB.Exec(`INSERT INTO ANIMAL_EVENT
+				(ANIMAL_UID, VERSION, CREATED_DATE, EVENT) VALUES (?, ?, ?, ?)`,
+				uid.Bytes(), latestVersion, time.Now(), e)
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,87 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/usetania/tania-core/src/livestock/repository"
+	"github.com/usetania/tania-core/src/livestock/storage"
+)
+
+type AnimalReadRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewAnimalReadRepositoryMysql(db *sql.DB) repository.AnimalRead {
+	return &AnimalReadRepositoryMysql{DB: db}
+}
+
+func (f *AnimalReadRepositoryMysql) Save(animalRead *storage.AnimalRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		defer close(result)
+
+		var birthDate, penAreaUID, penAreaName, motherUID, weight, weightUnit, weighedDate, deathDate interface{}
+
+		if animalRead.BirthDate != nil {
+			birthDate = *animalRead.BirthDate
+		}
+
+		if animalRead.PenArea != nil {
+			penAreaUID = animalRead.PenArea.UID.Bytes()
+			penAreaName = animalRead.PenArea.Name
+		}
+
+		if animalRead.MotherUID != nil {
+			motherUID = animalRead.MotherUID.Bytes()
+		}
+
+		if animalRead.LatestWeight != nil {
+			weight = animalRead.LatestWeight.Weight
+			weightUnit = animalRead.LatestWeight.Unit
+			weighedDate = animalRead.LatestWeight.WeighedDate
+		}
+
+		if animalRead.DeathDate != nil {
+			deathDate = *animalRead.DeathDate
+		}
+
+		_, err := f.DB.Exec(`INSERT INTO ANIMAL_READ
+			(UID, SPECIES, TAG_ID, SEX, BIRTH_DATE, ANIMAL_GROUP, FARM_UID, FARM_NAME, PEN_AREA_UID, PEN_AREA_NAME,
+			MOTHER_UID, STATUS, WEIGHT, WEIGHT_UNIT, WEIGHED_DATE, OFFSPRING_COUNT, DEATH_DATE, DEATH_CAUSE, CREATED_DATE)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE TAG_ID = VALUES(TAG_ID), SEX = VALUES(SEX), BIRTH_DATE = VALUES(BIRTH_DATE),
+			ANIMAL_GROUP = VALUES(ANIMAL_GROUP), FARM_NAME = VALUES(FARM_NAME), PEN_AREA_UID = VALUES(PEN_AREA_UID),
+			PEN_AREA_NAME = VALUES(PEN_AREA_NAME), STATUS = VALUES(STATUS), WEIGHT = VALUES(WEIGHT),
+			WEIGHT_UNIT = VALUES(WEIGHT_UNIT), WEIGHED_DATE = VALUES(WEIGHED_DATE),
+			OFFSPRING_COUNT = VALUES(OFFSPRING_COUNT), DEATH_DATE = VALUES(DEATH_DATE), DEATH_CAUSE = VALUES(DEATH_CAUSE)`,
+			animalRead.UID.Bytes(),
+			animalRead.Species,
+			animalRead.TagID,
+			animalRead.Sex,
+			birthDate,
+			animalRead.Group,
+			animalRead.Farm.UID.Bytes(),
+			animalRead.Farm.Name,
+			penAreaUID,
+			penAreaName,
+			motherUID,
+			animalRead.Status,
+			weight,
+			weightUnit,
+			weighedDate,
+			animalRead.OffspringCount,
+			deathDate,
+			animalRead.DeathCause,
+			animalRead.CreatedDate)
+		if err != nil {
+			result <- err
+
+			return
+		}
+
+		result <- nil
+	}()
+
+	return result
+}
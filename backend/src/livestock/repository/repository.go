@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/livestock/domain"
+	"github.com/usetania/tania-core/src/livestock/storage"
+)
+
+type AnimalEvent interface {
+	Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error
+}
+
+type AnimalRead interface {
+	Save(animalRead *storage.AnimalRead) <-chan error
+}
+
+func NewAnimalFromHistory(events []storage.AnimalEvent) *domain.Animal {
+	state := &domain.Animal{}
+	for _, v := range events {
+		state.Transition(v.Event)
+		state.Version++
+	}
+
+	return state
+}
@@ -0,0 +1,83 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/usetania/tania-core/src/livestock/repository"
+	"github.com/usetania/tania-core/src/livestock/storage"
+)
+
+type AnimalReadRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewAnimalReadRepositorySqlite(db *sql.DB) repository.AnimalRead {
+	return &AnimalReadRepositorySqlite{DB: db}
+}
+
+func (f *AnimalReadRepositorySqlite) Save(animalRead *storage.AnimalRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		defer close(result)
+
+		var birthDate, penAreaUID, penAreaName, motherUID, weight, weightUnit, weighedDate, deathDate interface{}
+
+		if animalRead.BirthDate != nil {
+			birthDate = animalRead.BirthDate.Format(time.RFC3339)
+		}
+
+		if animalRead.PenArea != nil {
+			penAreaUID = animalRead.PenArea.UID
+			penAreaName = animalRead.PenArea.Name
+		}
+
+		if animalRead.MotherUID != nil {
+			motherUID = *animalRead.MotherUID
+		}
+
+		if animalRead.LatestWeight != nil {
+			weight = animalRead.LatestWeight.Weight
+			weightUnit = animalRead.LatestWeight.Unit
+			weighedDate = animalRead.LatestWeight.WeighedDate.Format(time.RFC3339)
+		}
+
+		if animalRead.DeathDate != nil {
+			deathDate = animalRead.DeathDate.Format(time.RFC3339)
+		}
+
+		_, err := f.DB.Exec(`INSERT OR REPLACE INTO ANIMAL_READ
+			(UID, SPECIES, TAG_ID, SEX, BIRTH_DATE, ANIMAL_GROUP, FARM_UID, FARM_NAME, PEN_AREA_UID, PEN_AREA_NAME,
+			MOTHER_UID, STATUS, WEIGHT, WEIGHT_UNIT, WEIGHED_DATE, OFFSPRING_COUNT, DEATH_DATE, DEATH_CAUSE, CREATED_DATE)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			animalRead.UID,
+			animalRead.Species,
+			animalRead.TagID,
+			animalRead.Sex,
+			birthDate,
+			animalRead.Group,
+			animalRead.Farm.UID,
+			animalRead.Farm.Name,
+			penAreaUID,
+			penAreaName,
+			motherUID,
+			animalRead.Status,
+			weight,
+			weightUnit,
+			weighedDate,
+			animalRead.OffspringCount,
+			deathDate,
+			animalRead.DeathCause,
+			animalRead.CreatedDate.Format(time.RFC3339))
+		if err != nil {
+			result <- err
+
+			return
+		}
+
+		result <- nil
+	}()
+
+	return result
+}
@@ -0,0 +1,737 @@
+package server
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/usetania/tania-core/config"
+	assetsstorage "github.com/usetania/tania-core/src/assets/storage"
+	"github.com/usetania/tania-core/src/eventbus"
+	"github.com/usetania/tania-core/src/helper/eventhelper"
+	"github.com/usetania/tania-core/src/helper/structhelper"
+	"github.com/usetania/tania-core/src/livestock/domain"
+	"github.com/usetania/tania-core/src/livestock/domain/service"
+	"github.com/usetania/tania-core/src/livestock/query"
+	queryInMem "github.com/usetania/tania-core/src/livestock/query/inmemory"
+	queryMysql "github.com/usetania/tania-core/src/livestock/query/mysql"
+	querySqlite "github.com/usetania/tania-core/src/livestock/query/sqlite"
+	"github.com/usetania/tania-core/src/livestock/repository"
+	repoInMem "github.com/usetania/tania-core/src/livestock/repository/inmemory"
+	repoMysql "github.com/usetania/tania-core/src/livestock/repository/mysql"
+	repoSqlite "github.com/usetania/tania-core/src/livestock/repository/sqlite"
+	"github.com/usetania/tania-core/src/livestock/storage"
+)
+
+// LivestockServer ties the routes and handlers with injected dependencies.
+type LivestockServer struct {
+	AnimalEventRepo   repository.AnimalEvent
+	AnimalEventQuery  query.AnimalEventQuery
+	AnimalReadRepo    repository.AnimalRead
+	AnimalReadQuery   query.AnimalReadQuery
+	AnimalService     domain.AnimalService
+	FarmReadQuery     query.FarmReadQuery
+	AreaReadQuery     query.AreaReadQuery
+	MaterialReadQuery query.MaterialReadQuery
+	EventBus          eventbus.TaniaEventBus
+}
+
+// NewLivestockServer initializes LivestockServer's dependencies and create new LivestockServer struct.
+func NewLivestockServer(
+	db *sql.DB,
+	bus eventbus.TaniaEventBus,
+	animalEventStorage *storage.AnimalEventStorage,
+	animalReadStorage *storage.AnimalReadStorage,
+	farmReadStorage *assetsstorage.FarmReadStorage,
+	areaReadStorage *assetsstorage.AreaReadStorage,
+	materialReadStorage *assetsstorage.MaterialReadStorage,
+) (*LivestockServer, error) {
+	livestockServer := &LivestockServer{
+		EventBus: bus,
+	}
+
+	switch *config.Config.TaniaPersistenceEngine {
+	case config.DBInmemory:
+		livestockServer.AnimalEventRepo = repoInMem.NewAnimalEventRepositoryInMemory(animalEventStorage)
+		livestockServer.AnimalEventQuery = queryInMem.NewAnimalEventQueryInMemory(animalEventStorage)
+		livestockServer.AnimalReadRepo = repoInMem.NewAnimalReadRepositoryInMemory(animalReadStorage)
+		livestockServer.AnimalReadQuery = queryInMem.NewAnimalReadQueryInMemory(animalReadStorage)
+
+		livestockServer.FarmReadQuery = queryInMem.NewFarmReadQueryInMemory(farmReadStorage)
+		livestockServer.AreaReadQuery = queryInMem.NewAreaReadQueryInMemory(areaReadStorage)
+		livestockServer.MaterialReadQuery = queryInMem.NewMaterialReadQueryInMemory(materialReadStorage)
+	case config.DBSqlite:
+		livestockServer.AnimalEventRepo = repoSqlite.NewAnimalEventRepositorySqlite(db)
+		livestockServer.AnimalEventQuery = querySqlite.NewAnimalEventQuerySqlite(db)
+		livestockServer.AnimalReadRepo = repoSqlite.NewAnimalReadRepositorySqlite(db)
+		livestockServer.AnimalReadQuery = querySqlite.NewAnimalReadQuerySqlite(db)
+
+		livestockServer.FarmReadQuery = querySqlite.NewFarmReadQuerySqlite(db)
+		livestockServer.AreaReadQuery = querySqlite.NewAreaReadQuerySqlite(db)
+		livestockServer.MaterialReadQuery = querySqlite.NewMaterialReadQuerySqlite(db)
+	case config.DBMysql:
+		livestockServer.AnimalEventRepo = repoMysql.NewAnimalEventRepositoryMysql(db)
+		livestockServer.AnimalEventQuery = queryMysql.NewAnimalEventQueryMysql(db)
+		livestockServer.AnimalReadRepo = repoMysql.NewAnimalReadRepositoryMysql(db)
+		livestockServer.AnimalReadQuery = queryMysql.NewAnimalReadQueryMysql(db)
+
+		livestockServer.FarmReadQuery = queryMysql.NewFarmReadQueryMysql(db)
+		livestockServer.AreaReadQuery = queryMysql.NewAreaReadQueryMysql(db)
+		livestockServer.MaterialReadQuery = queryMysql.NewMaterialReadQueryMysql(db)
+	}
+
+	livestockServer.AnimalService = service.AnimalServiceInMemory{
+		FarmReadQuery:     livestockServer.FarmReadQuery,
+		AreaReadQuery:     livestockServer.AreaReadQuery,
+		MaterialReadQuery: livestockServer.MaterialReadQuery,
+		AnimalReadQuery:   livestockServer.AnimalReadQuery,
+	}
+
+	livestockServer.InitSubscriber()
+
+	return livestockServer, nil
+}
+
+// InitSubscriber defines the mapping of which event this domain listen with their handler.
+func (s *LivestockServer) InitSubscriber() {
+	s.EventBus.Subscribe("AnimalCreated", s.SaveToAnimalReadModel)
+	s.EventBus.Subscribe("AnimalDetailsChanged", s.SaveToAnimalReadModel)
+	s.EventBus.Subscribe("AnimalGroupChanged", s.SaveToAnimalReadModel)
+	s.EventBus.Subscribe("AnimalMoved", s.SaveToAnimalReadModel)
+	s.EventBus.Subscribe("AnimalWeighed", s.SaveToAnimalReadModel)
+	s.EventBus.Subscribe("AnimalTreated", s.SaveToAnimalReadModel)
+	s.EventBus.Subscribe("AnimalFed", s.SaveToAnimalReadModel)
+	s.EventBus.Subscribe("AnimalGaveBirth", s.SaveToAnimalReadModel)
+	s.EventBus.Subscribe("AnimalDied", s.SaveToAnimalReadModel)
+
+	s.EventBus.Subscribe("TaskCompleted", s.RecordTaskTreatment)
+}
+
+// Mount defines the LivestockServer's endpoints with its handlers.
+func (s *LivestockServer) Mount(g *echo.Group) {
+	g.GET("/animals/species", s.GetAnimalSpecies)
+	g.GET("/:id/animals", s.FindAllAnimals)
+	g.POST("/:id/animals", s.SaveAnimal)
+	g.GET("/animals/:id", s.FindAnimalByID)
+	g.PUT("/animals/:id", s.UpdateAnimal)
+	g.POST("/animals/:id/weights", s.SaveAnimalWeight)
+	g.POST("/animals/:id/treatments", s.SaveAnimalTreatment)
+	g.POST("/animals/:id/feedings", s.SaveAnimalFeeding)
+	g.POST("/animals/:id/births", s.SaveAnimalBirth)
+	g.POST("/animals/:id/death", s.SaveAnimalDeath)
+	g.GET("/animals/:id/records", s.GetAnimalRecords)
+}
+
+// GetAnimalSpecies is a LivestockServer's handler to list the species of animals which can be kept.
+func (*LivestockServer) GetAnimalSpecies(c echo.Context) error {
+	data := make(map[string][]domain.AnimalSpecies)
+
+	data["data"] = domain.AnimalSpeciesList()
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// FindAllAnimals is a LivestockServer's handler to list the animals of a farm,
+// which can be filtered by status, group and species.
+func (s *LivestockServer) FindAllAnimals(c echo.Context) error {
+	data := make(map[string][]storage.AnimalRead)
+
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(NotFound, "id"))
+	}
+
+	queryResult := <-s.AnimalReadQuery.FindAllByFarm(
+		farmUID,
+		c.QueryParam("status"),
+		c.QueryParam("group"),
+		c.QueryParam("species"),
+	)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	animals, ok := queryResult.Result.([]storage.AnimalRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	data["data"] = animals
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// SaveAnimal is a LivestockServer's handler to register an animal of a livestock farm.
+func (s *LivestockServer) SaveAnimal(c echo.Context) error {
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(NotFound, "id"))
+	}
+
+	birthDate, err := parseOptionalDate(c.FormValue("birth_date"), "birth_date")
+	if err != nil {
+		return Error(c, err)
+	}
+
+	penAreaUID, err := parseOptionalUID(c.FormValue("pen_area_id"), "pen_area_id")
+	if err != nil {
+		return Error(c, err)
+	}
+
+	animal, err := domain.CreateAnimal(
+		s.AnimalService,
+		farmUID,
+		c.FormValue("species"),
+		c.FormValue("tag_id"),
+		c.FormValue("sex"),
+		birthDate,
+		c.FormValue("group"),
+		penAreaUID,
+	)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveAnimalChanges(c, animal)
+}
+
+// FindAnimalByID is a LivestockServer's handler to get an animal.
+func (s *LivestockServer) FindAnimalByID(c echo.Context) error {
+	data := make(map[string]storage.AnimalRead)
+
+	animalUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(NotFound, "id"))
+	}
+
+	queryResult := <-s.AnimalReadQuery.FindByID(animalUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	animal, ok := queryResult.Result.(storage.AnimalRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if animal.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NotFound, "id"))
+	}
+
+	data["data"] = animal
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// UpdateAnimal is a LivestockServer's handler to change an animal.
+// Only the fields sent are changed, so the birth date and the pen area can be cleared with an empty value.
+func (s *LivestockServer) UpdateAnimal(c echo.Context) error {
+	animal, err := s.findAnimalFromHistory(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	params, err := c.FormParams()
+	if err != nil {
+		return Error(c, err)
+	}
+
+	_, hasTagID := params["tag_id"]
+	_, hasSex := params["sex"]
+	_, hasBirthDate := params["birth_date"]
+
+	if hasTagID || hasSex || hasBirthDate {
+		tagID, sex, birthDate := animal.TagID, animal.Sex, animal.BirthDate
+
+		if hasTagID {
+			tagID = c.FormValue("tag_id")
+		}
+
+		if hasSex {
+			sex = c.FormValue("sex")
+		}
+
+		if hasBirthDate {
+			birthDate, err = parseOptionalDate(c.FormValue("birth_date"), "birth_date")
+			if err != nil {
+				return Error(c, err)
+			}
+		}
+
+		err = animal.ChangeDetails(s.AnimalService, tagID, sex, birthDate)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	if _, ok := params["group"]; ok {
+		err = animal.ChangeGroup(c.FormValue("group"))
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	if _, ok := params["pen_area_id"]; ok {
+		penAreaUID, err := parseOptionalUID(c.FormValue("pen_area_id"), "pen_area_id")
+		if err != nil {
+			return Error(c, err)
+		}
+
+		err = animal.MoveToPen(s.AnimalService, penAreaUID)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	return s.saveAnimalChanges(c, animal)
+}
+
+// SaveAnimalWeight is a LivestockServer's handler to record a weighing of an animal,
+// today unless a weighed_date is given.
+func (s *LivestockServer) SaveAnimalWeight(c echo.Context) error {
+	animal, err := s.findAnimalFromHistory(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	weight, err := strconv.ParseFloat(c.FormValue("weight"), 32)
+	if err != nil {
+		return Error(c, NewRequestValidationError(Float, "weight"))
+	}
+
+	weighedDate, err := parseRecordDate(c.FormValue("weighed_date"), "weighed_date")
+	if err != nil {
+		return Error(c, err)
+	}
+
+	_, err = animal.RecordWeight(float32(weight), c.FormValue("unit"), weighedDate, c.FormValue("notes"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveAnimalChanges(c, animal)
+}
+
+// SaveAnimalTreatment is a LivestockServer's handler to record a health treatment of an animal,
+// today unless a treated_date is given. The quantity of the veterinary material given is taken out of its stock.
+func (s *LivestockServer) SaveAnimalTreatment(c echo.Context) error {
+	animal, err := s.findAnimalFromHistory(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	materialUID, err := parseOptionalUID(c.FormValue("material_id"), "material_id")
+	if err != nil {
+		return Error(c, err)
+	}
+
+	var quantity float64
+
+	if materialUID != nil {
+		quantity, err = strconv.ParseFloat(c.FormValue("quantity"), 32)
+		if err != nil {
+			return Error(c, NewRequestValidationError(Float, "quantity"))
+		}
+	}
+
+	treatedDate, err := parseRecordDate(c.FormValue("treated_date"), "treated_date")
+	if err != nil {
+		return Error(c, err)
+	}
+
+	_, err = animal.RecordTreatment(
+		s.AnimalService,
+		c.FormValue("treatment"),
+		materialUID,
+		float32(quantity),
+		treatedDate,
+		nil,
+		c.FormValue("notes"),
+	)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveAnimalChanges(c, animal)
+}
+
+// SaveAnimalFeeding is a LivestockServer's handler to record a ration of feed given to an animal,
+// today unless a fed_date is given.
+func (s *LivestockServer) SaveAnimalFeeding(c echo.Context) error {
+	animal, err := s.findAnimalFromHistory(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	quantity, err := strconv.ParseFloat(c.FormValue("quantity"), 32)
+	if err != nil {
+		return Error(c, NewRequestValidationError(Float, "quantity"))
+	}
+
+	fedDate, err := parseRecordDate(c.FormValue("fed_date"), "fed_date")
+	if err != nil {
+		return Error(c, err)
+	}
+
+	_, err = animal.RecordFeeding(c.FormValue("feed"), float32(quantity), c.FormValue("unit"), fedDate,
+		c.FormValue("notes"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveAnimalChanges(c, animal)
+}
+
+// SaveAnimalBirth is a LivestockServer's handler to register the offspring born to an animal,
+// today unless a birth_date is given. It responds with the offspring.
+func (s *LivestockServer) SaveAnimalBirth(c echo.Context) error {
+	animal, err := s.findAnimalFromHistory(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	birthDate, err := parseRecordDate(c.FormValue("birth_date"), "birth_date")
+	if err != nil {
+		return Error(c, err)
+	}
+
+	penAreaUID, err := parseOptionalUID(c.FormValue("pen_area_id"), "pen_area_id")
+	if err != nil {
+		return Error(c, err)
+	}
+
+	offspring, err := animal.RecordBirth(
+		s.AnimalService,
+		c.FormValue("tag_id"),
+		c.FormValue("sex"),
+		birthDate,
+		c.FormValue("group"),
+		penAreaUID,
+	)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// The offspring is saved first, so its mother is never read with an offspring which does not exist.
+	err = <-s.AnimalEventRepo.Save(offspring.UID, offspring.Version, offspring.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	s.publishUncommittedEvents(offspring)
+
+	err = <-s.AnimalEventRepo.Save(animal.UID, animal.Version, animal.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	s.publishUncommittedEvents(animal)
+
+	return s.respondAnimal(c, offspring)
+}
+
+// SaveAnimalDeath is a LivestockServer's handler to record the death of an animal,
+// today unless a death_date is given.
+func (s *LivestockServer) SaveAnimalDeath(c echo.Context) error {
+	animal, err := s.findAnimalFromHistory(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	deathDate, err := parseRecordDate(c.FormValue("death_date"), "death_date")
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = animal.RecordDeath(deathDate, c.FormValue("cause"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveAnimalChanges(c, animal)
+}
+
+// AnimalRecords are the weighings, treatments and feedings of an animal.
+type AnimalRecords struct {
+	Weights    []domain.AnimalWeight    `json:"weights"`
+	Treatments []domain.AnimalTreatment `json:"treatments"`
+	Feedings   []domain.AnimalFeeding   `json:"feedings"`
+}
+
+// GetAnimalRecords is a LivestockServer's handler to list the records of an animal, the latest first.
+func (s *LivestockServer) GetAnimalRecords(c echo.Context) error {
+	data := make(map[string]AnimalRecords)
+
+	animal, err := s.findAnimalFromHistory(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	records := AnimalRecords{
+		Weights:    append([]domain.AnimalWeight{}, animal.Weights...),
+		Treatments: append([]domain.AnimalTreatment{}, animal.Treatments...),
+		Feedings:   append([]domain.AnimalFeeding{}, animal.Feedings...),
+	}
+
+	sort.SliceStable(records.Weights, func(i, j int) bool {
+		return records.Weights[i].WeighedDate.After(records.Weights[j].WeighedDate)
+	})
+	sort.SliceStable(records.Treatments, func(i, j int) bool {
+		return records.Treatments[i].TreatedDate.After(records.Treatments[j].TreatedDate)
+	})
+	sort.SliceStable(records.Feedings, func(i, j int) bool {
+		return records.Feedings[i].FedDate.After(records.Feedings[j].FedDate)
+	})
+
+	data["data"] = records
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// RecordTaskTreatment is a subscriber which records the treatment of an animal by a completed task
+// which used a veterinary material. The material is already taken out of the stock by the task.
+func (s *LivestockServer) RecordTaskTreatment(event interface{}) error {
+	e := taskCompleted{}
+
+	err := eventhelper.Decode(event, &e)
+	if err != nil {
+		log.Println(err)
+
+		return err
+	}
+
+	if e.AnimalID == nil || e.MaterialID == nil || e.MaterialQuantity <= 0 {
+		return nil
+	}
+
+	animal, err := s.findAnimalFromHistory(e.AnimalID.String())
+	if err != nil {
+		log.Println("treated animal not found:", e.AnimalID)
+
+		return nil
+	}
+
+	treatedDate := time.Now()
+	if e.CompletedDate != nil {
+		treatedDate = *e.CompletedDate
+	}
+
+	taskUID := e.UID
+
+	_, err = animal.RecordTreatment(s.AnimalService, e.Treatment, e.MaterialID, e.MaterialQuantity, treatedDate,
+		&taskUID, "")
+	if err != nil {
+		// The animal may have died since the task was created.
+		log.Println(err)
+
+		return nil
+	}
+
+	err = eventhelper.SaveFromSubscriber(s.AnimalEventRepo, animal.UID, animal.Version, animal.UncommittedChanges,
+		s.SaveToAnimalReadModel)
+	if err != nil {
+		log.Println(err)
+
+		return err
+	}
+
+	return nil
+}
+
+// SaveToAnimalReadModel is a subscriber which updates the animal read model from the animal history.
+func (s *LivestockServer) SaveToAnimalReadModel(event interface{}) error {
+	var animalUID uuid.UUID
+
+	switch e := event.(type) {
+	case domain.AnimalCreated:
+		animalUID = e.UID
+	case domain.AnimalDetailsChanged:
+		animalUID = e.AnimalUID
+	case domain.AnimalGroupChanged:
+		animalUID = e.AnimalUID
+	case domain.AnimalMoved:
+		animalUID = e.AnimalUID
+	case domain.AnimalWeighed:
+		animalUID = e.AnimalUID
+	case domain.AnimalTreated:
+		animalUID = e.AnimalUID
+	case domain.AnimalFed:
+		animalUID = e.AnimalUID
+	case domain.AnimalGaveBirth:
+		animalUID = e.AnimalUID
+	case domain.AnimalDied:
+		animalUID = e.AnimalUID
+	default:
+		log.Println(errors.New("internal server error. unknown animal event"))
+
+		return nil
+	}
+
+	animal, err := s.findAnimalFromHistory(animalUID.String())
+	if err != nil {
+		log.Println(err)
+
+		return nil
+	}
+
+	animalRead, err := MapToAnimalRead(s, *animal)
+	if err != nil {
+		log.Println(err)
+
+		return nil
+	}
+
+	err = <-s.AnimalReadRepo.Save(&animalRead)
+	if err != nil {
+		log.Println(err)
+	}
+
+	return nil
+}
+
+// MapToAnimalRead maps the animal with the names of its farm and pen area.
+func MapToAnimalRead(s *LivestockServer, animal domain.Animal) (storage.AnimalRead, error) {
+	farm, err := s.AnimalService.FindFarmByID(animal.FarmUID)
+	if err != nil {
+		return storage.AnimalRead{}, err
+	}
+
+	animalRead := storage.AnimalRead{
+		UID:            animal.UID,
+		Species:        animal.Species,
+		TagID:          animal.TagID,
+		Sex:            animal.Sex,
+		BirthDate:      animal.BirthDate,
+		Group:          animal.Group,
+		Farm:           storage.AnimalFarm{UID: farm.UID, Name: farm.Name},
+		MotherUID:      animal.MotherUID,
+		Status:         animal.Status,
+		OffspringCount: len(animal.OffspringUIDs),
+		DeathDate:      animal.DeathDate,
+		DeathCause:     animal.DeathCause,
+		CreatedDate:    animal.CreatedDate,
+	}
+
+	if animal.PenAreaUID != nil {
+		area, err := s.AnimalService.FindAreaByID(*animal.PenAreaUID)
+		if err != nil {
+			return storage.AnimalRead{}, err
+		}
+
+		animalRead.PenArea = &storage.AnimalPenArea{UID: area.UID, Name: area.Name}
+	}
+
+	if weight := animal.LatestWeight(); weight != nil {
+		animalRead.LatestWeight = &storage.AnimalWeight{
+			Weight:      weight.Weight,
+			Unit:        weight.Unit,
+			WeighedDate: weight.WeighedDate,
+		}
+	}
+
+	return animalRead, nil
+}
+
+func (s *LivestockServer) findAnimalFromHistory(id string) (*domain.Animal, error) {
+	animalUID, err := uuid.FromString(id)
+	if err != nil {
+		return nil, NewRequestValidationError(NotFound, "id")
+	}
+
+	eventQueryResult := <-s.AnimalEventQuery.FindAllByID(animalUID)
+	if eventQueryResult.Error != nil {
+		return nil, eventQueryResult.Error
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.AnimalEvent)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	if len(events) == 0 {
+		return nil, NewRequestValidationError(NotFound, "id")
+	}
+
+	return repository.NewAnimalFromHistory(events), nil
+}
+
+// saveAnimalChanges persists and publishes the changes of the animal, then responds with it.
+func (s *LivestockServer) saveAnimalChanges(c echo.Context, animal *domain.Animal) error {
+	err := <-s.AnimalEventRepo.Save(animal.UID, animal.Version, animal.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	s.publishUncommittedEvents(animal)
+
+	return s.respondAnimal(c, animal)
+}
+
+func (s *LivestockServer) respondAnimal(c echo.Context, animal *domain.Animal) error {
+	data := make(map[string]storage.AnimalRead)
+
+	animalRead, err := MapToAnimalRead(s, *animal)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data["data"] = animalRead
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *LivestockServer) publishUncommittedEvents(entity interface{}) {
+	switch e := entity.(type) {
+	case *domain.Animal:
+		for _, v := range e.UncommittedChanges {
+			name := structhelper.GetName(v)
+			s.EventBus.Publish(name, v)
+		}
+	}
+}
+
+func parseOptionalDate(value, fieldName string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, NewRequestValidationError(ParseFailed, fieldName)
+	}
+
+	return &date, nil
+}
+
+// parseRecordDate parses the date of a record, which is today when it is not given.
+func parseRecordDate(value, fieldName string) (time.Time, error) {
+	date, err := parseOptionalDate(value, fieldName)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if date == nil {
+		return time.Now(), nil
+	}
+
+	return *date, nil
+}
+
+func parseOptionalUID(value, fieldName string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	uid, err := uuid.FromString(value)
+	if err != nil {
+		return nil, NewRequestValidationError(NotFound, fieldName)
+	}
+
+	return &uid, nil
+}
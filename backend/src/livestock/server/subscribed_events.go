@@ -0,0 +1,20 @@
+package server
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// The events of the other modules the LivestockServer subscribes to are mirrored here with the fields it uses,
+// and decoded with eventhelper.Decode.
+
+// taskCompleted mirrors the TaskCompleted event of the tasks module.
+type taskCompleted struct {
+	UID              uuid.UUID  `json:"uid"`
+	CompletedDate    *time.Time `json:"completed_date"`
+	MaterialID       *uuid.UUID `json:"material_id"`
+	MaterialQuantity float32    `json:"material_quantity"`
+	AnimalID         *uuid.UUID `json:"animal_id"`
+	Treatment        string     `json:"treatment"`
+}
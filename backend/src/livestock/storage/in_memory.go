@@ -0,0 +1,39 @@
+package storage
+
+import (
+	"log"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/sasha-s/go-deadlock"
+)
+
+type AnimalEventStorage struct {
+	Lock         *deadlock.RWMutex
+	AnimalEvents []AnimalEvent
+}
+
+func CreateAnimalEventStorage() *AnimalEventStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		log.Println("ANIMAL EVENT STORAGE DEADLOCK!")
+	}
+
+	return &AnimalEventStorage{Lock: &rwMutex}
+}
+
+type AnimalReadStorage struct {
+	Lock          *deadlock.RWMutex
+	AnimalReadMap map[uuid.UUID]AnimalRead
+}
+
+func CreateAnimalReadStorage() *AnimalReadStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		log.Println("ANIMAL READ STORAGE DEADLOCK!")
+	}
+
+	return &AnimalReadStorage{AnimalReadMap: make(map[uuid.UUID]AnimalRead), Lock: &rwMutex}
+}
@@ -0,0 +1,48 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+type AnimalEvent struct {
+	AnimalUID   uuid.UUID
+	Version     int
+	CreatedDate time.Time
+	Event       interface{}
+}
+
+type AnimalRead struct {
+	UID            uuid.UUID      `json:"uid"`
+	Species        string         `json:"species"`
+	TagID          string         `json:"tag_id"`
+	Sex            string         `json:"sex"`
+	BirthDate      *time.Time     `json:"birth_date"`
+	Group          string         `json:"group"`
+	Farm           AnimalFarm     `json:"farm"`
+	PenArea        *AnimalPenArea `json:"pen_area"`
+	MotherUID      *uuid.UUID     `json:"mother_id"`
+	Status         string         `json:"status"`
+	LatestWeight   *AnimalWeight  `json:"latest_weight"`
+	OffspringCount int            `json:"offspring_count"`
+	DeathDate      *time.Time     `json:"death_date"`
+	DeathCause     string         `json:"death_cause"`
+	CreatedDate    time.Time      `json:"created_date"`
+}
+
+type AnimalFarm struct {
+	UID  uuid.UUID `json:"uid"`
+	Name string    `json:"name"`
+}
+
+type AnimalPenArea struct {
+	UID  uuid.UUID `json:"uid"`
+	Name string    `json:"name"`
+}
+
+type AnimalWeight struct {
+	Weight      float32   `json:"weight"`
+	Unit        string    `json:"unit"`
+	WeighedDate time.Time `json:"weighed_date"`
+}
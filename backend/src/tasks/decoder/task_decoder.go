@@ -229,7 +229,13 @@ func makeDomainDetails(v interface{}, domainCode string) (domain.TaskDomain, err
 	case domain.TaskDomainGeneralCode:
 		domainDetails = domain.TaskDomainGeneral{}
 	case domain.TaskDomainInventoryCode:
-		domainDetails = domain.TaskDomainInventory{}
+		taskDomainInventory := domain.TaskDomainInventory{}
+
+		if val, ok2 := mapped["reason"].(string); ok2 {
+			taskDomainInventory.Reason = val
+		}
+
+		domainDetails = taskDomainInventory
 	case domain.TaskDomainReservoirCode:
 		taskDomainReservoir := domain.TaskDomainReservoir{}
 
@@ -247,7 +253,51 @@ func makeDomainDetails(v interface{}, domainCode string) (domain.TaskDomain, err
 			taskDomainReservoir.MaterialID = &uid
 		}
 
+		if val, ok2 := mapped["reason"].(string); ok2 {
+			taskDomainReservoir.Reason = val
+		}
+
+		if val, ok2 := mapped["parameter"].(string); ok2 {
+			taskDomainReservoir.Parameter = val
+		}
+
 		domainDetails = taskDomainReservoir
+	case domain.TaskDomainEquipmentCode:
+		taskDomainEquipment := domain.TaskDomainEquipment{}
+
+		// Either of them can be null.
+		if val, ok2 := mapped["material_id"].(string); ok2 {
+			uid, err := uuid.FromString(val)
+			if err != nil {
+				return domain.TaskDomainEquipment{}, err
+			}
+
+			taskDomainEquipment.MaterialID = &uid
+		}
+
+		if val, ok2 := mapped["maintenance_schedule_id"].(string); ok2 {
+			uid, err := uuid.FromString(val)
+			if err != nil {
+				return domain.TaskDomainEquipment{}, err
+			}
+
+			taskDomainEquipment.MaintenanceScheduleID = &uid
+		}
+
+		domainDetails = taskDomainEquipment
+	case domain.TaskDomainLivestockCode:
+		taskDomainLivestock := domain.TaskDomainLivestock{}
+
+		if val, ok2 := mapped["material_id"].(string); ok2 {
+			uid, err := uuid.FromString(val)
+			if err != nil {
+				return domain.TaskDomainLivestock{}, err
+			}
+
+			taskDomainLivestock.MaterialID = &uid
+		}
+
+		domainDetails = taskDomainLivestock
 	}
 
 	return domainDetails, nil
@@ -13,6 +13,8 @@ type TaskServiceSqlite struct {
 	AreaQuery      query.Area
 	MaterialQuery  query.Material
 	ReservoirQuery query.Reservoir
+	EquipmentQuery query.Equipment
+	AnimalQuery    query.Animal
 }
 
 func (s TaskServiceSqlite) FindAreaByID(uid uuid.UUID) domain.ServiceResult {
@@ -122,3 +124,57 @@ func (s TaskServiceSqlite) FindReservoirByID(uid uuid.UUID) domain.ServiceResult
 		Result: reservoir,
 	}
 }
+
+func (s TaskServiceSqlite) FindEquipmentByID(uid uuid.UUID) domain.ServiceResult {
+	result := <-s.EquipmentQuery.FindEquipmentByID(uid)
+
+	if result.Error != nil {
+		return domain.ServiceResult{
+			Error: result.Error,
+		}
+	}
+
+	equipment, ok := result.Result.(query.TaskEquipmentResult)
+	if !ok {
+		return domain.ServiceResult{
+			Error: domain.TaskError{Code: domain.TaskErrorInvalidAssetIDCode},
+		}
+	}
+
+	if equipment.UID == (uuid.UUID{}) {
+		return domain.ServiceResult{
+			Error: domain.TaskError{Code: domain.TaskErrorInvalidAssetIDCode},
+		}
+	}
+
+	return domain.ServiceResult{
+		Result: equipment,
+	}
+}
+
+func (s TaskServiceSqlite) FindAnimalByID(uid uuid.UUID) domain.ServiceResult {
+	result := <-s.AnimalQuery.FindAnimalByID(uid)
+
+	if result.Error != nil {
+		return domain.ServiceResult{
+			Error: result.Error,
+		}
+	}
+
+	animal, ok := result.Result.(query.TaskAnimalResult)
+	if !ok {
+		return domain.ServiceResult{
+			Error: domain.TaskError{Code: domain.TaskErrorInvalidAssetIDCode},
+		}
+	}
+
+	if animal.UID == (uuid.UUID{}) {
+		return domain.ServiceResult{
+			Error: domain.TaskError{Code: domain.TaskErrorInvalidAssetIDCode},
+		}
+	}
+
+	return domain.ServiceResult{
+		Result: animal,
+	}
+}
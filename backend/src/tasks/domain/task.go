@@ -11,6 +11,8 @@ type TaskService interface {
 	FindCropByID(uid uuid.UUID) ServiceResult
 	FindMaterialByID(uid uuid.UUID) ServiceResult
 	FindReservoirByID(uid uuid.UUID) ServiceResult
+	FindEquipmentByID(uid uuid.UUID) ServiceResult
+	FindAnimalByID(uid uuid.UUID) ServiceResult
 }
 
 // ServiceResult is the container for service result.
@@ -205,11 +207,71 @@ func (t *Task) SetTaskAsDue() {
 func (t *Task) CompleteTask() {
 	completedTime := time.Now()
 
-	t.TrackChange(TaskCompleted{
+	event := TaskCompleted{
 		UID:           t.UID,
 		Status:        TaskCompletedCode,
 		CompletedDate: &completedTime,
-	})
+	}
+
+	t.setCompletedMaintenance(&event)
+	t.setCompletedTreatment(&event)
+
+	t.TrackChange(event)
+}
+
+// CompleteTaskUsingMaterial completes the task and records the quantity used of its material.
+// It is refused when the stock of the material is lower, unless forced.
+func (t *Task) CompleteTaskUsingMaterial(quantity, stock float32, force bool) error {
+	materialID := TaskMaterialID(t.DomainDetails)
+	if materialID == nil {
+		return TaskError{TaskErrorInventoryIDEmptyCode}
+	}
+
+	if quantity <= 0 {
+		return TaskError{TaskErrorInvalidMaterialQuantityCode}
+	}
+
+	if quantity > stock && !force {
+		return TaskError{TaskErrorInsufficientMaterialCode}
+	}
+
+	completedTime := time.Now()
+
+	event := TaskCompleted{
+		UID:              t.UID,
+		Status:           TaskCompletedCode,
+		CompletedDate:    &completedTime,
+		MaterialID:       materialID,
+		MaterialQuantity: quantity,
+	}
+
+	t.setCompletedMaintenance(&event)
+	t.setCompletedTreatment(&event)
+
+	t.TrackChange(event)
+
+	return nil
+}
+
+// setCompletedMaintenance tells which equipment maintenance is done when the task is completed.
+func (t *Task) setCompletedMaintenance(event *TaskCompleted) {
+	details, ok := t.DomainDetails.(TaskDomainEquipment)
+	if !ok || details.MaintenanceScheduleID == nil {
+		return
+	}
+
+	event.EquipmentID = t.AssetID
+	event.MaintenanceScheduleID = details.MaintenanceScheduleID
+}
+
+// setCompletedTreatment tells which animal is treated when the task is completed.
+func (t *Task) setCompletedTreatment(event *TaskCompleted) {
+	if _, ok := t.DomainDetails.(TaskDomainLivestock); !ok || t.AssetID == nil {
+		return
+	}
+
+	event.AnimalID = t.AssetID
+	event.Treatment = t.Title
 }
 
 // CompleteTask.
@@ -357,6 +419,18 @@ func validateAssetID(taskService TaskService, assetid *uuid.UUID, taskdomain str
 		case TaskDomainReservoirCode:
 			serviceResult := taskService.FindReservoirByID(*assetid)
 
+			if serviceResult.Error != nil {
+				return serviceResult.Error
+			}
+		case TaskDomainEquipmentCode:
+			serviceResult := taskService.FindEquipmentByID(*assetid)
+
+			if serviceResult.Error != nil {
+				return serviceResult.Error
+			}
+		case TaskDomainLivestockCode:
+			serviceResult := taskService.FindAnimalByID(*assetid)
+
 			if serviceResult.Error != nil {
 				return serviceResult.Error
 			}
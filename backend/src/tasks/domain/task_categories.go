@@ -6,6 +6,8 @@ const (
 	TaskCategoryFinance     = "FINANCE"
 	TaskCategoryGeneral     = "GENERAL"
 	TaskCategoryInventory   = "INVENTORY"
+	TaskCategoryLivestock   = "LIVESTOCK"
+	TaskCategoryMaintenance = "MAINTENANCE"
 	TaskCategoryNutrient    = "NUTRIENT"
 	TaskCategoryPestControl = "PESTCONTROL"
 	TaskCategoryReservoir   = "RESERVOIR"
@@ -25,6 +27,8 @@ func FindAllTaskCategories() []TaskCategory {
 		{Code: TaskCategoryFinance, Name: "Finance"},
 		{Code: TaskCategoryGeneral, Name: "General"},
 		{Code: TaskCategoryInventory, Name: "Inventory"},
+		{Code: TaskCategoryLivestock, Name: "Livestock"},
+		{Code: TaskCategoryMaintenance, Name: "Maintenance"},
 		{Code: TaskCategoryNutrient, Name: "Nutrient"},
 		{Code: TaskCategoryPestControl, Name: "Pest Control"},
 		{Code: TaskCategoryReservoir, Name: "Reservoir"},
@@ -1,15 +1,21 @@
 package domain
 
 import (
+	"time"
+
 	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/helper/datehelper"
+	"github.com/usetania/tania-core/src/tasks/query"
 )
 
 const (
 	TaskDomainAreaCode      = "AREA"
 	TaskDomainCropCode      = "CROP"
+	TaskDomainEquipmentCode = "EQUIPMENT"
 	TaskDomainFinanceCode   = "FINANCE"
 	TaskDomainGeneralCode   = "GENERAL"
 	TaskDomainInventoryCode = "INVENTORY"
+	TaskDomainLivestockCode = "LIVESTOCK"
 	TaskDomainReservoirCode = "RESERVOIR"
 )
 
@@ -36,6 +42,16 @@ func (TaskDomainCrop) Code() string {
 	return TaskDomainCropCode
 }
 
+// EQUIPMENT.
+type TaskDomainEquipment struct {
+	MaterialID            *uuid.UUID `json:"material_id"`
+	MaintenanceScheduleID *uuid.UUID `json:"maintenance_schedule_id"`
+}
+
+func (TaskDomainEquipment) Code() string {
+	return TaskDomainEquipmentCode
+}
+
 // FINANCE.
 type TaskDomainFinance struct{}
 
@@ -51,21 +67,86 @@ func (TaskDomainGeneral) Code() string {
 }
 
 // INVENTORY.
-type TaskDomainInventory struct{}
+type TaskDomainInventory struct {
+	// Reason is why the material checkers created the task, so they create one task per reason.
+	Reason string `json:"reason,omitempty"`
+}
+
+const (
+	TaskInventoryReasonReorder    = "REORDER"
+	TaskInventoryReasonExpiration = "EXPIRATION"
+)
 
 func (TaskDomainInventory) Code() string {
 	return TaskDomainInventoryCode
 }
 
+// LIVESTOCK.
+type TaskDomainLivestock struct {
+	MaterialID *uuid.UUID `json:"material_id"`
+}
+
+func (TaskDomainLivestock) Code() string {
+	return TaskDomainLivestockCode
+}
+
 // RESERVOIR.
 type TaskDomainReservoir struct {
 	MaterialID *uuid.UUID `json:"material_id"`
+	// Reason is why the reservoir checkers created the task, so they create one task per reason.
+	Reason string `json:"reason,omitempty"`
+	// Parameter is the water quality parameter out of its range, for the WATER_QUALITY reason.
+	Parameter string `json:"parameter,omitempty"`
 }
 
+const (
+	TaskReservoirReasonRefill       = "REFILL"
+	TaskReservoirReasonWaterQuality = "WATER_QUALITY"
+)
+
 func (TaskDomainReservoir) Code() string {
 	return TaskDomainReservoirCode
 }
 
+// TaskMaterialID returns the material referenced by the task domain details, if any.
+func TaskMaterialID(details TaskDomain) *uuid.UUID {
+	switch d := details.(type) {
+	case TaskDomainArea:
+		return d.MaterialID
+	case TaskDomainCrop:
+		return d.MaterialID
+	case TaskDomainEquipment:
+		return d.MaterialID
+	case TaskDomainLivestock:
+		return d.MaterialID
+	case TaskDomainReservoir:
+		return d.MaterialID
+	}
+
+	return nil
+}
+
+const materialTypeAgrochemical = "AGROCHEMICAL"
+
+// validateMaterialNotExpired rejects the agrochemicals past their expiration date, which are unsafe to apply.
+func validateMaterialNotExpired(ts TaskService, materialID *uuid.UUID) error {
+	serviceResult := ts.FindMaterialByID(*materialID)
+	if serviceResult.Error != nil {
+		return serviceResult.Error
+	}
+
+	material, ok := serviceResult.Result.(query.TaskMaterialResult)
+	if !ok {
+		return TaskError{TaskErrorInvalidInventoryIDCode}
+	}
+
+	if material.TypeCode == materialTypeAgrochemical && datehelper.IsExpired(material.ExpirationDate, time.Now()) {
+		return TaskError{TaskErrorMaterialExpiredCode}
+	}
+
+	return nil
+}
+
 // CreateTaskDomainArea.
 func CreateTaskDomainArea(taskService TaskService, category string, materialID *uuid.UUID) (TaskDomainArea, error) {
 	err := validateTaskCategory(category)
@@ -78,6 +159,11 @@ func CreateTaskDomainArea(taskService TaskService, category string, materialID *
 		if err != nil {
 			return TaskDomainArea{}, err
 		}
+
+		err = validateMaterialNotExpired(taskService, materialID)
+		if err != nil {
+			return TaskDomainArea{}, err
+		}
 	}
 
 	return TaskDomainArea{
@@ -97,6 +183,11 @@ func CreateTaskDomainCrop(ts TaskService, category string, materialID, areaID *u
 		if err != nil {
 			return TaskDomainCrop{}, err
 		}
+
+		err = validateMaterialNotExpired(ts, materialID)
+		if err != nil {
+			return TaskDomainCrop{}, err
+		}
 	}
 
 	if areaID != nil {
@@ -112,6 +203,35 @@ func CreateTaskDomainCrop(ts TaskService, category string, materialID, areaID *u
 	}, nil
 }
 
+// CreateTaskDomainEquipment. The maintenance schedule is the one of the equipment done by completing the task.
+func CreateTaskDomainEquipment(
+	ts TaskService,
+	category string,
+	materialID, maintenanceScheduleID *uuid.UUID,
+) (TaskDomainEquipment, error) {
+	err := validateTaskCategory(category)
+	if err != nil {
+		return TaskDomainEquipment{}, err
+	}
+
+	if materialID != nil {
+		err := validateAssetID(ts, materialID, TaskDomainInventoryCode)
+		if err != nil {
+			return TaskDomainEquipment{}, err
+		}
+
+		err = validateMaterialNotExpired(ts, materialID)
+		if err != nil {
+			return TaskDomainEquipment{}, err
+		}
+	}
+
+	return TaskDomainEquipment{
+		MaterialID:            materialID,
+		MaintenanceScheduleID: maintenanceScheduleID,
+	}, nil
+}
+
 // CreateTaskDomainFinance.
 func CreateTaskDomainFinance() (TaskDomainFinance, error) {
 	return TaskDomainFinance{}, nil
@@ -127,6 +247,30 @@ func CreateTaskDomainInventory() (TaskDomainInventory, error) {
 	return TaskDomainInventory{}, nil
 }
 
+// CreateTaskDomainLivestock. The material is the veterinary material given to the animal by completing the task.
+func CreateTaskDomainLivestock(ts TaskService, category string, materialID *uuid.UUID) (TaskDomainLivestock, error) {
+	err := validateTaskCategory(category)
+	if err != nil {
+		return TaskDomainLivestock{}, err
+	}
+
+	if materialID != nil {
+		err := validateAssetID(ts, materialID, TaskDomainInventoryCode)
+		if err != nil {
+			return TaskDomainLivestock{}, err
+		}
+
+		err = validateMaterialNotExpired(ts, materialID)
+		if err != nil {
+			return TaskDomainLivestock{}, err
+		}
+	}
+
+	return TaskDomainLivestock{
+		MaterialID: materialID,
+	}, nil
+}
+
 // CreateTaskDomainReservoir.
 func CreateTaskDomainReservoir(ts TaskService, category string, materialID *uuid.UUID) (TaskDomainReservoir, error) {
 	err := validateTaskCategory(category)
@@ -139,6 +283,11 @@ func CreateTaskDomainReservoir(ts TaskService, category string, materialID *uuid
 		if err != nil {
 			return TaskDomainReservoir{}, err
 		}
+
+		err = validateMaterialNotExpired(ts, materialID)
+		if err != nil {
+			return TaskDomainReservoir{}, err
+		}
 	}
 
 	return TaskDomainReservoir{
@@ -41,6 +41,13 @@ const (
 
 	// Task General Errors.
 	TaskErrorTaskNotFoundCode
+
+	// Material Consumption Errors.
+	TaskErrorInvalidMaterialQuantityCode
+	TaskErrorInsufficientMaterialCode
+
+	// Material Expiration Errors.
+	TaskErrorMaterialExpiredCode
 )
 
 // TaskError is a custom error from Go built-in error.
@@ -88,6 +95,12 @@ func (e TaskError) Error() string {
 		return "Task area reference is invalid."
 	case TaskErrorTaskNotFoundCode:
 		return "Task not found"
+	case TaskErrorInvalidMaterialQuantityCode:
+		return "Task material quantity should be more than zero."
+	case TaskErrorInsufficientMaterialCode:
+		return "Task material quantity is more than the material in stock."
+	case TaskErrorMaterialExpiredCode:
+		return "Task material is an agrochemical past its expiration date."
 	default:
 		return "Unrecognized Task Error Code"
 	}
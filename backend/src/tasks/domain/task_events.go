@@ -71,9 +71,19 @@ type TaskAssetIDChanged struct {
 }
 
 type TaskCompleted struct {
-	UID           uuid.UUID  `json:"uid"`
-	Status        string     `json:"status"`
-	CompletedDate *time.Time `json:"completed_date"`
+	UID              uuid.UUID  `json:"uid"`
+	Status           string     `json:"status"`
+	CompletedDate    *time.Time `json:"completed_date"`
+	MaterialID       *uuid.UUID `json:"material_id,omitempty"`
+	MaterialQuantity float32    `json:"material_quantity,omitempty"`
+
+	// The equipment maintenance done by completing the task, if any.
+	EquipmentID           *uuid.UUID `json:"equipment_id,omitempty"`
+	MaintenanceScheduleID *uuid.UUID `json:"maintenance_schedule_id,omitempty"`
+
+	// The animal treated by completing the task, if any, with the title of the task as the treatment.
+	AnimalID  *uuid.UUID `json:"animal_id,omitempty"`
+	Treatment string     `json:"treatment,omitempty"`
 }
 
 type TaskCancelled struct {
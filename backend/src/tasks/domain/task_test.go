@@ -39,6 +39,18 @@ func (m *TaskServiceMock) FindReservoirByID(uid uuid.UUID) ServiceResult {
 	return args.Get(0).(ServiceResult)
 }
 
+func (m *TaskServiceMock) FindEquipmentByID(uid uuid.UUID) ServiceResult {
+	args := m.Called(uid)
+
+	return args.Get(0).(ServiceResult)
+}
+
+func (m *TaskServiceMock) FindAnimalByID(uid uuid.UUID) ServiceResult {
+	args := m.Called(uid)
+
+	return args.Get(0).(ServiceResult)
+}
+
 func TestCreateTask(t *testing.T) {
 	t.Parallel()
 
@@ -167,3 +179,167 @@ func TestCreateTask(t *testing.T) {
 
 	assert.Equal(t, TaskError{TaskErrorInvalidAssetIDCode}, err)
 }
+
+func TestCompleteTaskUsingMaterial(t *testing.T) {
+	t.Parallel()
+	// Given
+	materialID, _ := uuid.NewV4()
+
+	withMaterial := &Task{DomainDetails: TaskDomainArea{MaterialID: &materialID}}
+	withoutMaterial := &Task{DomainDetails: TaskDomainGeneral{}}
+	forced := &Task{DomainDetails: TaskDomainReservoir{MaterialID: &materialID}}
+
+	// When
+	errNoMaterial := withoutMaterial.CompleteTaskUsingMaterial(1, 10, false)
+	errZero := withMaterial.CompleteTaskUsingMaterial(0, 10, false)
+	errInsufficient := withMaterial.CompleteTaskUsingMaterial(11, 10, false)
+	errForced := forced.CompleteTaskUsingMaterial(11, 10, true)
+	err := withMaterial.CompleteTaskUsingMaterial(2.5, 10, false)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorInventoryIDEmptyCode}, errNoMaterial)
+	assert.Equal(t, TaskError{TaskErrorInvalidMaterialQuantityCode}, errZero)
+	assert.Equal(t, TaskError{TaskErrorInsufficientMaterialCode}, errInsufficient)
+	assert.Nil(t, errForced)
+	assert.Nil(t, err)
+	assert.Equal(t, TaskStatusCompleted, withMaterial.Status)
+	assert.Len(t, withMaterial.UncommittedChanges, 1)
+
+	event, ok := withMaterial.UncommittedChanges[0].(TaskCompleted)
+
+	assert.True(t, ok)
+	assert.Equal(t, &materialID, event.MaterialID)
+	assert.InDelta(t, 2.5, event.MaterialQuantity, 0.0001)
+}
+
+func TestCompleteEquipmentMaintenanceTask(t *testing.T) {
+	t.Parallel()
+	// Given
+	taskServiceMock := new(TaskServiceMock)
+
+	equipmentID, _ := uuid.NewV4()
+	scheduleID, _ := uuid.NewV4()
+	unknownID, _ := uuid.NewV4()
+
+	taskServiceMock.On("FindEquipmentByID", equipmentID).Return(ServiceResult{
+		Result: query.TaskEquipmentResult{UID: equipmentID, Name: "Tractor"},
+	})
+	taskServiceMock.On("FindEquipmentByID", unknownID).Return(ServiceResult{
+		Error: TaskError{TaskErrorInvalidAssetIDCode},
+	})
+
+	taskDomain, errDomain := CreateTaskDomainEquipment(taskServiceMock, TaskCategoryMaintenance, nil, &scheduleID)
+
+	// When
+	task, err := CreateTask(
+		taskServiceMock, "Oil change of Tractor", "Due soon", "NORMAL", TaskCategoryMaintenance,
+		nil, taskDomain, &equipmentID)
+	_, errUnknown := CreateTask(
+		taskServiceMock, "Oil change of Tractor", "Due soon", "NORMAL", TaskCategoryMaintenance,
+		nil, taskDomain, &unknownID)
+
+	task.CompleteTask()
+
+	// Then
+	assert.Nil(t, errDomain)
+	assert.Nil(t, err)
+	assert.Equal(t, TaskError{TaskErrorInvalidAssetIDCode}, errUnknown)
+
+	event, ok := task.UncommittedChanges[1].(TaskCompleted)
+
+	assert.True(t, ok)
+	assert.Equal(t, &equipmentID, event.EquipmentID)
+	assert.Equal(t, &scheduleID, event.MaintenanceScheduleID)
+}
+
+func TestCompleteLivestockTreatmentTask(t *testing.T) {
+	t.Parallel()
+	// Given
+	taskServiceMock := new(TaskServiceMock)
+
+	animalID, _ := uuid.NewV4()
+	materialID, _ := uuid.NewV4()
+
+	taskServiceMock.On("FindAnimalByID", animalID).Return(ServiceResult{
+		Result: query.TaskAnimalResult{UID: animalID, TagID: "COW-12"},
+	})
+	taskServiceMock.On("FindMaterialByID", materialID).Return(ServiceResult{
+		Result: query.TaskMaterialResult{UID: materialID, TypeCode: "AGROCHEMICAL"},
+	})
+
+	taskDomain, errDomain := CreateTaskDomainLivestock(taskServiceMock, TaskCategoryLivestock, &materialID)
+
+	task, err := CreateTask(
+		taskServiceMock, "Deworming", "Twice a year", "NORMAL", TaskCategoryLivestock,
+		nil, taskDomain, &animalID)
+
+	// When
+	errComplete := task.CompleteTaskUsingMaterial(5, 100, false)
+
+	// Then
+	assert.Nil(t, errDomain)
+	assert.Nil(t, err)
+	assert.Nil(t, errComplete)
+
+	event, ok := task.UncommittedChanges[1].(TaskCompleted)
+
+	assert.True(t, ok)
+	assert.Equal(t, &animalID, event.AnimalID)
+	assert.Equal(t, "Deworming", event.Treatment)
+	assert.Equal(t, &materialID, event.MaterialID)
+}
+
+func TestCreateTaskDomainWithExpiredAgrochemical(t *testing.T) {
+	t.Parallel()
+	// Given
+	taskServiceMock := new(TaskServiceMock)
+
+	yesterday := time.Now().AddDate(0, 0, -1)
+	today := time.Now()
+
+	expiredID, _ := uuid.NewV4()
+	taskServiceMock.On("FindMaterialByID", expiredID).Return(ServiceResult{
+		Result: query.TaskMaterialResult{
+			UID:            expiredID,
+			TypeCode:       "AGROCHEMICAL",
+			ExpirationDate: &yesterday,
+		},
+	})
+
+	expiringID, _ := uuid.NewV4()
+	taskServiceMock.On("FindMaterialByID", expiringID).Return(ServiceResult{
+		Result: query.TaskMaterialResult{
+			UID:            expiringID,
+			TypeCode:       "AGROCHEMICAL",
+			ExpirationDate: &today,
+		},
+	})
+
+	expiredSeedID, _ := uuid.NewV4()
+	taskServiceMock.On("FindMaterialByID", expiredSeedID).Return(ServiceResult{
+		Result: query.TaskMaterialResult{
+			UID:            expiredSeedID,
+			TypeCode:       "SEED",
+			ExpirationDate: &yesterday,
+		},
+	})
+
+	notMaterialID, _ := uuid.NewV4()
+	taskServiceMock.On("FindMaterialByID", notMaterialID).Return(ServiceResult{})
+
+	// When
+	_, errArea := CreateTaskDomainArea(taskServiceMock, TaskCategoryNutrient, &expiredID)
+	_, errCrop := CreateTaskDomainCrop(taskServiceMock, TaskCategoryNutrient, &expiredID, nil)
+	_, errExpiring := CreateTaskDomainCrop(taskServiceMock, TaskCategoryNutrient, &expiringID, nil)
+	_, errSeed := CreateTaskDomainCrop(taskServiceMock, TaskCategoryCrop, &expiredSeedID, nil)
+	_, errReservoir := CreateTaskDomainReservoir(taskServiceMock, TaskCategoryNutrient, &expiredID)
+	_, errNotMaterial := CreateTaskDomainReservoir(taskServiceMock, TaskCategoryNutrient, &notMaterialID)
+
+	// Then
+	assert.Equal(t, TaskError{TaskErrorMaterialExpiredCode}, errArea)
+	assert.Equal(t, TaskError{TaskErrorMaterialExpiredCode}, errCrop)
+	assert.Nil(t, errExpiring)
+	assert.Nil(t, errSeed)
+	assert.Equal(t, TaskError{TaskErrorMaterialExpiredCode}, errReservoir)
+	assert.Equal(t, TaskError{TaskErrorInvalidInventoryIDCode}, errNotMaterial)
+}
@@ -0,0 +1,37 @@
+package inmemory
+
+import (
+	"github.com/gofrs/uuid"
+	livestockstorage "github.com/usetania/tania-core/src/livestock/storage"
+	"github.com/usetania/tania-core/src/tasks/query"
+)
+
+type AnimalQueryInMemory struct {
+	Storage *livestockstorage.AnimalReadStorage
+}
+
+func NewAnimalQueryInMemory(s *livestockstorage.AnimalReadStorage) query.Animal {
+	return AnimalQueryInMemory{Storage: s}
+}
+
+func (s AnimalQueryInMemory) FindAnimalByID(animalUID uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		ci := query.TaskAnimalResult{}
+
+		if val, ok := s.Storage.AnimalReadMap[animalUID]; ok {
+			ci.UID = val.UID
+			ci.TagID = val.TagID
+		}
+
+		result <- query.Result{Result: ci}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,73 @@
+package inmemory
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/assets/storage"
+	"github.com/usetania/tania-core/src/tasks/query"
+)
+
+type EquipmentQueryInMemory struct {
+	Storage *storage.EquipmentReadStorage
+}
+
+func NewEquipmentQueryInMemory(s *storage.EquipmentReadStorage) query.Equipment {
+	return EquipmentQueryInMemory{Storage: s}
+}
+
+func (s EquipmentQueryInMemory) FindEquipmentByID(equipmentUID uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		ci := query.TaskEquipmentResult{}
+
+		if val, ok := s.Storage.EquipmentReadMap[equipmentUID]; ok {
+			ci.UID = val.UID
+			ci.Name = val.Name
+		}
+
+		result <- query.Result{Result: ci}
+
+		close(result)
+	}()
+
+	return result
+}
+
+// FindMaintenancesDue finds the equipment maintenance schedules due before the given date.
+func (s EquipmentQueryInMemory) FindMaintenancesDue(before time.Time) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		maintenances := []query.TaskEquipmentMaintenanceResult{}
+
+		for _, val := range s.Storage.EquipmentReadMap {
+			for _, schedule := range val.MaintenanceSchedules {
+				if !schedule.NextDueDate.Before(before) {
+					continue
+				}
+
+				maintenances = append(maintenances, query.TaskEquipmentMaintenanceResult{
+					UID:         val.UID,
+					Name:        val.Name,
+					ScheduleUID: schedule.UID,
+					Title:       schedule.Title,
+					NextDueDate: schedule.NextDueDate,
+				})
+			}
+		}
+
+		result <- query.Result{Result: maintenances}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -1,6 +1,8 @@
 package inmemory
 
 import (
+	"time"
+
 	"github.com/gofrs/uuid"
 	assetsdomain "github.com/usetania/tania-core/src/assets/domain"
 	assetsstorage "github.com/usetania/tania-core/src/assets/storage"
@@ -30,6 +32,9 @@ func (s MaterialQueryInMemory) FindMaterialByID(inventoryUID uuid.UUID) <-chan q
 				ci.UID = val.UID
 				ci.Name = val.Name
 				ci.TypeCode = val.Type.Code()
+				ci.Quantity = val.Quantity.Value
+				ci.QuantityUnit = val.Quantity.Unit.Code
+				ci.ExpirationDate = val.ExpirationDate
 
 				switch v := val.Type.(type) {
 				case assetsdomain.MaterialTypeSeed:
@@ -48,3 +53,69 @@ func (s MaterialQueryInMemory) FindMaterialByID(inventoryUID uuid.UUID) <-chan q
 
 	return result
 }
+
+// FindLowStockMaterials finds the materials whose quantity is below their reorder point.
+func (s MaterialQueryInMemory) FindLowStockMaterials() <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		materials := []query.TaskMaterialResult{}
+
+		for _, val := range s.Storage.MaterialReadMap {
+			if !assetsdomain.MaterialQuantity(val.Quantity).IsBelow(val.ReorderPoint) {
+				continue
+			}
+
+			materials = append(materials, query.TaskMaterialResult{
+				UID:          val.UID,
+				Name:         val.Name,
+				TypeCode:     val.Type.Code(),
+				Quantity:     val.Quantity.Value,
+				QuantityUnit: val.Quantity.Unit.Code,
+				ReorderPoint: *val.ReorderPoint,
+			})
+		}
+
+		result <- query.Result{Result: materials}
+
+		close(result)
+	}()
+
+	return result
+}
+
+// FindExpiringMaterials finds the materials left in stock whose expiration date is before the given date.
+func (s MaterialQueryInMemory) FindExpiringMaterials(before time.Time) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		materials := []query.TaskMaterialResult{}
+
+		for _, val := range s.Storage.MaterialReadMap {
+			if val.ExpirationDate == nil || !val.ExpirationDate.Before(before) || val.Quantity.Value <= 0 {
+				continue
+			}
+
+			materials = append(materials, query.TaskMaterialResult{
+				UID:            val.UID,
+				Name:           val.Name,
+				TypeCode:       val.Type.Code(),
+				Quantity:       val.Quantity.Value,
+				QuantityUnit:   val.Quantity.Unit.Code,
+				ExpirationDate: val.ExpirationDate,
+			})
+		}
+
+		result <- query.Result{Result: materials}
+
+		close(result)
+	}()
+
+	return result
+}
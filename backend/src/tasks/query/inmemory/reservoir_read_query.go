@@ -1,6 +1,8 @@
 package inmemory
 
 import (
+	"time"
+
 	"github.com/gofrs/uuid"
 	"github.com/usetania/tania-core/src/assets/storage"
 	"github.com/usetania/tania-core/src/tasks/query"
@@ -38,3 +40,71 @@ func (s ReservoirQueryInMemory) FindReservoirByID(reservoirUID uuid.UUID) <-chan
 
 	return result
 }
+
+// FindReservoirsRunningDry finds the buckets predicted to run dry before the given date.
+func (s ReservoirQueryInMemory) FindReservoirsRunningDry(before time.Time) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		reservoirs := []query.TaskReservoirResult{}
+
+		for _, val := range s.Storage.ReservoirReadMap {
+			if val.Level == nil || val.Level.PredictedDryDate == nil || !val.Level.PredictedDryDate.Before(before) {
+				continue
+			}
+
+			reservoirs = append(reservoirs, query.TaskReservoirResult{
+				UID:              val.UID,
+				Name:             val.Name,
+				Level:            val.Level.Level,
+				PredictedDryDate: val.Level.PredictedDryDate,
+			})
+		}
+
+		result <- query.Result{Result: reservoirs}
+
+		close(result)
+	}()
+
+	return result
+}
+
+// FindReservoirsOutOfRange finds the water quality parameters last measured outside their acceptable range.
+func (s ReservoirQueryInMemory) FindReservoirsOutOfRange() <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		reservoirs := []query.TaskReservoirWaterQualityResult{}
+
+		for _, val := range s.Storage.ReservoirReadMap {
+			for _, wq := range val.WaterQuality {
+				if !wq.OutOfRange || wq.Value == nil || wq.MeasuredDate == nil {
+					continue
+				}
+
+				reservoirs = append(reservoirs, query.TaskReservoirWaterQualityResult{
+					UID:          val.UID,
+					Name:         val.Name,
+					Parameter:    wq.Parameter,
+					Unit:         wq.Unit,
+					Value:        *wq.Value,
+					Min:          wq.Min,
+					Max:          wq.Max,
+					MeasuredDate: *wq.MeasuredDate,
+				})
+			}
+		}
+
+		result <- query.Result{Result: reservoirs}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,57 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/tasks/query"
+)
+
+type AnimalQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewAnimalQueryMysql(db *sql.DB) query.Animal {
+	return AnimalQueryMysql{DB: db}
+}
+
+func (s AnimalQueryMysql) FindAnimalByID(uid uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		defer close(result)
+
+		rowsData := struct {
+			UID   []byte
+			TagID string
+		}{}
+
+		err := s.DB.QueryRow(`SELECT UID, TAG_ID
+			FROM ANIMAL_READ WHERE UID = ?`, uid.Bytes()).Scan(&rowsData.UID, &rowsData.TagID)
+		if err == sql.ErrNoRows {
+			result <- query.Result{Result: query.TaskAnimalResult{}}
+
+			return
+		}
+
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		animalUID, err := uuid.FromBytes(rowsData.UID)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		result <- query.Result{Result: query.TaskAnimalResult{
+			UID:   animalUID,
+			TagID: rowsData.TagID,
+		}}
+	}()
+
+	return result
+}
@@ -2,6 +2,7 @@ package mysql
 
 import (
 	"database/sql"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/usetania/tania-core/src/tasks/query"
@@ -20,30 +21,53 @@ func (s MaterialQueryMysql) FindMaterialByID(uid uuid.UUID) <-chan query.Result
 
 	go func() {
 		rowsData := struct {
-			UID      []byte
-			Name     string
-			Type     string
-			TypeData string
+			UID            []byte
+			Name           string
+			Type           string
+			TypeData       string
+			Quantity       sql.NullFloat64
+			QuantityUnit   sql.NullString
+			ExpirationDate sql.NullString
 		}{}
 		material := query.TaskMaterialResult{}
 
-		s.DB.QueryRow(`SELECT UID, NAME, TYPE, TYPE_DATA
+		s.DB.QueryRow(`SELECT UID, NAME, TYPE, TYPE_DATA, QUANTITY, QUANTITY_UNIT, EXPIRATION_DATE
 			FROM MATERIAL_READ WHERE UID = ?`, uid.Bytes()).Scan(
 			&rowsData.UID,
 			&rowsData.Name,
 			&rowsData.Type,
 			&rowsData.TypeData,
+			&rowsData.Quantity,
+			&rowsData.QuantityUnit,
+			&rowsData.ExpirationDate,
 		)
 
 		materialUID, err := uuid.FromBytes(rowsData.UID)
 		if err != nil {
 			result <- query.Result{Error: err}
+			close(result)
+
+			return
 		}
 
 		material.UID = materialUID
 		material.Name = rowsData.Name
 		material.TypeCode = rowsData.Type
 		material.DetailedTypeCode = rowsData.TypeData
+		material.Quantity = float32(rowsData.Quantity.Float64)
+		material.QuantityUnit = rowsData.QuantityUnit.String
+
+		if rowsData.ExpirationDate.Valid && rowsData.ExpirationDate.String != "" {
+			date, err := time.Parse("2006-01-02 15:04:05", rowsData.ExpirationDate.String)
+			if err != nil {
+				result <- query.Result{Error: err}
+				close(result)
+
+				return
+			}
+
+			material.ExpirationDate = &date
+		}
 
 		result <- query.Result{Result: material}
 
@@ -52,3 +76,160 @@ func (s MaterialQueryMysql) FindMaterialByID(uid uuid.UUID) <-chan query.Result
 
 	return result
 }
+
+// FindLowStockMaterials finds the materials whose quantity is below their reorder point.
+func (s MaterialQueryMysql) FindLowStockMaterials() <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		materials := []query.TaskMaterialResult{}
+
+		rows, err := s.DB.Query(`SELECT M.UID, M.NAME, M.TYPE, M.TYPE_DATA, M.QUANTITY, M.QUANTITY_UNIT, R.REORDER_POINT
+			FROM MATERIAL_READ M INNER JOIN MATERIAL_READ_REORDER_POINT R ON M.UID = R.MATERIAL_UID
+			WHERE M.QUANTITY < R.REORDER_POINT`)
+		if err != nil {
+			result <- query.Result{Error: err}
+			close(result)
+
+			return
+		}
+
+		defer rows.Close()
+
+		for rows.Next() {
+			rowsData := struct {
+				UID          []byte
+				Name         string
+				Type         string
+				TypeData     string
+				Quantity     float32
+				QuantityUnit string
+				ReorderPoint float32
+			}{}
+
+			err = rows.Scan(
+				&rowsData.UID,
+				&rowsData.Name,
+				&rowsData.Type,
+				&rowsData.TypeData,
+				&rowsData.Quantity,
+				&rowsData.QuantityUnit,
+				&rowsData.ReorderPoint,
+			)
+			if err != nil {
+				result <- query.Result{Error: err}
+				close(result)
+
+				return
+			}
+
+			materialUID, err := uuid.FromBytes(rowsData.UID)
+			if err != nil {
+				result <- query.Result{Error: err}
+				close(result)
+
+				return
+			}
+
+			materials = append(materials, query.TaskMaterialResult{
+				UID:              materialUID,
+				Name:             rowsData.Name,
+				TypeCode:         rowsData.Type,
+				DetailedTypeCode: rowsData.TypeData,
+				Quantity:         rowsData.Quantity,
+				QuantityUnit:     rowsData.QuantityUnit,
+				ReorderPoint:     rowsData.ReorderPoint,
+			})
+		}
+
+		result <- query.Result{Result: materials}
+
+		close(result)
+	}()
+
+	return result
+}
+
+// FindExpiringMaterials finds the materials left in stock whose expiration date is before the given date.
+func (s MaterialQueryMysql) FindExpiringMaterials(before time.Time) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		materials := []query.TaskMaterialResult{}
+
+		rows, err := s.DB.Query(`SELECT UID, NAME, TYPE, TYPE_DATA, QUANTITY, QUANTITY_UNIT, EXPIRATION_DATE
+			FROM MATERIAL_READ WHERE EXPIRATION_DATE IS NOT NULL AND EXPIRATION_DATE != '' AND QUANTITY > 0`)
+		if err != nil {
+			result <- query.Result{Error: err}
+			close(result)
+
+			return
+		}
+
+		defer rows.Close()
+
+		for rows.Next() {
+			rowsData := struct {
+				UID            []byte
+				Name           string
+				Type           string
+				TypeData       string
+				Quantity       float32
+				QuantityUnit   string
+				ExpirationDate string
+			}{}
+
+			err = rows.Scan(
+				&rowsData.UID,
+				&rowsData.Name,
+				&rowsData.Type,
+				&rowsData.TypeData,
+				&rowsData.Quantity,
+				&rowsData.QuantityUnit,
+				&rowsData.ExpirationDate,
+			)
+			if err != nil {
+				result <- query.Result{Error: err}
+				close(result)
+
+				return
+			}
+
+			materialUID, err := uuid.FromBytes(rowsData.UID)
+			if err != nil {
+				result <- query.Result{Error: err}
+				close(result)
+
+				return
+			}
+
+			expirationDate, err := time.Parse("2006-01-02 15:04:05", rowsData.ExpirationDate)
+			if err != nil {
+				result <- query.Result{Error: err}
+				close(result)
+
+				return
+			}
+
+			if !expirationDate.Before(before) {
+				continue
+			}
+
+			materials = append(materials, query.TaskMaterialResult{
+				UID:              materialUID,
+				Name:             rowsData.Name,
+				TypeCode:         rowsData.Type,
+				DetailedTypeCode: rowsData.TypeData,
+				Quantity:         rowsData.Quantity,
+				QuantityUnit:     rowsData.QuantityUnit,
+				ExpirationDate:   &expirationDate,
+			})
+		}
+
+		result <- query.Result{Result: materials}
+
+		close(result)
+	}()
+
+	return result
+}
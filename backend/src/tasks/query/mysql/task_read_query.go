@@ -69,6 +69,15 @@ func (q TaskReadQueryMysql) FindAll(page, limit int) <-chan query.Result {
 			tasks = append(tasks, taskRead)
 		}
 
+		tasks, err = q.withDetailsKeptAside(tasks)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			close(result)
+
+			return
+		}
+
 		result <- query.Result{Result: tasks}
 
 		close(result)
@@ -98,7 +107,16 @@ func (q TaskReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.Result {
 			task = taskRead
 		}
 
-		result <- query.Result{Result: task}
+		withDetails, err := q.withDetailsKeptAside([]storage.TaskRead{task})
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			close(result)
+
+			return
+		}
+
+		result <- query.Result{Result: withDetails[0]}
 		close(result)
 	}()
 
@@ -183,6 +201,15 @@ func (q TaskReadQueryMysql) FindTasksWithFilter(params map[string]string, page,
 			tasks = append(tasks, taskRead)
 		}
 
+		tasks, err = q.withDetailsKeptAside(tasks)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			close(result)
+
+			return
+		}
+
 		result <- query.Result{Result: tasks}
 
 		close(result)
@@ -331,6 +358,26 @@ func (TaskReadQueryMysql) populateQueryResult(rows *sql.Rows) (storage.TaskRead,
 		domainDetails = domain.TaskDomainInventory{}
 	case domain.TaskDomainReservoirCode:
 		domainDetails = domain.TaskDomainReservoir{}
+	case domain.TaskDomainEquipmentCode:
+		var materialID *uuid.UUID
+
+		if rowsData.DomainDataMaterialID.Valid {
+			materialID = &rowsData.DomainDataMaterialID.UUID
+		}
+
+		domainDetails = domain.TaskDomainEquipment{
+			MaterialID: materialID,
+		}
+	case domain.TaskDomainLivestockCode:
+		var materialID *uuid.UUID
+
+		if rowsData.DomainDataMaterialID.Valid {
+			materialID = &rowsData.DomainDataMaterialID.UUID
+		}
+
+		domainDetails = domain.TaskDomainLivestock{
+			MaterialID: materialID,
+		}
 	}
 
 	assetUID := &uuid.UUID{}
@@ -360,3 +407,102 @@ func (TaskReadQueryMysql) populateQueryResult(rows *sql.Rows) (storage.TaskRead,
 		AssetID:       assetUID,
 	}, nil
 }
+
+// withDetailsKeptAside adds the domain details which are kept aside from TASK_READ.
+func (q TaskReadQueryMysql) withDetailsKeptAside(tasks []storage.TaskRead) ([]storage.TaskRead, error) {
+	tasks, err := q.withMaintenanceSchedules(tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err = q.withInventoryReasons(tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	return q.withReservoirReasons(tasks)
+}
+
+// withMaintenanceSchedules adds the maintenance schedules, kept aside from TASK_READ, to the equipment tasks.
+func (q TaskReadQueryMysql) withMaintenanceSchedules(tasks []storage.TaskRead) ([]storage.TaskRead, error) {
+	for i, v := range tasks {
+		details, ok := v.DomainDetails.(domain.TaskDomainEquipment)
+		if !ok {
+			continue
+		}
+
+		scheduleUID := uuid.NullUUID{}
+
+		err := q.DB.QueryRow(`SELECT MAINTENANCE_SCHEDULE_UID FROM TASK_READ_EQUIPMENT_MAINTENANCE
+			WHERE TASK_UID = ?`, v.UID.Bytes()).Scan(&scheduleUID)
+		if err == sql.ErrNoRows {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if scheduleUID.Valid {
+			details.MaintenanceScheduleID = &scheduleUID.UUID
+			tasks[i].DomainDetails = details
+		}
+	}
+
+	return tasks, nil
+}
+
+// withInventoryReasons adds the reasons, kept aside from TASK_READ, to the inventory tasks created by the checkers.
+func (q TaskReadQueryMysql) withInventoryReasons(tasks []storage.TaskRead) ([]storage.TaskRead, error) {
+	for i, v := range tasks {
+		details, ok := v.DomainDetails.(domain.TaskDomainInventory)
+		if !ok {
+			continue
+		}
+
+		reason := sql.NullString{}
+
+		err := q.DB.QueryRow(`SELECT REASON FROM TASK_READ_INVENTORY WHERE TASK_UID = ?`, v.UID.Bytes()).Scan(&reason)
+		if err == sql.ErrNoRows {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		details.Reason = reason.String
+		tasks[i].DomainDetails = details
+	}
+
+	return tasks, nil
+}
+
+// withReservoirReasons adds the reasons and parameters, kept aside from TASK_READ, to the reservoir tasks
+// created by the checkers.
+func (q TaskReadQueryMysql) withReservoirReasons(tasks []storage.TaskRead) ([]storage.TaskRead, error) {
+	for i, v := range tasks {
+		details, ok := v.DomainDetails.(domain.TaskDomainReservoir)
+		if !ok {
+			continue
+		}
+
+		reason, parameter := sql.NullString{}, sql.NullString{}
+
+		err := q.DB.QueryRow(`SELECT REASON, PARAMETER FROM TASK_READ_RESERVOIR WHERE TASK_UID = ?`,
+			v.UID.Bytes()).Scan(&reason, &parameter)
+		if err == sql.ErrNoRows {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		details.Reason = reason.String
+		details.Parameter = parameter.String
+		tasks[i].DomainDetails = details
+	}
+
+	return tasks, nil
+}
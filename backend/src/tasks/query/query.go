@@ -1,6 +1,8 @@
 package query
 
 import (
+	"time"
+
 	"github.com/gofrs/uuid"
 )
 
@@ -26,6 +28,8 @@ type Crop interface {
 
 type Material interface {
 	FindMaterialByID(materialID uuid.UUID) <-chan Result
+	FindLowStockMaterials() <-chan Result
+	FindExpiringMaterials(before time.Time) <-chan Result
 }
 
 type TaskEvent interface {
@@ -42,6 +46,17 @@ type TaskRead interface {
 
 type Reservoir interface {
 	FindReservoirByID(reservoirUID uuid.UUID) <-chan Result
+	FindReservoirsRunningDry(before time.Time) <-chan Result
+	FindReservoirsOutOfRange() <-chan Result
+}
+
+type Equipment interface {
+	FindEquipmentByID(equipmentUID uuid.UUID) <-chan Result
+	FindMaintenancesDue(before time.Time) <-chan Result
+}
+
+type Animal interface {
+	FindAnimalByID(animalUID uuid.UUID) <-chan Result
 }
 
 // QUERY RESULTS
@@ -57,13 +72,51 @@ type TaskCropResult struct {
 }
 
 type TaskMaterialResult struct {
-	UID              uuid.UUID `json:"uid"`
-	TypeCode         string    `json:"type"`
-	DetailedTypeCode string    `json:"detailed_type"`
-	Name             string    `json:"name"`
+	UID              uuid.UUID  `json:"uid"`
+	TypeCode         string     `json:"type"`
+	DetailedTypeCode string     `json:"detailed_type"`
+	Name             string     `json:"name"`
+	Quantity         float32    `json:"quantity"`
+	QuantityUnit     string     `json:"quantity_unit"`
+	ReorderPoint     float32    `json:"reorder_point,omitempty"`
+	ExpirationDate   *time.Time `json:"expiration_date,omitempty"`
 }
 
 type TaskReservoirResult struct {
+	UID              uuid.UUID  `json:"uid"`
+	Name             string     `json:"name"`
+	Level            float32    `json:"level"`
+	PredictedDryDate *time.Time `json:"predicted_dry_date"`
+}
+
+// TaskReservoirWaterQualityResult is the latest measurement of a water quality parameter of a reservoir
+// which is outside its acceptable range.
+type TaskReservoirWaterQualityResult struct {
+	UID          uuid.UUID `json:"uid"`
+	Name         string    `json:"name"`
+	Parameter    string    `json:"parameter"`
+	Unit         string    `json:"unit"`
+	Value        float32   `json:"value"`
+	Min          *float32  `json:"min"`
+	Max          *float32  `json:"max"`
+	MeasuredDate time.Time `json:"measured_date"`
+}
+
+type TaskEquipmentResult struct {
 	UID  uuid.UUID `json:"uid"`
 	Name string    `json:"name"`
 }
+
+type TaskAnimalResult struct {
+	UID   uuid.UUID `json:"uid"`
+	TagID string    `json:"tag_id"`
+}
+
+// TaskEquipmentMaintenanceResult is a maintenance schedule of an equipment with its next due date.
+type TaskEquipmentMaintenanceResult struct {
+	UID         uuid.UUID `json:"uid"`
+	Name        string    `json:"name"`
+	ScheduleUID uuid.UUID `json:"schedule_id"`
+	Title       string    `json:"title"`
+	NextDueDate time.Time `json:"next_due_date"`
+}
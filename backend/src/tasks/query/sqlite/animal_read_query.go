@@ -0,0 +1,57 @@
+package sqlite
+
+import (
+	"database/sql"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/tasks/query"
+)
+
+type AnimalQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewAnimalQuerySqlite(db *sql.DB) query.Animal {
+	return AnimalQuerySqlite{DB: db}
+}
+
+func (s AnimalQuerySqlite) FindAnimalByID(uid uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		defer close(result)
+
+		rowsData := struct {
+			UID   string
+			TagID string
+		}{}
+
+		err := s.DB.QueryRow(`SELECT UID, TAG_ID
+			FROM ANIMAL_READ WHERE UID = ?`, uid).Scan(&rowsData.UID, &rowsData.TagID)
+		if err == sql.ErrNoRows {
+			result <- query.Result{Result: query.TaskAnimalResult{}}
+
+			return
+		}
+
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		animalUID, err := uuid.FromString(rowsData.UID)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		result <- query.Result{Result: query.TaskAnimalResult{
+			UID:   animalUID,
+			TagID: rowsData.TagID,
+		}}
+	}()
+
+	return result
+}
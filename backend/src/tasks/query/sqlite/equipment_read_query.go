@@ -0,0 +1,136 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/tasks/query"
+)
+
+type EquipmentQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewEquipmentQuerySqlite(db *sql.DB) query.Equipment {
+	return EquipmentQuerySqlite{DB: db}
+}
+
+func (s EquipmentQuerySqlite) FindEquipmentByID(uid uuid.UUID) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		defer close(result)
+
+		rowsData := struct {
+			UID  string
+			Name string
+		}{}
+
+		err := s.DB.QueryRow(`SELECT UID, NAME
+			FROM EQUIPMENT_READ WHERE UID = ?`, uid).Scan(&rowsData.UID, &rowsData.Name)
+		if err == sql.ErrNoRows {
+			result <- query.Result{Result: query.TaskEquipmentResult{}}
+
+			return
+		}
+
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		equipmentUID, err := uuid.FromString(rowsData.UID)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+
+		result <- query.Result{Result: query.TaskEquipmentResult{
+			UID:  equipmentUID,
+			Name: rowsData.Name,
+		}}
+	}()
+
+	return result
+}
+
+// FindMaintenancesDue finds the equipment maintenance schedules due before the given date.
+func (s EquipmentQuerySqlite) FindMaintenancesDue(before time.Time) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		defer close(result)
+
+		maintenances := []query.TaskEquipmentMaintenanceResult{}
+
+		rows, err := s.DB.Query(`SELECT EQUIPMENT_READ.UID, EQUIPMENT_READ.NAME,
+			EQUIPMENT_READ_MAINTENANCE_SCHEDULE.UID, EQUIPMENT_READ_MAINTENANCE_SCHEDULE.TITLE,
+			EQUIPMENT_READ_MAINTENANCE_SCHEDULE.NEXT_DUE_DATE
+			FROM EQUIPMENT_READ
+			JOIN EQUIPMENT_READ_MAINTENANCE_SCHEDULE
+			ON EQUIPMENT_READ_MAINTENANCE_SCHEDULE.EQUIPMENT_UID = EQUIPMENT_READ.UID`)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			rowsData := struct {
+				UID         string
+				Name        string
+				ScheduleUID string
+				Title       string
+				NextDueDate string
+			}{}
+
+			err = rows.Scan(&rowsData.UID, &rowsData.Name, &rowsData.ScheduleUID, &rowsData.Title, &rowsData.NextDueDate)
+			if err != nil {
+				result <- query.Result{Error: err}
+
+				return
+			}
+
+			nextDueDate, err := time.Parse(time.RFC3339, rowsData.NextDueDate)
+			if err != nil {
+				result <- query.Result{Error: err}
+
+				return
+			}
+
+			if !nextDueDate.Before(before) {
+				continue
+			}
+
+			equipmentUID, err := uuid.FromString(rowsData.UID)
+			if err != nil {
+				result <- query.Result{Error: err}
+
+				return
+			}
+
+			scheduleUID, err := uuid.FromString(rowsData.ScheduleUID)
+			if err != nil {
+				result <- query.Result{Error: err}
+
+				return
+			}
+
+			maintenances = append(maintenances, query.TaskEquipmentMaintenanceResult{
+				UID:         equipmentUID,
+				Name:        rowsData.Name,
+				ScheduleUID: scheduleUID,
+				Title:       rowsData.Title,
+				NextDueDate: nextDueDate,
+			})
+		}
+
+		result <- query.Result{Result: maintenances}
+	}()
+
+	return result
+}
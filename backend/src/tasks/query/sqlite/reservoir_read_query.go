@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"database/sql"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/usetania/tania-core/src/tasks/query"
@@ -43,3 +44,145 @@ func (s ReservoirQuerySqlite) FindReservoirByID(uid uuid.UUID) <-chan query.Resu
 
 	return result
 }
+
+// FindReservoirsRunningDry finds the buckets predicted to run dry before the given date.
+func (s ReservoirQuerySqlite) FindReservoirsRunningDry(before time.Time) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		reservoirs := []query.TaskReservoirResult{}
+
+		rows, err := s.DB.Query(`SELECT RESERVOIR_READ.UID, RESERVOIR_READ.NAME,
+			RESERVOIR_READ_LEVEL.LEVEL, RESERVOIR_READ_LEVEL.PREDICTED_DRY_DATE
+			FROM RESERVOIR_READ
+			JOIN RESERVOIR_READ_LEVEL ON RESERVOIR_READ_LEVEL.RESERVOIR_UID = RESERVOIR_READ.UID
+			WHERE RESERVOIR_READ_LEVEL.PREDICTED_DRY_DATE IS NOT NULL`)
+		if err != nil {
+			result <- query.Result{Error: err}
+		}
+
+		for rows.Next() {
+			rowsData := struct {
+				UID              string
+				Name             string
+				Level            sql.NullFloat64
+				PredictedDryDate sql.NullString
+			}{}
+
+			err = rows.Scan(&rowsData.UID, &rowsData.Name, &rowsData.Level, &rowsData.PredictedDryDate)
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			reservoirUID, err := uuid.FromString(rowsData.UID)
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			predictedDryDate, err := time.Parse(time.RFC3339, rowsData.PredictedDryDate.String)
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			if !predictedDryDate.Before(before) {
+				continue
+			}
+
+			reservoirs = append(reservoirs, query.TaskReservoirResult{
+				UID:              reservoirUID,
+				Name:             rowsData.Name,
+				Level:            float32(rowsData.Level.Float64),
+				PredictedDryDate: &predictedDryDate,
+			})
+		}
+
+		result <- query.Result{Result: reservoirs}
+		close(result)
+	}()
+
+	return result
+}
+
+// FindReservoirsOutOfRange finds the water quality parameters last measured outside their acceptable range.
+func (s ReservoirQuerySqlite) FindReservoirsOutOfRange() <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		reservoirs := []query.TaskReservoirWaterQualityResult{}
+
+		rows, err := s.DB.Query(`SELECT RESERVOIR_READ.UID, RESERVOIR_READ.NAME,
+			RESERVOIR_READ_WATER_QUALITY.PARAMETER, RESERVOIR_READ_WATER_QUALITY.UNIT,
+			RESERVOIR_READ_WATER_QUALITY.VALUE, RESERVOIR_READ_WATER_QUALITY.MIN,
+			RESERVOIR_READ_WATER_QUALITY.MAX, RESERVOIR_READ_WATER_QUALITY.MEASURED_DATE
+			FROM RESERVOIR_READ
+			JOIN RESERVOIR_READ_WATER_QUALITY ON RESERVOIR_READ_WATER_QUALITY.RESERVOIR_UID = RESERVOIR_READ.UID
+			WHERE RESERVOIR_READ_WATER_QUALITY.OUT_OF_RANGE = 1
+			AND RESERVOIR_READ_WATER_QUALITY.MEASURED_DATE IS NOT NULL`)
+		if err != nil {
+			result <- query.Result{Error: err}
+		}
+
+		for rows.Next() {
+			rowsData := struct {
+				UID          string
+				Name         string
+				Parameter    string
+				Unit         string
+				Value        float32
+				Min          sql.NullFloat64
+				Max          sql.NullFloat64
+				MeasuredDate string
+			}{}
+
+			err = rows.Scan(
+				&rowsData.UID,
+				&rowsData.Name,
+				&rowsData.Parameter,
+				&rowsData.Unit,
+				&rowsData.Value,
+				&rowsData.Min,
+				&rowsData.Max,
+				&rowsData.MeasuredDate,
+			)
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			reservoirUID, err := uuid.FromString(rowsData.UID)
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			measuredDate, err := time.Parse(time.RFC3339, rowsData.MeasuredDate)
+			if err != nil {
+				result <- query.Result{Error: err}
+			}
+
+			reservoirs = append(reservoirs, query.TaskReservoirWaterQualityResult{
+				UID:          reservoirUID,
+				Name:         rowsData.Name,
+				Parameter:    rowsData.Parameter,
+				Unit:         rowsData.Unit,
+				Value:        rowsData.Value,
+				Min:          nullFloat32(rowsData.Min),
+				Max:          nullFloat32(rowsData.Max),
+				MeasuredDate: measuredDate,
+			})
+		}
+
+		result <- query.Result{Result: reservoirs}
+		close(result)
+	}()
+
+	return result
+}
+
+func nullFloat32(value sql.NullFloat64) *float32 {
+	if !value.Valid {
+		return nil
+	}
+
+	v := float32(value.Float64)
+
+	return &v
+}
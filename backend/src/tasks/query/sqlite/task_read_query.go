@@ -68,6 +68,15 @@ func (q TaskReadQuerySqlite) FindAll(page, limit int) <-chan query.Result {
 			tasks = append(tasks, taskRead)
 		}
 
+		tasks, err = q.withDetailsKeptAside(tasks)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			close(result)
+
+			return
+		}
+
 		result <- query.Result{Result: tasks}
 
 		close(result)
@@ -97,7 +106,16 @@ func (q TaskReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.Result {
 			task = taskRead
 		}
 
-		result <- query.Result{Result: task}
+		withDetails, err := q.withDetailsKeptAside([]storage.TaskRead{task})
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			close(result)
+
+			return
+		}
+
+		result <- query.Result{Result: withDetails[0]}
 		close(result)
 	}()
 
@@ -181,6 +199,15 @@ func (q TaskReadQuerySqlite) FindTasksWithFilter(params map[string]string, page,
 			tasks = append(tasks, taskRead)
 		}
 
+		tasks, err = q.withDetailsKeptAside(tasks)
+		if err != nil {
+			result <- query.Result{Error: err}
+
+			close(result)
+
+			return
+		}
+
 		result <- query.Result{Result: tasks}
 
 		close(result)
@@ -404,6 +431,36 @@ func (TaskReadQuerySqlite) populateQueryResult(rows *sql.Rows) (storage.TaskRead
 		domainDetails = domain.TaskDomainReservoir{
 			MaterialID: materialID,
 		}
+	case domain.TaskDomainEquipmentCode:
+		materialID := (*uuid.UUID)(nil)
+
+		if rowsData.DomainDataMaterialID.Valid && rowsData.DomainDataMaterialID.String != "" {
+			uid, err := uuid.FromString(rowsData.DomainDataMaterialID.String)
+			if err != nil {
+				return storage.TaskRead{}, err
+			}
+
+			materialID = &uid
+		}
+
+		domainDetails = domain.TaskDomainEquipment{
+			MaterialID: materialID,
+		}
+	case domain.TaskDomainLivestockCode:
+		materialID := (*uuid.UUID)(nil)
+
+		if rowsData.DomainDataMaterialID.Valid && rowsData.DomainDataMaterialID.String != "" {
+			uid, err := uuid.FromString(rowsData.DomainDataMaterialID.String)
+			if err != nil {
+				return storage.TaskRead{}, err
+			}
+
+			materialID = &uid
+		}
+
+		domainDetails = domain.TaskDomainLivestock{
+			MaterialID: materialID,
+		}
 	}
 
 	var assetUID *uuid.UUID
@@ -434,3 +491,107 @@ func (TaskReadQuerySqlite) populateQueryResult(rows *sql.Rows) (storage.TaskRead
 		AssetID:       assetUID,
 	}, nil
 }
+
+// withDetailsKeptAside adds the domain details which are kept aside from TASK_READ.
+func (q TaskReadQuerySqlite) withDetailsKeptAside(tasks []storage.TaskRead) ([]storage.TaskRead, error) {
+	tasks, err := q.withMaintenanceSchedules(tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err = q.withInventoryReasons(tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	return q.withReservoirReasons(tasks)
+}
+
+// withMaintenanceSchedules adds the maintenance schedules, kept aside from TASK_READ, to the equipment tasks.
+func (q TaskReadQuerySqlite) withMaintenanceSchedules(tasks []storage.TaskRead) ([]storage.TaskRead, error) {
+	for i, v := range tasks {
+		details, ok := v.DomainDetails.(domain.TaskDomainEquipment)
+		if !ok {
+			continue
+		}
+
+		scheduleUID := sql.NullString{}
+
+		err := q.DB.QueryRow(`SELECT MAINTENANCE_SCHEDULE_UID FROM TASK_READ_EQUIPMENT_MAINTENANCE
+			WHERE TASK_UID = ?`, v.UID).Scan(&scheduleUID)
+		if err == sql.ErrNoRows {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if scheduleUID.Valid && scheduleUID.String != "" {
+			uid, err := uuid.FromString(scheduleUID.String)
+			if err != nil {
+				return nil, err
+			}
+
+			details.MaintenanceScheduleID = &uid
+			tasks[i].DomainDetails = details
+		}
+	}
+
+	return tasks, nil
+}
+
+// withInventoryReasons adds the reasons, kept aside from TASK_READ, to the inventory tasks created by the checkers.
+func (q TaskReadQuerySqlite) withInventoryReasons(tasks []storage.TaskRead) ([]storage.TaskRead, error) {
+	for i, v := range tasks {
+		details, ok := v.DomainDetails.(domain.TaskDomainInventory)
+		if !ok {
+			continue
+		}
+
+		reason := sql.NullString{}
+
+		err := q.DB.QueryRow(`SELECT REASON FROM TASK_READ_INVENTORY WHERE TASK_UID = ?`, v.UID).Scan(&reason)
+		if err == sql.ErrNoRows {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		details.Reason = reason.String
+		tasks[i].DomainDetails = details
+	}
+
+	return tasks, nil
+}
+
+// withReservoirReasons adds the reasons and parameters, kept aside from TASK_READ, to the reservoir tasks
+// created by the checkers.
+func (q TaskReadQuerySqlite) withReservoirReasons(tasks []storage.TaskRead) ([]storage.TaskRead, error) {
+	for i, v := range tasks {
+		details, ok := v.DomainDetails.(domain.TaskDomainReservoir)
+		if !ok {
+			continue
+		}
+
+		reason, parameter := sql.NullString{}, sql.NullString{}
+
+		err := q.DB.QueryRow(`SELECT REASON, PARAMETER FROM TASK_READ_RESERVOIR WHERE TASK_UID = ?`,
+			v.UID).Scan(&reason, &parameter)
+		if err == sql.ErrNoRows {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		details.Reason = reason.String
+		details.Parameter = parameter.String
+		tasks[i].DomainDetails = details
+	}
+
+	return tasks, nil
+}
@@ -24,6 +24,10 @@ func (f *TaskReadRepositoryMysql) Save(taskRead *storage.TaskRead) <-chan error
 
 		var domainDataAreaID []byte
 
+		var maintenanceScheduleID []byte
+
+		inventoryReason, reservoirReason, reservoirParameter := "", "", ""
+
 		switch v := taskRead.DomainDetails.(type) {
 		case domain.TaskDomainCrop:
 			if v.MaterialID != nil {
@@ -33,6 +37,23 @@ func (f *TaskReadRepositoryMysql) Save(taskRead *storage.TaskRead) <-chan error
 			if v.AreaID != nil {
 				domainDataAreaID = v.AreaID.Bytes()
 			}
+		case domain.TaskDomainEquipment:
+			if v.MaterialID != nil {
+				domainDataMaterialID = v.MaterialID.Bytes()
+			}
+
+			if v.MaintenanceScheduleID != nil {
+				maintenanceScheduleID = v.MaintenanceScheduleID.Bytes()
+			}
+		case domain.TaskDomainLivestock:
+			if v.MaterialID != nil {
+				domainDataMaterialID = v.MaterialID.Bytes()
+			}
+		case domain.TaskDomainInventory:
+			inventoryReason = v.Reason
+		case domain.TaskDomainReservoir:
+			reservoirReason = v.Reason
+			reservoirParameter = v.Parameter
 		}
 
 		var assetID []byte
@@ -78,6 +99,37 @@ func (f *TaskReadRepositoryMysql) Save(taskRead *storage.TaskRead) <-chan error
 			}
 		}
 
+		// The equipment maintenance schedule is kept aside as TASK_READ has no column for it.
+		if maintenanceScheduleID != nil {
+			_, err := f.DB.Exec(`INSERT INTO TASK_READ_EQUIPMENT_MAINTENANCE
+				(TASK_UID, MAINTENANCE_SCHEDULE_UID) VALUES (?, ?)
+				ON DUPLICATE KEY UPDATE MAINTENANCE_SCHEDULE_UID = VALUES(MAINTENANCE_SCHEDULE_UID)`,
+				taskRead.UID.Bytes(), maintenanceScheduleID)
+			if err != nil {
+				result <- err
+			}
+		}
+
+		// So is the reason of the inventory tasks created by the material checkers.
+		if inventoryReason != "" {
+			_, err := f.DB.Exec(`INSERT INTO TASK_READ_INVENTORY (TASK_UID, REASON) VALUES (?, ?)
+				ON DUPLICATE KEY UPDATE REASON = VALUES(REASON)`,
+				taskRead.UID.Bytes(), inventoryReason)
+			if err != nil {
+				result <- err
+			}
+		}
+
+		// And the reason of the reservoir tasks created by the reservoir checkers.
+		if reservoirReason != "" {
+			_, err := f.DB.Exec(`INSERT INTO TASK_READ_RESERVOIR (TASK_UID, REASON, PARAMETER) VALUES (?, ?, ?)
+				ON DUPLICATE KEY UPDATE REASON = VALUES(REASON), PARAMETER = VALUES(PARAMETER)`,
+				taskRead.UID.Bytes(), reservoirReason, reservoirParameter)
+			if err != nil {
+				result <- err
+			}
+		}
+
 		result <- nil
 		close(result)
 	}()
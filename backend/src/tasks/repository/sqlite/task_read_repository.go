@@ -43,7 +43,9 @@ func (f *TaskReadRepositorySqlite) Save(taskRead *storage.TaskRead) <-chan error
 			cancelledDate = &d
 		}
 
-		var domainDataMaterialID, domainDataAreaID *uuid.UUID
+		var domainDataMaterialID, domainDataAreaID, maintenanceScheduleID *uuid.UUID
+
+		inventoryReason, reservoirReason, reservoirParameter := "", "", ""
 
 		switch v := taskRead.DomainDetails.(type) {
 		case domain.TaskDomainArea:
@@ -53,6 +55,15 @@ func (f *TaskReadRepositorySqlite) Save(taskRead *storage.TaskRead) <-chan error
 			domainDataAreaID = v.AreaID
 		case domain.TaskDomainReservoir:
 			domainDataMaterialID = v.MaterialID
+			reservoirReason = v.Reason
+			reservoirParameter = v.Parameter
+		case domain.TaskDomainEquipment:
+			domainDataMaterialID = v.MaterialID
+			maintenanceScheduleID = v.MaintenanceScheduleID
+		case domain.TaskDomainLivestock:
+			domainDataMaterialID = v.MaterialID
+		case domain.TaskDomainInventory:
+			inventoryReason = v.Reason
 		}
 
 		res, err := f.DB.Exec(`UPDATE TASK_READ SET
@@ -91,6 +102,35 @@ func (f *TaskReadRepositorySqlite) Save(taskRead *storage.TaskRead) <-chan error
 			}
 		}
 
+		// The equipment maintenance schedule is kept aside as TASK_READ has no column for it.
+		if maintenanceScheduleID != nil {
+			_, err := f.DB.Exec(`INSERT OR REPLACE INTO TASK_READ_EQUIPMENT_MAINTENANCE
+				(TASK_UID, MAINTENANCE_SCHEDULE_UID) VALUES (?, ?)`,
+				taskRead.UID, maintenanceScheduleID)
+			if err != nil {
+				result <- err
+			}
+		}
+
+		// So is the reason of the inventory tasks created by the material checkers.
+		if inventoryReason != "" {
+			_, err := f.DB.Exec(`INSERT OR REPLACE INTO TASK_READ_INVENTORY (TASK_UID, REASON) VALUES (?, ?)`,
+				taskRead.UID, inventoryReason)
+			if err != nil {
+				result <- err
+			}
+		}
+
+		// And the reason of the reservoir tasks created by the reservoir checkers.
+		if reservoirReason != "" {
+			_, err := f.DB.Exec(`INSERT OR REPLACE INTO TASK_READ_RESERVOIR (TASK_UID, REASON, PARAMETER)
+				VALUES (?, ?, ?)`,
+				taskRead.UID, reservoirReason, reservoirParameter)
+			if err != nil {
+				result <- err
+			}
+		}
+
 		result <- nil
 		close(result)
 	}()
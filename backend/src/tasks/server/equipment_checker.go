@@ -0,0 +1,63 @@
+package server
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/usetania/tania-core/src/tasks/domain"
+	"github.com/usetania/tania-core/src/tasks/query"
+	"github.com/usetania/tania-core/src/tasks/storage"
+)
+
+// StartEquipmentChecker checks the equipment maintenances due within the given number of days now,
+// then every interval. A zero interval disables the checker.
+func (s *TaskServer) StartEquipmentChecker(interval time.Duration, days int) {
+	s.startChecker(interval, func() error {
+		return s.CheckEquipmentMaintenances(days)
+	})
+}
+
+// CheckEquipmentMaintenances creates a maintenance task for each equipment maintenance schedule
+// due within the given number of days, unless the schedule already has one waiting to be done.
+// Completing the task records the maintenance, which moves the schedule to its next due date.
+// A schedule whose task can not be created is logged, and the other schedules are still checked.
+func (s *TaskServer) CheckEquipmentMaintenances(days int) error {
+	now := time.Now()
+	before := time.Date(now.Year(), now.Month(), now.Day()+days+1, 0, 0, 0, 0, time.UTC)
+
+	queryResult := <-s.EquipmentQuery.FindMaintenancesDue(before)
+	if queryResult.Error != nil {
+		return queryResult.Error
+	}
+
+	maintenances, ok := queryResult.Result.([]query.TaskEquipmentMaintenanceResult)
+	if !ok {
+		return fmt.Errorf("internal server error. error type assertion")
+	}
+
+	for _, maintenance := range maintenances {
+		scheduleUID := maintenance.ScheduleUID
+
+		err := s.createCheckerTask(
+			domain.TaskDomainEquipment{MaintenanceScheduleID: &scheduleUID},
+			domain.TaskCategoryMaintenance,
+			maintenance.UID,
+			fmt.Sprintf("%s of %s", maintenance.Title, maintenance.Name),
+			fmt.Sprintf("%s of %s is due on %s.",
+				maintenance.Title,
+				maintenance.Name,
+				maintenance.NextDueDate.Format("2006-01-02")),
+			func(task storage.TaskRead) bool {
+				details, ok := task.DomainDetails.(domain.TaskDomainEquipment)
+
+				return ok && details.MaintenanceScheduleID != nil && *details.MaintenanceScheduleID == scheduleUID
+			},
+		)
+		if err != nil {
+			log.Printf("maintenance schedule %s of equipment %s: %v", scheduleUID, maintenance.UID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package server
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/helper/datehelper"
+	"github.com/usetania/tania-core/src/tasks/domain"
+	"github.com/usetania/tania-core/src/tasks/query"
+	"github.com/usetania/tania-core/src/tasks/storage"
+)
+
+// StartLowStockChecker checks the materials below their reorder point now, then every interval.
+// A zero interval disables the checker.
+func (s *TaskServer) StartLowStockChecker(interval time.Duration) {
+	s.startChecker(interval, s.CheckLowStock)
+}
+
+// StartExpirationChecker checks the materials expiring within the given number of days now, then every interval.
+// A zero interval disables the checker.
+func (s *TaskServer) StartExpirationChecker(interval time.Duration, days int) {
+	s.startChecker(interval, func() error {
+		return s.CheckExpiringMaterials(days)
+	})
+}
+
+func (s *TaskServer) startChecker(interval time.Duration, check func() error) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			err := check()
+			if err != nil {
+				log.Println(err)
+			}
+
+			<-ticker.C
+		}
+	}()
+}
+
+// CheckLowStock creates a reorder task for each material below its reorder point,
+// unless the material already has one waiting to be done.
+func (s *TaskServer) CheckLowStock() error {
+	queryResult := <-s.MaterialQuery.FindLowStockMaterials()
+	if queryResult.Error != nil {
+		return queryResult.Error
+	}
+
+	materials, ok := queryResult.Result.([]query.TaskMaterialResult)
+	if !ok {
+		return fmt.Errorf("internal server error. error type assertion")
+	}
+
+	for _, material := range materials {
+		err := s.createMaterialTask(
+			material.UID,
+			domain.TaskInventoryReasonReorder,
+			"Reorder "+material.Name,
+			fmt.Sprintf("%s is down to %s %s, below its reorder point of %s %s.",
+				material.Name,
+				formatQuantity(material.Quantity), material.QuantityUnit,
+				formatQuantity(material.ReorderPoint), material.QuantityUnit),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CheckExpiringMaterials creates a task to use or dispose of each material left in stock which expires
+// within the given number of days, or to dispose of it once it expired, unless the material already has
+// an expiration task waiting to be done.
+func (s *TaskServer) CheckExpiringMaterials(days int) error {
+	now := time.Now()
+	before := time.Date(now.Year(), now.Month(), now.Day()+days+1, 0, 0, 0, 0, time.UTC)
+
+	queryResult := <-s.MaterialQuery.FindExpiringMaterials(before)
+	if queryResult.Error != nil {
+		return queryResult.Error
+	}
+
+	materials, ok := queryResult.Result.([]query.TaskMaterialResult)
+	if !ok {
+		return fmt.Errorf("internal server error. error type assertion")
+	}
+
+	for _, material := range materials {
+		expirationDate := material.ExpirationDate.Format("2006-01-02")
+
+		title := "Use or dispose of " + material.Name
+		description := fmt.Sprintf("%s %s of %s expire on %s.",
+			formatQuantity(material.Quantity), material.QuantityUnit, material.Name, expirationDate)
+
+		if datehelper.IsExpired(material.ExpirationDate, now) {
+			title = "Dispose of " + material.Name
+			description = fmt.Sprintf("%s %s of %s expired on %s.",
+				formatQuantity(material.Quantity), material.QuantityUnit, material.Name, expirationDate)
+		}
+
+		err := s.createMaterialTask(material.UID, domain.TaskInventoryReasonExpiration, title, description)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createMaterialTask creates an inventory task for the material, unless one for the same reason
+// is still waiting to be done.
+func (s *TaskServer) createMaterialTask(materialUID uuid.UUID, reason, title, description string) error {
+	return s.createCheckerTask(
+		domain.TaskDomainInventory{Reason: reason},
+		domain.TaskCategoryInventory,
+		materialUID,
+		title,
+		description,
+		func(task storage.TaskRead) bool {
+			details, ok := task.DomainDetails.(domain.TaskDomainInventory)
+
+			return ok && details.Reason == reason
+		},
+	)
+}
+
+// createCheckerTask creates a task for the asset found by a checker, unless the asset has a task
+// of the domain still waiting to be done which isSame, whatever its title.
+func (s *TaskServer) createCheckerTask(
+	taskDomain domain.TaskDomain,
+	category string,
+	assetUID uuid.UUID,
+	title, description string,
+	isSame func(task storage.TaskRead) bool,
+) error {
+	queryResult := <-s.TaskReadQuery.FindTasksWithFilter(map[string]string{
+		"status":   domain.TaskStatusCreated,
+		"domain":   taskDomain.Code(),
+		"asset_id": assetUID.String(),
+	}, 0, 0)
+	if queryResult.Error != nil {
+		return queryResult.Error
+	}
+
+	tasks, ok := queryResult.Result.([]storage.TaskRead)
+	if !ok {
+		return fmt.Errorf("internal server error. error type assertion")
+	}
+
+	for _, v := range tasks {
+		if isSame(v) {
+			return nil
+		}
+	}
+
+	task, err := domain.CreateTask(
+		s.TaskService,
+		title,
+		description,
+		domain.TaskPriorityNormal,
+		category,
+		nil,
+		taskDomain,
+		&assetUID)
+	if err != nil {
+		return err
+	}
+
+	err = <-s.TaskEventRepo.Save(task.UID, 0, task.UncommittedChanges)
+	if err != nil {
+		return err
+	}
+
+	s.publishUncommittedEvents(task)
+
+	return nil
+}
+
+func formatQuantity(quantity float32) string {
+	return strconv.FormatFloat(float64(quantity), 'f', -1, 32)
+}
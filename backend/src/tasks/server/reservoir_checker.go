@@ -0,0 +1,132 @@
+package server
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/tasks/domain"
+	"github.com/usetania/tania-core/src/tasks/query"
+	"github.com/usetania/tania-core/src/tasks/storage"
+)
+
+// StartReservoirChecker checks the buckets predicted to run dry within the given number of days now,
+// then every interval. A zero interval disables the checker.
+func (s *TaskServer) StartReservoirChecker(interval time.Duration, days int) {
+	s.startChecker(interval, func() error {
+		return s.CheckReservoirsRunningDry(days)
+	})
+}
+
+// CheckReservoirsRunningDry creates a task to refill each bucket predicted to run dry
+// within the given number of days, unless the bucket already has one waiting to be done.
+func (s *TaskServer) CheckReservoirsRunningDry(days int) error {
+	now := time.Now()
+	before := time.Date(now.Year(), now.Month(), now.Day()+days+1, 0, 0, 0, 0, time.UTC)
+
+	queryResult := <-s.ReservoirQuery.FindReservoirsRunningDry(before)
+	if queryResult.Error != nil {
+		return queryResult.Error
+	}
+
+	reservoirs, ok := queryResult.Result.([]query.TaskReservoirResult)
+	if !ok {
+		return fmt.Errorf("internal server error. error type assertion")
+	}
+
+	for _, reservoir := range reservoirs {
+		err := s.createReservoirTask(
+			reservoir.UID,
+			domain.TaskReservoirReasonRefill,
+			"Refill "+reservoir.Name,
+			fmt.Sprintf("%s is down to %s and is predicted to run dry on %s.",
+				reservoir.Name,
+				formatQuantity(reservoir.Level),
+				reservoir.PredictedDryDate.Format("2006-01-02")),
+		)
+		if err != nil {
+			log.Printf("reservoir %s running dry: %v", reservoir.UID, err)
+		}
+	}
+
+	return nil
+}
+
+// StartWaterQualityChecker checks the water quality measured outside its acceptable range now, then every interval.
+// A zero interval disables the checker.
+func (s *TaskServer) StartWaterQualityChecker(interval time.Duration) {
+	s.startChecker(interval, s.CheckWaterQuality)
+}
+
+// CheckWaterQuality creates a task to adjust each water quality parameter of a reservoir last measured
+// outside its acceptable range, unless the reservoir already has one for the parameter waiting to be done.
+func (s *TaskServer) CheckWaterQuality() error {
+	queryResult := <-s.ReservoirQuery.FindReservoirsOutOfRange()
+	if queryResult.Error != nil {
+		return queryResult.Error
+	}
+
+	reservoirs, ok := queryResult.Result.([]query.TaskReservoirWaterQualityResult)
+	if !ok {
+		return fmt.Errorf("internal server error. error type assertion")
+	}
+
+	for _, reservoir := range reservoirs {
+		limit := ""
+
+		switch {
+		case reservoir.Min != nil && reservoir.Value < *reservoir.Min:
+			limit = "below its minimum of " + formatQuantity(*reservoir.Min)
+		case reservoir.Max != nil && reservoir.Value > *reservoir.Max:
+			limit = "above its maximum of " + formatQuantity(*reservoir.Max)
+		default:
+			continue
+		}
+
+		err := s.createCheckerTask(
+			domain.TaskDomainReservoir{
+				Reason:    domain.TaskReservoirReasonWaterQuality,
+				Parameter: reservoir.Parameter,
+			},
+			domain.TaskCategoryReservoir,
+			reservoir.UID,
+			fmt.Sprintf("Adjust %s of %s", reservoir.Parameter, reservoir.Name),
+			fmt.Sprintf("%s of %s was %s %s on %s, %s.",
+				reservoir.Parameter,
+				reservoir.Name,
+				formatQuantity(reservoir.Value),
+				reservoir.Unit,
+				reservoir.MeasuredDate.Format("2006-01-02"),
+				limit),
+			func(task storage.TaskRead) bool {
+				details, ok := task.DomainDetails.(domain.TaskDomainReservoir)
+
+				return ok && details.Reason == domain.TaskReservoirReasonWaterQuality &&
+					details.Parameter == reservoir.Parameter
+			},
+		)
+		if err != nil {
+			log.Printf("%s of reservoir %s: %v", reservoir.Parameter, reservoir.UID, err)
+		}
+	}
+
+	return nil
+}
+
+// createReservoirTask creates a reservoir task for the reservoir, unless one for the same reason
+// is still waiting to be done.
+func (s *TaskServer) createReservoirTask(reservoirUID uuid.UUID, reason, title, description string) error {
+	return s.createCheckerTask(
+		domain.TaskDomainReservoir{Reason: reason},
+		domain.TaskCategoryReservoir,
+		reservoirUID,
+		title,
+		description,
+		func(task storage.TaskRead) bool {
+			details, ok := task.DomainDetails.(domain.TaskDomainReservoir)
+
+			return ok && details.Reason == reason
+		},
+	)
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"database/sql"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -13,6 +14,7 @@ import (
 	cropstorage "github.com/usetania/tania-core/src/growth/storage"
 	"github.com/usetania/tania-core/src/helper/paginationhelper"
 	"github.com/usetania/tania-core/src/helper/structhelper"
+	livestockstorage "github.com/usetania/tania-core/src/livestock/storage"
 	"github.com/usetania/tania-core/src/tasks/domain"
 	"github.com/usetania/tania-core/src/tasks/domain/service"
 	"github.com/usetania/tania-core/src/tasks/query"
@@ -33,6 +35,9 @@ type TaskServer struct {
 	TaskEventQuery query.TaskEvent
 	TaskReadQuery  query.TaskRead
 	TaskService    domain.TaskService
+	MaterialQuery  query.Material
+	ReservoirQuery query.Reservoir
+	EquipmentQuery query.Equipment
 	EventBus       eventbus.TaniaEventBus
 }
 
@@ -44,6 +49,8 @@ func NewTaskServer(
 	areaStorage *assetsstorage.AreaReadStorage,
 	materialStorage *assetsstorage.MaterialReadStorage,
 	reservoirStorage *assetsstorage.ReservoirReadStorage,
+	equipmentStorage *assetsstorage.EquipmentReadStorage,
+	animalStorage *livestockstorage.AnimalReadStorage,
 	taskEventStorage *storage.TaskEventStorage,
 	taskReadStorage *storage.TaskReadStorage) (*TaskServer, error,
 ) {
@@ -62,13 +69,20 @@ func NewTaskServer(
 		cropQuery := queryInMem.NewCropQueryInMemory(cropStorage)
 		areaQuery := queryInMem.NewAreaQueryInMemory(areaStorage)
 		materialReadQuery := queryInMem.NewMaterialQueryInMemory(materialStorage)
+		taskServer.MaterialQuery = materialReadQuery
 		reservoirQuery := queryInMem.NewReservoirQueryInMemory(reservoirStorage)
+		taskServer.ReservoirQuery = reservoirQuery
+		equipmentQuery := queryInMem.NewEquipmentQueryInMemory(equipmentStorage)
+		taskServer.EquipmentQuery = equipmentQuery
+		animalQuery := queryInMem.NewAnimalQueryInMemory(animalStorage)
 
 		taskServer.TaskService = service.TaskServiceSqlite{
 			CropQuery:      cropQuery,
 			AreaQuery:      areaQuery,
 			MaterialQuery:  materialReadQuery,
 			ReservoirQuery: reservoirQuery,
+			EquipmentQuery: equipmentQuery,
+			AnimalQuery:    animalQuery,
 		}
 
 	case config.DBSqlite:
@@ -81,13 +95,20 @@ func NewTaskServer(
 		cropQuery := querySqlite.NewCropQuerySqlite(db)
 		areaQuery := querySqlite.NewAreaQuerySqlite(db)
 		materialReadQuery := querySqlite.NewMaterialQuerySqlite(db)
+		taskServer.MaterialQuery = materialReadQuery
 		reservoirQuery := querySqlite.NewReservoirQuerySqlite(db)
+		taskServer.ReservoirQuery = reservoirQuery
+		equipmentQuery := querySqlite.NewEquipmentQuerySqlite(db)
+		taskServer.EquipmentQuery = equipmentQuery
+		animalQuery := querySqlite.NewAnimalQuerySqlite(db)
 
 		taskServer.TaskService = service.TaskServiceSqlite{
 			CropQuery:      cropQuery,
 			AreaQuery:      areaQuery,
 			MaterialQuery:  materialReadQuery,
 			ReservoirQuery: reservoirQuery,
+			EquipmentQuery: equipmentQuery,
+			AnimalQuery:    animalQuery,
 		}
 
 	case config.DBMysql:
@@ -100,13 +121,20 @@ func NewTaskServer(
 		cropQuery := queryMysql.NewCropQueryMysql(db)
 		areaQuery := queryMysql.NewAreaQueryMysql(db)
 		materialReadQuery := queryMysql.NewMaterialQueryMysql(db)
+		taskServer.MaterialQuery = materialReadQuery
 		reservoirQuery := queryMysql.NewReservoirQueryMysql(db)
+		taskServer.ReservoirQuery = reservoirQuery
+		equipmentQuery := queryMysql.NewEquipmentQueryMysql(db)
+		taskServer.EquipmentQuery = equipmentQuery
+		animalQuery := queryMysql.NewAnimalQueryMysql(db)
 
 		taskServer.TaskService = service.TaskServiceSqlite{
 			CropQuery:      cropQuery,
 			AreaQuery:      areaQuery,
 			MaterialQuery:  materialReadQuery,
 			ReservoirQuery: reservoirQuery,
+			EquipmentQuery: equipmentQuery,
+			AnimalQuery:    animalQuery,
 		}
 	}
 
@@ -388,6 +416,50 @@ func (s *TaskServer) CreateTaskDomainByCode(domaincode string, c echo.Context) (
 		}
 
 		return domain.CreateTaskDomainReservoir(s.TaskService, category, materialPtr)
+	case domain.TaskDomainEquipmentCode:
+		category := c.FormValue("category")
+		materialID := c.FormValue("material_id")
+		scheduleID := c.FormValue("maintenance_schedule_id")
+
+		materialPtr := (*uuid.UUID)(nil)
+
+		if materialID != "" {
+			uid, err := uuid.FromString(materialID)
+			if err != nil {
+				return domain.TaskDomainEquipment{}, err
+			}
+
+			materialPtr = &uid
+		}
+
+		schedulePtr := (*uuid.UUID)(nil)
+
+		if scheduleID != "" {
+			uid, err := uuid.FromString(scheduleID)
+			if err != nil {
+				return domain.TaskDomainEquipment{}, err
+			}
+
+			schedulePtr = &uid
+		}
+
+		return domain.CreateTaskDomainEquipment(s.TaskService, category, materialPtr, schedulePtr)
+	case domain.TaskDomainLivestockCode:
+		category := c.FormValue("category")
+		materialID := c.FormValue("material_id")
+
+		materialPtr := (*uuid.UUID)(nil)
+
+		if materialID != "" {
+			uid, err := uuid.FromString(materialID)
+			if err != nil {
+				return domain.TaskDomainLivestock{}, err
+			}
+
+			materialPtr = &uid
+		}
+
+		return domain.CreateTaskDomainLivestock(s.TaskService, category, materialPtr)
 	default:
 		return nil, NewRequestValidationError(InvalidOption, "domain")
 	}
@@ -519,6 +591,53 @@ func (s *TaskServer) AppendTaskDomainDetails(task *storage.TaskRead) error {
 				MaterialDetailedType: materialQueryResult.DetailedTypeCode,
 			}
 		}
+	case domain.TaskDomainEquipmentCode:
+		details := task.DomainDetails.(domain.TaskDomainEquipment)
+		detailed := &storage.TaskDomainDetailedEquipment{
+			MaintenanceScheduleID: details.MaintenanceScheduleID,
+		}
+
+		if details.MaterialID != nil {
+			materialResult := s.TaskService.FindMaterialByID(*details.MaterialID)
+			materialQueryResult, ok := materialResult.Result.(query.TaskMaterialResult)
+
+			if !ok {
+				return echo.NewHTTPError(http.StatusBadRequest, "Internal server error")
+			}
+
+			detailed.MaterialID = &materialQueryResult.UID
+			detailed.MaterialName = materialQueryResult.Name
+			detailed.MaterialType = materialQueryResult.TypeCode
+			detailed.MaterialDetailedType = materialQueryResult.DetailedTypeCode
+		}
+
+		task.DomainDetails = detailed
+	case domain.TaskDomainLivestockCode:
+		details := task.DomainDetails.(domain.TaskDomainLivestock)
+		detailed := &storage.TaskDomainDetailedLivestock{}
+
+		if details.MaterialID != nil {
+			materialResult := s.TaskService.FindMaterialByID(*details.MaterialID)
+			materialQueryResult, ok := materialResult.Result.(query.TaskMaterialResult)
+
+			if !ok {
+				return echo.NewHTTPError(http.StatusBadRequest, "Internal server error")
+			}
+
+			detailed.MaterialID = &materialQueryResult.UID
+			detailed.MaterialName = materialQueryResult.Name
+			detailed.MaterialType = materialQueryResult.TypeCode
+			detailed.MaterialDetailedType = materialQueryResult.DetailedTypeCode
+		}
+
+		if task.AssetID != nil {
+			animalResult := s.TaskService.FindAnimalByID(*task.AssetID)
+			if animal, ok := animalResult.Result.(query.TaskAnimalResult); ok {
+				detailed.AnimalTagID = animal.TagID
+			}
+		}
+
+		task.DomainDetails = detailed
 	}
 
 	return nil
@@ -721,7 +840,9 @@ func (s *TaskServer) CompleteTask(c echo.Context) error {
 		return Error(c, err)
 	}
 
-	updatedTask.CompleteTask()
+	if err := s.completeTask(updatedTask, c); err != nil {
+		return Error(c, err)
+	}
 
 	// Save new TaskEvent
 	err = <-s.TaskEventRepo.Save(updatedTask.UID, updatedTask.Version, updatedTask.UncommittedChanges)
@@ -742,6 +863,40 @@ func (s *TaskServer) CompleteTask(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
+// completeTask completes the task, recording the quantity used of its material when given.
+// A quantity more than the material stock is refused unless force is true.
+func (s *TaskServer) completeTask(task *domain.Task, c echo.Context) error {
+	quantity := c.FormValue("material_quantity")
+	if quantity == "" {
+		task.CompleteTask()
+
+		return nil
+	}
+
+	q, err := strconv.ParseFloat(quantity, 32)
+	if err != nil {
+		return NewRequestValidationError(Float, "material_quantity")
+	}
+
+	stock := float32(0)
+
+	if materialID := domain.TaskMaterialID(task.DomainDetails); materialID != nil {
+		serviceResult := s.TaskService.FindMaterialByID(*materialID)
+		if serviceResult.Error != nil {
+			return serviceResult.Error
+		}
+
+		material, ok := serviceResult.Result.(query.TaskMaterialResult)
+		if !ok {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+		}
+
+		stock = material.Quantity
+	}
+
+	return task.CompleteTaskUsingMaterial(float32(q), stock, c.FormValue("force") == "true")
+}
+
 func (s *TaskServer) SetTaskAsDue(c echo.Context) error {
 	data := make(map[string]storage.TaskRead)
 
@@ -81,3 +81,27 @@ type TaskDomainDetailedReservoir struct {
 func (TaskDomainDetailedReservoir) Code() string {
 	return domain.TaskDomainCropCode
 }
+
+type TaskDomainDetailedEquipment struct {
+	MaterialID            *uuid.UUID `json:"material_id"`
+	MaterialName          string     `json:"material_name"`
+	MaterialType          string     `json:"material_type"`
+	MaterialDetailedType  string     `json:"material_detailed_type"`
+	MaintenanceScheduleID *uuid.UUID `json:"maintenance_schedule_id"`
+}
+
+func (TaskDomainDetailedEquipment) Code() string {
+	return domain.TaskDomainEquipmentCode
+}
+
+type TaskDomainDetailedLivestock struct {
+	MaterialID           *uuid.UUID `json:"material_id"`
+	MaterialName         string     `json:"material_name"`
+	MaterialType         string     `json:"material_type"`
+	MaterialDetailedType string     `json:"material_detailed_type"`
+	AnimalTagID          string     `json:"animal_tag_id"`
+}
+
+func (TaskDomainDetailedLivestock) Code() string {
+	return domain.TaskDomainLivestockCode
+}
@@ -49,6 +49,86 @@ func (w *UserEventWrapper) UnmarshalJSON(b []byte) error {
 			return err
 		}
 
+		w.EventData = e
+
+	case "TwoFactorEnrollmentStarted":
+		e := domain.TwoFactorEnrollmentStarted{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "TwoFactorEnabled":
+		e := domain.TwoFactorEnabled{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "TwoFactorDisabled":
+		e := domain.TwoFactorDisabled{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "TwoFactorVerified":
+		e := domain.TwoFactorVerified{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "RecoveryCodeUsed":
+		e := domain.RecoveryCodeUsed{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "RecoveryCodesRegenerated":
+		e := domain.RecoveryCodesRegenerated{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "ExternalIdentityLinked":
+		e := domain.ExternalIdentityLinked{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "UserRolesChanged":
+		e := domain.UserRolesChanged{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
 		w.EventData = e
 	}
 
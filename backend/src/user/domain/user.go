@@ -13,6 +13,9 @@ type User struct {
 	Username    string
 	Password    []byte
 	ClientID    string
+	TwoFactor   UserTwoFactor
+	Roles       []string
+	Identities  []UserExternalIdentity
 	CreatedDate time.Time
 	LastUpdated time.Time
 
@@ -47,6 +50,45 @@ func (u *User) Transition(event interface{}) {
 	case PasswordChanged:
 		u.Password = e.NewPassword
 		u.LastUpdated = e.DateChanged
+
+	case TwoFactorEnrollmentStarted:
+		u.TwoFactor = UserTwoFactor{Secret: e.Secret}
+		u.LastUpdated = e.DateStarted
+
+	case TwoFactorEnabled:
+		u.TwoFactor.Enabled = true
+		u.TwoFactor.RecoveryCodes = e.RecoveryCodes
+		u.LastUpdated = e.DateEnabled
+
+	case TwoFactorDisabled:
+		u.TwoFactor = UserTwoFactor{}
+		u.LastUpdated = e.DateDisabled
+
+	case TwoFactorVerified:
+		u.TwoFactor.LastTimeStep = e.TimeStep
+
+	case RecoveryCodeUsed:
+		codes := []string{}
+
+		for _, v := range u.TwoFactor.RecoveryCodes {
+			if v != e.RecoveryCode {
+				codes = append(codes, v)
+			}
+		}
+
+		u.TwoFactor.RecoveryCodes = codes
+
+	case RecoveryCodesRegenerated:
+		u.TwoFactor.RecoveryCodes = e.RecoveryCodes
+		u.LastUpdated = e.DateGenerated
+
+	case ExternalIdentityLinked:
+		u.Identities = append(u.Identities, UserExternalIdentity{Issuer: e.Issuer, Subject: e.Subject})
+		u.LastUpdated = e.DateLinked
+
+	case UserRolesChanged:
+		u.Roles = e.Roles
+		u.LastUpdated = e.DateChanged
 	}
 }
 
@@ -13,6 +13,14 @@ const (
 	UserErrorUsernameExistsCode
 	UserErrorPasswordConfirmationNotMatchCode
 	UserChangePasswordErrorWrongOldPasswordCode
+	UserErrorTwoFactorAlreadyEnabledCode
+	UserErrorTwoFactorNotEnrolledCode
+	UserErrorTwoFactorNotEnabledCode
+	UserErrorTwoFactorCodeRequiredCode
+	UserErrorInvalidTwoFactorCode
+	UserErrorInvalidRoleCode
+	UserErrorExternalIdentityEmptyCode
+	UserErrorNoRoleMappedCode
 )
 
 func (e UserError) Error() string {
@@ -31,6 +39,22 @@ func (e UserError) Error() string {
 		return "Password confirmation didn't match"
 	case UserChangePasswordErrorWrongOldPasswordCode:
 		return "Invalid old password"
+	case UserErrorTwoFactorAlreadyEnabledCode:
+		return "Two-factor authentication is already enabled"
+	case UserErrorTwoFactorNotEnrolledCode:
+		return "Two-factor authentication enrollment has not been started"
+	case UserErrorTwoFactorNotEnabledCode:
+		return "Two-factor authentication is not enabled"
+	case UserErrorTwoFactorCodeRequiredCode:
+		return "Two-factor authentication code is required"
+	case UserErrorInvalidTwoFactorCode:
+		return "Invalid two-factor authentication code"
+	case UserErrorInvalidRoleCode:
+		return "Invalid role"
+	case UserErrorExternalIdentityEmptyCode:
+		return "External identity issuer and subject cannot be empty"
+	case UserErrorNoRoleMappedCode:
+		return "None of the user groups is mapped to a role"
 	default:
 		return "Unrecognized user error code"
 	}
@@ -19,3 +19,51 @@ type PasswordChanged struct {
 	NewPassword []byte
 	DateChanged time.Time
 }
+
+type TwoFactorEnrollmentStarted struct {
+	UID         uuid.UUID
+	Secret      string
+	DateStarted time.Time
+}
+
+type TwoFactorEnabled struct {
+	UID           uuid.UUID
+	RecoveryCodes []string
+	DateEnabled   time.Time
+}
+
+type TwoFactorDisabled struct {
+	UID          uuid.UUID
+	DateDisabled time.Time
+}
+
+type TwoFactorVerified struct {
+	UID          uuid.UUID
+	TimeStep     int64
+	DateVerified time.Time
+}
+
+type RecoveryCodeUsed struct {
+	UID          uuid.UUID
+	RecoveryCode string
+	DateUsed     time.Time
+}
+
+type RecoveryCodesRegenerated struct {
+	UID           uuid.UUID
+	RecoveryCodes []string
+	DateGenerated time.Time
+}
+
+type ExternalIdentityLinked struct {
+	UID        uuid.UUID
+	Issuer     string
+	Subject    string
+	DateLinked time.Time
+}
+
+type UserRolesChanged struct {
+	UID         uuid.UUID
+	Roles       []string
+	DateChanged time.Time
+}
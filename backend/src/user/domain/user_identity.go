@@ -0,0 +1,231 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	RoleAdmin   = "ADMIN"
+	RoleManager = "MANAGER"
+	RoleStaff   = "STAFF"
+
+	unusablePasswordLength = 32
+)
+
+type Role struct {
+	Code  string `json:"code"`
+	Label string `json:"label"`
+}
+
+func Roles() []Role {
+	return []Role{
+		{Code: RoleAdmin, Label: "Admin"},
+		{Code: RoleManager, Label: "Manager"},
+		{Code: RoleStaff, Label: "Staff"},
+	}
+}
+
+func GetRole(code string) Role {
+	for _, v := range Roles() {
+		if v.Code == code {
+			return v
+		}
+	}
+
+	return Role{}
+}
+
+// UserExternalIdentity links a user to the subject of an external identity provider.
+type UserExternalIdentity struct {
+	Issuer  string
+	Subject string
+}
+
+// CreateUserFromExternalIdentity provisions a user who logs in through an external identity provider.
+// The user gets a random password, so the account can only be used through the identity provider.
+func CreateUserFromExternalIdentity(
+	userService UserService,
+	username, issuer, subject string,
+	roles []string,
+) (*User, error) {
+	if username == "" {
+		return nil, UserError{UserErrorUsernameEmptyCode}
+	}
+
+	if issuer == "" || subject == "" {
+		return nil, UserError{UserErrorExternalIdentityEmptyCode}
+	}
+
+	userResult, err := userService.FindUserByUsername(username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user by username: %w", err)
+	}
+
+	if userResult.UID != (uuid.UUID{}) {
+		return nil, UserError{UserErrorUsernameExistsCode}
+	}
+
+	roles, err = validateRoles(roles)
+	if err != nil {
+		return nil, err
+	}
+
+	randomPassword := make([]byte, unusablePasswordLength)
+
+	_, err = rand.Read(randomPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(hex.EncodeToString(randomPassword)), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate password hash: %w", err)
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate UUID: %w", err)
+	}
+
+	user := &User{}
+
+	now := time.Now()
+
+	user.TrackChange(UserCreated{
+		UID:         uid,
+		Username:    username,
+		Password:    hash,
+		CreatedDate: now,
+		LastUpdated: now,
+	})
+
+	user.TrackChange(ExternalIdentityLinked{
+		UID:        uid,
+		Issuer:     issuer,
+		Subject:    subject,
+		DateLinked: now,
+	})
+
+	user.TrackChange(UserRolesChanged{
+		UID:         uid,
+		Roles:       roles,
+		DateChanged: now,
+	})
+
+	return user, nil
+}
+
+// ChangeRoles replaces the roles of the user. Nothing is tracked when the roles are the same.
+func (u *User) ChangeRoles(roles []string) error {
+	roles, err := validateRoles(roles)
+	if err != nil {
+		return err
+	}
+
+	if len(roles) == len(u.Roles) {
+		same := true
+
+		for i := range roles {
+			if roles[i] != u.Roles[i] {
+				same = false
+
+				break
+			}
+		}
+
+		if same {
+			return nil
+		}
+	}
+
+	u.TrackChange(UserRolesChanged{
+		UID:         u.UID,
+		Roles:       roles,
+		DateChanged: time.Now(),
+	})
+
+	return nil
+}
+
+func (u *User) HasRole(role string) bool {
+	for _, v := range u.Roles {
+		if v == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AllowsChange tells whether users with the roles may change a part of Tania, like "farms" or "tasks".
+// Admins and managers change everything, staff only change tasks and their own account.
+// Users without any role are local accounts, which keep full access.
+func AllowsChange(roles []string, part string) bool {
+	if len(roles) == 0 {
+		return true
+	}
+
+	for _, v := range roles {
+		switch v {
+		case RoleAdmin, RoleManager:
+			return true
+		case RoleStaff:
+			if part == "tasks" || part == "user" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// MapGroupsToRoles translates the identity provider groups to roles using the configured mapping.
+// The default role is given when none of the groups is mapped. An empty default role denies those users.
+func MapGroupsToRoles(groups []string, mapping map[string]string, defaultRole string) ([]string, error) {
+	roles := []string{}
+
+	for _, g := range groups {
+		if r, ok := mapping[g]; ok {
+			roles = append(roles, r)
+		}
+	}
+
+	if len(roles) == 0 {
+		if defaultRole == "" {
+			return nil, UserError{UserErrorNoRoleMappedCode}
+		}
+
+		roles = append(roles, defaultRole)
+	}
+
+	return validateRoles(roles)
+}
+
+// validateRoles checks each role and returns them sorted without duplicates.
+func validateRoles(roles []string) ([]string, error) {
+	unique := map[string]bool{}
+	result := []string{}
+
+	for _, v := range roles {
+		if GetRole(v) == (Role{}) {
+			return nil, UserError{UserErrorInvalidRoleCode}
+		}
+
+		if !unique[v] {
+			unique[v] = true
+
+			result = append(result, v)
+		}
+	}
+
+	sort.Strings(result)
+
+	return result, nil
+}
@@ -2,10 +2,12 @@ package domain_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/usetania/tania-core/src/helper/totphelper"
 	. "github.com/usetania/tania-core/src/user/domain"
 )
 
@@ -68,3 +70,120 @@ func TestChangePassword(t *testing.T) {
 	assert.Nil(t, errValid)
 	assert.Equal(t, true, isValid)
 }
+
+func TestTwoFactorAuthentication(t *testing.T) {
+	t.Parallel()
+	// Given
+	userServiceMock := new(UserServiceMock)
+	userServiceMock.On("FindUserByUsername", "username").Return(UserServiceResult{})
+
+	user, err := CreateUser(userServiceMock, "username", "password", "password")
+
+	// When
+	enrollment, errEnroll := user.EnrollTwoFactor()
+	code, _ := totphelper.GenerateCode(enrollment.Secret, totphelper.TimeStep(time.Now()))
+	_, errWrongCode := user.EnableTwoFactor("000000")
+	recoveryCodes, errEnable := user.EnableTwoFactor(code)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Nil(t, errEnroll)
+	assert.Contains(t, enrollment.URI, "otpauth://totp/Tania:username")
+	assert.Equal(t, UserError{UserErrorInvalidTwoFactorCode}, errWrongCode)
+	assert.Nil(t, errEnable)
+	assert.True(t, user.IsTwoFactorEnabled())
+	assert.Len(t, recoveryCodes, RecoveryCodeTotal)
+
+	// When the same TOTP code is replayed
+	errReplay := user.VerifyTwoFactor(code)
+
+	// Then
+	assert.Equal(t, UserError{UserErrorInvalidTwoFactorCode}, errReplay)
+
+	// When a recovery code is used
+	errRecovery := user.VerifyTwoFactor(recoveryCodes[0])
+	errRecoveryReused := user.VerifyTwoFactor(recoveryCodes[0])
+
+	// Then
+	assert.Nil(t, errRecovery)
+	assert.Equal(t, UserError{UserErrorInvalidTwoFactorCode}, errRecoveryReused)
+	assert.Len(t, user.TwoFactor.RecoveryCodes, RecoveryCodeTotal-1)
+
+	event, ok := user.UncommittedChanges[len(user.UncommittedChanges)-1].(RecoveryCodeUsed)
+	assert.True(t, ok)
+	assert.Equal(t, user.UID, event.UID)
+
+	// When
+	errEmpty := user.VerifyTwoFactor("")
+	errDisable := user.DisableTwoFactor("password", recoveryCodes[1])
+
+	// Then
+	assert.Equal(t, UserError{UserErrorTwoFactorCodeRequiredCode}, errEmpty)
+	assert.Nil(t, errDisable)
+	assert.False(t, user.IsTwoFactorEnabled())
+}
+
+func TestCreateUserFromExternalIdentity(t *testing.T) {
+	t.Parallel()
+	// Given
+	userServiceMock := new(UserServiceMock)
+	userServiceMock.On("FindUserByUsername", "jdoe").Return(UserServiceResult{})
+
+	// When
+	roles := []string{RoleStaff, RoleManager, RoleStaff}
+	user, err := CreateUserFromExternalIdentity(userServiceMock, "jdoe", "https://idp", "b5e8a2d1", roles)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "jdoe", user.Username)
+	assert.Equal(t, []string{RoleManager, RoleStaff}, user.Roles)
+	assert.Equal(t, []UserExternalIdentity{{Issuer: "https://idp", Subject: "b5e8a2d1"}}, user.Identities)
+	assert.Len(t, user.UncommittedChanges, 3)
+
+	// When
+	_, errPassword := user.IsPasswordValid("")
+	errRoles := user.ChangeRoles([]string{RoleManager, RoleStaff})
+	errInvalid := user.ChangeRoles([]string{"OWNER"})
+
+	// Then
+	assert.NotNil(t, errPassword)
+	assert.Nil(t, errRoles)
+	assert.Len(t, user.UncommittedChanges, 3)
+	assert.Equal(t, UserError{UserErrorInvalidRoleCode}, errInvalid)
+
+	// When
+	err = user.ChangeRoles([]string{RoleAdmin})
+
+	// Then
+	assert.Nil(t, err)
+	assert.True(t, user.HasRole(RoleAdmin))
+	assert.False(t, user.HasRole(RoleStaff))
+}
+
+func TestMapGroupsToRoles(t *testing.T) {
+	t.Parallel()
+	// Given
+	mapping := map[string]string{"farm-managers": RoleManager, "admins": RoleAdmin}
+
+	// When
+	roles, err := MapGroupsToRoles([]string{"farm-managers", "others"}, mapping, RoleStaff)
+	defaultRoles, errDefault := MapGroupsToRoles([]string{"others"}, mapping, RoleStaff)
+	_, errDenied := MapGroupsToRoles([]string{"others"}, mapping, "")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, []string{RoleManager}, roles)
+	assert.Nil(t, errDefault)
+	assert.Equal(t, []string{RoleStaff}, defaultRoles)
+	assert.Equal(t, UserError{UserErrorNoRoleMappedCode}, errDenied)
+}
+
+func TestAllowsChange(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, AllowsChange(nil, "farms"))
+	assert.True(t, AllowsChange([]string{RoleManager}, "farms"))
+	assert.True(t, AllowsChange([]string{RoleStaff}, "tasks"))
+	assert.True(t, AllowsChange([]string{RoleStaff, RoleAdmin}, "farms"))
+	assert.False(t, AllowsChange([]string{RoleStaff}, "farms"))
+}
@@ -0,0 +1,219 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/usetania/tania-core/src/helper/totphelper"
+)
+
+const (
+	TwoFactorIssuer        = "Tania"
+	RecoveryCodeTotal      = 10
+	recoveryCodeLength     = 10
+	recoveryCodeCharacters = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
+
+// UserTwoFactor holds the TOTP two-factor authentication state of a user.
+// Recovery codes are only kept as SHA-256 hashes, the plain codes are shown once to the user.
+type UserTwoFactor struct {
+	Secret        string
+	Enabled       bool
+	RecoveryCodes []string
+	LastTimeStep  int64
+}
+
+// TwoFactorEnrollment is the data needed by the authenticator app to be registered.
+type TwoFactorEnrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+func (u *User) IsTwoFactorEnabled() bool {
+	return u.TwoFactor.Enabled
+}
+
+// EnrollTwoFactor generates a new TOTP secret for the user.
+// Two-factor authentication is not active until it is confirmed with EnableTwoFactor.
+func (u *User) EnrollTwoFactor() (TwoFactorEnrollment, error) {
+	if u.TwoFactor.Enabled {
+		return TwoFactorEnrollment{}, UserError{UserErrorTwoFactorAlreadyEnabledCode}
+	}
+
+	secret, err := totphelper.GenerateSecret()
+	if err != nil {
+		return TwoFactorEnrollment{}, err
+	}
+
+	u.TrackChange(TwoFactorEnrollmentStarted{
+		UID:         u.UID,
+		Secret:      secret,
+		DateStarted: time.Now(),
+	})
+
+	return TwoFactorEnrollment{
+		Secret: secret,
+		URI:    totphelper.KeyURI(TwoFactorIssuer, u.Username, secret),
+	}, nil
+}
+
+// EnableTwoFactor confirms the enrollment with a code from the authenticator app
+// and returns the plain recovery codes.
+func (u *User) EnableTwoFactor(code string) ([]string, error) {
+	if u.TwoFactor.Enabled {
+		return nil, UserError{UserErrorTwoFactorAlreadyEnabledCode}
+	}
+
+	if u.TwoFactor.Secret == "" {
+		return nil, UserError{UserErrorTwoFactorNotEnrolledCode}
+	}
+
+	err := u.verifyTOTP(code)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	u.TrackChange(TwoFactorEnabled{
+		UID:           u.UID,
+		RecoveryCodes: hashes,
+		DateEnabled:   time.Now(),
+	})
+
+	return codes, nil
+}
+
+// VerifyTwoFactor checks the second factor on login. The code can either be
+// a TOTP code or one of the unused recovery codes.
+func (u *User) VerifyTwoFactor(code string) error {
+	if !u.TwoFactor.Enabled {
+		return UserError{UserErrorTwoFactorNotEnabledCode}
+	}
+
+	if strings.TrimSpace(code) == "" {
+		return UserError{UserErrorTwoFactorCodeRequiredCode}
+	}
+
+	err := u.verifyTOTP(code)
+	if err == nil {
+		return nil
+	}
+
+	hash := hashRecoveryCode(code)
+
+	for _, v := range u.TwoFactor.RecoveryCodes {
+		if v == hash {
+			u.TrackChange(RecoveryCodeUsed{
+				UID:          u.UID,
+				RecoveryCode: hash,
+				DateUsed:     time.Now(),
+			})
+
+			return nil
+		}
+	}
+
+	return UserError{UserErrorInvalidTwoFactorCode}
+}
+
+// DisableTwoFactor turns off two-factor authentication. It requires both the password and a valid code.
+func (u *User) DisableTwoFactor(password, code string) error {
+	_, err := u.IsPasswordValid(password)
+	if err != nil {
+		return err
+	}
+
+	err = u.VerifyTwoFactor(code)
+	if err != nil {
+		return err
+	}
+
+	u.TrackChange(TwoFactorDisabled{
+		UID:          u.UID,
+		DateDisabled: time.Now(),
+	})
+
+	return nil
+}
+
+// RegenerateRecoveryCodes invalidates the remaining recovery codes and returns a new set.
+func (u *User) RegenerateRecoveryCodes(code string) ([]string, error) {
+	if !u.TwoFactor.Enabled {
+		return nil, UserError{UserErrorTwoFactorNotEnabledCode}
+	}
+
+	err := u.verifyTOTP(code)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	u.TrackChange(RecoveryCodesRegenerated{
+		UID:           u.UID,
+		RecoveryCodes: hashes,
+		DateGenerated: time.Now(),
+	})
+
+	return codes, nil
+}
+
+// verifyTOTP validates the TOTP code and records its time step,
+// so the same code cannot be replayed.
+func (u *User) verifyTOTP(code string) error {
+	if strings.TrimSpace(code) == "" {
+		return UserError{UserErrorTwoFactorCodeRequiredCode}
+	}
+
+	step, ok := totphelper.Validate(u.TwoFactor.Secret, code, time.Now())
+	if !ok || step <= u.TwoFactor.LastTimeStep {
+		return UserError{UserErrorInvalidTwoFactorCode}
+	}
+
+	u.TrackChange(TwoFactorVerified{
+		UID:          u.UID,
+		TimeStep:     step,
+		DateVerified: time.Now(),
+	})
+
+	return nil
+}
+
+func generateRecoveryCodes() (codes, hashes []string, err error) {
+	for i := 0; i < RecoveryCodeTotal; i++ {
+		b := make([]byte, recoveryCodeLength)
+
+		_, err := rand.Read(b)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for j := range b {
+			b[j] = recoveryCodeCharacters[int(b[j])%len(recoveryCodeCharacters)]
+		}
+
+		code := string(b[:recoveryCodeLength/2]) + "-" + string(b[recoveryCodeLength/2:])
+
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+
+	return codes, hashes, nil
+}
+
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,359 @@
+// Package oidc is a minimal OpenID Connect relying party used to delegate
+// the login to an external identity provider, such as Keycloak.
+// It only supports the authorization code flow with RS256 signed ID tokens.
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	discoveryPath = "/.well-known/openid-configuration"
+	clockSkew     = time.Minute
+)
+
+var (
+	ErrInvalidIDToken = errors.New("invalid id token")
+	ErrUnknownKey     = errors.New("id token signing key not found")
+)
+
+// Provider holds the endpoints of the identity provider read from its discovery document.
+type Provider struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+
+	Client *http.Client `json:"-"`
+}
+
+// Token is the response of the provider token endpoint.
+type Token struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	IDToken     string `json:"id_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// Claims are the ID token claims which are relevant to Tania.
+// Groups are read from the claim name configured by the caller.
+type Claims struct {
+	Issuer            string
+	Subject           string
+	Audience          []string
+	Expiry            time.Time
+	Nonce             string
+	PreferredUsername string
+	Email             string
+	Groups            []string
+}
+
+// Discover fetches the discovery document. The URL can either be the issuer
+// or the full `.well-known/openid-configuration` URL.
+func Discover(ctx context.Context, client *http.Client, discoveryURL string) (*Provider, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	if !strings.HasSuffix(discoveryURL, discoveryPath) {
+		discoveryURL = strings.TrimSuffix(discoveryURL, "/") + discoveryPath
+	}
+
+	p := &Provider{}
+
+	err := getJSON(ctx, client, discoveryURL, p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read oidc discovery document: %w", err)
+	}
+
+	if p.Issuer == "" || p.AuthorizationEndpoint == "" || p.TokenEndpoint == "" || p.JWKSURI == "" {
+		return nil, errors.New("incomplete oidc discovery document")
+	}
+
+	p.Client = client
+
+	return p, nil
+}
+
+// AuthCodeURL builds the URL where the user agent is redirected to login at the provider.
+func (p *Provider) AuthCodeURL(clientID, redirectURI, state, nonce string, scopes []string) string {
+	v := url.Values{}
+	v.Set("response_type", "code")
+	v.Set("client_id", clientID)
+	v.Set("redirect_uri", redirectURI)
+	v.Set("scope", strings.Join(scopes, " "))
+	v.Set("state", state)
+	v.Set("nonce", nonce)
+
+	sep := "?"
+	if strings.Contains(p.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+
+	return p.AuthorizationEndpoint + sep + v.Encode()
+}
+
+// Exchange trades the authorization code for tokens at the token endpoint.
+func (p *Provider) Exchange(ctx context.Context, clientID, clientSecret, code, redirectURI string) (Token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", redirectURI)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return Token{}, err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(clientID), url.QueryEscape(clientSecret))
+
+	res, err := p.Client.Do(req)
+	if err != nil {
+		return Token{}, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return Token{}, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return Token{}, fmt.Errorf("oidc token endpoint returned %d: %s", res.StatusCode, body)
+	}
+
+	token := Token{}
+
+	err = json.Unmarshal(body, &token)
+	if err != nil {
+		return Token{}, err
+	}
+
+	if token.IDToken == "" {
+		return Token{}, errors.New("oidc token response has no id_token")
+	}
+
+	return token, nil
+}
+
+// VerifyIDToken checks the signature of the ID token against the provider keys
+// and validates issuer, audience, expiry and nonce.
+func (p *Provider) VerifyIDToken(
+	ctx context.Context,
+	rawIDToken, clientID, nonce, groupsClaim string,
+) (Claims, error) {
+	parts := strings.Split(rawIDToken, ".")
+	if len(parts) != 3 {
+		return Claims{}, ErrInvalidIDToken
+	}
+
+	header := struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}{}
+
+	err := decodeSegment(parts[0], &header)
+	if err != nil {
+		return Claims{}, err
+	}
+
+	if header.Alg != "RS256" {
+		return Claims{}, fmt.Errorf("%w: unsupported signing algorithm %s", ErrInvalidIDToken, header.Alg)
+	}
+
+	key, err := p.findKey(ctx, header.Kid)
+	if err != nil {
+		return Claims{}, err
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return Claims{}, ErrInvalidIDToken
+	}
+
+	hashed := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+
+	err = rsa.VerifyPKCS1v15(key, crypto.SHA256, hashed[:], signature)
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+
+	raw := map[string]interface{}{}
+
+	err = decodeSegment(parts[1], &raw)
+	if err != nil {
+		return Claims{}, err
+	}
+
+	claims := mapClaims(raw, groupsClaim)
+
+	if claims.Issuer != p.Issuer {
+		return Claims{}, fmt.Errorf("%w: unexpected issuer", ErrInvalidIDToken)
+	}
+
+	if !contains(claims.Audience, clientID) {
+		return Claims{}, fmt.Errorf("%w: unexpected audience", ErrInvalidIDToken)
+	}
+
+	if time.Now().After(claims.Expiry.Add(clockSkew)) {
+		return Claims{}, fmt.Errorf("%w: token expired", ErrInvalidIDToken)
+	}
+
+	if claims.Nonce != nonce {
+		return Claims{}, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+
+	if claims.Subject == "" {
+		return Claims{}, fmt.Errorf("%w: missing subject", ErrInvalidIDToken)
+	}
+
+	return claims, nil
+}
+
+func (p *Provider) findKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	jwks := struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}{}
+
+	err := getJSON(ctx, p.Client, p.JWKSURI, &jwks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read oidc keys: %w", err)
+	}
+
+	for _, k := range jwks.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+
+		if kid != "" && k.Kid != kid {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	}
+
+	return nil, ErrUnknownKey
+}
+
+func mapClaims(raw map[string]interface{}, groupsClaim string) Claims {
+	claims := Claims{}
+
+	claims.Issuer, _ = raw["iss"].(string)
+	claims.Subject, _ = raw["sub"].(string)
+	claims.Nonce, _ = raw["nonce"].(string)
+	claims.PreferredUsername, _ = raw["preferred_username"].(string)
+	claims.Email, _ = raw["email"].(string)
+	claims.Audience = toStrings(raw["aud"])
+
+	if exp, ok := raw["exp"].(float64); ok {
+		claims.Expiry = time.Unix(int64(exp), 0)
+	}
+
+	if groupsClaim != "" {
+		for _, v := range toStrings(raw[groupsClaim]) {
+			// Keycloak group mapper prefixes the full path with slash.
+			claims.Groups = append(claims.Groups, strings.TrimPrefix(v, "/"))
+		}
+	}
+
+	return claims
+}
+
+func toStrings(val interface{}) []string {
+	switch v := val.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		s := []string{}
+
+		for _, item := range v {
+			if str, ok := item.(string); ok {
+				s = append(s, str)
+			}
+		}
+
+		return s
+	default:
+		return nil
+	}
+}
+
+func contains(values []string, val string) bool {
+	for _, v := range values {
+		if v == val {
+			return true
+		}
+	}
+
+	return false
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segment, "="))
+	if err != nil {
+		return ErrInvalidIDToken
+	}
+
+	err = json.Unmarshal(b, v)
+	if err != nil {
+		return ErrInvalidIDToken
+	}
+
+	return nil
+}
+
+func getJSON(ctx context.Context, client *http.Client, u string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %d", u, res.StatusCode)
+	}
+
+	return json.NewDecoder(io.LimitReader(res.Body, 1<<20)).Decode(v)
+}
@@ -0,0 +1,189 @@
+package oidc_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/usetania/tania-core/src/user/oidc"
+)
+
+// mockProvider is a local OpenID Connect provider which issues an ID token
+// with the given claims for any authorization code.
+type mockProvider struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	claims map[string]interface{}
+}
+
+func newMockProvider(t *testing.T) *mockProvider {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := &mockProvider{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]string{
+			"issuer":                 m.server.URL,
+			"authorization_endpoint": m.server.URL + "/auth",
+			"token_endpoint":         m.server.URL + "/token",
+			"jwks_uri":               m.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "test",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		clientID, secret, ok := r.BasicAuth()
+		if !ok || clientID != "tania" || secret != "secret" || r.FormValue("code") != "valid-code" {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		writeJSON(w, map[string]interface{}{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"id_token":     m.sign(t),
+			"expires_in":   300,
+		})
+	})
+
+	m.server = httptest.NewServer(mux)
+	m.claims = map[string]interface{}{
+		"iss":                m.server.URL,
+		"sub":                "b5e8a2d1",
+		"aud":                "tania",
+		"exp":                time.Now().Add(time.Minute).Unix(),
+		"nonce":              "nonce",
+		"preferred_username": "jdoe",
+		"email":              "jdoe@example.com",
+		"groups":             []string{"/farm-managers", "staff"},
+	}
+
+	return m
+}
+
+func (m *mockProvider) sign(t *testing.T) string {
+	t.Helper()
+
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "kid": "test", "typ": "JWT"})
+	payload, _ := json.Marshal(m.claims)
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	hashed := sha256.Sum256([]byte(signingInput))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, m.key, crypto.SHA256, hashed[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestDiscoverAndAuthCodeURL(t *testing.T) {
+	t.Parallel()
+	// Given
+	mock := newMockProvider(t)
+	defer mock.server.Close()
+
+	// When
+	provider, err := oidc.Discover(context.Background(), mock.server.Client(), mock.server.URL)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, mock.server.URL, provider.Issuer)
+	assert.Equal(t, mock.server.URL+"/token", provider.TokenEndpoint)
+
+	// When
+	scopes := []string{"openid", "profile"}
+	authURL, err := url.Parse(provider.AuthCodeURL("tania", "http://localhost/callback", "state", "nonce", scopes))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "/auth", authURL.Path)
+	assert.Equal(t, "code", authURL.Query().Get("response_type"))
+	assert.Equal(t, "openid profile", authURL.Query().Get("scope"))
+	assert.Equal(t, "nonce", authURL.Query().Get("nonce"))
+}
+
+func TestExchangeAndVerifyIDToken(t *testing.T) {
+	t.Parallel()
+	// Given
+	mock := newMockProvider(t)
+	defer mock.server.Close()
+
+	provider, _ := oidc.Discover(context.Background(), mock.server.Client(), mock.server.URL)
+
+	// When
+	token, err := provider.Exchange(context.Background(), "tania", "secret", "valid-code", "http://localhost/callback")
+
+	// Then
+	assert.Nil(t, err)
+
+	// When
+	claims, err := provider.VerifyIDToken(context.Background(), token.IDToken, "tania", "nonce", "groups")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "b5e8a2d1", claims.Subject)
+	assert.Equal(t, "jdoe", claims.PreferredUsername)
+	assert.Equal(t, []string{"farm-managers", "staff"}, claims.Groups)
+
+	// When
+	_, errCode := provider.Exchange(context.Background(), "tania", "secret", "wrong-code", "http://localhost/callback")
+	_, errNonce := provider.VerifyIDToken(context.Background(), token.IDToken, "tania", "other", "groups")
+	_, errAudience := provider.VerifyIDToken(context.Background(), token.IDToken, "other", "nonce", "groups")
+	_, errTampered := provider.VerifyIDToken(context.Background(), token.IDToken+"x", "tania", "nonce", "groups")
+
+	// Then
+	assert.NotNil(t, errCode)
+	assert.ErrorIs(t, errNonce, oidc.ErrInvalidIDToken)
+	assert.ErrorIs(t, errAudience, oidc.ErrInvalidIDToken)
+	assert.NotNil(t, errTampered)
+}
+
+func TestVerifyExpiredIDToken(t *testing.T) {
+	t.Parallel()
+	// Given
+	mock := newMockProvider(t)
+	defer mock.server.Close()
+
+	mock.claims["exp"] = time.Now().Add(-time.Hour).Unix()
+	provider, _ := oidc.Discover(context.Background(), mock.server.Client(), mock.server.URL)
+
+	// When
+	_, err := provider.VerifyIDToken(context.Background(), mock.sign(t), "tania", "nonce", "groups")
+
+	// Then
+	assert.ErrorIs(t, err, oidc.ErrInvalidIDToken)
+}
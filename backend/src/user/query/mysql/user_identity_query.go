@@ -0,0 +1,74 @@
+package mysql
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/user/query"
+	"github.com/usetania/tania-core/src/user/storage"
+)
+
+type UserIdentityQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewUserIdentityQueryMysql(db *sql.DB) query.UserIdentity {
+	return UserIdentityQueryMysql{DB: db}
+}
+
+type userIdentityResult struct {
+	Issuer      string
+	Subject     string
+	UserUID     []byte
+	CreatedDate time.Time
+}
+
+func (s UserIdentityQueryMysql) FindByIssuerAndSubject(issuer, subject string) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		rowsData := userIdentityResult{}
+
+		err := s.DB.QueryRow(`SELECT ISSUER, SUBJECT, USER_UID, CREATED_DATE
+			FROM USER_IDENTITY WHERE ISSUER = ? AND SUBJECT = ?`, issuer, subject).Scan(
+			&rowsData.Issuer,
+			&rowsData.Subject,
+			&rowsData.UserUID,
+			&rowsData.CreatedDate,
+		)
+
+		if errors.Is(err, sql.ErrNoRows) {
+			result <- query.Result{Result: storage.UserIdentity{}}
+			close(result)
+
+			return
+		}
+
+		if err != nil {
+			result <- query.Result{Error: err}
+			close(result)
+
+			return
+		}
+
+		userUID, err := uuid.FromBytes(rowsData.UserUID)
+		if err != nil {
+			result <- query.Result{Error: err}
+			close(result)
+
+			return
+		}
+
+		result <- query.Result{Result: storage.UserIdentity{
+			Issuer:      rowsData.Issuer,
+			Subject:     rowsData.Subject,
+			UserUID:     userUID,
+			CreatedDate: rowsData.CreatedDate,
+		}}
+		close(result)
+	}()
+
+	return result
+}
@@ -16,6 +16,10 @@ type UserAuth interface {
 	FindByUserID(userUID uuid.UUID) <-chan Result
 }
 
+type UserIdentity interface {
+	FindByIssuerAndSubject(issuer, subject string) <-chan Result
+}
+
 type Result struct {
 	Result interface{}
 	Error  error
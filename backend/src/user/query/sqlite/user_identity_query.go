@@ -0,0 +1,82 @@
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/usetania/tania-core/src/user/query"
+	"github.com/usetania/tania-core/src/user/storage"
+)
+
+type UserIdentityQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewUserIdentityQuerySqlite(db *sql.DB) query.UserIdentity {
+	return UserIdentityQuerySqlite{DB: db}
+}
+
+type userIdentityResult struct {
+	Issuer      string
+	Subject     string
+	UserUID     string
+	CreatedDate string
+}
+
+func (s UserIdentityQuerySqlite) FindByIssuerAndSubject(issuer, subject string) <-chan query.Result {
+	result := make(chan query.Result)
+
+	go func() {
+		rowsData := userIdentityResult{}
+
+		err := s.DB.QueryRow(`SELECT ISSUER, SUBJECT, USER_UID, CREATED_DATE
+			FROM USER_IDENTITY WHERE ISSUER = ? AND SUBJECT = ?`, issuer, subject).Scan(
+			&rowsData.Issuer,
+			&rowsData.Subject,
+			&rowsData.UserUID,
+			&rowsData.CreatedDate,
+		)
+
+		if errors.Is(err, sql.ErrNoRows) {
+			result <- query.Result{Result: storage.UserIdentity{}}
+			close(result)
+
+			return
+		}
+
+		if err != nil {
+			result <- query.Result{Error: err}
+			close(result)
+
+			return
+		}
+
+		userUID, err := uuid.FromString(rowsData.UserUID)
+		if err != nil {
+			result <- query.Result{Error: err}
+			close(result)
+
+			return
+		}
+
+		createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+		if err != nil {
+			result <- query.Result{Error: err}
+			close(result)
+
+			return
+		}
+
+		result <- query.Result{Result: storage.UserIdentity{
+			Issuer:      rowsData.Issuer,
+			Subject:     rowsData.Subject,
+			UserUID:     userUID,
+			CreatedDate: createdDate,
+		}}
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,39 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/usetania/tania-core/src/user/repository"
+	"github.com/usetania/tania-core/src/user/storage"
+)
+
+type UserIdentityRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewUserIdentityRepositoryMysql(db *sql.DB) repository.UserIdentity {
+	return &UserIdentityRepositoryMysql{DB: db}
+}
+
+func (s *UserIdentityRepositoryMysql) Save(userIdentity *storage.UserIdentity) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := s.DB.Exec(`INSERT INTO USER_IDENTITY
+			(ISSUER, SUBJECT, USER_UID, CREATED_DATE)
+			VALUES (?,?,?,?)`,
+			userIdentity.Issuer, userIdentity.Subject, userIdentity.UserUID.Bytes(),
+			userIdentity.CreatedDate)
+		if err != nil {
+			result <- err
+			close(result)
+
+			return
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
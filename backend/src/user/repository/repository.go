@@ -25,6 +25,14 @@ type UserAuth interface {
 	Save(userAuth *storage.UserAuth) <-chan error
 }
 
+type UserIdentity interface {
+	Save(userIdentity *storage.UserIdentity) <-chan error
+}
+
+type UserRole interface {
+	Save(userRole *storage.UserRole) <-chan error
+}
+
 func NewUserFromHistory(events []storage.UserEvent) *domain.User {
 	state := &domain.User{}
 	for _, v := range events {
@@ -0,0 +1,40 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/usetania/tania-core/src/user/repository"
+	"github.com/usetania/tania-core/src/user/storage"
+)
+
+type UserIdentityRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewUserIdentityRepositorySqlite(db *sql.DB) repository.UserIdentity {
+	return &UserIdentityRepositorySqlite{DB: db}
+}
+
+func (s *UserIdentityRepositorySqlite) Save(userIdentity *storage.UserIdentity) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := s.DB.Exec(`INSERT INTO USER_IDENTITY
+			(ISSUER, SUBJECT, USER_UID, CREATED_DATE)
+			VALUES (?,?,?,?)`,
+			userIdentity.Issuer, userIdentity.Subject, userIdentity.UserUID,
+			userIdentity.CreatedDate.Format(time.RFC3339))
+		if err != nil {
+			result <- err
+			close(result)
+
+			return
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
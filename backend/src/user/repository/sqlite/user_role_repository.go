@@ -0,0 +1,46 @@
+package sqlite
+
+import (
+	"database/sql"
+
+	"github.com/usetania/tania-core/src/user/repository"
+	"github.com/usetania/tania-core/src/user/storage"
+)
+
+type UserRoleRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewUserRoleRepositorySqlite(db *sql.DB) repository.UserRole {
+	return &UserRoleRepositorySqlite{DB: db}
+}
+
+// Save replaces the roles of the user.
+func (s *UserRoleRepositorySqlite) Save(userRole *storage.UserRole) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := s.DB.Exec(`DELETE FROM USER_READ_ROLE WHERE USER_UID = ?`, userRole.UserUID)
+		if err != nil {
+			result <- err
+			close(result)
+
+			return
+		}
+
+		for _, v := range userRole.Roles {
+			_, err = s.DB.Exec(`INSERT INTO USER_READ_ROLE (USER_UID, ROLE) VALUES (?, ?)`, userRole.UserUID, v)
+			if err != nil {
+				result <- err
+				close(result)
+
+				return
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,288 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/usetania/tania-core/config"
+	"github.com/usetania/tania-core/src/user/domain"
+	"github.com/usetania/tania-core/src/user/oidc"
+	"github.com/usetania/tania-core/src/user/repository"
+	"github.com/usetania/tania-core/src/user/storage"
+)
+
+const oidcLoginTimeout = 10 * time.Minute
+
+// oidcLogin keeps the client request while the user logs in at the identity provider.
+type oidcLogin struct {
+	RedirectURI string
+	State       string
+	Nonce       string
+	ExpiresAt   time.Time
+}
+
+// OIDCLogin redirects the user to the identity provider. It accepts the same
+// client parameters as Authorize, so the frontend gets the access token the same way.
+func (s *AuthServer) OIDCLogin(c echo.Context) error {
+	provider, err := s.getOIDCProvider(c.Request().Context())
+	if err != nil {
+		return Error(c, err)
+	}
+
+	redirectURI, err := validateClient(
+		c.QueryParam("client_id"),
+		c.QueryParam("redirect_uri"),
+		c.QueryParam("response_type"),
+	)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	state, err := randomString()
+	if err != nil {
+		return Error(c, err)
+	}
+
+	nonce, err := randomString()
+	if err != nil {
+		return Error(c, err)
+	}
+
+	s.oidcLock.Lock()
+
+	for k, v := range s.oidcLogins {
+		if time.Now().After(v.ExpiresAt) {
+			delete(s.oidcLogins, k)
+		}
+	}
+
+	s.oidcLogins[state] = oidcLogin{
+		RedirectURI: redirectURI,
+		State:       c.QueryParam("state"),
+		Nonce:       nonce,
+		ExpiresAt:   time.Now().Add(oidcLoginTimeout),
+	}
+
+	s.oidcLock.Unlock()
+
+	scopes := []string{}
+	for _, v := range config.Config.OIDCScopes {
+		scopes = append(scopes, *v)
+	}
+
+	return c.Redirect(http.StatusFound, provider.AuthCodeURL(
+		*config.Config.OIDCClientID,
+		*config.Config.OIDCCallbackURI,
+		state,
+		nonce,
+		scopes,
+	))
+}
+
+// OIDCCallback handles the redirect from the identity provider.
+// Users logging in for the first time are provisioned, and their roles are synced from their groups on every login.
+func (s *AuthServer) OIDCCallback(c echo.Context) error {
+	provider, err := s.getOIDCProvider(c.Request().Context())
+	if err != nil {
+		return Error(c, err)
+	}
+
+	if c.QueryParam("error") != "" {
+		return Error(c, errors.New(c.QueryParam("error")+": "+c.QueryParam("error_description")))
+	}
+
+	s.oidcLock.Lock()
+	login, ok := s.oidcLogins[c.QueryParam("state")]
+	delete(s.oidcLogins, c.QueryParam("state"))
+	s.oidcLock.Unlock()
+
+	if !ok || time.Now().After(login.ExpiresAt) {
+		return Error(c, NewRequestValidationError(Invalid, "state"))
+	}
+
+	if c.QueryParam("code") == "" {
+		return Error(c, NewRequestValidationError(Required, "code"))
+	}
+
+	token, err := provider.Exchange(
+		c.Request().Context(),
+		*config.Config.OIDCClientID,
+		*config.Config.OIDCClientSecret,
+		c.QueryParam("code"),
+		*config.Config.OIDCCallbackURI,
+	)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	claims, err := provider.VerifyIDToken(
+		c.Request().Context(),
+		token.IDToken,
+		*config.Config.OIDCClientID,
+		login.Nonce,
+		*config.Config.OIDCGroupsClaim,
+	)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	roles, err := domain.MapGroupsToRoles(claims.Groups, config.Config.OIDCRoleMapping, *config.Config.OIDCDefaultRole)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	userAuth, err := s.loginExternalIdentity(claims, roles)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.redirectWithAccessToken(c, userAuth, login.RedirectURI, login.State)
+}
+
+// loginExternalIdentity finds the user linked to the identity, or provisions a new one,
+// and updates the user roles.
+func (s *AuthServer) loginExternalIdentity(claims oidc.Claims, roles []string) (storage.UserAuth, error) {
+	queryResult := <-s.UserIdentityQuery.FindByIssuerAndSubject(claims.Issuer, claims.Subject)
+	if queryResult.Error != nil {
+		return storage.UserAuth{}, queryResult.Error
+	}
+
+	identity, ok := queryResult.Result.(storage.UserIdentity)
+	if !ok {
+		return storage.UserAuth{}, errors.New("error type assertion")
+	}
+
+	if identity.UserUID == (uuid.UUID{}) {
+		return s.provisionExternalUser(claims, roles)
+	}
+
+	queryResult = <-s.UserEventQuery.FindAllByID(identity.UserUID)
+	if queryResult.Error != nil {
+		return storage.UserAuth{}, queryResult.Error
+	}
+
+	events, ok := queryResult.Result.([]storage.UserEvent)
+	if !ok {
+		return storage.UserAuth{}, errors.New("error type assertion")
+	}
+
+	user := repository.NewUserFromHistory(events)
+
+	err := user.ChangeRoles(roles)
+	if err != nil {
+		return storage.UserAuth{}, err
+	}
+
+	err = <-s.UserEventRepo.Save(user.UID, user.Version, user.UncommittedChanges)
+	if err != nil {
+		return storage.UserAuth{}, err
+	}
+
+	s.publishUncommittedEvents(user)
+
+	queryResult = <-s.UserAuthQuery.FindByUserID(user.UID)
+	if queryResult.Error != nil {
+		return storage.UserAuth{}, queryResult.Error
+	}
+
+	userAuth, ok := queryResult.Result.(storage.UserAuth)
+	if !ok {
+		return storage.UserAuth{}, errors.New("error type assertion")
+	}
+
+	return userAuth, nil
+}
+
+func (s *AuthServer) provisionExternalUser(claims oidc.Claims, roles []string) (storage.UserAuth, error) {
+	username := claims.PreferredUsername
+	if username == "" {
+		username = claims.Email
+	}
+
+	if username == "" {
+		username = claims.Subject
+	}
+
+	user, err := domain.CreateUserFromExternalIdentity(s.UserService, username, claims.Issuer, claims.Subject, roles)
+	if err != nil {
+		return storage.UserAuth{}, err
+	}
+
+	err = <-s.UserEventRepo.Save(user.UID, user.Version, user.UncommittedChanges)
+	if err != nil {
+		return storage.UserAuth{}, err
+	}
+
+	userAuth := storage.UserAuth{
+		UserUID:     user.UID,
+		CreatedDate: user.CreatedDate,
+		LastUpdated: user.LastUpdated,
+	}
+
+	err = <-s.UserAuthRepo.Save(&userAuth)
+	if err != nil {
+		return storage.UserAuth{}, err
+	}
+
+	err = <-s.UserIdentityRepo.Save(&storage.UserIdentity{
+		Issuer:      claims.Issuer,
+		Subject:     claims.Subject,
+		UserUID:     user.UID,
+		CreatedDate: user.CreatedDate,
+	})
+	if err != nil {
+		return storage.UserAuth{}, err
+	}
+
+	s.publishUncommittedEvents(user)
+
+	return userAuth, nil
+}
+
+// getOIDCProvider reads the discovery document on the first use,
+// so Tania still starts when the identity provider is unreachable.
+func (s *AuthServer) getOIDCProvider(ctx context.Context) (*oidc.Provider, error) {
+	if config.Config.OIDCDiscoveryURL == nil || *config.Config.OIDCDiscoveryURL == "" {
+		return nil, errors.New("OpenID Connect login is not configured")
+	}
+
+	s.oidcLock.Lock()
+	provider := s.oidcProvider
+	s.oidcLock.Unlock()
+
+	if provider != nil {
+		return provider, nil
+	}
+
+	// Discovery runs without the lock, so an unreachable identity provider doesn't hold up the logins in progress.
+	provider, err := oidc.Discover(ctx, &http.Client{Timeout: 10 * time.Second}, *config.Config.OIDCDiscoveryURL)
+	if err != nil {
+		return nil, err
+	}
+
+	s.oidcLock.Lock()
+	defer s.oidcLock.Unlock()
+
+	if s.oidcProvider == nil {
+		s.oidcProvider = provider
+	}
+
+	return s.oidcProvider, nil
+}
+
+func randomString() (string, error) {
+	b := make([]byte, 16)
+
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"sync"
 
 	"github.com/gofrs/uuid"
 	"github.com/labstack/echo/v4"
@@ -14,6 +15,7 @@ import (
 	"github.com/usetania/tania-core/src/helper/structhelper"
 	"github.com/usetania/tania-core/src/user/domain"
 	"github.com/usetania/tania-core/src/user/domain/service"
+	"github.com/usetania/tania-core/src/user/oidc"
 	"github.com/usetania/tania-core/src/user/query"
 	queryMysql "github.com/usetania/tania-core/src/user/query/mysql"
 	querySqlite "github.com/usetania/tania-core/src/user/query/sqlite"
@@ -33,6 +35,14 @@ type AuthServer struct {
 	UserAuthQuery  query.UserAuth
 	UserService    domain.UserService
 	EventBus       eventbus.TaniaEventBus
+
+	UserIdentityRepo  repository.UserIdentity
+	UserIdentityQuery query.UserIdentity
+	UserRoleRepo      repository.UserRole
+
+	oidcProvider *oidc.Provider
+	oidcLogins   map[string]oidcLogin
+	oidcLock     sync.Mutex
 }
 
 // NewAuthServer initializes AuthServer's dependencies and create new AuthServer struct.
@@ -41,7 +51,8 @@ func NewAuthServer(
 	eventBus eventbus.TaniaEventBus,
 ) (*AuthServer, error) {
 	authServer := &AuthServer{
-		EventBus: eventBus,
+		EventBus:   eventBus,
+		oidcLogins: make(map[string]oidcLogin),
 	}
 
 	switch *config.Config.TaniaPersistenceEngine {
@@ -54,6 +65,10 @@ func NewAuthServer(
 		authServer.UserAuthRepo = repoSqlite.NewUserAuthRepositorySqlite(db)
 		authServer.UserAuthQuery = querySqlite.NewUserAuthQuerySqlite(db)
 
+		authServer.UserIdentityRepo = repoSqlite.NewUserIdentityRepositorySqlite(db)
+		authServer.UserIdentityQuery = querySqlite.NewUserIdentityQuerySqlite(db)
+		authServer.UserRoleRepo = repoSqlite.NewUserRoleRepositorySqlite(db)
+
 		authServer.UserService = service.UserServiceImpl{UserReadQuery: authServer.UserReadQuery}
 
 	case config.DBMysql:
@@ -65,6 +80,10 @@ func NewAuthServer(
 		authServer.UserAuthRepo = repoMysql.NewUserAuthRepositoryMysql(db)
 		authServer.UserAuthQuery = queryMysql.NewUserAuthQueryMysql(db)
 
+		authServer.UserIdentityRepo = repoMysql.NewUserIdentityRepositoryMysql(db)
+		authServer.UserIdentityQuery = queryMysql.NewUserIdentityQueryMysql(db)
+		authServer.UserRoleRepo = repoMysql.NewUserRoleRepositoryMysql(db)
+
 		authServer.UserService = service.UserServiceImpl{UserReadQuery: authServer.UserReadQuery}
 	}
 
@@ -76,19 +95,18 @@ func NewAuthServer(
 // InitSubscriber defines the mapping of which event this domain listen with their handler.
 func (s *AuthServer) InitSubscriber() {
 	s.EventBus.Subscribe("UserCreated", s.SaveToUserReadModel)
+	s.EventBus.Subscribe("UserRolesChanged", s.SaveToUserRoleReadModel)
 }
 
 // Mount defines the AuthServer's endpoints with its handlers.
 func (s *AuthServer) Mount(g *echo.Group) {
 	g.POST("authorize", s.Authorize)
 	g.POST("register", s.Register)
+	g.GET("oidc/login", s.OIDCLogin)
+	g.GET("oidc/callback", s.OIDCCallback)
 }
 
 func (s *AuthServer) Authorize(c echo.Context) error {
-	responseType := "token"
-	redirectURI := config.Config.RedirectURI
-	clientID := *config.Config.ClientID
-
 	reqUsername := c.FormValue("username")
 	reqPassword := c.FormValue("password")
 	reqClientID := c.FormValue("client_id")
@@ -120,39 +138,26 @@ func (s *AuthServer) Authorize(c echo.Context) error {
 		return Error(c, NewRequestValidationError(Invalid, "username"))
 	}
 
-	if reqClientID != clientID {
-		return Error(c, NewRequestValidationError(Invalid, "client_id"))
-	}
-
-	if reqRedirectURI == "" {
-		return Error(c, NewRequestValidationError(Required, "redirect_uri"))
-	}
-
-	var err error
-
-	reqRedirectURI, err = url.PathUnescape(reqRedirectURI)
+	selectedRedirectURI, err := validateClient(reqClientID, reqRedirectURI, reqResponseType)
 	if err != nil {
 		return Error(c, err)
 	}
 
-	selectedRedirectURI := ""
-
-	for _, v := range redirectURI {
-		if reqRedirectURI == *v {
-			selectedRedirectURI = *v
-
-			break
-		}
-	}
-
-	if selectedRedirectURI == "" {
-		return Error(c, NewRequestValidationError(Invalid, "redirect_uri"))
+	err = s.verifyTwoFactor(userRead, c.FormValue("otp_code"))
+	if err != nil {
+		return Error(c, err)
 	}
 
-	if reqResponseType != responseType {
-		return Error(c, NewRequestValidationError(Invalid, "response_type"))
-	}
+	return s.redirectWithAccessToken(c, userAuth, selectedRedirectURI, reqState)
+}
 
+// redirectWithAccessToken generates a new access token for the user
+// and redirects back to the client as the OAuth2 implicit grant does.
+func (s *AuthServer) redirectWithAccessToken(
+	c echo.Context,
+	userAuth storage.UserAuth,
+	redirectURI, state string,
+) error {
 	// Generate access token here
 	// We use uuid method temporarily until we find better method
 	uidAccessToken, err := uuid.NewV4()
@@ -174,11 +179,52 @@ func (s *AuthServer) Authorize(c echo.Context) error {
 		return Error(c, err)
 	}
 
-	selectedRedirectURI += "?" + "access_token=" + accessToken + "&state=" + reqState + "&expires_in=" + strconv.Itoa(expiresIn) //nolint:lll
+	redirectURI += "?" + "access_token=" + accessToken + "&state=" + state + "&expires_in=" + strconv.Itoa(expiresIn)
 
 	c.Response().Header().Set(echo.HeaderAuthorization, "Bearer "+accessToken)
 
-	return c.Redirect(302, selectedRedirectURI)
+	return c.Redirect(302, redirectURI)
+}
+
+// validateClient checks the OAuth2 implicit grant parameters against the configuration
+// and returns the matched redirect URI.
+func validateClient(reqClientID, reqRedirectURI, reqResponseType string) (string, error) {
+	responseType := "token"
+	redirectURI := config.Config.RedirectURI
+	clientID := *config.Config.ClientID
+
+	if reqClientID != clientID {
+		return "", NewRequestValidationError(Invalid, "client_id")
+	}
+
+	if reqRedirectURI == "" {
+		return "", NewRequestValidationError(Required, "redirect_uri")
+	}
+
+	reqRedirectURI, err := url.PathUnescape(reqRedirectURI)
+	if err != nil {
+		return "", err
+	}
+
+	selectedRedirectURI := ""
+
+	for _, v := range redirectURI {
+		if reqRedirectURI == *v {
+			selectedRedirectURI = *v
+
+			break
+		}
+	}
+
+	if selectedRedirectURI == "" {
+		return "", NewRequestValidationError(Invalid, "redirect_uri")
+	}
+
+	if reqResponseType != responseType {
+		return "", NewRequestValidationError(Invalid, "response_type")
+	}
+
+	return selectedRedirectURI, nil
 }
 
 func (s *AuthServer) Register(c echo.Context) error {
@@ -232,6 +278,40 @@ func (s *AuthServer) RegisterNewUser(
 	return user, &userAuth, nil
 }
 
+// verifyTwoFactor checks the second factor of users who have enabled two-factor authentication.
+// Users without two-factor authentication pass through without any code.
+func (s *AuthServer) verifyTwoFactor(userRead storage.UserRead, code string) error {
+	queryResult := <-s.UserEventQuery.FindAllByID(userRead.UID)
+	if queryResult.Error != nil {
+		return queryResult.Error
+	}
+
+	events, ok := queryResult.Result.([]storage.UserEvent)
+	if !ok {
+		return errors.New("error type assertion")
+	}
+
+	user := repository.NewUserFromHistory(events)
+
+	if !user.IsTwoFactorEnabled() {
+		return nil
+	}
+
+	err := user.VerifyTwoFactor(code)
+	if err != nil {
+		return err
+	}
+
+	err = <-s.UserEventRepo.Save(user.UID, user.Version, user.UncommittedChanges)
+	if err != nil {
+		return err
+	}
+
+	s.publishUncommittedEvents(user)
+
+	return nil
+}
+
 func (s *AuthServer) publishUncommittedEvents(entity interface{}) {
 	switch e := entity.(type) {
 	case *domain.User:
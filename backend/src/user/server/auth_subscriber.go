@@ -26,3 +26,21 @@ func (s *AuthServer) SaveToUserReadModel(event interface{}) error {
 
 	return nil
 }
+
+// SaveToUserRoleReadModel keeps the roles the API checks before changes.
+func (s *AuthServer) SaveToUserRoleReadModel(event interface{}) error {
+	e, ok := event.(domain.UserRolesChanged)
+	if !ok {
+		return nil
+	}
+
+	err := <-s.UserRoleRepo.Save(&storage.UserRole{
+		UserUID: e.UID,
+		Roles:   e.Roles,
+	})
+	if err != nil {
+		log.Println(err)
+	}
+
+	return nil
+}
@@ -14,3 +14,11 @@ func MapToUserRead(user *domain.User) storage.UserRead {
 
 	return userRead
 }
+
+type RecoveryCodes struct {
+	Codes []string `json:"recovery_codes"`
+}
+
+func MapToRecoveryCodes(codes []string) RecoveryCodes {
+	return RecoveryCodes{Codes: codes}
+}
@@ -79,6 +79,10 @@ func (s *UserServer) InitSubscriber() {
 // Mount defines the UserServer's endpoints with its handlers.
 func (s *UserServer) Mount(g *echo.Group) {
 	g.POST("/change_password", s.ChangePassword)
+	g.POST("/two_factor/enroll", s.EnrollTwoFactor)
+	g.POST("/two_factor/enable", s.EnableTwoFactor)
+	g.POST("/two_factor/disable", s.DisableTwoFactor)
+	g.POST("/two_factor/recovery_codes", s.RegenerateRecoveryCodes)
 }
 
 func (s *UserServer) ChangePassword(c echo.Context) error {
@@ -143,6 +147,166 @@ func (s *UserServer) ChangePassword(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
+func (s *UserServer) EnrollTwoFactor(c echo.Context) error {
+	user, err := s.findLoggedInUser(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	enrollment, err := user.EnrollTwoFactor()
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	err = <-s.UserEventRepo.Save(user.UID, user.Version, user.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Publish //
+	s.publishUncommittedEvents(user)
+
+	data := make(map[string]domain.TwoFactorEnrollment)
+	data["data"] = enrollment
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *UserServer) EnableTwoFactor(c echo.Context) error {
+	code := c.FormValue("code")
+	if code == "" {
+		return Error(c, NewRequestValidationError(Required, "code"))
+	}
+
+	user, err := s.findLoggedInUser(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	recoveryCodes, err := user.EnableTwoFactor(code)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	err = <-s.UserEventRepo.Save(user.UID, user.Version, user.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Publish //
+	s.publishUncommittedEvents(user)
+
+	data := make(map[string]RecoveryCodes)
+	data["data"] = MapToRecoveryCodes(recoveryCodes)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *UserServer) DisableTwoFactor(c echo.Context) error {
+	password := c.FormValue("password")
+	code := c.FormValue("code")
+
+	if password == "" {
+		return Error(c, NewRequestValidationError(Required, "password"))
+	}
+
+	if code == "" {
+		return Error(c, NewRequestValidationError(Required, "code"))
+	}
+
+	user, err := s.findLoggedInUser(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = user.DisableTwoFactor(password, code)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	err = <-s.UserEventRepo.Save(user.UID, user.Version, user.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Publish //
+	s.publishUncommittedEvents(user)
+
+	data := make(map[string]storage.UserRead)
+	data["data"] = MapToUserRead(user)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *UserServer) RegenerateRecoveryCodes(c echo.Context) error {
+	code := c.FormValue("code")
+	if code == "" {
+		return Error(c, NewRequestValidationError(Required, "code"))
+	}
+
+	user, err := s.findLoggedInUser(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	recoveryCodes, err := user.RegenerateRecoveryCodes(code)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	err = <-s.UserEventRepo.Save(user.UID, user.Version, user.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Publish //
+	s.publishUncommittedEvents(user)
+
+	data := make(map[string]RecoveryCodes)
+	data["data"] = MapToRecoveryCodes(recoveryCodes)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// findLoggedInUser rebuilds the user of the current request from its event history.
+// The token middleware is skipped in demo mode, so we fall back to the default `tania` user.
+func (s *UserServer) findLoggedInUser(c echo.Context) (*domain.User, error) {
+	userUID, ok := c.Get("USER_UID").(uuid.UUID)
+	if !ok {
+		queryResult := <-s.UserReadQuery.FindByUsername("tania")
+		if queryResult.Error != nil {
+			return nil, queryResult.Error
+		}
+
+		userRead, ok := queryResult.Result.(storage.UserRead)
+		if !ok {
+			return nil, errors.New("error type assertion")
+		}
+
+		userUID = userRead.UID
+	}
+
+	if userUID == (uuid.UUID{}) {
+		return nil, NewRequestValidationError(NotFound, "id")
+	}
+
+	eventQueryResult := <-s.UserEventQuery.FindAllByID(userUID)
+	if eventQueryResult.Error != nil {
+		return nil, eventQueryResult.Error
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.UserEvent)
+	if !ok {
+		return nil, errors.New("error type assertion")
+	}
+
+	return repository.NewUserFromHistory(events), nil
+}
+
 func (s *UserServer) publishUncommittedEvents(entity interface{}) {
 	switch e := entity.(type) {
 	case *domain.User:
@@ -28,3 +28,16 @@ type UserAuth struct {
 	CreatedDate  time.Time `json:"created_date"`
 	LastUpdated  time.Time `json:"last_updated"`
 }
+
+type UserIdentity struct {
+	Issuer      string    `json:"issuer"`
+	Subject     string    `json:"subject"`
+	UserUID     uuid.UUID `json:"user_uid"`
+	CreatedDate time.Time `json:"created_date"`
+}
+
+// UserRole is the roles of a user, which the API checks before changes.
+type UserRole struct {
+	UserUID uuid.UUID `json:"user_uid"`
+	Roles   []string  `json:"roles"`
+}